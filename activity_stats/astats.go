@@ -21,6 +21,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 	"github.com/mikeydub/go-gallery/service/pubsub/gcp"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/redis"
 	"github.com/mikeydub/go-gallery/service/rpc"
 	"github.com/mikeydub/go-gallery/service/task"
@@ -81,6 +82,7 @@ func setDefaults() {
 	viper.SetDefault("TASK_QUEUE_HOST", "localhost:8123")
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "[::1]:8085")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_REALTIME", "dev-realtime")
 	viper.SetDefault("GCLOUD_PUSH_NOTIFICATIONS_QUEUE", "projects/gallery-local/locations/here/queues/push-notifications")
 	viper.SetDefault("PUSH_NOTIFICATIONS_SECRET", "push-notifications-secret")
 	viper.SetDefault("PUSH_NOTIFICATIONS_URL", "http://localhost:8000")
@@ -130,7 +132,7 @@ func InitSentry() {
 
 func useEventHandler(q *coredb.Queries, p *pubsub.Client, t *task.Client, l *redislock.Client, n *farcaster.NeynarAPI) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		event.AddTo(c, false, notifications.New(q, p, t, l, false), q, t, n)
+		event.AddTo(c, false, notifications.New(q, p, t, l, false), realtime.New(p, false), q, t, n)
 		c.Next()
 	}
 }
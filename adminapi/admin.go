@@ -2,6 +2,7 @@ package adminapi
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"github.com/mikeydub/go-gallery/service/auth/basicauth"
 	"github.com/mikeydub/go-gallery/service/logger"
@@ -13,6 +14,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/tokenmanage"
 	"github.com/mikeydub/go-gallery/service/user"
 	"github.com/mikeydub/go-gallery/validate"
 )
@@ -21,12 +23,14 @@ type AdminAPI struct {
 	repos            *postgres.Repositories
 	queries          *db.Queries
 	authRefreshCache *redis.Cache
+	suspensionCache  *redis.Cache
 	validator        *validator.Validate
 	multichain       *multichain.Provider
+	submitter        tokenmanage.Submitter
 }
 
-func NewAPI(repos *postgres.Repositories, queries *db.Queries, authRefreshCache *redis.Cache, validator *validator.Validate, mp *multichain.Provider) *AdminAPI {
-	return &AdminAPI{repos, queries, authRefreshCache, validator, mp}
+func NewAPI(repos *postgres.Repositories, queries *db.Queries, authRefreshCache, suspensionCache *redis.Cache, validator *validator.Validate, mp *multichain.Provider, submitter tokenmanage.Submitter) *AdminAPI {
+	return &AdminAPI{repos, queries, authRefreshCache, suspensionCache, validator, mp, submitter}
 }
 
 func (api *AdminAPI) AddRolesToUser(ctx context.Context, username string, roles []*persist.Role) (*db.User, error) {
@@ -188,3 +192,56 @@ func (api *AdminAPI) RemoveContractOverrideCreator(ctx context.Context, contract
 
 	return api.queries.RemoveContractOverrideCreator(ctx, contractID)
 }
+
+const (
+	defaultTokenProcessingFailuresLimit = 100
+	maxTokenProcessingFailuresLimit     = 1000
+)
+
+// GetTokenProcessingFailures returns tokens that were dead-lettered after running out of processing retries, most recent first.
+// Failures can be filtered by chain, contract and error class.
+func (api *AdminAPI) GetTokenProcessingFailures(ctx context.Context, chain *persist.Chain, contractAddress *persist.Address, errorClass *string, limit *int) ([]db.GetDeadLetteredTokenProcessingFailuresRow, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"limit": validate.WithTag(limit, fmt.Sprintf("omitempty,gte=1,lte=%d", maxTokenProcessingFailuresLimit)),
+	}); err != nil {
+		return nil, err
+	}
+
+	params := db.GetDeadLetteredTokenProcessingFailuresParams{Limit: defaultTokenProcessingFailuresLimit}
+	if chain != nil {
+		params.Chain = sql.NullInt32{Int32: int32(*chain), Valid: true}
+	}
+	if contractAddress != nil {
+		params.ContractAddress = sql.NullString{String: contractAddress.String(), Valid: true}
+	}
+	if errorClass != nil {
+		params.ErrorClass = sql.NullString{String: *errorClass, Valid: true}
+	}
+	if limit != nil {
+		params.Limit = int32(*limit)
+	}
+
+	return api.queries.GetDeadLetteredTokenProcessingFailures(ctx, params)
+}
+
+// ReplayTokenProcessingFailures resubmits dead-lettered tokens that match the filters for processing. It returns the failures that were replayed.
+func (api *AdminAPI) ReplayTokenProcessingFailures(ctx context.Context, chain *persist.Chain, contractAddress *persist.Address, errorClass *string, limit *int) ([]db.GetDeadLetteredTokenProcessingFailuresRow, error) {
+	rows, err := api.GetTokenProcessingFailures(ctx, chain, contractAddress, errorClass, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	failures := make([]db.TokenProcessingFailure, len(rows))
+	for i, row := range rows {
+		failures[i] = row.TokenProcessingFailure
+	}
+
+	err = tokenmanage.ReplayFailures(ctx, api.queries, api.submitter, failures)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
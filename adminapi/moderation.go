@@ -0,0 +1,404 @@
+package adminapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/redis"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+const (
+	defaultModerationLimit = 100
+	maxModerationLimit     = 1000
+
+	// autoHideReporterThreshold is the number of distinct users that must report a piece of content
+	// before it is hidden without waiting for a moderator
+	autoHideReporterThreshold = 5
+
+	// suspensionCacheTTL bounds how long a user's suspension status is cached. Moderating a user clears the
+	// cached status, and cached suspensions are ignored once they expire.
+	suspensionCacheTTL = 10 * time.Minute
+)
+
+var ErrModerationActionNotRevocable = errors.New("moderation action does not exist, was already revoked, or is not a hide, suspension or ban")
+
+// GetModerationQueue returns content with unresolved reports, ordered by the number of distinct reporters.
+func (api *AdminAPI) GetModerationQueue(ctx context.Context, subjectType *persist.ReportSubjectType, limit *int) ([]db.GetModerationQueueRow, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"limit": validate.WithTag(limit, fmt.Sprintf("omitempty,gte=1,lte=%d", maxModerationLimit)),
+	}); err != nil {
+		return nil, err
+	}
+
+	params := db.GetModerationQueueParams{Limit: defaultModerationLimit}
+	if subjectType != nil {
+		params.SubjectType = sql.NullString{String: string(*subjectType), Valid: true}
+	}
+	if limit != nil {
+		params.Limit = int32(*limit)
+	}
+
+	return api.queries.GetModerationQueue(ctx, params)
+}
+
+// GetModerationActions returns the audit trail of moderation actions, most recent first.
+func (api *AdminAPI) GetModerationActions(ctx context.Context, subjectType *persist.ReportSubjectType, subjectID *persist.DBID, userID *persist.DBID, limit *int) ([]db.ModerationAction, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"limit": validate.WithTag(limit, fmt.Sprintf("omitempty,gte=1,lte=%d", maxModerationLimit)),
+	}); err != nil {
+		return nil, err
+	}
+
+	params := db.GetModerationActionsParams{Limit: defaultModerationLimit}
+	if subjectType != nil {
+		params.SubjectType = sql.NullString{String: string(*subjectType), Valid: true}
+	}
+	if subjectID != nil {
+		params.SubjectID = sql.NullString{String: subjectID.String(), Valid: true}
+	}
+	if userID != nil {
+		params.UserID = sql.NullString{String: userID.String(), Valid: true}
+	}
+	if limit != nil {
+		params.Limit = int32(*limit)
+	}
+
+	return api.queries.GetModerationActions(ctx, params)
+}
+
+// Moderate applies an action to reported content or a user, resolves any open reports against it and records
+// the action in the audit trail.
+func (api *AdminAPI) Moderate(ctx context.Context, subjectType persist.ReportSubjectType, subjectID persist.DBID, action persist.ModerationActionType, reason *persist.ReportReason, note *string, moderator *string, suspendDays *int) (*db.ModerationAction, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"subjectType": validate.WithTag(subjectType, "required"),
+		"subjectID":   validate.WithTag(subjectID, "required"),
+		"action":      validate.WithTag(action, "required"),
+		"suspendDays": validate.WithTag(suspendDays, "omitempty,gte=1"),
+	}); err != nil {
+		return nil, err
+	}
+
+	if action == persist.ModerationActionSuspend && suspendDays == nil {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"suspendDays"}, Reasons: []string{"suspendDays is required to suspend a user"}}
+	}
+
+	userID, err := authorOfSubject(ctx, api.queries, subjectType, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	moderationReason := persist.ReportReason(persist.ReportReasonSomethingElse)
+	if reason != nil {
+		moderationReason = *reason
+	}
+
+	params := db.InsertModerationActionParams{
+		ID:          persist.GenerateID(),
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		UserID:      userID,
+		Action:      action,
+		Reason:      util.ToNullString(string(moderationReason), true),
+		Note:        util.ToNullStringEmptyNull(util.FromPointer(note)),
+		Moderator:   util.ToNullStringEmptyNull(util.FromPointer(moderator)),
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := api.queries.WithTx(tx)
+
+	switch action {
+	case persist.ModerationActionHide:
+		err = setSubjectHidden(ctx, q, subjectType, subjectID, true)
+	case persist.ModerationActionSuspend:
+		params.ExpiresAt = sql.NullTime{Time: time.Now().AddDate(0, 0, *suspendDays), Valid: true}
+	case persist.ModerationActionBan:
+		err = q.BlockUserFromFeed(ctx, db.BlockUserFromFeedParams{
+			ID:     persist.GenerateID(),
+			UserID: userID,
+			Reason: moderationReason,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.ResolveReportsBySubject(ctx, db.ResolveReportsBySubjectParams{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moderationAction, err := q.InsertModerationAction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if action == persist.ModerationActionSuspend || action == persist.ModerationActionBan {
+		clearCachedSuspension(ctx, api.suspensionCache, userID)
+	}
+
+	return &moderationAction, nil
+}
+
+// RevokeModerationAction shows hidden content again, or lifts a suspension or ban.
+func (api *AdminAPI) RevokeModerationAction(ctx context.Context, actionID persist.DBID) (*db.ModerationAction, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"actionID": validate.WithTag(actionID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := api.queries.WithTx(tx)
+
+	action, err := q.RevokeModerationAction(ctx, actionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrModerationActionNotRevocable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch action.Action {
+	case persist.ModerationActionHide:
+		// The subject stays hidden if it was hidden more than once and another hide is still in effect
+		hidden, err := q.IsSubjectHidden(ctx, db.IsSubjectHiddenParams{
+			SubjectType: action.SubjectType,
+			SubjectID:   action.SubjectID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !hidden {
+			err = setSubjectHidden(ctx, q, action.SubjectType, action.SubjectID, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case persist.ModerationActionBan:
+		// A hidden user stays out of the feed even after their ban is lifted
+		hidden, err := q.IsSubjectHidden(ctx, db.IsSubjectHiddenParams{
+			SubjectType: persist.ReportSubjectTypeUser,
+			SubjectID:   action.UserID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !hidden {
+			err = q.UnblockUserFromFeed(ctx, action.UserID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if action.Action == persist.ModerationActionSuspend || action.Action == persist.ModerationActionBan {
+		clearCachedSuspension(ctx, api.suspensionCache, action.UserID)
+	}
+
+	return &action, nil
+}
+
+// GetActiveSuspension returns the suspension or ban currently in effect for a user, or nil if they aren't
+// suspended. Every mutation checks this, so the result is cached.
+func GetActiveSuspension(ctx context.Context, queries *db.Queries, cache *redis.Cache, userID persist.DBID) (*db.ModerationAction, error) {
+	cached, err := cache.Get(ctx, userID.String())
+	if err == nil {
+		var suspension *db.ModerationAction
+		err = json.Unmarshal(cached, &suspension)
+		if err == nil && (suspension == nil || !suspension.ExpiresAt.Valid || suspension.ExpiresAt.Time.After(time.Now())) {
+			return suspension, nil
+		}
+	}
+	if err != nil && !util.ErrorIs[redis.ErrKeyNotFound](err) {
+		logger.For(ctx).Errorf("error reading cached suspension for user %s: %s", userID, err)
+	}
+
+	var suspension *db.ModerationAction
+	active, err := queries.GetActiveSuspensionByUserID(ctx, userID)
+	if err == nil {
+		suspension = &active
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	b, err := json.Marshal(suspension)
+	if err == nil {
+		err = cache.Set(ctx, userID.String(), b, suspensionCacheTTL)
+	}
+	if err != nil {
+		logger.For(ctx).Errorf("error caching suspension for user %s: %s", userID, err)
+	}
+
+	return suspension, nil
+}
+
+func clearCachedSuspension(ctx context.Context, cache *redis.Cache, userID persist.DBID) {
+	// The cache entry expires on its own, so a failure here only delays the change
+	if err := cache.Delete(ctx, userID.String()); err != nil {
+		logger.For(ctx).Errorf("error clearing cached suspension for user %s: %s", userID, err)
+	}
+}
+
+// AutoHideReportedContent hides content once enough distinct users have reported it. It is a no-op if the
+// content is below the threshold or has already been hidden. Hidden content can be shown again by revoking the hide.
+func AutoHideReportedContent(ctx context.Context, repos *postgres.Repositories, queries *db.Queries, subjectType persist.ReportSubjectType, subjectID persist.DBID) error {
+	// Users are only ever suspended or banned by a moderator
+	if subjectType == persist.ReportSubjectTypeUser {
+		return nil
+	}
+
+	reporters, err := queries.CountUnresolvedReportersBySubject(ctx, db.CountUnresolvedReportersBySubjectParams{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if reporters < autoHideReporterThreshold {
+		return nil
+	}
+
+	hidden, err := queries.IsSubjectHidden(ctx, db.IsSubjectHiddenParams{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	})
+	if err != nil || hidden {
+		return err
+	}
+
+	userID, err := authorOfSubject(ctx, queries, subjectType, subjectID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := queries.WithTx(tx)
+
+	err = setSubjectHidden(ctx, q, subjectType, subjectID, true)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.InsertModerationAction(ctx, db.InsertModerationActionParams{
+		ID:          persist.GenerateID(),
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		UserID:      userID,
+		Action:      persist.ModerationActionHide,
+		Note:        util.ToNullString(fmt.Sprintf("reported by %d users", reporters), true),
+		Automated:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.For(ctx).Infof("auto-hid %s %s after reports from %d users", subjectType, subjectID, reporters)
+
+	return nil
+}
+
+func authorOfSubject(ctx context.Context, queries *db.Queries, subjectType persist.ReportSubjectType, subjectID persist.DBID) (persist.DBID, error) {
+	switch subjectType {
+	case persist.ReportSubjectTypePost:
+		post, err := queries.GetPostByID(ctx, subjectID)
+		return post.ActorID, err
+	case persist.ReportSubjectTypeComment:
+		comment, err := queries.GetCommentByCommentID(ctx, subjectID)
+		return comment.ActorID, err
+	case persist.ReportSubjectTypeGallery:
+		gallery, err := queries.GetGalleryById(ctx, subjectID)
+		return gallery.OwnerUserID, err
+	case persist.ReportSubjectTypeUser:
+		user, err := queries.GetUserById(ctx, subjectID)
+		return user.ID, err
+	}
+	return "", fmt.Errorf("unknown report subject type: %s", subjectType)
+}
+
+// setSubjectHidden hides or shows content without changing it, so that a hide can be revoked
+func setSubjectHidden(ctx context.Context, queries *db.Queries, subjectType persist.ReportSubjectType, subjectID persist.DBID, hidden bool) error {
+	switch subjectType {
+	case persist.ReportSubjectTypePost:
+		return queries.SetPostModerationHidden(ctx, db.SetPostModerationHiddenParams{ID: subjectID, Hidden: hidden})
+	case persist.ReportSubjectTypeComment:
+		return queries.SetCommentModerationHidden(ctx, db.SetCommentModerationHiddenParams{ID: subjectID, Hidden: hidden})
+	case persist.ReportSubjectTypeGallery:
+		return queries.SetGalleryModerationHidden(ctx, db.SetGalleryModerationHiddenParams{ID: subjectID, Hidden: hidden})
+	case persist.ReportSubjectTypeUser:
+		if !hidden {
+			return unblockUnlessBanned(ctx, queries, subjectID)
+		}
+		return queries.BlockUserFromFeed(ctx, db.BlockUserFromFeedParams{
+			ID:     persist.GenerateID(),
+			UserID: subjectID,
+			Reason: persist.ReportReasonSomethingElse,
+		})
+	}
+	return fmt.Errorf("unknown report subject type: %s", subjectType)
+}
+
+// unblockUnlessBanned returns a user to the feed, unless they're also banned
+func unblockUnlessBanned(ctx context.Context, queries *db.Queries, userID persist.DBID) error {
+	suspension, err := queries.GetActiveSuspensionByUserID(ctx, userID)
+	if err == nil && suspension.Action == persist.ModerationActionBan {
+		return nil
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	return queries.UnblockUserFromFeed(ctx, userID)
+}
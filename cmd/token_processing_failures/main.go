@@ -0,0 +1,140 @@
+// Lists tokens that were dead-lettered after running out of processing retries, and replays them through tokenprocessing.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/redis"
+	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/mikeydub/go-gallery/service/tokenmanage"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+var (
+	envVar     string
+	chain      int
+	contract   string
+	errorClass string
+	limit      int
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "token_processing_failures",
+	Short: "Inspect and replay dead-lettered token processing failures",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if env.GetString("ENV") == "local" && envVar != "local" {
+			util.LoadEncryptedEnvFile(util.ResolveEnvFile("tokenprocessing", envVar))
+		}
+	},
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List dead-lettered tokens, most recent first",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		rows, err := db.New(postgres.NewPgxClient()).GetDeadLetteredTokenProcessingFailures(ctx, filterParams(cmd))
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTOKEN DEFINITION\tCHAIN\tCONTRACT\tCAUSE\tERROR CLASS\tATTEMPTS\tFAILED STEPS\tDEAD-LETTERED AT")
+		for _, row := range rows {
+			f := row.TokenProcessingFailure
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%d\t%s\t%s\n",
+				f.ID, f.TokenDefinitionID, f.Chain, f.ContractAddress, f.ProcessingCause, f.ErrorClass,
+				f.Attempts+1, strings.Join(row.PipelineMetadata.FailedSteps(), ","), f.DeadLetteredAt.Time.Format(time.RFC3339))
+		}
+		return w.Flush()
+	},
+}
+
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Resubmit dead-lettered tokens for processing",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		queries := db.New(postgres.NewPgxClient())
+
+		rows, err := queries.GetDeadLetteredTokenProcessingFailures(ctx, filterParams(cmd))
+		if err != nil {
+			return err
+		}
+
+		failures := make([]db.TokenProcessingFailure, len(rows))
+		for i, row := range rows {
+			failures[i] = row.TokenProcessingFailure
+		}
+
+		submitter := &tokenmanage.TokenProcessingSubmitter{
+			TaskClient: task.NewClient(ctx),
+			Registry:   &tokenmanage.Registry{Cache: redis.NewCache(redis.TokenManageCache)},
+		}
+
+		if err := tokenmanage.ReplayFailures(ctx, queries, submitter, failures); err != nil {
+			return err
+		}
+
+		logger.For(ctx).Infof("replayed %d tokens", len(failures))
+		return nil
+	},
+}
+
+func filterParams(cmd *cobra.Command) db.GetDeadLetteredTokenProcessingFailuresParams {
+	params := db.GetDeadLetteredTokenProcessingFailuresParams{Limit: int32(limit)}
+	if cmd.Flags().Changed("chain") {
+		params.Chain = sql.NullInt32{Int32: int32(chain), Valid: true}
+	}
+	if contract != "" {
+		params.ContractAddress = sql.NullString{String: contract, Valid: true}
+	}
+	if errorClass != "" {
+		params.ErrorClass = sql.NullString{String: errorClass, Valid: true}
+	}
+	return params
+}
+
+func main() {
+	rootCmd.PersistentFlags().StringVarP(&envVar, "env", "e", "local", "env to run against")
+	rootCmd.PersistentFlags().IntVar(&chain, "chain", 0, "only include tokens on this chain")
+	rootCmd.PersistentFlags().StringVar(&contract, "contract", "", "only include tokens of this contract")
+	rootCmd.PersistentFlags().StringVar(&errorClass, "error-class", "", "only include tokens that failed with this class of error")
+	rootCmd.PersistentFlags().IntVar(&limit, "limit", 100, "max number of tokens to include")
+	rootCmd.AddCommand(listCmd, replayCmd)
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	setDefaults()
+	logger.InitWithGCPDefaults()
+}
+
+func setDefaults() {
+	viper.SetDefault("ENV", "local")
+	viper.SetDefault("POSTGRES_HOST", "0.0.0.0")
+	viper.SetDefault("POSTGRES_PORT", 5432)
+	viper.SetDefault("POSTGRES_USER", "gallery_backend")
+	viper.SetDefault("POSTGRES_PASSWORD", "")
+	viper.SetDefault("POSTGRES_DB", "postgres")
+	viper.SetDefault("REDIS_URL", "localhost:6379")
+	viper.SetDefault("TASK_QUEUE_HOST", "localhost:8123")
+	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
+	viper.SetDefault("TOKEN_PROCESSING_URL", "http://localhost:6500")
+	viper.AutomaticEnv()
+}
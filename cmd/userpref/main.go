@@ -60,8 +60,7 @@ func init() {
 }
 
 func upload(ctx context.Context, bucketName, objectName string) (int, error) {
-	s := rpc.NewStorageClient(ctx)
-	b := store.NewBucketStorer(s, bucketName)
+	b := store.NewBucketStorer(rpc.NewObjectStore(ctx), bucketName)
 	pgx := postgres.NewPgxClient()
 	q := db.New(pgx)
 
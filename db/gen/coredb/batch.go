@@ -200,7 +200,7 @@ func (b *CountAdmiresByTokenIDBatchBatchResults) Close() error {
 }
 
 const countCommentsByFeedEventIDBatch = `-- name: CountCommentsByFeedEventIDBatch :batchone
-SELECT count(*) FROM comments WHERE feed_event_id = $1 AND reply_to is null AND deleted = false
+SELECT count(*) FROM comments WHERE feed_event_id = $1 AND reply_to is null AND deleted = false AND moderation_hidden = false
 `
 
 type CountCommentsByFeedEventIDBatchBatchResults struct {
@@ -246,6 +246,8 @@ func (b *CountCommentsByFeedEventIDBatchBatchResults) Close() error {
 
 const countCommentsByPostIDBatch = `-- name: CountCommentsByPostIDBatch :batchone
 SELECT count(*) FROM comments WHERE post_id = $1 AND reply_to is null AND deleted = false
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = $2)
 `
 
 type CountCommentsByPostIDBatchBatchResults struct {
@@ -254,16 +256,22 @@ type CountCommentsByPostIDBatchBatchResults struct {
 	closed bool
 }
 
-func (q *Queries) CountCommentsByPostIDBatch(ctx context.Context, postID []persist.DBID) *CountCommentsByPostIDBatchBatchResults {
+type CountCommentsByPostIDBatchParams struct {
+	PostID   persist.DBID `db:"post_id" json:"post_id"`
+	ViewerID persist.DBID `db:"viewer_id" json:"viewer_id"`
+}
+
+func (q *Queries) CountCommentsByPostIDBatch(ctx context.Context, arg []CountCommentsByPostIDBatchParams) *CountCommentsByPostIDBatchBatchResults {
 	batch := &pgx.Batch{}
-	for _, a := range postID {
+	for _, a := range arg {
 		vals := []interface{}{
-			a,
+			a.PostID,
+			a.ViewerID,
 		}
 		batch.Queue(countCommentsByPostIDBatch, vals...)
 	}
 	br := q.db.SendBatch(ctx, batch)
-	return &CountCommentsByPostIDBatchBatchResults{br, len(postID), false}
+	return &CountCommentsByPostIDBatchBatchResults{br, len(arg), false}
 }
 
 func (b *CountCommentsByPostIDBatchBatchResults) QueryRow(f func(int, int64, error)) {
@@ -291,7 +299,7 @@ func (b *CountCommentsByPostIDBatchBatchResults) Close() error {
 
 const countGalleriesDisplayingCommunityIDBatch = `-- name: CountGalleriesDisplayingCommunityIDBatch :batchone
 select count(*) from community_galleries cg
-    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden
+    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden and not g.moderation_hidden
 where cg.community_id = $1
 `
 
@@ -339,7 +347,7 @@ func (b *CountGalleriesDisplayingCommunityIDBatchBatchResults) Close() error {
 const countInteractionsByFeedEventIDBatch = `-- name: CountInteractionsByFeedEventIDBatch :batchmany
 SELECT count(*), $1::int as tag FROM admires t WHERE $1 != 0 AND t.feed_event_id = $2 AND t.deleted = false
                                                         UNION
-SELECT count(*), $3::int as tag FROM comments t WHERE $3 != 0 AND t.feed_event_id = $2 AND t.reply_to is null AND t.deleted = false
+SELECT count(*), $3::int as tag FROM comments t WHERE $3 != 0 AND t.feed_event_id = $2 AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
 `
 
 type CountInteractionsByFeedEventIDBatchBatchResults struct {
@@ -412,7 +420,8 @@ func (b *CountInteractionsByFeedEventIDBatchBatchResults) Close() error {
 const countInteractionsByPostIDBatch = `-- name: CountInteractionsByPostIDBatch :batchmany
 SELECT count(*), $1::int as tag FROM admires t WHERE $1 != 0 AND t.post_id = $2 AND t.deleted = false
                                                         UNION
-SELECT count(*), $3::int as tag FROM comments t WHERE $3 != 0 AND t.post_id = $2 AND t.reply_to is null AND t.deleted = false
+SELECT count(*), $3::int as tag FROM comments t WHERE $3 != 0 AND t.post_id = $2 AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
+    AND (NOT t.hidden OR t.actor_id = $4)
 `
 
 type CountInteractionsByPostIDBatchBatchResults struct {
@@ -425,6 +434,7 @@ type CountInteractionsByPostIDBatchParams struct {
 	AdmireTag  int32        `db:"admire_tag" json:"admire_tag"`
 	PostID     persist.DBID `db:"post_id" json:"post_id"`
 	CommentTag int32        `db:"comment_tag" json:"comment_tag"`
+	ViewerID   persist.DBID `db:"viewer_id" json:"viewer_id"`
 }
 
 type CountInteractionsByPostIDBatchRow struct {
@@ -439,6 +449,7 @@ func (q *Queries) CountInteractionsByPostIDBatch(ctx context.Context, arg []Coun
 			a.AdmireTag,
 			a.PostID,
 			a.CommentTag,
+			a.ViewerID,
 		}
 		batch.Queue(countInteractionsByPostIDBatch, vals...)
 	}
@@ -491,6 +502,8 @@ WHERE
         ELSE c.reply_to = $1 
     END
     AND c.deleted = false
+    AND c.moderation_hidden = false
+    AND (NOT c.hidden OR c.actor_id = $2)
 `
 
 type CountRepliesByCommentIDBatchBatchResults struct {
@@ -499,16 +512,22 @@ type CountRepliesByCommentIDBatchBatchResults struct {
 	closed bool
 }
 
-func (q *Queries) CountRepliesByCommentIDBatch(ctx context.Context, commentID []persist.DBID) *CountRepliesByCommentIDBatchBatchResults {
+type CountRepliesByCommentIDBatchParams struct {
+	CommentID persist.DBID `db:"comment_id" json:"comment_id"`
+	ViewerID  persist.DBID `db:"viewer_id" json:"viewer_id"`
+}
+
+func (q *Queries) CountRepliesByCommentIDBatch(ctx context.Context, arg []CountRepliesByCommentIDBatchParams) *CountRepliesByCommentIDBatchBatchResults {
 	batch := &pgx.Batch{}
-	for _, a := range commentID {
+	for _, a := range arg {
 		vals := []interface{}{
-			a,
+			a.CommentID,
+			a.ViewerID,
 		}
 		batch.Queue(countRepliesByCommentIDBatch, vals...)
 	}
 	br := q.db.SendBatch(ctx, batch)
-	return &CountRepliesByCommentIDBatchBatchResults{br, len(commentID), false}
+	return &CountRepliesByCommentIDBatchBatchResults{br, len(arg), false}
 }
 
 func (b *CountRepliesByCommentIDBatchBatchResults) QueryRow(f func(int, int64, error)) {
@@ -534,6 +553,51 @@ func (b *CountRepliesByCommentIDBatchBatchResults) Close() error {
 	return b.br.Close()
 }
 
+const countRepostsByPostIDBatch = `-- name: CountRepostsByPostIDBatch :batchone
+select count(*) from reposts where post_id = $1 and not deleted
+`
+
+type CountRepostsByPostIDBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) CountRepostsByPostIDBatch(ctx context.Context, postID []persist.DBID) *CountRepostsByPostIDBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range postID {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(countRepostsByPostIDBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &CountRepostsByPostIDBatchBatchResults{br, len(postID), false}
+}
+
+func (b *CountRepostsByPostIDBatchBatchResults) QueryRow(f func(int, int64, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var count int64
+		if b.closed {
+			if f != nil {
+				f(t, count, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&count)
+		if f != nil {
+			f(t, count, err)
+		}
+	}
+}
+
+func (b *CountRepostsByPostIDBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const getAdmireByActorIDAndCommentID = `-- name: GetAdmireByActorIDAndCommentID :batchone
 SELECT id, version, feed_event_id, actor_id, deleted, created_at, last_updated, post_id, token_id, comment_id FROM admires WHERE actor_id = $1 AND comment_id = $2 AND deleted = false
 `
@@ -1144,7 +1208,7 @@ func (b *GetCollectionsByGalleryIdBatchBatchResults) Close() error {
 }
 
 const getCommentByCommentIDBatch = `-- name: GetCommentByCommentIDBatch :batchone
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id FROM comments WHERE id = $1 AND deleted = false
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden FROM comments WHERE id = $1 AND deleted = false AND moderation_hidden = false
 `
 
 type GetCommentByCommentIDBatchBatchResults struct {
@@ -1189,6 +1253,8 @@ func (b *GetCommentByCommentIDBatchBatchResults) QueryRow(f func(int, Comment, e
 			&i.PostID,
 			&i.Removed,
 			&i.TopLevelCommentID,
+			&i.Hidden,
+			&i.ModerationHidden,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -1526,6 +1592,57 @@ func (b *GetContractByChainAddressBatchBatchResults) Close() error {
 	return b.br.Close()
 }
 
+const getContractSpamScoreByContractIDBatch = `-- name: GetContractSpamScoreByContractIDBatch :batchone
+select contract_id, score, reasons, created_at, last_updated from contract_spam_scores where contract_id = $1
+`
+
+type GetContractSpamScoreByContractIDBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) GetContractSpamScoreByContractIDBatch(ctx context.Context, contractID []persist.DBID) *GetContractSpamScoreByContractIDBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range contractID {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(getContractSpamScoreByContractIDBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetContractSpamScoreByContractIDBatchBatchResults{br, len(contractID), false}
+}
+
+func (b *GetContractSpamScoreByContractIDBatchBatchResults) QueryRow(f func(int, ContractSpamScore, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i ContractSpamScore
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(
+			&i.ContractID,
+			&i.Score,
+			&i.Reasons,
+			&i.CreatedAt,
+			&i.LastUpdated,
+		)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetContractSpamScoreByContractIDBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const getContractsDisplayedByUserIDBatch = `-- name: GetContractsDisplayedByUserIDBatch :batchmany
 with last_refreshed as (
   select last_updated from owned_contracts limit 1
@@ -2049,7 +2166,7 @@ with community_data as (
     limit 1
 )
 
-(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd
+(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd
     join tokens t on t.contract_id = cd.contract_id
     join token_definitions td on t.token_definition_id = td.id
     join users u on u.id = t.owner_user_id
@@ -2065,7 +2182,7 @@ limit $2)
 
 union all
 
-(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd, tokens t
+(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd, tokens t
     join token_community_memberships tcm on t.token_definition_id = tcm.token_definition_id
     join token_definitions td on td.id = t.token_definition_id
     join users u on u.id = t.owner_user_id
@@ -2157,6 +2274,7 @@ func (b *GetFrameTokensByCommunityIDBatchResults) Query(f func(int, []GetFrameTo
 					&i.Token.TokenDefinitionID,
 					&i.Token.IsHolderToken,
 					&i.Token.Displayable,
+					&i.Token.AcquiredAt,
 					&i.TokenDefinition.ID,
 					&i.TokenDefinition.CreatedAt,
 					&i.TokenDefinition.LastUpdated,
@@ -2211,7 +2329,7 @@ func (b *GetFrameTokensByCommunityIDBatchResults) Close() error {
 }
 
 const getGalleriesByUserIdBatch = `-- name: GetGalleriesByUserIdBatch :batchmany
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden FROM galleries WHERE owner_user_id = $1 AND deleted = false AND moderation_hidden = false order by position
 `
 
 type GetGalleriesByUserIdBatchBatchResults struct {
@@ -2262,6 +2380,7 @@ func (b *GetGalleriesByUserIdBatchBatchResults) Query(f func(int, []Gallery, err
 					&i.Description,
 					&i.Hidden,
 					&i.Position,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -2281,7 +2400,7 @@ func (b *GetGalleriesByUserIdBatchBatchResults) Close() error {
 }
 
 const getGalleriesDisplayingCommunityIDPaginateBatch = `-- name: GetGalleriesDisplayingCommunityIDPaginateBatch :batchmany
-select g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position,
+select g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position, g.moderation_hidden,
        cg.token_ids as community_token_ids,
        cg.token_medias as community_medias,
        cg.token_media_last_updated::timestamptz[] as community_media_last_updated,
@@ -2289,7 +2408,7 @@ select g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id
        cg2.token_medias as all_medias,
        cg2.token_media_last_updated::timestamptz[] as all_media_last_updated,
        (-cg.gallery_relevance)::float8 as relevance from community_galleries cg
-    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden
+    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden and not g.moderation_hidden
     join community_galleries cg2 on cg2.gallery_id = cg.gallery_id and cg2.community_id is null
 where cg.community_id = $1
     and (cg.user_id != $2, cg.community_id, -cg.gallery_relevance, cg.gallery_id) < ($3::bool, $1, $4::float8, $5::dbid)
@@ -2380,6 +2499,7 @@ func (b *GetGalleriesDisplayingCommunityIDPaginateBatchBatchResults) Query(f fun
 					&i.Gallery.Description,
 					&i.Gallery.Hidden,
 					&i.Gallery.Position,
+					&i.Gallery.ModerationHidden,
 					&i.CommunityTokenIds,
 					&i.CommunityMedias,
 					&i.CommunityMediaLastUpdated,
@@ -2406,7 +2526,7 @@ func (b *GetGalleriesDisplayingCommunityIDPaginateBatchBatchResults) Close() err
 }
 
 const getGalleryByCollectionIdBatch = `-- name: GetGalleryByCollectionIdBatch :batchone
-SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
+SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position, g.moderation_hidden FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false AND g.moderation_hidden = false
 `
 
 type GetGalleryByCollectionIdBatchBatchResults struct {
@@ -2450,6 +2570,7 @@ func (b *GetGalleryByCollectionIdBatchBatchResults) QueryRow(f func(int, Gallery
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.ModerationHidden,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2463,7 +2584,7 @@ func (b *GetGalleryByCollectionIdBatchBatchResults) Close() error {
 }
 
 const getGalleryByIdBatch = `-- name: GetGalleryByIdBatch :batchone
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE id = $1 AND deleted = false
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden FROM galleries WHERE id = $1 AND deleted = false AND moderation_hidden = false
 `
 
 type GetGalleryByIdBatchBatchResults struct {
@@ -2507,6 +2628,7 @@ func (b *GetGalleryByIdBatchBatchResults) QueryRow(f func(int, Gallery, error))
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.ModerationHidden,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2862,7 +2984,7 @@ with new_tokens as (
     select added.id, row_number() over () added_order
     from (select jsonb_array_elements_text(data -> 'collection_new_token_ids') id from feed_events f where f.id = $1 and f.deleted = false) added
 )
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable from new_tokens a join tokens t on a.id = t.id and t.displayable and t.deleted = false order by a.added_order
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at from new_tokens a join tokens t on a.id = t.id and t.displayable and t.deleted = false order by a.added_order
 `
 
 type GetNewTokensByFeedEventIdBatchBatchResults struct {
@@ -2919,6 +3041,7 @@ func (b *GetNewTokensByFeedEventIdBatchBatchResults) Query(f func(int, []Token,
 					&i.TokenDefinitionID,
 					&i.IsHolderToken,
 					&i.Displayable,
+					&i.AcquiredAt,
 				); err != nil {
 					return err
 				}
@@ -3118,7 +3241,7 @@ func (b *GetOwnersByContractIdBatchPaginateBatchResults) Close() error {
 }
 
 const getPostByIdBatch = `-- name: GetPostByIdBatch :batchone
-SELECT id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url FROM posts WHERE id = $1 AND deleted = false
+SELECT id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url, edited_at, comment_restriction, moderation_hidden FROM posts WHERE id = $1 AND deleted = false AND moderation_hidden = false
 `
 
 type GetPostByIdBatchBatchResults struct {
@@ -3162,6 +3285,9 @@ func (b *GetPostByIdBatchBatchResults) QueryRow(f func(int, Post, error)) {
 			&i.Deleted,
 			&i.IsFirstPost,
 			&i.UserMintUrl,
+			&i.EditedAt,
+			&i.CommentRestriction,
+			&i.ModerationHidden,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -3175,10 +3301,10 @@ func (b *GetPostByIdBatchBatchResults) Close() error {
 }
 
 const getPostsByIdsPaginateBatch = `-- name: GetPostsByIdsPaginateBatch :batchmany
-select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
 from posts
 join unnest($1::varchar[]) with ordinality t(id, pos) using(id)
-where not posts.deleted and t.pos > $2::int and t.pos < $3::int
+where not posts.deleted and not posts.moderation_hidden and t.pos > $2::int and t.pos < $3::int
 order by t.pos asc
 `
 
@@ -3238,6 +3364,9 @@ func (b *GetPostsByIdsPaginateBatchBatchResults) Query(f func(int, []Post, error
 					&i.Deleted,
 					&i.IsFirstPost,
 					&i.UserMintUrl,
+					&i.EditedAt,
+					&i.CommentRestriction,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -3330,6 +3459,118 @@ func (b *GetProfileImageByIdBatchBatchResults) Close() error {
 	return b.br.Close()
 }
 
+const getRepostByActorIDAndPostID = `-- name: GetRepostByActorIDAndPostID :batchone
+select id, actor_id, post_id, caption, deleted, created_at, last_updated from reposts where actor_id = $1 and post_id = $2 and caption is null and not deleted
+`
+
+type GetRepostByActorIDAndPostIDBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type GetRepostByActorIDAndPostIDParams struct {
+	ActorID persist.DBID `db:"actor_id" json:"actor_id"`
+	PostID  persist.DBID `db:"post_id" json:"post_id"`
+}
+
+func (q *Queries) GetRepostByActorIDAndPostID(ctx context.Context, arg []GetRepostByActorIDAndPostIDParams) *GetRepostByActorIDAndPostIDBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ActorID,
+			a.PostID,
+		}
+		batch.Queue(getRepostByActorIDAndPostID, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetRepostByActorIDAndPostIDBatchResults{br, len(arg), false}
+}
+
+func (b *GetRepostByActorIDAndPostIDBatchResults) QueryRow(f func(int, Repost, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i Repost
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.PostID,
+			&i.Caption,
+			&i.Deleted,
+			&i.CreatedAt,
+			&i.LastUpdated,
+		)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetRepostByActorIDAndPostIDBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const getRepostByIdBatch = `-- name: GetRepostByIdBatch :batchone
+select id, actor_id, post_id, caption, deleted, created_at, last_updated from reposts where id = $1 and not deleted
+`
+
+type GetRepostByIdBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) GetRepostByIdBatch(ctx context.Context, id []persist.DBID) *GetRepostByIdBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range id {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(getRepostByIdBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetRepostByIdBatchBatchResults{br, len(id), false}
+}
+
+func (b *GetRepostByIdBatchBatchResults) QueryRow(f func(int, Repost, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i Repost
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.PostID,
+			&i.Caption,
+			&i.Deleted,
+			&i.CreatedAt,
+			&i.LastUpdated,
+		)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetRepostByIdBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const getSharedCommunitiesBatchPaginate = `-- name: GetSharedCommunitiesBatchPaginate :batchmany
 select communities.id, communities.version, communities.community_type, communities.key1, communities.key2, communities.key3, communities.key4, communities.name, communities.override_name, communities.description, communities.override_description, communities.profile_image_url, communities.override_profile_image_url, communities.badge_url, communities.override_badge_url, communities.contract_id, communities.created_at, communities.last_updated, communities.deleted, communities.website_url, communities.override_website_url, communities.mint_url, communities.override_mint_url, a.displayed as displayed_by_user_a, b.displayed as displayed_by_user_b, a.owned_count
 from owned_communities a, owned_communities b, communities
@@ -3599,7 +3840,7 @@ func (b *GetSharedFollowersBatchPaginateBatchResults) Close() error {
 }
 
 const getTokenByIdBatch = `-- name: GetTokenByIdBatch :batchone
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 where t.id = $1 and t.displayable and t.deleted = false and td.deleted = false
@@ -3657,6 +3898,7 @@ func (b *GetTokenByIdBatchBatchResults) QueryRow(f func(int, GetTokenByIdBatchRo
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -3686,7 +3928,7 @@ func (b *GetTokenByIdBatchBatchResults) Close() error {
 }
 
 const getTokenByIdIgnoreDisplayableBatch = `-- name: GetTokenByIdIgnoreDisplayableBatch :batchone
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 where t.id = $1 and t.deleted = false and td.deleted = false
@@ -3744,6 +3986,7 @@ func (b *GetTokenByIdIgnoreDisplayableBatchBatchResults) QueryRow(f func(int, Ge
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -3773,7 +4016,7 @@ func (b *GetTokenByIdIgnoreDisplayableBatchBatchResults) Close() error {
 }
 
 const getTokenByUserTokenIdentifiersBatch = `-- name: GetTokenByUserTokenIdentifiersBatch :batchone
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
 from tokens t, token_definitions td, contracts c
 where t.token_definition_id = td.id
     and td.contract_id = c.id
@@ -3851,6 +4094,7 @@ func (b *GetTokenByUserTokenIdentifiersBatchBatchResults) QueryRow(f func(int, G
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -3899,7 +4143,7 @@ func (b *GetTokenByUserTokenIdentifiersBatchBatchResults) Close() error {
 }
 
 const getTokenByUserTokenIdentifiersIgnoreDisplayableBatch = `-- name: GetTokenByUserTokenIdentifiersIgnoreDisplayableBatch :batchone
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain, tm.id, tm.created_at, tm.last_updated, tm.version, tm.active, tm.media, tm.processing_job_id, tm.deleted, tm.chain, tm.contract_address, tm.token_id
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain, tm.id, tm.created_at, tm.last_updated, tm.version, tm.active, tm.media, tm.processing_job_id, tm.deleted, tm.chain, tm.contract_address, tm.token_id
 from tokens t, token_definitions td, contracts c, token_medias tm
 where t.token_definition_id = td.id
     and td.contract_id = c.id
@@ -3978,6 +4222,7 @@ func (b *GetTokenByUserTokenIdentifiersIgnoreDisplayableBatchBatchResults) Query
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -4165,8 +4410,59 @@ func (b *GetTokenDefinitionByTokenDbidBatchBatchResults) Close() error {
 	return b.br.Close()
 }
 
+const getTokenDefinitionSpamScoreByIDBatch = `-- name: GetTokenDefinitionSpamScoreByIDBatch :batchone
+select token_definition_id, score, reasons, created_at, last_updated from token_definition_spam_scores where token_definition_id = $1
+`
+
+type GetTokenDefinitionSpamScoreByIDBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) GetTokenDefinitionSpamScoreByIDBatch(ctx context.Context, tokenDefinitionID []persist.DBID) *GetTokenDefinitionSpamScoreByIDBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range tokenDefinitionID {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(getTokenDefinitionSpamScoreByIDBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetTokenDefinitionSpamScoreByIDBatchBatchResults{br, len(tokenDefinitionID), false}
+}
+
+func (b *GetTokenDefinitionSpamScoreByIDBatchBatchResults) QueryRow(f func(int, TokenDefinitionSpamScore, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i TokenDefinitionSpamScore
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(
+			&i.TokenDefinitionID,
+			&i.Score,
+			&i.Reasons,
+			&i.CreatedAt,
+			&i.LastUpdated,
+		)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetTokenDefinitionSpamScoreByIDBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const getTokensByCollectionIdBatch = `-- name: GetTokensByCollectionIdBatch :batchmany
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable from collections c,
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at from collections c,
     unnest(c.nfts) with ordinality as u(nft_id, nft_ord)
     join tokens t on t.id = u.nft_id
     where c.id = $1
@@ -4238,6 +4534,7 @@ func (b *GetTokensByCollectionIdBatchBatchResults) Query(f func(int, []Token, er
 					&i.TokenDefinitionID,
 					&i.IsHolderToken,
 					&i.Displayable,
+					&i.AcquiredAt,
 				); err != nil {
 					return err
 				}
@@ -4257,16 +4554,19 @@ func (b *GetTokensByCollectionIdBatchBatchResults) Close() error {
 }
 
 const getTokensByUserIdBatch = `-- name: GetTokensByUserIdBatch :batchmany
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 join contracts c on c.id = td.contract_id
+left join contract_spam_scores css on css.contract_id = c.id
+left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where t.owner_user_id = $1
     and t.deleted = false
     and t.displayable
     and (($2::bool and t.is_holder_token) or ($3::bool and t.is_creator_token))
     and td.deleted = false
     and c.deleted = false
+    and ($4::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= $4::int)
 order by t.created_at desc, td.name desc, t.id desc
 `
 
@@ -4277,9 +4577,10 @@ type GetTokensByUserIdBatchBatchResults struct {
 }
 
 type GetTokensByUserIdBatchParams struct {
-	OwnerUserID    persist.DBID `db:"owner_user_id" json:"owner_user_id"`
-	IncludeHolder  bool         `db:"include_holder" json:"include_holder"`
-	IncludeCreator bool         `db:"include_creator" json:"include_creator"`
+	OwnerUserID    persist.DBID  `db:"owner_user_id" json:"owner_user_id"`
+	IncludeHolder  bool          `db:"include_holder" json:"include_holder"`
+	IncludeCreator bool          `db:"include_creator" json:"include_creator"`
+	MaxSpamScore   sql.NullInt32 `db:"max_spam_score" json:"max_spam_score"`
 }
 
 type GetTokensByUserIdBatchRow struct {
@@ -4295,6 +4596,7 @@ func (q *Queries) GetTokensByUserIdBatch(ctx context.Context, arg []GetTokensByU
 			a.OwnerUserID,
 			a.IncludeHolder,
 			a.IncludeCreator,
+			a.MaxSpamScore,
 		}
 		batch.Queue(getTokensByUserIdBatch, vals...)
 	}
@@ -4338,6 +4640,7 @@ func (b *GetTokensByUserIdBatchBatchResults) Query(f func(int, []GetTokensByUser
 					&i.Token.TokenDefinitionID,
 					&i.Token.IsHolderToken,
 					&i.Token.Displayable,
+					&i.Token.AcquiredAt,
 					&i.TokenDefinition.ID,
 					&i.TokenDefinition.CreatedAt,
 					&i.TokenDefinition.LastUpdated,
@@ -4392,7 +4695,7 @@ func (b *GetTokensByUserIdBatchBatchResults) Close() error {
 }
 
 const getTokensByWalletIdsBatch = `-- name: GetTokensByWalletIdsBatch :batchmany
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 where t.owned_by_wallets && $1 and t.displayable and t.deleted = false and td.deleted = false
@@ -4458,6 +4761,7 @@ func (b *GetTokensByWalletIdsBatchBatchResults) Query(f func(int, []GetTokensByW
 					&i.Token.TokenDefinitionID,
 					&i.Token.IsHolderToken,
 					&i.Token.Displayable,
+					&i.Token.AcquiredAt,
 					&i.TokenDefinition.ID,
 					&i.TokenDefinition.CreatedAt,
 					&i.TokenDefinition.LastUpdated,
@@ -5616,7 +5920,7 @@ func (b *PaginateAdmiresByTokenIDBatchBatchResults) Close() error {
 }
 
 const paginateCommentsByFeedEventIDBatch = `-- name: PaginateCommentsByFeedEventIDBatch :batchmany
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id FROM comments WHERE feed_event_id = $1 AND reply_to is null AND deleted = false
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden FROM comments WHERE feed_event_id = $1 AND reply_to is null AND deleted = false AND moderation_hidden = false
     AND (created_at, id) < ($2, $3::dbid)
     AND (created_at, id) > ($4, $5::dbid)
     ORDER BY CASE WHEN $6::bool THEN (created_at, id) END ASC,
@@ -5689,6 +5993,8 @@ func (b *PaginateCommentsByFeedEventIDBatchBatchResults) Query(f func(int, []Com
 					&i.PostID,
 					&i.Removed,
 					&i.TopLevelCommentID,
+					&i.Hidden,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -5708,12 +6014,16 @@ func (b *PaginateCommentsByFeedEventIDBatchBatchResults) Close() error {
 }
 
 const paginateCommentsByPostIDBatch = `-- name: PaginateCommentsByPostIDBatch :batchmany
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id FROM comments WHERE post_id = $1 AND reply_to is null AND deleted = false
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden FROM comments WHERE post_id = $1 AND reply_to is null AND deleted = false
     AND (created_at, id) < ($2, $3::dbid)
     AND (created_at, id) > ($4, $5::dbid)
-    ORDER BY CASE WHEN $6::bool THEN (created_at, id) END ASC,
-             CASE WHEN NOT $6::bool THEN (created_at, id) END DESC
-    LIMIT $7
+    AND NOT EXISTS (SELECT 1 FROM mutes m WHERE m.user_id = $6 AND NOT m.deleted AND (m.expires_at IS NULL OR m.expires_at > now())
+        AND (m.muted_user_id = comments.actor_id OR position(m.keyword in lower(comments.comment)) > 0))
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = $6)
+    ORDER BY CASE WHEN $7::bool THEN (created_at, id) END ASC,
+             CASE WHEN NOT $7::bool THEN (created_at, id) END DESC
+    LIMIT $8
 `
 
 type PaginateCommentsByPostIDBatchBatchResults struct {
@@ -5728,6 +6038,7 @@ type PaginateCommentsByPostIDBatchParams struct {
 	CurBeforeID   persist.DBID `db:"cur_before_id" json:"cur_before_id"`
 	CurAfterTime  time.Time    `db:"cur_after_time" json:"cur_after_time"`
 	CurAfterID    persist.DBID `db:"cur_after_id" json:"cur_after_id"`
+	ViewerID      persist.DBID `db:"viewer_id" json:"viewer_id"`
 	PagingForward bool         `db:"paging_forward" json:"paging_forward"`
 	Limit         int32        `db:"limit" json:"limit"`
 }
@@ -5741,6 +6052,7 @@ func (q *Queries) PaginateCommentsByPostIDBatch(ctx context.Context, arg []Pagin
 			a.CurBeforeID,
 			a.CurAfterTime,
 			a.CurAfterID,
+			a.ViewerID,
 			a.PagingForward,
 			a.Limit,
 		}
@@ -5781,6 +6093,8 @@ func (b *PaginateCommentsByPostIDBatchBatchResults) Query(f func(int, []Comment,
 					&i.PostID,
 					&i.Removed,
 					&i.TopLevelCommentID,
+					&i.Hidden,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -5807,7 +6121,7 @@ with community_data as (
 ),
 
 community_tokens as (
-    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable
+    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at
     from community_data, tokens
     where community_data.community_type = 0
         and tokens.contract_id = community_data.contract_id
@@ -5815,7 +6129,7 @@ community_tokens as (
 
     union all
 
-    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable
+    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at
     from community_data, tokens
         join token_community_memberships on tokens.token_definition_id = token_community_memberships.token_definition_id
             and token_community_memberships.community_id = $7
@@ -5937,7 +6251,7 @@ SELECT interactions.created_At, interactions.id, interactions.tag FROM (
     SELECT t.created_at, t.id, $1::int as tag FROM admires t WHERE $1 != 0 AND t.feed_event_id = $2 AND t.deleted = false
         AND ($1, t.created_at, t.id) < ($3::int, $4, $5::dbid) AND ($1, t.created_at, t.id) > ($6::int, $7, $8::dbid)
                                                                     UNION
-    SELECT t.created_at, t.id, $9::int as tag FROM comments t WHERE $9 != 0 AND t.feed_event_id = $2 AND t.reply_to is null AND t.deleted = false
+    SELECT t.created_at, t.id, $9::int as tag FROM comments t WHERE $9 != 0 AND t.feed_event_id = $2 AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
         AND ($9, t.created_at, t.id) < ($3::int, $4, $5::dbid) AND ($9, t.created_at, t.id) > ($6::int, $7, $8::dbid)
 ) as interactions
 
@@ -6035,13 +6349,14 @@ SELECT interactions.created_At, interactions.id, interactions.tag FROM (
     SELECT t.created_at, t.id, $1::int as tag FROM admires t WHERE $1 != 0 AND t.post_id = $2 AND t.deleted = false
         AND ($1, t.created_at, t.id) < ($3::int, $4, $5::dbid) AND ($1, t.created_at, t.id) > ($6::int, $7, $8::dbid)
                                                                     UNION
-    SELECT t.created_at, t.id, $9::int as tag FROM comments t WHERE $9 != 0 AND t.post_id = $2 AND t.reply_to is null AND t.deleted = false
+    SELECT t.created_at, t.id, $9::int as tag FROM comments t WHERE $9 != 0 AND t.post_id = $2 AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
+        AND (NOT t.hidden OR t.actor_id = $10)
         AND ($9, t.created_at, t.id) < ($3::int, $4, $5::dbid) AND ($9, t.created_at, t.id) > ($6::int, $7, $8::dbid)
 ) as interactions
 
-ORDER BY CASE WHEN $10::bool THEN (tag, created_at, id) END ASC,
-         CASE WHEN NOT $10::bool THEN (tag, created_at, id) END DESC
-LIMIT $11
+ORDER BY CASE WHEN $11::bool THEN (tag, created_at, id) END ASC,
+         CASE WHEN NOT $11::bool THEN (tag, created_at, id) END DESC
+LIMIT $12
 `
 
 type PaginateInteractionsByPostIDBatchBatchResults struct {
@@ -6060,6 +6375,7 @@ type PaginateInteractionsByPostIDBatchParams struct {
 	CurAfterTime  time.Time    `db:"cur_after_time" json:"cur_after_time"`
 	CurAfterID    persist.DBID `db:"cur_after_id" json:"cur_after_id"`
 	CommentTag    int32        `db:"comment_tag" json:"comment_tag"`
+	ViewerID      persist.DBID `db:"viewer_id" json:"viewer_id"`
 	PagingForward bool         `db:"paging_forward" json:"paging_forward"`
 	Limit         int32        `db:"limit" json:"limit"`
 }
@@ -6083,6 +6399,7 @@ func (q *Queries) PaginateInteractionsByPostIDBatch(ctx context.Context, arg []P
 			a.CurAfterTime,
 			a.CurAfterID,
 			a.CommentTag,
+			a.ViewerID,
 			a.PagingForward,
 			a.Limit,
 		}
@@ -6136,11 +6453,12 @@ with community_data as (
 )
 
 (
-select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
     from community_data, posts
     where community_data.community_type = 0
         and community_data.contract_id = any(posts.contract_ids)
         and posts.deleted = false
+        and posts.moderation_hidden = false
         and (posts.created_at, posts.id) < ($2, $3::dbid)
         and (posts.created_at, posts.id) > ($4, $5::dbid)
     order by
@@ -6152,7 +6470,7 @@ select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor
 union all
 
 (
-select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
     from community_data, posts
         join tokens on tokens.id = any(posts.token_ids) and not tokens.deleted
         join token_community_memberships on tokens.token_definition_id = token_community_memberships.token_definition_id
@@ -6160,6 +6478,7 @@ select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor
             and not token_community_memberships.deleted
     where community_data.community_type = 1
       and posts.deleted = false
+      and posts.moderation_hidden = false
       and (posts.created_at, posts.id) < ($2, $3::dbid)
       and (posts.created_at, posts.id) > ($4, $5::dbid)
     order by
@@ -6233,6 +6552,9 @@ func (b *PaginatePostsByCommunityIDBatchResults) Query(f func(int, []Post, error
 					&i.Deleted,
 					&i.IsFirstPost,
 					&i.UserMintUrl,
+					&i.EditedAt,
+					&i.CommentRestriction,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -6252,10 +6574,11 @@ func (b *PaginatePostsByCommunityIDBatchResults) Close() error {
 }
 
 const paginatePostsByContractID = `-- name: PaginatePostsByContractID :batchmany
-SELECT posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+SELECT posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
 FROM posts
 WHERE $1::dbid = ANY(posts.contract_ids)
 AND posts.deleted = false
+AND posts.moderation_hidden = false
 AND (posts.created_at, posts.id) < ($2, $3::dbid)
 AND (posts.created_at, posts.id) > ($4, $5::dbid)
 ORDER BY
@@ -6328,6 +6651,9 @@ func (b *PaginatePostsByContractIDBatchResults) Query(f func(int, []Post, error)
 					&i.Deleted,
 					&i.IsFirstPost,
 					&i.UserMintUrl,
+					&i.EditedAt,
+					&i.CommentRestriction,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -6347,7 +6673,7 @@ func (b *PaginatePostsByContractIDBatchResults) Close() error {
 }
 
 const paginateRepliesByCommentIDBatch = `-- name: PaginateRepliesByCommentIDBatch :batchmany
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id FROM comments c 
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden FROM comments c 
 WHERE 
     CASE 
         WHEN (SELECT reply_to FROM comments cc WHERE cc.id = $1) IS NULL 
@@ -6357,14 +6683,18 @@ WHERE
     AND c.deleted = false
     AND (c.created_at, c.id) < ($2, $3::dbid)
     AND (c.created_at, c.id) > ($4, $5::dbid)
+    AND NOT EXISTS (SELECT 1 FROM mutes m WHERE m.user_id = $6 AND NOT m.deleted AND (m.expires_at IS NULL OR m.expires_at > now())
+        AND (m.muted_user_id = c.actor_id OR position(m.keyword in lower(c.comment)) > 0))
+    AND NOT c.moderation_hidden
+    AND (NOT c.hidden OR c.actor_id = $6)
 ORDER BY 
     CASE 
-        WHEN $6::bool THEN (c.created_at, c.id) 
+        WHEN $7::bool THEN (c.created_at, c.id) 
     END ASC,
     CASE 
-        WHEN NOT $6::bool THEN (c.created_at, c.id) 
+        WHEN NOT $7::bool THEN (c.created_at, c.id) 
     END DESC
-LIMIT $7
+LIMIT $8
 `
 
 type PaginateRepliesByCommentIDBatchBatchResults struct {
@@ -6379,6 +6709,7 @@ type PaginateRepliesByCommentIDBatchParams struct {
 	CurBeforeID   persist.DBID `db:"cur_before_id" json:"cur_before_id"`
 	CurAfterTime  time.Time    `db:"cur_after_time" json:"cur_after_time"`
 	CurAfterID    persist.DBID `db:"cur_after_id" json:"cur_after_id"`
+	ViewerID      persist.DBID `db:"viewer_id" json:"viewer_id"`
 	PagingForward bool         `db:"paging_forward" json:"paging_forward"`
 	Limit         int32        `db:"limit" json:"limit"`
 }
@@ -6392,6 +6723,7 @@ func (q *Queries) PaginateRepliesByCommentIDBatch(ctx context.Context, arg []Pag
 			a.CurBeforeID,
 			a.CurAfterTime,
 			a.CurAfterID,
+			a.ViewerID,
 			a.PagingForward,
 			a.Limit,
 		}
@@ -6432,6 +6764,8 @@ func (b *PaginateRepliesByCommentIDBatchBatchResults) Query(f func(int, []Commen
 					&i.PostID,
 					&i.Removed,
 					&i.TopLevelCommentID,
+					&i.Hidden,
+					&i.ModerationHidden,
 				); err != nil {
 					return err
 				}
@@ -6451,7 +6785,7 @@ func (b *PaginateRepliesByCommentIDBatchBatchResults) Close() error {
 }
 
 const paginateTokensAdmiredByUserIDBatch = `-- name: PaginateTokensAdmiredByUserIDBatch :batchmany
-select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, admires.id, admires.version, admires.feed_event_id, admires.actor_id, admires.deleted, admires.created_at, admires.last_updated, admires.post_id, admires.token_id, admires.comment_id
+select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at, admires.id, admires.version, admires.feed_event_id, admires.actor_id, admires.deleted, admires.created_at, admires.last_updated, admires.post_id, admires.token_id, admires.comment_id
 from admires
 join tokens on admires.token_id = tokens.id
 where actor_id = $1 and not admires.deleted and not tokens.deleted
@@ -6537,6 +6871,7 @@ func (b *PaginateTokensAdmiredByUserIDBatchBatchResults) Query(f func(int, []Pag
 					&i.Token.TokenDefinitionID,
 					&i.Token.IsHolderToken,
 					&i.Token.Displayable,
+					&i.Token.AcquiredAt,
 					&i.Admire.ID,
 					&i.Admire.Version,
 					&i.Admire.FeedEventID,
@@ -6573,11 +6908,13 @@ with community_data as (
     limit 1
 )
 
-(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd
+(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd
     join tokens t on t.contract_id = cd.contract_id
     join token_definitions td on t.token_definition_id = td.id
     join users u on u.id = t.owner_user_id
     join contracts c on t.contract_id = c.id
+    left join contract_spam_scores css on css.contract_id = c.id
+    left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where cd.community_type = 0
     and t.displayable
     and t.deleted = false
@@ -6587,17 +6924,20 @@ where cd.community_type = 0
     and u.universal = false
     and (t.created_at,t.id) < ($2::timestamptz, $3::dbid)
     and (t.created_at,t.id) > ($4::timestamptz, $5::dbid)
-order by case when $6::bool then (t.created_at,t.id) end asc,
-         case when not $6::bool then (t.created_at,t.id) end desc
-limit $7)
+    and ($6::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= $6::int)
+order by case when $7::bool then (t.created_at,t.id) end asc,
+         case when not $7::bool then (t.created_at,t.id) end desc
+limit $8)
 
 union all
 
-(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd, tokens t
+(select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain from community_data cd, tokens t
     join token_community_memberships tcm on t.token_definition_id = tcm.token_definition_id
     join token_definitions td on td.id = t.token_definition_id
     join users u on u.id = t.owner_user_id
     join contracts c on t.contract_id = c.id
+    left join contract_spam_scores css on css.contract_id = c.id
+    left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where cd.community_type != 0
     and tcm.community_id = cd.community_id
     and t.displayable
@@ -6609,9 +6949,10 @@ where cd.community_type != 0
     and u.universal = false
     and (t.created_at,t.id) < ($2::timestamptz, $3::dbid)
     and (t.created_at,t.id) > ($4::timestamptz, $5::dbid)
-order by case when $6::bool then (t.created_at,t.id) end asc,
-         case when not $6::bool then (t.created_at,t.id) end desc
-limit $7)
+    and ($6::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= $6::int)
+order by case when $7::bool then (t.created_at,t.id) end asc,
+         case when not $7::bool then (t.created_at,t.id) end desc
+limit $8)
 `
 
 type PaginateTokensByCommunityIDBatchResults struct {
@@ -6621,13 +6962,14 @@ type PaginateTokensByCommunityIDBatchResults struct {
 }
 
 type PaginateTokensByCommunityIDParams struct {
-	CommunityID   persist.DBID `db:"community_id" json:"community_id"`
-	CurBeforeTime time.Time    `db:"cur_before_time" json:"cur_before_time"`
-	CurBeforeID   persist.DBID `db:"cur_before_id" json:"cur_before_id"`
-	CurAfterTime  time.Time    `db:"cur_after_time" json:"cur_after_time"`
-	CurAfterID    persist.DBID `db:"cur_after_id" json:"cur_after_id"`
-	PagingForward bool         `db:"paging_forward" json:"paging_forward"`
-	Limit         int32        `db:"limit" json:"limit"`
+	CommunityID   persist.DBID  `db:"community_id" json:"community_id"`
+	CurBeforeTime time.Time     `db:"cur_before_time" json:"cur_before_time"`
+	CurBeforeID   persist.DBID  `db:"cur_before_id" json:"cur_before_id"`
+	CurAfterTime  time.Time     `db:"cur_after_time" json:"cur_after_time"`
+	CurAfterID    persist.DBID  `db:"cur_after_id" json:"cur_after_id"`
+	MaxSpamScore  sql.NullInt32 `db:"max_spam_score" json:"max_spam_score"`
+	PagingForward bool          `db:"paging_forward" json:"paging_forward"`
+	Limit         int32         `db:"limit" json:"limit"`
 }
 
 type PaginateTokensByCommunityIDRow struct {
@@ -6652,6 +6994,7 @@ func (q *Queries) PaginateTokensByCommunityID(ctx context.Context, arg []Paginat
 			a.CurBeforeID,
 			a.CurAfterTime,
 			a.CurAfterID,
+			a.MaxSpamScore,
 			a.PagingForward,
 			a.Limit,
 		}
@@ -6697,6 +7040,7 @@ func (b *PaginateTokensByCommunityIDBatchResults) Query(f func(int, []PaginateTo
 					&i.Token.TokenDefinitionID,
 					&i.Token.IsHolderToken,
 					&i.Token.Displayable,
+					&i.Token.AcquiredAt,
 					&i.TokenDefinition.ID,
 					&i.TokenDefinition.CreatedAt,
 					&i.TokenDefinition.LastUpdated,
@@ -7,6 +7,7 @@ package coredb
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/jackc/pgtype"
 	"github.com/mikeydub/go-gallery/service/persist"
@@ -20,7 +21,7 @@ with community_data as (
 ),
 
 community_tokens as (
-    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable
+    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at
     from community_data, tokens
     where community_data.community_type = 0
         and tokens.contract_id = community_data.contract_id
@@ -28,7 +29,7 @@ community_tokens as (
 
     union all
 
-    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable
+    select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at
     from community_data, tokens
         join token_community_memberships on tokens.token_definition_id = token_community_memberships.token_definition_id
             and token_community_memberships.community_id = $1
@@ -60,17 +61,18 @@ with community_data as (
 
 community_posts as (
     (
-        select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+        select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
             from community_data, posts
             where community_data.community_type = 0
                 and community_data.contract_id = any(posts.contract_ids)
                 and posts.deleted = false
+                and posts.moderation_hidden = false
     )
 
     union all
 
     (
-        select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url
+        select posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden
             from community_data, posts
                 join tokens on tokens.id = any(posts.token_ids) and not tokens.deleted
                 join token_community_memberships on tokens.token_definition_id = token_community_memberships.token_definition_id
@@ -78,6 +80,7 @@ community_posts as (
                     and not token_community_memberships.deleted
             where community_data.community_type = 1
               and posts.deleted = false
+              and posts.moderation_hidden = false
     )
 )
 
@@ -105,13 +108,16 @@ select sum(u.count) from (
         join token_definitions td on t.token_definition_id = td.id
         join users u on u.id = t.owner_user_id
         join contracts c on t.contract_id = c.id
+        left join contract_spam_scores css on css.contract_id = c.id
+        left join token_definition_spam_scores tss on tss.token_definition_id = td.id
     where cd.community_type = 0
         and t.displayable
         and t.deleted = false
         and c.deleted = false
         and td.deleted = false
         and u.deleted = false
-        and u.universal = false)
+        and u.universal = false
+        and ($2::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= $2::int))
 
     union all
 
@@ -120,6 +126,8 @@ select sum(u.count) from (
         join token_definitions td on td.id = t.token_definition_id
         join users u on u.id = t.owner_user_id
         join contracts c on t.contract_id = c.id
+        left join contract_spam_scores css on css.contract_id = c.id
+        left join token_definition_spam_scores tss on tss.token_definition_id = td.id
     where cd.community_type != 0
         and tcm.community_id = cd.community_id
         and t.displayable
@@ -128,12 +136,18 @@ select sum(u.count) from (
         and c.deleted = false
         and td.deleted = false
         and u.deleted = false
-        and u.universal = false)
+        and u.universal = false
+        and ($2::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= $2::int))
 ) u
 `
 
-func (q *Queries) CountTokensByCommunityID(ctx context.Context, communityID persist.DBID) (int64, error) {
-	row := q.db.QueryRow(ctx, countTokensByCommunityID, communityID)
+type CountTokensByCommunityIDParams struct {
+	CommunityID  persist.DBID  `db:"community_id" json:"community_id"`
+	MaxSpamScore sql.NullInt32 `db:"max_spam_score" json:"max_spam_score"`
+}
+
+func (q *Queries) CountTokensByCommunityID(ctx context.Context, arg CountTokensByCommunityIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTokensByCommunityID, arg.CommunityID, arg.MaxSpamScore)
 	var sum int64
 	err := row.Scan(&sum)
 	return sum, err
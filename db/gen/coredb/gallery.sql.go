@@ -68,7 +68,7 @@ func (q *Queries) GalleryRepoCountColls(ctx context.Context, id persist.DBID) (i
 }
 
 const galleryRepoCreate = `-- name: GalleryRepoCreate :one
-insert into galleries (id, owner_user_id, name, description, position) values ($1, $2, $3, $4, $5) returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position
+insert into galleries (id, owner_user_id, name, description, position) values ($1, $2, $3, $4, $5) returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden
 `
 
 type GalleryRepoCreateParams struct {
@@ -100,6 +100,7 @@ func (q *Queries) GalleryRepoCreate(ctx context.Context, arg GalleryRepoCreatePa
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
@@ -119,7 +120,7 @@ func (q *Queries) GalleryRepoDelete(ctx context.Context, arg GalleryRepoDeletePa
 }
 
 const galleryRepoGetByUserIDRaw = `-- name: GalleryRepoGetByUserIDRaw :many
-select id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position from galleries g where g.owner_user_id = $1 and g.deleted = false order by position
+select id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden from galleries g where g.owner_user_id = $1 and g.deleted = false order by position
 `
 
 func (q *Queries) GalleryRepoGetByUserIDRaw(ctx context.Context, ownerUserID persist.DBID) ([]Gallery, error) {
@@ -143,6 +144,7 @@ func (q *Queries) GalleryRepoGetByUserIDRaw(ctx context.Context, ownerUserID per
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -63,6 +63,8 @@ type Comment struct {
 	PostID            persist.DBID `db:"post_id" json:"post_id"`
 	Removed           bool         `db:"removed" json:"removed"`
 	TopLevelCommentID persist.DBID `db:"top_level_comment_id" json:"top_level_comment_id"`
+	Hidden            bool         `db:"hidden" json:"hidden"`
+	ModerationHidden  bool         `db:"moderation_hidden" json:"moderation_hidden"`
 }
 
 type Community struct {
@@ -171,6 +173,14 @@ type ContractCreator struct {
 	CreatorAddress persist.Address `db:"creator_address" json:"creator_address"`
 }
 
+type ContractSpamScore struct {
+	ContractID  persist.DBID        `db:"contract_id" json:"contract_id"`
+	Score       int32               `db:"score" json:"score"`
+	Reasons     persist.SpamReasons `db:"reasons" json:"reasons"`
+	CreatedAt   time.Time           `db:"created_at" json:"created_at"`
+	LastUpdated time.Time           `db:"last_updated" json:"last_updated"`
+}
+
 type DevMetadataUser struct {
 	UserID          persist.DBID  `db:"user_id" json:"user_id"`
 	HasEmailAddress persist.Email `db:"has_email_address" json:"has_email_address"`
@@ -282,17 +292,18 @@ type Follow struct {
 }
 
 type Gallery struct {
-	ID          persist.DBID     `db:"id" json:"id"`
-	Deleted     bool             `db:"deleted" json:"deleted"`
-	LastUpdated time.Time        `db:"last_updated" json:"last_updated"`
-	CreatedAt   time.Time        `db:"created_at" json:"created_at"`
-	Version     sql.NullInt32    `db:"version" json:"version"`
-	OwnerUserID persist.DBID     `db:"owner_user_id" json:"owner_user_id"`
-	Collections persist.DBIDList `db:"collections" json:"collections"`
-	Name        string           `db:"name" json:"name"`
-	Description string           `db:"description" json:"description"`
-	Hidden      bool             `db:"hidden" json:"hidden"`
-	Position    string           `db:"position" json:"position"`
+	ID               persist.DBID     `db:"id" json:"id"`
+	Deleted          bool             `db:"deleted" json:"deleted"`
+	LastUpdated      time.Time        `db:"last_updated" json:"last_updated"`
+	CreatedAt        time.Time        `db:"created_at" json:"created_at"`
+	Version          sql.NullInt32    `db:"version" json:"version"`
+	OwnerUserID      persist.DBID     `db:"owner_user_id" json:"owner_user_id"`
+	Collections      persist.DBIDList `db:"collections" json:"collections"`
+	Name             string           `db:"name" json:"name"`
+	Description      string           `db:"description" json:"description"`
+	Hidden           bool             `db:"hidden" json:"hidden"`
+	Position         string           `db:"position" json:"position"`
+	ModerationHidden bool             `db:"moderation_hidden" json:"moderation_hidden"`
 }
 
 type GalleryRelevance struct {
@@ -300,6 +311,19 @@ type GalleryRelevance struct {
 	Score int32        `db:"score" json:"score"`
 }
 
+type GalleryVersion struct {
+	ID                    persist.DBID                      `db:"id" json:"id"`
+	GalleryID             persist.DBID                      `db:"gallery_id" json:"gallery_id"`
+	OwnerUserID           persist.DBID                      `db:"owner_user_id" json:"owner_user_id"`
+	Name                  string                            `db:"name" json:"name"`
+	Description           string                            `db:"description" json:"description"`
+	Collections           persist.GalleryVersionCollections `db:"collections" json:"collections"`
+	Published             bool                              `db:"published" json:"published"`
+	RestoredFromVersionID sql.NullString                    `db:"restored_from_version_id" json:"restored_from_version_id"`
+	Deleted               bool                              `db:"deleted" json:"deleted"`
+	CreatedAt             time.Time                         `db:"created_at" json:"created_at"`
+}
+
 type HighlightMintClaim struct {
 	ID                    persist.DBID          `db:"id" json:"id"`
 	RecipientUserID       persist.DBID          `db:"recipient_user_id" json:"recipient_user_id"`
@@ -320,6 +344,16 @@ type HighlightMintClaim struct {
 	Deleted               bool                  `db:"deleted" json:"deleted"`
 }
 
+type IndexerCheckpoint struct {
+	ID          persist.DBID  `db:"id" json:"id"`
+	Chain       persist.Chain `db:"chain" json:"chain"`
+	BlockNumber int64         `db:"block_number" json:"block_number"`
+	BlockHash   string        `db:"block_hash" json:"block_hash"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	LastUpdated time.Time     `db:"last_updated" json:"last_updated"`
+	Deleted     bool          `db:"deleted" json:"deleted"`
+}
+
 type LegacyNonce struct {
 	ID          persist.DBID    `db:"id" json:"id"`
 	Deleted     bool            `db:"deleted" json:"deleted"`
@@ -408,6 +442,38 @@ type MigrationValidation struct {
 	LastRefreshed            interface{}    `db:"last_refreshed" json:"last_refreshed"`
 }
 
+type ModerationAction struct {
+	ID          persist.DBID                 `db:"id" json:"id"`
+	SubjectType persist.ReportSubjectType    `db:"subject_type" json:"subject_type"`
+	SubjectID   persist.DBID                 `db:"subject_id" json:"subject_id"`
+	UserID      persist.DBID                 `db:"user_id" json:"user_id"`
+	Action      persist.ModerationActionType `db:"action" json:"action"`
+	Reason      persist.ReportReason         `db:"reason" json:"reason"`
+	Note        sql.NullString               `db:"note" json:"note"`
+	Moderator   sql.NullString               `db:"moderator" json:"moderator"`
+	Automated   bool                         `db:"automated" json:"automated"`
+	ExpiresAt   sql.NullTime                 `db:"expires_at" json:"expires_at"`
+	RevokedAt   sql.NullTime                 `db:"revoked_at" json:"revoked_at"`
+	CreatedAt   time.Time                    `db:"created_at" json:"created_at"`
+}
+
+type Mute struct {
+	ID               persist.DBID   `db:"id" json:"id"`
+	UserID           persist.DBID   `db:"user_id" json:"user_id"`
+	MutedUserID      persist.DBID   `db:"muted_user_id" json:"muted_user_id"`
+	MutedCommunityID persist.DBID   `db:"muted_community_id" json:"muted_community_id"`
+	Keyword          sql.NullString `db:"keyword" json:"keyword"`
+	ExpiresAt        sql.NullTime   `db:"expires_at" json:"expires_at"`
+	Deleted          bool           `db:"deleted" json:"deleted"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated      time.Time      `db:"last_updated" json:"last_updated"`
+}
+
+type MutedPost struct {
+	UserID persist.DBID `db:"user_id" json:"user_id"`
+	PostID persist.DBID `db:"post_id" json:"post_id"`
+}
+
 type Nonce struct {
 	ID        persist.DBID `db:"id" json:"id"`
 	Value     string       `db:"value" json:"value"`
@@ -506,17 +572,35 @@ type PiiUserView struct {
 }
 
 type Post struct {
-	ID          persist.DBID     `db:"id" json:"id"`
-	Version     int32            `db:"version" json:"version"`
-	TokenIds    persist.DBIDList `db:"token_ids" json:"token_ids"`
-	ContractIds persist.DBIDList `db:"contract_ids" json:"contract_ids"`
-	ActorID     persist.DBID     `db:"actor_id" json:"actor_id"`
-	Caption     sql.NullString   `db:"caption" json:"caption"`
-	CreatedAt   time.Time        `db:"created_at" json:"created_at"`
-	LastUpdated time.Time        `db:"last_updated" json:"last_updated"`
-	Deleted     bool             `db:"deleted" json:"deleted"`
-	IsFirstPost bool             `db:"is_first_post" json:"is_first_post"`
-	UserMintUrl sql.NullString   `db:"user_mint_url" json:"user_mint_url"`
+	ID                 persist.DBID               `db:"id" json:"id"`
+	Version            int32                      `db:"version" json:"version"`
+	TokenIds           persist.DBIDList           `db:"token_ids" json:"token_ids"`
+	ContractIds        persist.DBIDList           `db:"contract_ids" json:"contract_ids"`
+	ActorID            persist.DBID               `db:"actor_id" json:"actor_id"`
+	Caption            sql.NullString             `db:"caption" json:"caption"`
+	CreatedAt          time.Time                  `db:"created_at" json:"created_at"`
+	LastUpdated        time.Time                  `db:"last_updated" json:"last_updated"`
+	Deleted            bool                       `db:"deleted" json:"deleted"`
+	IsFirstPost        bool                       `db:"is_first_post" json:"is_first_post"`
+	UserMintUrl        sql.NullString             `db:"user_mint_url" json:"user_mint_url"`
+	EditedAt           sql.NullTime               `db:"edited_at" json:"edited_at"`
+	CommentRestriction persist.CommentRestriction `db:"comment_restriction" json:"comment_restriction"`
+	ModerationHidden   bool                       `db:"moderation_hidden" json:"moderation_hidden"`
+}
+
+type PostEdit struct {
+	ID          persist.DBID   `db:"id" json:"id"`
+	PostID      persist.DBID   `db:"post_id" json:"post_id"`
+	ActorID     persist.DBID   `db:"actor_id" json:"actor_id"`
+	Caption     sql.NullString `db:"caption" json:"caption"`
+	UserMintUrl sql.NullString `db:"user_mint_url" json:"user_mint_url"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+}
+
+type PostHashtag struct {
+	PostID    persist.DBID `db:"post_id" json:"post_id"`
+	Tag       string       `db:"tag" json:"tag"`
+	CreatedAt time.Time    `db:"created_at" json:"created_at"`
 }
 
 type PrivyUser struct {
@@ -560,6 +644,30 @@ type PushNotificationToken struct {
 	Deleted   bool         `db:"deleted" json:"deleted"`
 }
 
+type QueuedTask struct {
+	ID                 persist.DBID      `db:"id" json:"id"`
+	Queue              string            `db:"queue" json:"queue"`
+	Url                string            `db:"url" json:"url"`
+	Method             string            `db:"method" json:"method"`
+	Headers            map[string]string `db:"headers" json:"headers"`
+	Body               []byte            `db:"body" json:"body"`
+	ScheduledAt        time.Time         `db:"scheduled_at" json:"scheduled_at"`
+	DispatchDeadlineMs int64             `db:"dispatch_deadline_ms" json:"dispatch_deadline_ms"`
+	Attempts           int32             `db:"attempts" json:"attempts"`
+	LockedUntil        sql.NullTime      `db:"locked_until" json:"locked_until"`
+	LastError          sql.NullString    `db:"last_error" json:"last_error"`
+	DeadLetteredAt     sql.NullTime      `db:"dead_lettered_at" json:"dead_lettered_at"`
+	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
+	LastUpdated        time.Time         `db:"last_updated" json:"last_updated"`
+}
+
+type QueuedTaskQueue struct {
+	Queue          string    `db:"queue" json:"queue"`
+	NextDispatchAt time.Time `db:"next_dispatch_at" json:"next_dispatch_at"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time `db:"last_updated" json:"last_updated"`
+}
+
 type RecommendationResult struct {
 	ID                persist.DBID  `db:"id" json:"id"`
 	Version           sql.NullInt32 `db:"version" json:"version"`
@@ -571,6 +679,18 @@ type RecommendationResult struct {
 	Deleted           bool          `db:"deleted" json:"deleted"`
 }
 
+type Report struct {
+	ID          persist.DBID              `db:"id" json:"id"`
+	ReporterID  persist.DBID              `db:"reporter_id" json:"reporter_id"`
+	SubjectType persist.ReportSubjectType `db:"subject_type" json:"subject_type"`
+	SubjectID   persist.DBID              `db:"subject_id" json:"subject_id"`
+	Reason      persist.ReportReason      `db:"reason" json:"reason"`
+	ResolvedAt  sql.NullTime              `db:"resolved_at" json:"resolved_at"`
+	Deleted     bool                      `db:"deleted" json:"deleted"`
+	CreatedAt   time.Time                 `db:"created_at" json:"created_at"`
+	LastUpdated time.Time                 `db:"last_updated" json:"last_updated"`
+}
+
 type ReportedPost struct {
 	ID          persist.DBID         `db:"id" json:"id"`
 	CreatedAt   time.Time            `db:"created_at" json:"created_at"`
@@ -581,12 +701,38 @@ type ReportedPost struct {
 	Reason      persist.ReportReason `db:"reason" json:"reason"`
 }
 
+type Repost struct {
+	ID          persist.DBID   `db:"id" json:"id"`
+	ActorID     persist.DBID   `db:"actor_id" json:"actor_id"`
+	PostID      persist.DBID   `db:"post_id" json:"post_id"`
+	Caption     sql.NullString `db:"caption" json:"caption"`
+	Deleted     bool           `db:"deleted" json:"deleted"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated time.Time      `db:"last_updated" json:"last_updated"`
+}
+
 type ReprocessJob struct {
 	ID           int          `db:"id" json:"id"`
 	TokenStartID persist.DBID `db:"token_start_id" json:"token_start_id"`
 	TokenEndID   persist.DBID `db:"token_end_id" json:"token_end_id"`
 }
 
+type ScheduledPost struct {
+	ID                 persist.DBID     `db:"id" json:"id"`
+	ActorID            persist.DBID     `db:"actor_id" json:"actor_id"`
+	TokenIds           persist.DBIDList `db:"token_ids" json:"token_ids"`
+	Caption            sql.NullString   `db:"caption" json:"caption"`
+	UserMintUrl        sql.NullString   `db:"user_mint_url" json:"user_mint_url"`
+	Mentions           pgtype.JSONB     `db:"mentions" json:"mentions"`
+	PublishAt          time.Time        `db:"publish_at" json:"publish_at"`
+	PostID             persist.DBID     `db:"post_id" json:"post_id"`
+	PublishedAt        sql.NullTime     `db:"published_at" json:"published_at"`
+	Deleted            bool             `db:"deleted" json:"deleted"`
+	CreatedAt          time.Time        `db:"created_at" json:"created_at"`
+	LastUpdated        time.Time        `db:"last_updated" json:"last_updated"`
+	EventsDispatchedAt sql.NullTime     `db:"events_dispatched_at" json:"events_dispatched_at"`
+}
+
 type ScrubbedPiiAccountCreationInfo struct {
 	UserID    persist.DBID    `db:"user_id" json:"user_id"`
 	IpAddress persist.Address `db:"ip_address" json:"ip_address"`
@@ -646,6 +792,7 @@ type Token struct {
 	TokenDefinitionID persist.DBID      `db:"token_definition_id" json:"token_definition_id"`
 	IsHolderToken     bool              `db:"is_holder_token" json:"is_holder_token"`
 	Displayable       bool              `db:"displayable" json:"displayable"`
+	AcquiredAt        sql.NullTime      `db:"acquired_at" json:"acquired_at"`
 }
 
 type TokenCommunityMembership struct {
@@ -678,6 +825,14 @@ type TokenDefinition struct {
 	IsFxhash        bool                  `db:"is_fxhash" json:"is_fxhash"`
 }
 
+type TokenDefinitionSpamScore struct {
+	TokenDefinitionID persist.DBID        `db:"token_definition_id" json:"token_definition_id"`
+	Score             int32               `db:"score" json:"score"`
+	Reasons           persist.SpamReasons `db:"reasons" json:"reasons"`
+	CreatedAt         time.Time           `db:"created_at" json:"created_at"`
+	LastUpdated       time.Time           `db:"last_updated" json:"last_updated"`
+}
+
 type TokenMedia struct {
 	ID              persist.DBID           `db:"id" json:"id"`
 	CreatedAt       time.Time              `db:"created_at" json:"created_at"`
@@ -692,6 +847,13 @@ type TokenMedia struct {
 	TokenID         persist.DecimalTokenID `db:"token_id" json:"token_id"`
 }
 
+type TokenMediaSource struct {
+	SourceKey    string       `db:"source_key" json:"source_key"`
+	TokenMediaID persist.DBID `db:"token_media_id" json:"token_media_id"`
+	CreatedAt    time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated  time.Time    `db:"last_updated" json:"last_updated"`
+}
+
 type TokenMediasActive struct {
 	ID               persist.DBID `db:"id" json:"id"`
 	LastUpdated      time.Time    `db:"last_updated" json:"last_updated"`
@@ -717,6 +879,24 @@ type TokenMediasNoValidationRule struct {
 	Reason      string       `db:"reason" json:"reason"`
 }
 
+type TokenProcessingFailure struct {
+	ID                  persist.DBID                    `db:"id" json:"id"`
+	TokenDefinitionID   persist.DBID                    `db:"token_definition_id" json:"token_definition_id"`
+	Chain               persist.Chain                   `db:"chain" json:"chain"`
+	ContractAddress     persist.Address                 `db:"contract_address" json:"contract_address"`
+	ProcessingCause     persist.ProcessingCause         `db:"processing_cause" json:"processing_cause"`
+	ErrorClass          string                          `db:"error_class" json:"error_class"`
+	ErrorMessage        string                          `db:"error_message" json:"error_message"`
+	Attempts            int32                           `db:"attempts" json:"attempts"`
+	AttemptHistory      persist.TokenProcessingAttempts `db:"attempt_history" json:"attempt_history"`
+	LastProcessingJobID persist.DBID                    `db:"last_processing_job_id" json:"last_processing_job_id"`
+	DeadLetteredAt      sql.NullTime                    `db:"dead_lettered_at" json:"dead_lettered_at"`
+	ReplayedAt          sql.NullTime                    `db:"replayed_at" json:"replayed_at"`
+	CreatedAt           time.Time                       `db:"created_at" json:"created_at"`
+	LastUpdated         time.Time                       `db:"last_updated" json:"last_updated"`
+	Deleted             bool                            `db:"deleted" json:"deleted"`
+}
+
 type TokenProcessingJob struct {
 	ID               persist.DBID             `db:"id" json:"id"`
 	CreatedAt        time.Time                `db:"created_at" json:"created_at"`
@@ -733,6 +913,15 @@ type TopRecommendedUser struct {
 	LastUpdated       interface{}  `db:"last_updated" json:"last_updated"`
 }
 
+type TopicFollow struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	UserID      persist.DBID `db:"user_id" json:"user_id"`
+	Tag         string       `db:"tag" json:"tag"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+}
+
 type User struct {
 	ID                   persist.DBID                     `db:"id" json:"id"`
 	Deleted              bool                             `db:"deleted" json:"deleted"`
@@ -798,3 +987,43 @@ type Wallet struct {
 	Chain       persist.Chain      `db:"chain" json:"chain"`
 	L1Chain     persist.L1Chain    `db:"l1_chain" json:"l1_chain"`
 }
+
+type WalletSyncCheckpoint struct {
+	ID             persist.DBID   `db:"id" json:"id"`
+	WalletID       persist.DBID   `db:"wallet_id" json:"wallet_id"`
+	OwnerUserID    persist.DBID   `db:"owner_user_id" json:"owner_user_id"`
+	Chain          persist.Chain  `db:"chain" json:"chain"`
+	BlockNumber    sql.NullInt64  `db:"block_number" json:"block_number"`
+	ProviderCursor sql.NullString `db:"provider_cursor" json:"provider_cursor"`
+	SyncedAt       time.Time      `db:"synced_at" json:"synced_at"`
+	FullSyncedAt   sql.NullTime   `db:"full_synced_at" json:"full_synced_at"`
+	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time      `db:"last_updated" json:"last_updated"`
+	Deleted        bool           `db:"deleted" json:"deleted"`
+}
+
+type Webhook struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	OwnerID     persist.DBID `db:"owner_id" json:"owner_id"`
+	CommunityID persist.DBID `db:"community_id" json:"community_id"`
+	Url         string       `db:"url" json:"url"`
+	Secret      string       `db:"secret" json:"secret"`
+	EventTypes  []string     `db:"event_types" json:"event_types"`
+	Enabled     bool         `db:"enabled" json:"enabled"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+}
+
+type WebhookDelivery struct {
+	ID             persist.DBID   `db:"id" json:"id"`
+	WebhookID      persist.DBID   `db:"webhook_id" json:"webhook_id"`
+	EventType      string         `db:"event_type" json:"event_type"`
+	Payload        pgtype.JSONB   `db:"payload" json:"payload"`
+	Status         string         `db:"status" json:"status"`
+	Attempts       int32          `db:"attempts" json:"attempts"`
+	ResponseStatus sql.NullInt32  `db:"response_status" json:"response_status"`
+	Error          sql.NullString `db:"error" json:"error"`
+	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time      `db:"last_updated" json:"last_updated"`
+}
@@ -157,6 +157,71 @@ func (q *Queries) CheckUserOwnsAllTokenDbids(ctx context.Context, arg CheckUserO
 	return owns_all, err
 }
 
+const claimQueuedTasks = `-- name: ClaimQueuedTasks :many
+with limits as (
+    select unnest($1::varchar[]) as queue, unnest($2::int[]) as queue_limit
+),
+ranked as (
+    select t.id, t.scheduled_at, row_number() over (partition by t.queue order by t.scheduled_at) as position, l.queue_limit
+    from queued_tasks t
+    join limits l on l.queue = t.queue
+    where t.dead_lettered_at is null and t.scheduled_at <= now() and (t.locked_until is null or t.locked_until <= now())
+),
+due as (
+    select queued_tasks.id from queued_tasks
+    join ranked on ranked.id = queued_tasks.id
+    where ranked.position <= ranked.queue_limit
+    order by ranked.scheduled_at
+    limit $3
+    for update of queued_tasks skip locked
+)
+update queued_tasks set attempts = queued_tasks.attempts + 1, locked_until = now() + (queued_tasks.dispatch_deadline_ms + 60000) * interval '1 millisecond', last_updated = now()
+from due where queued_tasks.id = due.id
+returning queued_tasks.id, queued_tasks.queue, queued_tasks.url, queued_tasks.method, queued_tasks.headers, queued_tasks.body, queued_tasks.scheduled_at, queued_tasks.dispatch_deadline_ms, queued_tasks.attempts, queued_tasks.locked_until, queued_tasks.last_error, queued_tasks.dead_lettered_at, queued_tasks.created_at, queued_tasks.last_updated
+`
+
+type ClaimQueuedTasksParams struct {
+	Queues      []string `db:"queues" json:"queues"`
+	QueueLimits []int32  `db:"queue_limits" json:"queue_limits"`
+	Limit       int32    `db:"limit" json:"limit"`
+}
+
+// Claims the earliest due tasks, taking at most queue_limits[i] tasks from queues[i]. Tasks of queues that aren't listed aren't claimed.
+func (q *Queries) ClaimQueuedTasks(ctx context.Context, arg ClaimQueuedTasksParams) ([]QueuedTask, error) {
+	rows, err := q.db.Query(ctx, claimQueuedTasks, arg.Queues, arg.QueueLimits, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueuedTask
+	for rows.Next() {
+		var i QueuedTask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Queue,
+			&i.Url,
+			&i.Method,
+			&i.Headers,
+			&i.Body,
+			&i.ScheduledAt,
+			&i.DispatchDeadlineMs,
+			&i.Attempts,
+			&i.LockedUntil,
+			&i.LastError,
+			&i.DeadLetteredAt,
+			&i.CreatedAt,
+			&i.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const clearNotificationsForUser = `-- name: ClearNotificationsForUser :many
 UPDATE notifications SET seen = true WHERE owner_id = $1 AND seen = false RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, post_id, token_id, mention_id, community_id
 `
@@ -212,7 +277,7 @@ func (q *Queries) CountAllUsers(ctx context.Context) (int64, error) {
 }
 
 const countCommentsAndRepliesByFeedEventID = `-- name: CountCommentsAndRepliesByFeedEventID :one
-SELECT count(*) FROM comments WHERE feed_event_id = $1 AND deleted = false
+SELECT count(*) FROM comments WHERE feed_event_id = $1 AND deleted = false AND moderation_hidden = false
 `
 
 func (q *Queries) CountCommentsAndRepliesByFeedEventID(ctx context.Context, feedEventID persist.DBID) (int64, error) {
@@ -224,10 +289,17 @@ func (q *Queries) CountCommentsAndRepliesByFeedEventID(ctx context.Context, feed
 
 const countCommentsAndRepliesByPostID = `-- name: CountCommentsAndRepliesByPostID :one
 SELECT count(*) FROM comments WHERE post_id = $1 AND deleted = false
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = $2)
 `
 
-func (q *Queries) CountCommentsAndRepliesByPostID(ctx context.Context, postID persist.DBID) (int64, error) {
-	row := q.db.QueryRow(ctx, countCommentsAndRepliesByPostID, postID)
+type CountCommentsAndRepliesByPostIDParams struct {
+	PostID   persist.DBID `db:"post_id" json:"post_id"`
+	ViewerID persist.DBID `db:"viewer_id" json:"viewer_id"`
+}
+
+func (q *Queries) CountCommentsAndRepliesByPostID(ctx context.Context, arg CountCommentsAndRepliesByPostIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCommentsAndRepliesByPostID, arg.PostID, arg.ViewerID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -271,6 +343,7 @@ select count(*)
 from posts
 where $1::dbid = any(posts.contract_ids)
 and posts.deleted = false
+and posts.moderation_hidden = false
 `
 
 func (q *Queries) CountPostsByContractID(ctx context.Context, contractID persist.DBID) (int64, error) {
@@ -281,7 +354,7 @@ func (q *Queries) CountPostsByContractID(ctx context.Context, contractID persist
 }
 
 const countPostsByUserID = `-- name: CountPostsByUserID :one
-select count(*) from posts where actor_id = $1 and not posts.deleted
+select count(*) from posts where actor_id = $1 and not posts.deleted and not posts.moderation_hidden
 `
 
 func (q *Queries) CountPostsByUserID(ctx context.Context, actorID persist.DBID) (int64, error) {
@@ -1609,6 +1682,20 @@ func (q *Queries) CreateViewGalleryNotification(ctx context.Context, arg CreateV
 	return i, err
 }
 
+const deadLetterQueuedTask = `-- name: DeadLetterQueuedTask :exec
+update queued_tasks set dead_lettered_at = now(), locked_until = null, last_error = $1::varchar, last_updated = now() where id = $2
+`
+
+type DeadLetterQueuedTaskParams struct {
+	LastError string       `db:"last_error" json:"last_error"`
+	ID        persist.DBID `db:"id" json:"id"`
+}
+
+func (q *Queries) DeadLetterQueuedTask(ctx context.Context, arg DeadLetterQueuedTaskParams) error {
+	_, err := q.db.Exec(ctx, deadLetterQueuedTask, arg.LastError, arg.ID)
+	return err
+}
+
 const deleteCollections = `-- name: DeleteCollections :exec
 update collections set deleted = true, last_updated = now() where id = any($1::varchar[])
 `
@@ -1618,6 +1705,40 @@ func (q *Queries) DeleteCollections(ctx context.Context, ids []string) error {
 	return err
 }
 
+const deleteMentionsByPostID = `-- name: DeleteMentionsByPostID :many
+update mentions set deleted = true where post_id = $1 and not deleted returning id, post_id, comment_id, user_id, start, length, created_at, deleted, community_id
+`
+
+func (q *Queries) DeleteMentionsByPostID(ctx context.Context, postID persist.DBID) ([]Mention, error) {
+	rows, err := q.db.Query(ctx, deleteMentionsByPostID, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Mention
+	for rows.Next() {
+		var i Mention
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.CommentID,
+			&i.UserID,
+			&i.Start,
+			&i.Length,
+			&i.CreatedAt,
+			&i.Deleted,
+			&i.CommunityID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deletePostByID = `-- name: DeletePostByID :exec
 update posts set deleted = true where id = $1
 `
@@ -1636,6 +1757,15 @@ func (q *Queries) DeletePushTokensByIDs(ctx context.Context, ids []persist.DBID)
 	return err
 }
 
+const deleteQueuedTask = `-- name: DeleteQueuedTask :exec
+delete from queued_tasks where id = $1
+`
+
+func (q *Queries) DeleteQueuedTask(ctx context.Context, id persist.DBID) error {
+	_, err := q.db.Exec(ctx, deleteQueuedTask, id)
+	return err
+}
+
 const deleteUserByID = `-- name: DeleteUserByID :exec
 update users set deleted = true where id = $1
 `
@@ -1668,6 +1798,34 @@ func (q *Queries) DeleteWalletByID(ctx context.Context, id persist.DBID) error {
 	return err
 }
 
+const getActiveMediaBySourceKey = `-- name: GetActiveMediaBySourceKey :one
+select tm.id, tm.created_at, tm.last_updated, tm.version, tm.active, tm.media, tm.processing_job_id, tm.deleted, tm.chain, tm.contract_address, tm.token_id
+from token_media_sources s
+join token_medias tm on s.token_media_id = tm.id
+where s.source_key = $1
+    and tm.active
+    and not tm.deleted
+`
+
+func (q *Queries) GetActiveMediaBySourceKey(ctx context.Context, sourceKey string) (TokenMedia, error) {
+	row := q.db.QueryRow(ctx, getActiveMediaBySourceKey, sourceKey)
+	var i TokenMedia
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LastUpdated,
+		&i.Version,
+		&i.Active,
+		&i.Media,
+		&i.ProcessingJobID,
+		&i.Deleted,
+		&i.Chain,
+		&i.ContractAddress,
+		&i.TokenID,
+	)
+	return i, err
+}
+
 const getActiveWallets = `-- name: GetActiveWallets :many
 select w.id, w.created_at, w.last_updated, w.deleted, w.version, w.address, w.wallet_type, w.chain, w.l1_chain from users u join wallets w on w.id = any(u.wallets) where not u.deleted and not w.deleted and not u.universal
 `
@@ -1950,7 +2108,7 @@ func (q *Queries) GetCollectionsByGalleryId(ctx context.Context, id persist.DBID
 }
 
 const getCommentByCommentID = `-- name: GetCommentByCommentID :one
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id FROM comments WHERE id = $1 AND deleted = false
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden FROM comments WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetCommentByCommentID(ctx context.Context, id persist.DBID) (Comment, error) {
@@ -1969,12 +2127,14 @@ func (q *Queries) GetCommentByCommentID(ctx context.Context, id persist.DBID) (C
 		&i.PostID,
 		&i.Removed,
 		&i.TopLevelCommentID,
+		&i.Hidden,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
 
 const getCommentsByCommentIDs = `-- name: GetCommentsByCommentIDs :many
-SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id from comments WHERE id = ANY($1::dbid[]) AND deleted = false
+SELECT id, version, feed_event_id, actor_id, reply_to, comment, deleted, created_at, last_updated, post_id, removed, top_level_comment_id, hidden, moderation_hidden from comments WHERE id = ANY($1::dbid[]) AND deleted = false
 `
 
 func (q *Queries) GetCommentsByCommentIDs(ctx context.Context, commentIds []persist.DBID) ([]Comment, error) {
@@ -1999,6 +2159,8 @@ func (q *Queries) GetCommentsByCommentIDs(ctx context.Context, commentIds []pers
 			&i.PostID,
 			&i.Removed,
 			&i.TopLevelCommentID,
+			&i.Hidden,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -2117,6 +2279,113 @@ func (q *Queries) GetContractCreatorsByIds(ctx context.Context, contractIds []st
 	return items, nil
 }
 
+const getContractIDsToScoreForSpam = `-- name: GetContractIDsToScoreForSpam :many
+select c.id
+from contracts c
+left join contract_spam_scores s on s.contract_id = c.id
+where not c.deleted and (s.contract_id is null or s.last_updated < $1)
+order by s.last_updated nulls first, c.id
+limit $2
+`
+
+type GetContractIDsToScoreForSpamParams struct {
+	ScoredBefore time.Time `db:"scored_before" json:"scored_before"`
+	Limit        int32     `db:"limit" json:"limit"`
+}
+
+func (q *Queries) GetContractIDsToScoreForSpam(ctx context.Context, arg GetContractIDsToScoreForSpamParams) ([]persist.DBID, error) {
+	rows, err := q.db.Query(ctx, getContractIDsToScoreForSpam, arg.ScoredBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []persist.DBID
+	for rows.Next() {
+		var id persist.DBID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContractSpamSignals = `-- name: GetContractSpamSignals :many
+with displayed_tokens as (
+    -- Tokens from these contracts that their owners display in a collection, found by unnesting the holders' collections once
+    select distinct dt.id as token_id
+    from collections col
+    cross join unnest(col.nfts) as u(token_id)
+    join tokens dt on dt.id = u.token_id and dt.owner_user_id = col.owner_user_id
+    where dt.contract_id = any($1::varchar[])
+        and not dt.deleted
+        and not col.deleted
+        and col.owner_user_id in (select owner_user_id from tokens where contract_id = any($1::varchar[]) and is_holder_token and not deleted)
+)
+select c.id as contract_id,
+    c.name,
+    c.description,
+    c.is_provider_marked_spam,
+    c.created_at,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token) as holders,
+    count(distinct t.owner_user_id) filter (where t.is_user_marked_spam) as spam_voters,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token and coalesce(t.acquired_at, t.created_at) < f.first_seen + interval '2 days') as early_holders,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token and d.token_id is not null) as displaying_holders
+from contracts c
+left join tokens t on t.contract_id = c.id and not t.deleted
+left join displayed_tokens d on d.token_id = t.id
+left join lateral (
+    select min(coalesce(tokens.acquired_at, tokens.created_at)) as first_seen from tokens where tokens.contract_id = c.id and tokens.is_holder_token and not tokens.deleted
+) f on true
+where c.id = any($1::varchar[]) and not c.deleted
+group by c.id, f.first_seen
+`
+
+type GetContractSpamSignalsRow struct {
+	ContractID           persist.DBID   `db:"contract_id" json:"contract_id"`
+	Name                 sql.NullString `db:"name" json:"name"`
+	Description          sql.NullString `db:"description" json:"description"`
+	IsProviderMarkedSpam bool           `db:"is_provider_marked_spam" json:"is_provider_marked_spam"`
+	CreatedAt            time.Time      `db:"created_at" json:"created_at"`
+	Holders              int64          `db:"holders" json:"holders"`
+	SpamVoters           int64          `db:"spam_voters" json:"spam_voters"`
+	EarlyHolders         int64          `db:"early_holders" json:"early_holders"`
+	DisplayingHolders    int64          `db:"displaying_holders" json:"displaying_holders"`
+}
+
+func (q *Queries) GetContractSpamSignals(ctx context.Context, contractIds []string) ([]GetContractSpamSignalsRow, error) {
+	rows, err := q.db.Query(ctx, getContractSpamSignals, contractIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContractSpamSignalsRow
+	for rows.Next() {
+		var i GetContractSpamSignalsRow
+		if err := rows.Scan(
+			&i.ContractID,
+			&i.Name,
+			&i.Description,
+			&i.IsProviderMarkedSpam,
+			&i.CreatedAt,
+			&i.Holders,
+			&i.SpamVoters,
+			&i.EarlyHolders,
+			&i.DisplayingHolders,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getContractsByIDs = `-- name: GetContractsByIDs :many
 with keys as (
     select unnest ($1::varchar[]) as id
@@ -2323,6 +2592,73 @@ func (q *Queries) GetCurrentTime(ctx context.Context) (time.Time, error) {
 	return column_1, err
 }
 
+const getDeadLetteredTokenProcessingFailures = `-- name: GetDeadLetteredTokenProcessingFailures :many
+select f.id, f.token_definition_id, f.chain, f.contract_address, f.processing_cause, f.error_class, f.error_message, f.attempts, f.attempt_history, f.last_processing_job_id, f.dead_lettered_at, f.replayed_at, f.created_at, f.last_updated, f.deleted, j.pipeline_metadata
+from token_processing_failures f
+left join token_processing_jobs j on j.id = f.last_processing_job_id
+where f.dead_lettered_at is not null
+    and not f.deleted
+    and ($1::int is null or f.chain = $1)
+    and ($2::varchar is null or f.contract_address = $2)
+    and ($3::varchar is null or f.error_class = $3)
+order by f.dead_lettered_at desc, f.id desc
+limit $4
+`
+
+type GetDeadLetteredTokenProcessingFailuresParams struct {
+	Chain           sql.NullInt32  `db:"chain" json:"chain"`
+	ContractAddress sql.NullString `db:"contract_address" json:"contract_address"`
+	ErrorClass      sql.NullString `db:"error_class" json:"error_class"`
+	Limit           int32          `db:"limit" json:"limit"`
+}
+
+type GetDeadLetteredTokenProcessingFailuresRow struct {
+	TokenProcessingFailure TokenProcessingFailure   `db:"token_processing_failure" json:"token_processing_failure"`
+	PipelineMetadata       persist.PipelineMetadata `db:"pipeline_metadata" json:"pipeline_metadata"`
+}
+
+func (q *Queries) GetDeadLetteredTokenProcessingFailures(ctx context.Context, arg GetDeadLetteredTokenProcessingFailuresParams) ([]GetDeadLetteredTokenProcessingFailuresRow, error) {
+	rows, err := q.db.Query(ctx, getDeadLetteredTokenProcessingFailures,
+		arg.Chain,
+		arg.ContractAddress,
+		arg.ErrorClass,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeadLetteredTokenProcessingFailuresRow
+	for rows.Next() {
+		var i GetDeadLetteredTokenProcessingFailuresRow
+		if err := rows.Scan(
+			&i.TokenProcessingFailure.ID,
+			&i.TokenProcessingFailure.TokenDefinitionID,
+			&i.TokenProcessingFailure.Chain,
+			&i.TokenProcessingFailure.ContractAddress,
+			&i.TokenProcessingFailure.ProcessingCause,
+			&i.TokenProcessingFailure.ErrorClass,
+			&i.TokenProcessingFailure.ErrorMessage,
+			&i.TokenProcessingFailure.Attempts,
+			&i.TokenProcessingFailure.AttemptHistory,
+			&i.TokenProcessingFailure.LastProcessingJobID,
+			&i.TokenProcessingFailure.DeadLetteredAt,
+			&i.TokenProcessingFailure.ReplayedAt,
+			&i.TokenProcessingFailure.CreatedAt,
+			&i.TokenProcessingFailure.LastUpdated,
+			&i.TokenProcessingFailure.Deleted,
+			&i.PipelineMetadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEthereumWalletsForEnsProfileImagesByUserID = `-- name: GetEthereumWalletsForEnsProfileImagesByUserID :many
 select w.id, w.created_at, w.last_updated, w.deleted, w.version, w.address, w.wallet_type, w.chain, w.l1_chain
 from wallets w
@@ -2644,8 +2980,30 @@ func (q *Queries) GetFeedEventsByIds(ctx context.Context, ids []string) ([]FeedE
 	return items, nil
 }
 
+const getFollowStatus = `-- name: GetFollowStatus :one
+select exists(select 1 from follows where follower = $1 and followee = $2 and not deleted)::bool as following,
+    exists(select 1 from follows where follower = $2 and followee = $1 and not deleted)::bool as followed_by
+`
+
+type GetFollowStatusParams struct {
+	UserID      persist.DBID `db:"user_id" json:"user_id"`
+	OtherUserID persist.DBID `db:"other_user_id" json:"other_user_id"`
+}
+
+type GetFollowStatusRow struct {
+	Following  bool `db:"following" json:"following"`
+	FollowedBy bool `db:"followed_by" json:"followed_by"`
+}
+
+func (q *Queries) GetFollowStatus(ctx context.Context, arg GetFollowStatusParams) (GetFollowStatusRow, error) {
+	row := q.db.QueryRow(ctx, getFollowStatus, arg.UserID, arg.OtherUserID)
+	var i GetFollowStatusRow
+	err := row.Scan(&i.Following, &i.FollowedBy)
+	return i, err
+}
+
 const getGalleriesByUserId = `-- name: GetGalleriesByUserId :many
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
 `
 
 func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.DBID) ([]Gallery, error) {
@@ -2669,6 +3027,7 @@ func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -2681,7 +3040,7 @@ func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.
 }
 
 const getGalleryByCollectionId = `-- name: GetGalleryByCollectionId :one
-SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
+SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position, g.moderation_hidden FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
 `
 
 func (q *Queries) GetGalleryByCollectionId(ctx context.Context, id persist.DBID) (Gallery, error) {
@@ -2699,12 +3058,13 @@ func (q *Queries) GetGalleryByCollectionId(ctx context.Context, id persist.DBID)
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
 
 const getGalleryById = `-- name: GetGalleryById :one
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE id = $1 AND deleted = false
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden FROM galleries WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetGalleryById(ctx context.Context, id persist.DBID) (Gallery, error) {
@@ -2722,6 +3082,7 @@ func (q *Queries) GetGalleryById(ctx context.Context, id persist.DBID) (Gallery,
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
@@ -2841,6 +3202,25 @@ func (q *Queries) GetHighlightMintClaimByID(ctx context.Context, id persist.DBID
 	return i, err
 }
 
+const getIndexerCheckpoint = `-- name: GetIndexerCheckpoint :one
+select id, chain, block_number, block_hash, created_at, last_updated, deleted from indexer_checkpoints where chain = $1 and not deleted
+`
+
+func (q *Queries) GetIndexerCheckpoint(ctx context.Context, chain persist.Chain) (IndexerCheckpoint, error) {
+	row := q.db.QueryRow(ctx, getIndexerCheckpoint, chain)
+	var i IndexerCheckpoint
+	err := row.Scan(
+		&i.ID,
+		&i.Chain,
+		&i.BlockNumber,
+		&i.BlockHash,
+		&i.CreatedAt,
+		&i.LastUpdated,
+		&i.Deleted,
+	)
+	return i, err
+}
+
 const getLastFeedEventForCollection = `-- name: GetLastFeedEventForCollection :one
 select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id from feed_events where deleted = false
     and owner_id = $1
@@ -3395,7 +3775,7 @@ func (q *Queries) GetOnboardingUserRecommendations(ctx context.Context, limit in
 }
 
 const getPostByID = `-- name: GetPostByID :one
-SELECT id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url FROM posts WHERE id = $1 AND deleted = false
+SELECT id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url, edited_at, comment_restriction, moderation_hidden FROM posts WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetPostByID(ctx context.Context, id persist.DBID) (Post, error) {
@@ -3413,10 +3793,44 @@ func (q *Queries) GetPostByID(ctx context.Context, id persist.DBID) (Post, error
 		&i.Deleted,
 		&i.IsFirstPost,
 		&i.UserMintUrl,
+		&i.EditedAt,
+		&i.CommentRestriction,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
 
+const getPostEditsByPostID = `-- name: GetPostEditsByPostID :many
+select id, post_id, actor_id, caption, user_mint_url, created_at from post_edits where post_id = $1 order by created_at desc, id desc
+`
+
+func (q *Queries) GetPostEditsByPostID(ctx context.Context, postID persist.DBID) ([]PostEdit, error) {
+	rows, err := q.db.Query(ctx, getPostEditsByPostID, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostEdit
+	for rows.Next() {
+		var i PostEdit
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.ActorID,
+			&i.Caption,
+			&i.UserMintUrl,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPotentialENSProfileImageByUserId = `-- name: GetPotentialENSProfileImageByUserId :one
 select token_definitions.id, token_definitions.created_at, token_definitions.last_updated, token_definitions.deleted, token_definitions.name, token_definitions.description, token_definitions.token_type, token_definitions.token_id, token_definitions.external_url, token_definitions.chain, token_definitions.metadata, token_definitions.fallback_media, token_definitions.contract_address, token_definitions.contract_id, token_definitions.token_media_id, token_definitions.is_fxhash, token_medias.id, token_medias.created_at, token_medias.last_updated, token_medias.version, token_medias.active, token_medias.media, token_medias.processing_job_id, token_medias.deleted, token_medias.chain, token_medias.contract_address, token_medias.token_id, wallets.id, wallets.created_at, wallets.last_updated, wallets.deleted, wallets.version, wallets.address, wallets.wallet_type, wallets.chain, wallets.l1_chain
 from token_definitions, tokens, users, token_medias, wallets, unnest(tokens.owned_by_wallets) tw(id)
@@ -3617,6 +4031,40 @@ func (q *Queries) GetPushTokensByUserID(ctx context.Context, userID persist.DBID
 	return items, nil
 }
 
+const getQueuedTaskQueueStates = `-- name: GetQueuedTaskQueueStates :many
+select t.queue, (count(*) filter (where t.locked_until > now()))::int as in_flight, s.next_dispatch_at
+from queued_tasks t
+left join queued_task_queues s on s.queue = t.queue
+where t.dead_lettered_at is null
+group by t.queue, s.next_dispatch_at
+`
+
+type GetQueuedTaskQueueStatesRow struct {
+	Queue          string       `db:"queue" json:"queue"`
+	InFlight       int32        `db:"in_flight" json:"in_flight"`
+	NextDispatchAt sql.NullTime `db:"next_dispatch_at" json:"next_dispatch_at"`
+}
+
+func (q *Queries) GetQueuedTaskQueueStates(ctx context.Context) ([]GetQueuedTaskQueueStatesRow, error) {
+	rows, err := q.db.Query(ctx, getQueuedTaskQueueStates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetQueuedTaskQueueStatesRow
+	for rows.Next() {
+		var i GetQueuedTaskQueueStatesRow
+		if err := rows.Scan(&i.Queue, &i.InFlight, &i.NextDispatchAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRecentUnseenNotifications = `-- name: GetRecentUnseenNotifications :many
 SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, post_id, token_id, mention_id, community_id FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false and created_at > $2 order by created_at desc limit $3
 `
@@ -3679,7 +4127,7 @@ func (q *Queries) GetReprocessJobRangeByID(ctx context.Context, id int) (Reproce
 
 const getSVGTokensWithContractsByIDs = `-- name: GetSVGTokensWithContractsByIDs :many
 SELECT
-    tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable,
+    tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at,
     contracts.id, contracts.deleted, contracts.version, contracts.created_at, contracts.last_updated, contracts.name, contracts.symbol, contracts.address, contracts.creator_address, contracts.chain, contracts.profile_banner_url, contracts.profile_image_url, contracts.badge_url, contracts.description, contracts.owner_address, contracts.is_provider_marked_spam, contracts.parent_id, contracts.override_creator_user_id, contracts.l1_chain,
     (
         SELECT wallets.address
@@ -3720,6 +4168,7 @@ type GetSVGTokensWithContractsByIDsRow struct {
 	TokenDefinitionID     persist.DBID      `db:"token_definition_id" json:"token_definition_id"`
 	IsHolderToken         bool              `db:"is_holder_token" json:"is_holder_token"`
 	Displayable           bool              `db:"displayable" json:"displayable"`
+	AcquiredAt            sql.NullTime      `db:"acquired_at" json:"acquired_at"`
 	ID_2                  persist.DBID      `db:"id_2" json:"id_2"`
 	Deleted_2             bool              `db:"deleted_2" json:"deleted_2"`
 	Version_2             sql.NullInt32     `db:"version_2" json:"version_2"`
@@ -3769,6 +4218,7 @@ func (q *Queries) GetSVGTokensWithContractsByIDs(ctx context.Context, arg GetSVG
 			&i.TokenDefinitionID,
 			&i.IsHolderToken,
 			&i.Displayable,
+			&i.AcquiredAt,
 			&i.ID_2,
 			&i.Deleted_2,
 			&i.Version_2,
@@ -3990,7 +4440,7 @@ func (q *Queries) GetSocialsByUserID(ctx context.Context, id persist.DBID) (pers
 }
 
 const getTokenById = `-- name: GetTokenById :one
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 where t.id = $1 and t.displayable and t.deleted = false and td.deleted = false
@@ -4022,6 +4472,7 @@ func (q *Queries) GetTokenById(ctx context.Context, id persist.DBID) (GetTokenBy
 		&i.Token.TokenDefinitionID,
 		&i.Token.IsHolderToken,
 		&i.Token.Displayable,
+		&i.Token.AcquiredAt,
 		&i.TokenDefinition.ID,
 		&i.TokenDefinition.CreatedAt,
 		&i.TokenDefinition.LastUpdated,
@@ -4043,7 +4494,7 @@ func (q *Queries) GetTokenById(ctx context.Context, id persist.DBID) (GetTokenBy
 }
 
 const getTokenByUserTokenIdentifiers = `-- name: GetTokenByUserTokenIdentifiers :one
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
 from tokens t, token_definitions td, contracts c
 where t.token_definition_id = td.id
     and td.contract_id = c.id
@@ -4096,6 +4547,7 @@ func (q *Queries) GetTokenByUserTokenIdentifiers(ctx context.Context, arg GetTok
 		&i.Token.TokenDefinitionID,
 		&i.Token.IsHolderToken,
 		&i.Token.Displayable,
+		&i.Token.AcquiredAt,
 		&i.TokenDefinition.ID,
 		&i.TokenDefinition.CreatedAt,
 		&i.TokenDefinition.LastUpdated,
@@ -4233,7 +4685,7 @@ func (q *Queries) GetTokenDefinitionByTokenIdentifiers(ctx context.Context, arg
 }
 
 const getTokenFullDetailsByUserTokenIdentifiers = `-- name: GetTokenFullDetailsByUserTokenIdentifiers :one
-select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, token_definitions.id, token_definitions.created_at, token_definitions.last_updated, token_definitions.deleted, token_definitions.name, token_definitions.description, token_definitions.token_type, token_definitions.token_id, token_definitions.external_url, token_definitions.chain, token_definitions.metadata, token_definitions.fallback_media, token_definitions.contract_address, token_definitions.contract_id, token_definitions.token_media_id, token_definitions.is_fxhash, contracts.id, contracts.deleted, contracts.version, contracts.created_at, contracts.last_updated, contracts.name, contracts.symbol, contracts.address, contracts.creator_address, contracts.chain, contracts.profile_banner_url, contracts.profile_image_url, contracts.badge_url, contracts.description, contracts.owner_address, contracts.is_provider_marked_spam, contracts.parent_id, contracts.override_creator_user_id, contracts.l1_chain
+select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at, token_definitions.id, token_definitions.created_at, token_definitions.last_updated, token_definitions.deleted, token_definitions.name, token_definitions.description, token_definitions.token_type, token_definitions.token_id, token_definitions.external_url, token_definitions.chain, token_definitions.metadata, token_definitions.fallback_media, token_definitions.contract_address, token_definitions.contract_id, token_definitions.token_media_id, token_definitions.is_fxhash, contracts.id, contracts.deleted, contracts.version, contracts.created_at, contracts.last_updated, contracts.name, contracts.symbol, contracts.address, contracts.creator_address, contracts.chain, contracts.profile_banner_url, contracts.profile_image_url, contracts.badge_url, contracts.description, contracts.owner_address, contracts.is_provider_marked_spam, contracts.parent_id, contracts.override_creator_user_id, contracts.l1_chain
 from tokens
 join token_definitions on tokens.token_definition_id = token_definitions.id
 join contracts on token_definitions.contract_id = contracts.id
@@ -4285,6 +4737,7 @@ func (q *Queries) GetTokenFullDetailsByUserTokenIdentifiers(ctx context.Context,
 		&i.Token.TokenDefinitionID,
 		&i.Token.IsHolderToken,
 		&i.Token.Displayable,
+		&i.Token.AcquiredAt,
 		&i.TokenDefinition.ID,
 		&i.TokenDefinition.CreatedAt,
 		&i.TokenDefinition.LastUpdated,
@@ -4325,7 +4778,7 @@ func (q *Queries) GetTokenFullDetailsByUserTokenIdentifiers(ctx context.Context,
 }
 
 const getTokensByContractAddressUserId = `-- name: GetTokensByContractAddressUserId :many
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 join contracts c on td.contract_id = c.id
@@ -4382,6 +4835,7 @@ func (q *Queries) GetTokensByContractAddressUserId(ctx context.Context, arg GetT
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -4429,7 +4883,7 @@ func (q *Queries) GetTokensByContractAddressUserId(ctx context.Context, arg GetT
 }
 
 const getTokensByContractIdPaginate = `-- name: GetTokensByContractIdPaginate :many
-select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain, u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.profile_image_id, u.persona from tokens t
+select t.id, t.deleted, t.version, t.created_at, t.last_updated, t.collectors_note, t.quantity, t.block_number, t.owner_user_id, t.owned_by_wallets, t.contract_id, t.is_user_marked_spam, t.last_synced, t.is_creator_token, t.token_definition_id, t.is_holder_token, t.displayable, t.acquired_at, td.id, td.created_at, td.last_updated, td.deleted, td.name, td.description, td.token_type, td.token_id, td.external_url, td.chain, td.metadata, td.fallback_media, td.contract_address, td.contract_id, td.token_media_id, td.is_fxhash, c.id, c.deleted, c.version, c.created_at, c.last_updated, c.name, c.symbol, c.address, c.creator_address, c.chain, c.profile_banner_url, c.profile_image_url, c.badge_url, c.description, c.owner_address, c.is_provider_marked_spam, c.parent_id, c.override_creator_user_id, c.l1_chain, u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.profile_image_id, u.persona from tokens t
     join token_definitions td on t.token_definition_id = td.id
     join users u on u.id = t.owner_user_id
     join contracts c on t.contract_id = c.id
@@ -4504,6 +4958,7 @@ func (q *Queries) GetTokensByContractIdPaginate(ctx context.Context, arg GetToke
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -6082,6 +6537,29 @@ func (q *Queries) InsertPost(ctx context.Context, arg InsertPostParams) (persist
 	return id, err
 }
 
+const insertPostEdit = `-- name: InsertPostEdit :exec
+insert into post_edits (id, post_id, actor_id, caption, user_mint_url) values ($1, $2, $3, $4, $5)
+`
+
+type InsertPostEditParams struct {
+	ID          persist.DBID   `db:"id" json:"id"`
+	PostID      persist.DBID   `db:"post_id" json:"post_id"`
+	ActorID     persist.DBID   `db:"actor_id" json:"actor_id"`
+	Caption     sql.NullString `db:"caption" json:"caption"`
+	UserMintUrl sql.NullString `db:"user_mint_url" json:"user_mint_url"`
+}
+
+func (q *Queries) InsertPostEdit(ctx context.Context, arg InsertPostEditParams) error {
+	_, err := q.db.Exec(ctx, insertPostEdit,
+		arg.ID,
+		arg.PostID,
+		arg.ActorID,
+		arg.Caption,
+		arg.UserMintUrl,
+	)
+	return err
+}
+
 const insertPostMention = `-- name: InsertPostMention :one
 insert into mentions (id, user_id, community_id, post_id, start, length) values ($1, $2::text, $3::text, $4, $5, $6) returning id, post_id, comment_id, user_id, start, length, created_at, deleted, community_id
 `
@@ -6119,6 +6597,35 @@ func (q *Queries) InsertPostMention(ctx context.Context, arg InsertPostMentionPa
 	return i, err
 }
 
+const insertQueuedTask = `-- name: InsertQueuedTask :exec
+insert into queued_tasks (id, queue, url, method, headers, body, scheduled_at, dispatch_deadline_ms) values ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertQueuedTaskParams struct {
+	ID                 persist.DBID      `db:"id" json:"id"`
+	Queue              string            `db:"queue" json:"queue"`
+	Url                string            `db:"url" json:"url"`
+	Method             string            `db:"method" json:"method"`
+	Headers            map[string]string `db:"headers" json:"headers"`
+	Body               []byte            `db:"body" json:"body"`
+	ScheduledAt        time.Time         `db:"scheduled_at" json:"scheduled_at"`
+	DispatchDeadlineMs int64             `db:"dispatch_deadline_ms" json:"dispatch_deadline_ms"`
+}
+
+func (q *Queries) InsertQueuedTask(ctx context.Context, arg InsertQueuedTaskParams) error {
+	_, err := q.db.Exec(ctx, insertQueuedTask,
+		arg.ID,
+		arg.Queue,
+		arg.Url,
+		arg.Method,
+		arg.Headers,
+		arg.Body,
+		arg.ScheduledAt,
+		arg.DispatchDeadlineMs,
+	)
+	return err
+}
+
 const insertSpamContracts = `-- name: InsertSpamContracts :exec
 with insert_spam_contracts as (
     insert into alchemy_spam_contracts (id, chain, address, created_at, is_spam) (
@@ -6257,6 +6764,71 @@ func (q *Queries) InsertTokenPipelineResults(ctx context.Context, arg InsertToke
 	return i, err
 }
 
+const insertTokenPipelineResultsWithExistingMedia = `-- name: InsertTokenPipelineResultsWithExistingMedia :one
+with insert_job(id) as (
+    insert into token_processing_jobs (id, token_properties, pipeline_metadata, processing_cause, processor_version)
+    values ($1, $2, $3, $4, $5)
+    returning id
+)
+, update_token_definition as (
+    update token_definitions
+    set metadata = $6::jsonb,
+        name = $7,
+        description = $8,
+        last_updated = now(),
+        token_media_id = $9
+    where (chain, contract_address, token_id) = ($10, $11, $12) and not deleted
+)
+select tm.id, tm.created_at, tm.last_updated, tm.version, tm.active, tm.media, tm.processing_job_id, tm.deleted, tm.chain, tm.contract_address, tm.token_id from token_medias tm where tm.id = $9 and not tm.deleted
+`
+
+type InsertTokenPipelineResultsWithExistingMediaParams struct {
+	ProcessingJobID  persist.DBID             `db:"processing_job_id" json:"processing_job_id"`
+	TokenProperties  persist.TokenProperties  `db:"token_properties" json:"token_properties"`
+	PipelineMetadata persist.PipelineMetadata `db:"pipeline_metadata" json:"pipeline_metadata"`
+	ProcessingCause  persist.ProcessingCause  `db:"processing_cause" json:"processing_cause"`
+	ProcessorVersion string                   `db:"processor_version" json:"processor_version"`
+	NewMetadata      pgtype.JSONB             `db:"new_metadata" json:"new_metadata"`
+	NewName          sql.NullString           `db:"new_name" json:"new_name"`
+	NewDescription   sql.NullString           `db:"new_description" json:"new_description"`
+	TokenMediaID     persist.DBID             `db:"token_media_id" json:"token_media_id"`
+	Chain            persist.Chain            `db:"chain" json:"chain"`
+	ContractAddress  persist.Address          `db:"contract_address" json:"contract_address"`
+	TokenID          persist.HexTokenID       `db:"token_id" json:"token_id"`
+}
+
+func (q *Queries) InsertTokenPipelineResultsWithExistingMedia(ctx context.Context, arg InsertTokenPipelineResultsWithExistingMediaParams) (TokenMedia, error) {
+	row := q.db.QueryRow(ctx, insertTokenPipelineResultsWithExistingMedia,
+		arg.ProcessingJobID,
+		arg.TokenProperties,
+		arg.PipelineMetadata,
+		arg.ProcessingCause,
+		arg.ProcessorVersion,
+		arg.NewMetadata,
+		arg.NewName,
+		arg.NewDescription,
+		arg.TokenMediaID,
+		arg.Chain,
+		arg.ContractAddress,
+		arg.TokenID,
+	)
+	var i TokenMedia
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.LastUpdated,
+		&i.Version,
+		&i.Active,
+		&i.Media,
+		&i.ProcessingJobID,
+		&i.Deleted,
+		&i.Chain,
+		&i.ContractAddress,
+		&i.TokenID,
+	)
+	return i, err
+}
+
 const insertUser = `-- name: InsertUser :one
 insert into users (id, username, username_idempotent, bio, universal, email_unsubscriptions) values ($1, $2, $3, $4, $5, $6) returning id
 `
@@ -6452,6 +7024,25 @@ func (q *Queries) IsFeedEventExistsForGroup(ctx context.Context, groupID sql.Nul
 	return exists, err
 }
 
+const lockQueuedTaskClaims = `-- name: LockQueuedTaskClaims :exec
+select pg_advisory_xact_lock(hashtext('queued_tasks'))
+`
+
+// Serializes claims across processes until the end of the transaction, so that claims don't race each other past a queue's limits.
+func (q *Queries) LockQueuedTaskClaims(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockQueuedTaskClaims)
+	return err
+}
+
+const markTokenProcessingFailuresReplayed = `-- name: MarkTokenProcessingFailuresReplayed :exec
+update token_processing_failures set dead_lettered_at = null, replayed_at = now(), last_updated = now() where id = any($1::varchar[]) and not deleted
+`
+
+func (q *Queries) MarkTokenProcessingFailuresReplayed(ctx context.Context, ids []string) error {
+	_, err := q.db.Exec(ctx, markTokenProcessingFailuresReplayed, ids)
+	return err
+}
+
 const paginateGlobalFeed = `-- name: PaginateGlobalFeed :many
 select fe.id, fe.feed_entity_type, fe.created_at, fe.actor_id
 from feed_entities fe
@@ -6509,12 +7100,20 @@ func (q *Queries) PaginateGlobalFeed(ctx context.Context, arg PaginateGlobalFeed
 }
 
 const paginatePersonalFeedByUserID = `-- name: PaginatePersonalFeedByUserID :many
-select fe.id, fe.feed_entity_type, fe.created_at, fe.actor_id from feed_entities fe, follows fl
-    where fl.deleted = false
-      and fe.actor_id = fl.followee
-      and fl.follower = $1
+select fe.id, fe.feed_entity_type, fe.created_at, fe.actor_id from feed_entities fe
+    where (exists (select 1 from follows fl where fl.follower = $1 and fl.followee = fe.actor_id and fl.deleted = false)
+        -- Posts from authors that aren't followed only come through topics, so they're held to the same
+        -- blocklist as the trending feed
+        or (exists (select 1 from post_hashtags h join topic_follows tf on tf.tag = h.tag
+                where h.post_id = fe.id and tf.user_id = $1 and not tf.deleted)
+            and not exists (select 1 from feed_blocklist fb where fb.user_id = fe.actor_id and not fb.deleted and fb.active)))
       and (fe.created_at, fe.id) < ($2, $3::dbid)
       and (fe.created_at, fe.id) > ($4, $5::dbid)
+      and not exists (select 1 from mutes m where m.user_id = $1 and m.muted_user_id = fe.actor_id and not m.deleted and (m.expires_at is null or m.expires_at > now()))
+      -- Reposts and quote posts are checked against the post they share, which also covers muting its author
+      and not exists (select 1 from muted_posts mp where mp.user_id = $1
+            and mp.post_id = coalesce((select r.post_id from reposts r where r.id = fe.id), fe.id))
+      and not exists (select 1 from user_blocklist ub where ub.user_id = $1 and ub.blocked_user_id = fe.actor_id and not ub.deleted and ub.active)
 order by
     case when $6::bool then (fe.created_at, fe.id) end asc,
     case when not $6::bool then (fe.created_at, fe.id) end desc
@@ -6577,8 +7176,9 @@ with valid_post_ids as (
             and token_definitions.deleted = false
     WHERE $7 = ANY(posts.contract_ids)
       AND posts.deleted = false
+      AND posts.moderation_hidden = false
 )
-SELECT posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url from posts
+SELECT posts.id, posts.version, posts.token_ids, posts.contract_ids, posts.actor_id, posts.caption, posts.created_at, posts.last_updated, posts.deleted, posts.is_first_post, posts.user_mint_url, posts.edited_at, posts.comment_restriction, posts.moderation_hidden from posts
     join valid_post_ids on posts.id = valid_post_ids.id
 WHERE (posts.created_at, posts.id) < ($1, $2::dbid)
   AND (posts.created_at, posts.id) > ($3, $4::dbid)
@@ -6629,6 +7229,9 @@ func (q *Queries) PaginatePostsByContractIDAndProjectID(ctx context.Context, arg
 			&i.Deleted,
 			&i.IsFirstPost,
 			&i.UserMintUrl,
+			&i.EditedAt,
+			&i.CommentRestriction,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -6641,12 +7244,13 @@ func (q *Queries) PaginatePostsByContractIDAndProjectID(ctx context.Context, arg
 }
 
 const paginatePostsByUserID = `-- name: PaginatePostsByUserID :many
-select id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url
+select id, version, token_ids, contract_ids, actor_id, caption, created_at, last_updated, deleted, is_first_post, user_mint_url, edited_at, comment_restriction, moderation_hidden
 from posts
 where actor_id = $1
         and (created_at, id) < ($2, $3::dbid)
         and (created_at, id) > ($4, $5::dbid)
         and not posts.deleted
+        and not posts.moderation_hidden
 order by
     case when $6::bool then (created_at, id) end asc,
     case when not $6::bool then (created_at, id) end desc
@@ -6692,6 +7296,9 @@ func (q *Queries) PaginatePostsByUserID(ctx context.Context, arg PaginatePostsBy
 			&i.Deleted,
 			&i.IsFirstPost,
 			&i.UserMintUrl,
+			&i.EditedAt,
+			&i.CommentRestriction,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -6818,29 +7425,19 @@ func (q *Queries) RemoveWalletFromTokens(ctx context.Context, arg RemoveWalletFr
 	return err
 }
 
-const reportPost = `-- name: ReportPost :one
-with offending_post as (select id from posts where posts.id = $4 and not deleted)
-insert into reported_posts (id, post_id, reporter_id, reason) (select $1, offending_post.id, $2::text, $3 from offending_post)
-on conflict(post_id, reporter_id, reason) where not deleted do update set last_updated = now() returning id
+const retryQueuedTask = `-- name: RetryQueuedTask :exec
+update queued_tasks set scheduled_at = $1, locked_until = null, last_error = $2::varchar, last_updated = now() where id = $3
 `
 
-type ReportPostParams struct {
-	ID       persist.DBID         `db:"id" json:"id"`
-	Reporter sql.NullString       `db:"reporter" json:"reporter"`
-	Reason   persist.ReportReason `db:"reason" json:"reason"`
-	PostID   persist.DBID         `db:"post_id" json:"post_id"`
+type RetryQueuedTaskParams struct {
+	ScheduledAt time.Time    `db:"scheduled_at" json:"scheduled_at"`
+	LastError   string       `db:"last_error" json:"last_error"`
+	ID          persist.DBID `db:"id" json:"id"`
 }
 
-func (q *Queries) ReportPost(ctx context.Context, arg ReportPostParams) (persist.DBID, error) {
-	row := q.db.QueryRow(ctx, reportPost,
-		arg.ID,
-		arg.Reporter,
-		arg.Reason,
-		arg.PostID,
-	)
-	var id persist.DBID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) RetryQueuedTask(ctx context.Context, arg RetryQueuedTaskParams) error {
+	_, err := q.db.Exec(ctx, retryQueuedTask, arg.ScheduledAt, arg.LastError, arg.ID)
+	return err
 }
 
 const saveHighlightMintClaim = `-- name: SaveHighlightMintClaim :one
@@ -6893,6 +7490,20 @@ func (q *Queries) SaveHighlightMintClaim(ctx context.Context, arg SaveHighlightM
 	return id, err
 }
 
+const setCommentHidden = `-- name: SetCommentHidden :exec
+update comments set hidden = $1, last_updated = now() where id = $2 and not deleted
+`
+
+type SetCommentHiddenParams struct {
+	Hidden    bool         `db:"hidden" json:"hidden"`
+	CommentID persist.DBID `db:"comment_id" json:"comment_id"`
+}
+
+func (q *Queries) SetCommentHidden(ctx context.Context, arg SetCommentHiddenParams) error {
+	_, err := q.db.Exec(ctx, setCommentHidden, arg.Hidden, arg.CommentID)
+	return err
+}
+
 const setContractOverrideCreator = `-- name: SetContractOverrideCreator :exec
 update contracts set override_creator_user_id = $1, last_updated = now() where id = $2 and deleted = false
 `
@@ -6921,6 +7532,20 @@ func (q *Queries) SetPersonaByUserID(ctx context.Context, arg SetPersonaByUserID
 	return err
 }
 
+const setPostCommentRestriction = `-- name: SetPostCommentRestriction :exec
+update posts set comment_restriction = $1, last_updated = now() where id = $2 and not deleted
+`
+
+type SetPostCommentRestrictionParams struct {
+	CommentRestriction persist.CommentRestriction `db:"comment_restriction" json:"comment_restriction"`
+	ID                 persist.DBID               `db:"id" json:"id"`
+}
+
+func (q *Queries) SetPostCommentRestriction(ctx context.Context, arg SetPostCommentRestrictionParams) error {
+	_, err := q.db.Exec(ctx, setPostCommentRestriction, arg.CommentRestriction, arg.ID)
+	return err
+}
+
 const setPrivyDIDForUser = `-- name: SetPrivyDIDForUser :exec
 insert into privy_users (id, user_id, privy_did)
     values ($1, $2, $3)
@@ -7153,7 +7778,7 @@ func (q *Queries) UpdateGalleryCollections(ctx context.Context, arg UpdateGaller
 }
 
 const updateGalleryHidden = `-- name: UpdateGalleryHidden :one
-update galleries set hidden = $1, last_updated = now() where id = $2 and deleted = false returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position
+update galleries set hidden = $1, last_updated = now() where id = $2 and deleted = false returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, moderation_hidden
 `
 
 type UpdateGalleryHiddenParams struct {
@@ -7176,6 +7801,7 @@ func (q *Queries) UpdateGalleryHidden(ctx context.Context, arg UpdateGalleryHidd
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.ModerationHidden,
 	)
 	return i, err
 }
@@ -7367,6 +7993,21 @@ func (q *Queries) UpdateNotificationSettingsByID(ctx context.Context, arg Update
 	return err
 }
 
+const updatePost = `-- name: UpdatePost :exec
+update posts set caption = $1, user_mint_url = $2, edited_at = now(), last_updated = now() where id = $3 and not deleted
+`
+
+type UpdatePostParams struct {
+	Caption     sql.NullString `db:"caption" json:"caption"`
+	UserMintUrl sql.NullString `db:"user_mint_url" json:"user_mint_url"`
+	ID          persist.DBID   `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) error {
+	_, err := q.db.Exec(ctx, updatePost, arg.Caption, arg.UserMintUrl, arg.ID)
+	return err
+}
+
 const updatePushTickets = `-- name: UpdatePushTickets :exec
 with updates as (
     select unnest($1::text[]) as id, unnest($2::timestamptz[]) as check_after, unnest($3::int[]) as num_check_attempts, unnest($4::text[]) as status, unnest($5::bool[]) as deleted
@@ -7595,6 +8236,59 @@ func (q *Queries) UpdateUserVerifiedEmail(ctx context.Context, arg UpdateUserVer
 	return err
 }
 
+const upsertContractSpamScore = `-- name: UpsertContractSpamScore :exec
+insert into contract_spam_scores (contract_id, score, reasons) values ($1, $2, $3)
+on conflict (contract_id) do update set score = excluded.score, reasons = excluded.reasons, last_updated = now()
+`
+
+type UpsertContractSpamScoreParams struct {
+	ContractID persist.DBID        `db:"contract_id" json:"contract_id"`
+	Score      int32               `db:"score" json:"score"`
+	Reasons    persist.SpamReasons `db:"reasons" json:"reasons"`
+}
+
+func (q *Queries) UpsertContractSpamScore(ctx context.Context, arg UpsertContractSpamScoreParams) error {
+	_, err := q.db.Exec(ctx, upsertContractSpamScore, arg.ContractID, arg.Score, arg.Reasons)
+	return err
+}
+
+const upsertIndexerCheckpoint = `-- name: UpsertIndexerCheckpoint :exec
+insert into indexer_checkpoints (id, chain, block_number, block_hash) values ($1, $2, $3, $4)
+on conflict (chain) where not deleted do update set block_number = excluded.block_number, block_hash = excluded.block_hash, last_updated = now()
+`
+
+type UpsertIndexerCheckpointParams struct {
+	ID          persist.DBID  `db:"id" json:"id"`
+	Chain       persist.Chain `db:"chain" json:"chain"`
+	BlockNumber int64         `db:"block_number" json:"block_number"`
+	BlockHash   string        `db:"block_hash" json:"block_hash"`
+}
+
+func (q *Queries) UpsertIndexerCheckpoint(ctx context.Context, arg UpsertIndexerCheckpointParams) error {
+	_, err := q.db.Exec(ctx, upsertIndexerCheckpoint,
+		arg.ID,
+		arg.Chain,
+		arg.BlockNumber,
+		arg.BlockHash,
+	)
+	return err
+}
+
+const upsertQueuedTaskQueue = `-- name: UpsertQueuedTaskQueue :exec
+insert into queued_task_queues (queue, next_dispatch_at) values ($1, $2)
+on conflict (queue) do update set next_dispatch_at = excluded.next_dispatch_at, last_updated = now()
+`
+
+type UpsertQueuedTaskQueueParams struct {
+	Queue          string    `db:"queue" json:"queue"`
+	NextDispatchAt time.Time `db:"next_dispatch_at" json:"next_dispatch_at"`
+}
+
+func (q *Queries) UpsertQueuedTaskQueue(ctx context.Context, arg UpsertQueuedTaskQueueParams) error {
+	_, err := q.db.Exec(ctx, upsertQueuedTaskQueue, arg.Queue, arg.NextDispatchAt)
+	return err
+}
+
 const upsertSession = `-- name: UpsertSession :one
 insert into sessions (id, user_id,
                       created_at, created_with_user_agent, created_with_platform, created_with_os,
@@ -7675,6 +8369,82 @@ func (q *Queries) UpsertSocialOAuth(ctx context.Context, arg UpsertSocialOAuthPa
 	return err
 }
 
+const upsertTokenDefinitionSpamScore = `-- name: UpsertTokenDefinitionSpamScore :exec
+insert into token_definition_spam_scores (token_definition_id, score, reasons) values ($1, $2, $3)
+on conflict (token_definition_id) do update set score = excluded.score, reasons = excluded.reasons, last_updated = now()
+`
+
+type UpsertTokenDefinitionSpamScoreParams struct {
+	TokenDefinitionID persist.DBID        `db:"token_definition_id" json:"token_definition_id"`
+	Score             int32               `db:"score" json:"score"`
+	Reasons           persist.SpamReasons `db:"reasons" json:"reasons"`
+}
+
+func (q *Queries) UpsertTokenDefinitionSpamScore(ctx context.Context, arg UpsertTokenDefinitionSpamScoreParams) error {
+	_, err := q.db.Exec(ctx, upsertTokenDefinitionSpamScore, arg.TokenDefinitionID, arg.Score, arg.Reasons)
+	return err
+}
+
+const upsertTokenMediaSource = `-- name: UpsertTokenMediaSource :exec
+insert into token_media_sources (source_key, token_media_id) values ($1, $2)
+on conflict (source_key) do update set token_media_id = excluded.token_media_id, last_updated = now()
+`
+
+type UpsertTokenMediaSourceParams struct {
+	SourceKey    string       `db:"source_key" json:"source_key"`
+	TokenMediaID persist.DBID `db:"token_media_id" json:"token_media_id"`
+}
+
+func (q *Queries) UpsertTokenMediaSource(ctx context.Context, arg UpsertTokenMediaSourceParams) error {
+	_, err := q.db.Exec(ctx, upsertTokenMediaSource, arg.SourceKey, arg.TokenMediaID)
+	return err
+}
+
+const upsertTokenProcessingFailure = `-- name: UpsertTokenProcessingFailure :exec
+insert into token_processing_failures (id, token_definition_id, chain, contract_address, processing_cause, error_class, error_message, attempts, attempt_history, last_processing_job_id, dead_lettered_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, case when $11::bool then now() end)
+on conflict (token_definition_id) where not deleted do update set
+    processing_cause = case when excluded.attempts = 1 then excluded.processing_cause else token_processing_failures.processing_cause end,
+    error_class = excluded.error_class,
+    error_message = excluded.error_message,
+    attempts = excluded.attempts,
+    attempt_history = token_processing_failures.attempt_history || excluded.attempt_history,
+    last_processing_job_id = excluded.last_processing_job_id,
+    dead_lettered_at = excluded.dead_lettered_at,
+    last_updated = now()
+`
+
+type UpsertTokenProcessingFailureParams struct {
+	ID                  persist.DBID                    `db:"id" json:"id"`
+	TokenDefinitionID   persist.DBID                    `db:"token_definition_id" json:"token_definition_id"`
+	Chain               persist.Chain                   `db:"chain" json:"chain"`
+	ContractAddress     persist.Address                 `db:"contract_address" json:"contract_address"`
+	ProcessingCause     persist.ProcessingCause         `db:"processing_cause" json:"processing_cause"`
+	ErrorClass          string                          `db:"error_class" json:"error_class"`
+	ErrorMessage        string                          `db:"error_message" json:"error_message"`
+	Attempts            int32                           `db:"attempts" json:"attempts"`
+	AttemptHistory      persist.TokenProcessingAttempts `db:"attempt_history" json:"attempt_history"`
+	LastProcessingJobID persist.DBID                    `db:"last_processing_job_id" json:"last_processing_job_id"`
+	DeadLettered        bool                            `db:"dead_lettered" json:"dead_lettered"`
+}
+
+func (q *Queries) UpsertTokenProcessingFailure(ctx context.Context, arg UpsertTokenProcessingFailureParams) error {
+	_, err := q.db.Exec(ctx, upsertTokenProcessingFailure,
+		arg.ID,
+		arg.TokenDefinitionID,
+		arg.Chain,
+		arg.ContractAddress,
+		arg.ProcessingCause,
+		arg.ErrorClass,
+		arg.ErrorMessage,
+		arg.Attempts,
+		arg.AttemptHistory,
+		arg.LastProcessingJobID,
+		arg.DeadLettered,
+	)
+	return err
+}
+
 const userHasDuplicateGalleryPositions = `-- name: UserHasDuplicateGalleryPositions :one
 select exists(select position,count(*) from galleries where owner_user_id = $1 and deleted = false group by position having count(*) > 1)
 `
@@ -99,12 +99,12 @@ with refreshed      as ( select greatest((select last_updated from feed_entity_s
      , t4           as ( select t3.id, (sum(cume) over (partition by actor_id order by created_at desc))::int group_number from t3 )
 select
   feed_entity_scores.id, feed_entity_scores.created_at, feed_entity_scores.actor_id, feed_entity_scores.action, feed_entity_scores.contract_ids, feed_entity_scores.interactions, feed_entity_scores.feed_entity_type, feed_entity_scores.last_updated
-  , p.id, p.version, p.token_ids, p.contract_ids, p.actor_id, p.caption, p.created_at, p.last_updated, p.deleted, p.is_first_post, p.user_mint_url
+  , p.id, p.version, p.token_ids, p.contract_ids, p.actor_id, p.caption, p.created_at, p.last_updated, p.deleted, p.is_first_post, p.user_mint_url, p.edited_at, p.comment_restriction, p.moderation_hidden
   , row_number() over (partition by p.actor_id order by (t4.group_number, random() > 0.5)) streak
   , coalesce(p.actor_id = (select id from gallery_user), false)::bool is_gallery_post
 from t2 feed_entity_scores
 join t4 using(id)
-join posts p on feed_entity_scores.id = p.id and not p.deleted
+join posts p on feed_entity_scores.id = p.id and not p.deleted and not p.moderation_hidden
 left join feed_blocklist fb on p.actor_id = fb.user_id and not fb.deleted and fb.active
 where (fb.user_id is null or $1 = fb.user_id)
 `
@@ -151,6 +151,9 @@ func (q *Queries) GetFeedEntityScores(ctx context.Context, arg GetFeedEntityScor
 			&i.Post.Deleted,
 			&i.Post.IsFirstPost,
 			&i.Post.UserMintUrl,
+			&i.Post.EditedAt,
+			&i.Post.CommentRestriction,
+			&i.Post.ModerationHidden,
 			&i.Streak,
 			&i.IsGalleryPost,
 		); err != nil {
@@ -107,7 +107,7 @@ const searchGalleries = `-- name: SearchGalleries :many
 with min_content_score as (
     select score from gallery_relevance where id is null
 )
-select galleries.id, galleries.deleted, galleries.last_updated, galleries.created_at, galleries.version, galleries.owner_user_id, galleries.collections, galleries.name, galleries.description, galleries.hidden, galleries.position from galleries left join gallery_relevance on gallery_relevance.id = galleries.id,
+select galleries.id, galleries.deleted, galleries.last_updated, galleries.created_at, galleries.version, galleries.owner_user_id, galleries.collections, galleries.name, galleries.description, galleries.hidden, galleries.position, galleries.moderation_hidden from galleries left join gallery_relevance on gallery_relevance.id = galleries.id,
     to_tsquery('simple', websearch_to_tsquery('simple', $1)::text || ':*') simple_partial_query,
     websearch_to_tsquery('english', $1) english_full_query,
     min_content_score,
@@ -120,7 +120,7 @@ where (
     simple_partial_query @@ fts_name or
     english_full_query @@ fts_description_english
     )
-    and deleted = false and hidden = false
+    and deleted = false and hidden = false and moderation_hidden = false
 order by content_score * match_score desc, content_score desc, match_score desc
 limit $4
 `
@@ -158,6 +158,7 @@ func (q *Queries) SearchGalleries(ctx context.Context, arg SearchGalleriesParams
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.ModerationHidden,
 		); err != nil {
 			return nil, err
 		}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgtype"
+	"github.com/mikeydub/go-gallery/service/persist"
 )
 
 const deleteTokensBeforeTimestamp = `-- name: DeleteTokensBeforeTimestamp :execrows
@@ -57,6 +58,92 @@ func (q *Queries) DeleteTokensBeforeTimestamp(ctx context.Context, arg DeleteTok
 	return result.RowsAffected(), nil
 }
 
+const deleteWalletSyncCheckpointsByWalletIDs = `-- name: DeleteWalletSyncCheckpointsByWalletIDs :exec
+update wallet_sync_checkpoints set deleted = true, last_updated = now() where wallet_id = any($1::varchar[]) and not deleted
+`
+
+func (q *Queries) DeleteWalletSyncCheckpointsByWalletIDs(ctx context.Context, walletIds []string) error {
+	_, err := q.db.Exec(ctx, deleteWalletSyncCheckpointsByWalletIDs, walletIds)
+	return err
+}
+
+const getWalletSyncCheckpointsByUserID = `-- name: GetWalletSyncCheckpointsByUserID :many
+select id, wallet_id, owner_user_id, chain, block_number, provider_cursor, synced_at, full_synced_at, created_at, last_updated, deleted from wallet_sync_checkpoints where owner_user_id = $1 and chain = any($2::int[]) and not deleted
+`
+
+type GetWalletSyncCheckpointsByUserIDParams struct {
+	OwnerUserID persist.DBID `db:"owner_user_id" json:"owner_user_id"`
+	Chains      []int32      `db:"chains" json:"chains"`
+}
+
+func (q *Queries) GetWalletSyncCheckpointsByUserID(ctx context.Context, arg GetWalletSyncCheckpointsByUserIDParams) ([]WalletSyncCheckpoint, error) {
+	rows, err := q.db.Query(ctx, getWalletSyncCheckpointsByUserID, arg.OwnerUserID, arg.Chains)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WalletSyncCheckpoint
+	for rows.Next() {
+		var i WalletSyncCheckpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.OwnerUserID,
+			&i.Chain,
+			&i.BlockNumber,
+			&i.ProviderCursor,
+			&i.SyncedAt,
+			&i.FullSyncedAt,
+			&i.CreatedAt,
+			&i.LastUpdated,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeWalletFromTokensByIdentifiers = `-- name: RemoveWalletFromTokensByIdentifiers :execrows
+update tokens t
+set owned_by_wallets = array_remove(t.owned_by_wallets, $1::varchar),
+    last_updated = now()
+from token_definitions td
+where t.owner_user_id = $2
+  and t.token_definition_id = td.id
+  and td.chain = $3
+  and (td.contract_address, td.token_id) in (select unnest($4::varchar[]), unnest($5::varchar[]))
+  and t.owned_by_wallets @> array[$1::varchar]
+  and t.deleted = false
+  and td.deleted = false
+`
+
+type RemoveWalletFromTokensByIdentifiersParams struct {
+	WalletID          string        `db:"wallet_id" json:"wallet_id"`
+	OwnerUserID       persist.DBID  `db:"owner_user_id" json:"owner_user_id"`
+	Chain             persist.Chain `db:"chain" json:"chain"`
+	ContractAddresses []string      `db:"contract_addresses" json:"contract_addresses"`
+	TokenIds          []string      `db:"token_ids" json:"token_ids"`
+}
+
+func (q *Queries) RemoveWalletFromTokensByIdentifiers(ctx context.Context, arg RemoveWalletFromTokensByIdentifiersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeWalletFromTokensByIdentifiers,
+		arg.WalletID,
+		arg.OwnerUserID,
+		arg.Chain,
+		arg.ContractAddresses,
+		arg.TokenIds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const upsertTokenDefinitionCommunityMemberships = `-- name: UpsertTokenDefinitionCommunityMemberships :many
 insert into token_community_memberships
 (
@@ -277,6 +364,7 @@ with tokens_insert as (
     , last_synced
     , token_definition_id
     , contract_id
+    , acquired_at
   ) (
     select
       bulk_upsert.id
@@ -293,6 +381,7 @@ with tokens_insert as (
       , now()
       , bulk_upsert.token_definition_id
       , bulk_upsert.contract_id
+      , bulk_upsert.acquired_at
     from (
       select unnest($3::varchar[]) as id
         , unnest($4::int[]) as version
@@ -309,6 +398,8 @@ with tokens_insert as (
         , unnest($15::int[]) as chain
         , unnest($16::varchar[]) as token_definition_id
         , unnest($17::varchar[]) as contract_id
+        -- Tokens without an acquisition time are sent as the zero time
+        , nullif(unnest($18::timestamptz[]), '0001-01-01 00:00:00+00') as acquired_at
     ) bulk_upsert
   )
   on conflict (owner_user_id, token_definition_id) where deleted = false
@@ -321,9 +412,10 @@ with tokens_insert as (
     , last_updated = excluded.last_updated
     , last_synced = greatest(excluded.last_synced,tokens.last_synced)
     , contract_id = excluded.contract_id
-  returning id, deleted, version, created_at, last_updated, collectors_note, quantity, block_number, owner_user_id, owned_by_wallets, contract_id, is_user_marked_spam, last_synced, is_creator_token, token_definition_id, is_holder_token, displayable
+    , acquired_at = least(tokens.acquired_at, excluded.acquired_at)
+  returning id, deleted, version, created_at, last_updated, collectors_note, quantity, block_number, owner_user_id, owned_by_wallets, contract_id, is_user_marked_spam, last_synced, is_creator_token, token_definition_id, is_holder_token, displayable, acquired_at
 )
-select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, token_definitions.id, token_definitions.created_at, token_definitions.last_updated, token_definitions.deleted, token_definitions.name, token_definitions.description, token_definitions.token_type, token_definitions.token_id, token_definitions.external_url, token_definitions.chain, token_definitions.metadata, token_definitions.fallback_media, token_definitions.contract_address, token_definitions.contract_id, token_definitions.token_media_id, token_definitions.is_fxhash, contracts.id, contracts.deleted, contracts.version, contracts.created_at, contracts.last_updated, contracts.name, contracts.symbol, contracts.address, contracts.creator_address, contracts.chain, contracts.profile_banner_url, contracts.profile_image_url, contracts.badge_url, contracts.description, contracts.owner_address, contracts.is_provider_marked_spam, contracts.parent_id, contracts.override_creator_user_id, contracts.l1_chain
+select tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.collectors_note, tokens.quantity, tokens.block_number, tokens.owner_user_id, tokens.owned_by_wallets, tokens.contract_id, tokens.is_user_marked_spam, tokens.last_synced, tokens.is_creator_token, tokens.token_definition_id, tokens.is_holder_token, tokens.displayable, tokens.acquired_at, token_definitions.id, token_definitions.created_at, token_definitions.last_updated, token_definitions.deleted, token_definitions.name, token_definitions.description, token_definitions.token_type, token_definitions.token_id, token_definitions.external_url, token_definitions.chain, token_definitions.metadata, token_definitions.fallback_media, token_definitions.contract_address, token_definitions.contract_id, token_definitions.token_media_id, token_definitions.is_fxhash, contracts.id, contracts.deleted, contracts.version, contracts.created_at, contracts.last_updated, contracts.name, contracts.symbol, contracts.address, contracts.creator_address, contracts.chain, contracts.profile_banner_url, contracts.profile_image_url, contracts.badge_url, contracts.description, contracts.owner_address, contracts.is_provider_marked_spam, contracts.parent_id, contracts.override_creator_user_id, contracts.l1_chain
 from tokens_insert tokens
 join token_definitions on tokens.token_definition_id = token_definitions.id and not token_definitions.deleted
 join contracts on token_definitions.contract_id = contracts.id
@@ -332,23 +424,24 @@ where prior_state.id is null
 `
 
 type UpsertTokensParams struct {
-	SetHolderFields             bool     `db:"set_holder_fields" json:"set_holder_fields"`
-	SetCreatorFields            bool     `db:"set_creator_fields" json:"set_creator_fields"`
-	TokenDbid                   []string `db:"token_dbid" json:"token_dbid"`
-	TokenVersion                []int32  `db:"token_version" json:"token_version"`
-	TokenCollectorsNote         []string `db:"token_collectors_note" json:"token_collectors_note"`
-	TokenQuantity               []string `db:"token_quantity" json:"token_quantity"`
-	TokenBlockNumber            []int64  `db:"token_block_number" json:"token_block_number"`
-	TokenOwnerUserID            []string `db:"token_owner_user_id" json:"token_owner_user_id"`
-	TokenOwnedByWallets         []string `db:"token_owned_by_wallets" json:"token_owned_by_wallets"`
-	TokenOwnedByWalletsStartIdx []int32  `db:"token_owned_by_wallets_start_idx" json:"token_owned_by_wallets_start_idx"`
-	TokenOwnedByWalletsEndIdx   []int32  `db:"token_owned_by_wallets_end_idx" json:"token_owned_by_wallets_end_idx"`
-	TokenIsCreatorToken         []bool   `db:"token_is_creator_token" json:"token_is_creator_token"`
-	TokenTokenID                []string `db:"token_token_id" json:"token_token_id"`
-	TokenContractAddress        []string `db:"token_contract_address" json:"token_contract_address"`
-	TokenChain                  []int32  `db:"token_chain" json:"token_chain"`
-	TokenDefinitionID           []string `db:"token_definition_id" json:"token_definition_id"`
-	TokenContractID             []string `db:"token_contract_id" json:"token_contract_id"`
+	SetHolderFields             bool        `db:"set_holder_fields" json:"set_holder_fields"`
+	SetCreatorFields            bool        `db:"set_creator_fields" json:"set_creator_fields"`
+	TokenDbid                   []string    `db:"token_dbid" json:"token_dbid"`
+	TokenVersion                []int32     `db:"token_version" json:"token_version"`
+	TokenCollectorsNote         []string    `db:"token_collectors_note" json:"token_collectors_note"`
+	TokenQuantity               []string    `db:"token_quantity" json:"token_quantity"`
+	TokenBlockNumber            []int64     `db:"token_block_number" json:"token_block_number"`
+	TokenOwnerUserID            []string    `db:"token_owner_user_id" json:"token_owner_user_id"`
+	TokenOwnedByWallets         []string    `db:"token_owned_by_wallets" json:"token_owned_by_wallets"`
+	TokenOwnedByWalletsStartIdx []int32     `db:"token_owned_by_wallets_start_idx" json:"token_owned_by_wallets_start_idx"`
+	TokenOwnedByWalletsEndIdx   []int32     `db:"token_owned_by_wallets_end_idx" json:"token_owned_by_wallets_end_idx"`
+	TokenIsCreatorToken         []bool      `db:"token_is_creator_token" json:"token_is_creator_token"`
+	TokenTokenID                []string    `db:"token_token_id" json:"token_token_id"`
+	TokenContractAddress        []string    `db:"token_contract_address" json:"token_contract_address"`
+	TokenChain                  []int32     `db:"token_chain" json:"token_chain"`
+	TokenDefinitionID           []string    `db:"token_definition_id" json:"token_definition_id"`
+	TokenContractID             []string    `db:"token_contract_id" json:"token_contract_id"`
+	TokenAcquiredAt             []time.Time `db:"token_acquired_at" json:"token_acquired_at"`
 }
 
 type UpsertTokensRow struct {
@@ -377,6 +470,7 @@ func (q *Queries) UpsertTokens(ctx context.Context, arg UpsertTokensParams) ([]U
 		arg.TokenChain,
 		arg.TokenDefinitionID,
 		arg.TokenContractID,
+		arg.TokenAcquiredAt,
 	)
 	if err != nil {
 		return nil, err
@@ -403,6 +497,7 @@ func (q *Queries) UpsertTokens(ctx context.Context, arg UpsertTokensParams) ([]U
 			&i.Token.TokenDefinitionID,
 			&i.Token.IsHolderToken,
 			&i.Token.Displayable,
+			&i.Token.AcquiredAt,
 			&i.TokenDefinition.ID,
 			&i.TokenDefinition.CreatedAt,
 			&i.TokenDefinition.LastUpdated,
@@ -448,3 +543,40 @@ func (q *Queries) UpsertTokens(ctx context.Context, arg UpsertTokensParams) ([]U
 	}
 	return items, nil
 }
+
+const upsertWalletSyncCheckpoint = `-- name: UpsertWalletSyncCheckpoint :exec
+insert into wallet_sync_checkpoints (id, wallet_id, owner_user_id, chain, block_number, provider_cursor, synced_at, full_synced_at)
+values ($1, $2, $3, $4, $5, $6, $7, case when $8::bool then $7 else null end)
+on conflict (wallet_id, chain) where not deleted do update set
+  owner_user_id = excluded.owner_user_id,
+  block_number = excluded.block_number,
+  provider_cursor = excluded.provider_cursor,
+  synced_at = excluded.synced_at,
+  full_synced_at = coalesce(excluded.full_synced_at, wallet_sync_checkpoints.full_synced_at),
+  last_updated = now()
+`
+
+type UpsertWalletSyncCheckpointParams struct {
+	ID             persist.DBID   `db:"id" json:"id"`
+	WalletID       persist.DBID   `db:"wallet_id" json:"wallet_id"`
+	OwnerUserID    persist.DBID   `db:"owner_user_id" json:"owner_user_id"`
+	Chain          persist.Chain  `db:"chain" json:"chain"`
+	BlockNumber    sql.NullInt64  `db:"block_number" json:"block_number"`
+	ProviderCursor sql.NullString `db:"provider_cursor" json:"provider_cursor"`
+	SyncedAt       time.Time      `db:"synced_at" json:"synced_at"`
+	IsFullSync     bool           `db:"is_full_sync" json:"is_full_sync"`
+}
+
+func (q *Queries) UpsertWalletSyncCheckpoint(ctx context.Context, arg UpsertWalletSyncCheckpointParams) error {
+	_, err := q.db.Exec(ctx, upsertWalletSyncCheckpoint,
+		arg.ID,
+		arg.WalletID,
+		arg.OwnerUserID,
+		arg.Chain,
+		arg.BlockNumber,
+		arg.ProviderCursor,
+		arg.SyncedAt,
+		arg.IsFullSync,
+	)
+	return err
+}
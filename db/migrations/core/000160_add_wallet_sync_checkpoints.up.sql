@@ -0,0 +1,15 @@
+create table if not exists wallet_sync_checkpoints (
+  id varchar(255) primary key,
+  wallet_id varchar(255) not null references wallets(id),
+  owner_user_id varchar(255) not null references users(id),
+  chain int not null,
+  block_number bigint,
+  provider_cursor varchar,
+  synced_at timestamptz not null, -- when the last successful sync of the wallet started
+  full_synced_at timestamptz, -- when the last successful full sync of the wallet started
+  created_at timestamptz not null default current_timestamp,
+  last_updated timestamptz not null default current_timestamp,
+  deleted boolean not null default false
+);
+create unique index if not exists wallet_sync_checkpoints_wallet_id_chain_idx on wallet_sync_checkpoints(wallet_id, chain) where not deleted;
+create index if not exists wallet_sync_checkpoints_owner_user_id_idx on wallet_sync_checkpoints(owner_user_id) where not deleted;
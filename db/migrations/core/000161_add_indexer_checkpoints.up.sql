@@ -0,0 +1,10 @@
+create table if not exists indexer_checkpoints (
+  id varchar(255) primary key,
+  chain int not null,
+  block_number bigint not null,
+  block_hash varchar not null, -- used to detect reorgs of the last indexed block
+  created_at timestamptz not null default current_timestamp,
+  last_updated timestamptz not null default current_timestamp,
+  deleted boolean not null default false
+);
+create unique index if not exists indexer_checkpoints_chain_idx on indexer_checkpoints(chain) where not deleted;
@@ -0,0 +1,17 @@
+create table if not exists queued_tasks (
+  id varchar(255) primary key,
+  queue varchar not null,
+  url varchar not null,
+  method varchar not null,
+  headers jsonb not null default '{}',
+  body bytea,
+  scheduled_at timestamptz not null default current_timestamp,
+  dispatch_deadline_ms bigint not null,
+  attempts int not null default 0,
+  locked_until timestamptz, -- set while a task is being dispatched so that other workers skip it
+  last_error varchar,
+  dead_lettered_at timestamptz,
+  created_at timestamptz not null default current_timestamp,
+  last_updated timestamptz not null default current_timestamp
+);
+create index if not exists queued_tasks_scheduled_at_idx on queued_tasks(scheduled_at) where dead_lettered_at is null;
@@ -0,0 +1,19 @@
+create table if not exists token_processing_failures (
+  id varchar(255) primary key,
+  token_definition_id varchar(255) not null references token_definitions(id),
+  chain int not null,
+  contract_address varchar not null,
+  processing_cause varchar not null, -- the cause of the first attempt
+  error_class varchar not null,
+  error_message varchar not null,
+  attempts int not null,
+  attempt_history jsonb not null default '[]',
+  last_processing_job_id varchar(255),
+  dead_lettered_at timestamptz, -- set once a token runs out of retries
+  replayed_at timestamptz,
+  created_at timestamptz not null default current_timestamp,
+  last_updated timestamptz not null default current_timestamp,
+  deleted boolean not null default false
+);
+create unique index if not exists token_processing_failures_token_definition_id_idx on token_processing_failures(token_definition_id) where not deleted;
+create index if not exists token_processing_failures_dead_lettered_at_idx on token_processing_failures(dead_lettered_at desc) where dead_lettered_at is not null and not deleted;
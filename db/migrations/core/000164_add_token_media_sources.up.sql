@@ -0,0 +1,8 @@
+create table if not exists token_media_sources (
+    source_key varchar primary key,
+    token_media_id varchar(255) not null references token_medias(id),
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create index if not exists token_media_sources_token_media_id_idx on token_media_sources(token_media_id);
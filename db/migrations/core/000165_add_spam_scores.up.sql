@@ -0,0 +1,17 @@
+create table if not exists contract_spam_scores (
+    contract_id varchar(255) primary key references contracts(id),
+    score int not null,
+    reasons jsonb not null default '[]',
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create index if not exists contract_spam_scores_last_updated_idx on contract_spam_scores(last_updated);
+
+create table if not exists token_definition_spam_scores (
+    token_definition_id varchar(255) primary key references token_definitions(id),
+    score int not null,
+    reasons jsonb not null default '[]',
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
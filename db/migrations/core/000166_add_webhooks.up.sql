@@ -0,0 +1,30 @@
+create table if not exists webhooks (
+    id varchar(255) primary key,
+    owner_id varchar(255) not null references users(id),
+    community_id varchar(255) references communities(id),
+    url varchar not null,
+    secret varchar not null,
+    event_types varchar[] not null,
+    enabled boolean not null default true,
+    deleted boolean not null default false,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create index if not exists webhooks_owner_id_idx on webhooks(owner_id) where not deleted;
+create index if not exists webhooks_community_id_idx on webhooks(community_id) where not deleted and community_id is not null;
+
+create table if not exists webhook_deliveries (
+    id varchar(255) primary key,
+    webhook_id varchar(255) not null references webhooks(id),
+    event_type varchar not null,
+    payload jsonb not null,
+    status varchar(32) not null default 'pending',
+    attempts int not null default 0,
+    response_status int,
+    error varchar,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create index if not exists webhook_deliveries_webhook_id_created_at_idx on webhook_deliveries(webhook_id, created_at desc, id desc);
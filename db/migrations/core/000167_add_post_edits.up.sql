@@ -0,0 +1,12 @@
+alter table posts add column if not exists edited_at timestamptz;
+
+create table if not exists post_edits (
+    id varchar(255) primary key,
+    post_id varchar(255) not null references posts(id),
+    actor_id varchar(255) not null references users(id),
+    caption varchar,
+    user_mint_url varchar,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists post_edits_post_id_created_at_idx on post_edits(post_id, created_at desc);
@@ -0,0 +1,16 @@
+create table if not exists scheduled_posts (
+    id varchar(255) primary key,
+    actor_id varchar(255) not null references users(id),
+    token_ids varchar(255)[] not null,
+    caption varchar,
+    user_mint_url varchar,
+    mentions jsonb,
+    publish_at timestamptz not null,
+    post_id varchar(255) references posts(id),
+    published_at timestamptz,
+    deleted boolean not null default false,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create index if not exists scheduled_posts_actor_id_publish_at_idx on scheduled_posts(actor_id, publish_at) where not deleted and post_id is null;
@@ -0,0 +1,42 @@
+create table if not exists reports (
+    id varchar(255) primary key,
+    reporter_id varchar(255) references users(id),
+    subject_type varchar not null,
+    subject_id varchar(255) not null,
+    reason varchar not null,
+    resolved_at timestamptz,
+    deleted boolean not null default false,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create unique index if not exists reports_subject_reporter_reason_idx on reports(subject_type, subject_id, reporter_id, reason) where not deleted;
+create index if not exists reports_unresolved_idx on reports(subject_type, subject_id) where not deleted and resolved_at is null;
+
+-- Post reports are now stored alongside reports for other kinds of content
+insert into reports (id, reporter_id, subject_type, subject_id, reason, created_at, last_updated)
+select id, reporter_id, 'POST', post_id, coalesce(reason, 'SOMETHING_ELSE'), created_at, last_updated
+from reported_posts
+where not deleted and post_id is not null
+on conflict do nothing;
+
+create table if not exists moderation_actions (
+    id varchar(255) primary key,
+    subject_type varchar not null,
+    subject_id varchar(255) not null,
+    -- The user the action applies to: the subject itself for users, otherwise the author of the content
+    user_id varchar(255) references users(id),
+    action varchar not null,
+    reason varchar,
+    note varchar,
+    moderator varchar,
+    -- Set for content that was hidden because it crossed the report threshold
+    automated boolean not null default false,
+    expires_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists moderation_actions_subject_idx on moderation_actions(subject_type, subject_id, created_at desc);
+create index if not exists moderation_actions_user_id_idx on moderation_actions(user_id, created_at desc);
+create index if not exists moderation_actions_suspensions_idx on moderation_actions(user_id) where action in ('SUSPEND', 'BAN') and revoked_at is null;
@@ -0,0 +1,41 @@
+create table if not exists mutes (
+    id varchar(255) primary key,
+    user_id varchar(255) not null references users(id),
+    -- Exactly one of muted_user_id, muted_community_id or keyword is set
+    muted_user_id varchar(255) references users(id),
+    muted_community_id varchar(255) references communities(id),
+    -- Keywords are stored lowercased and matched as a case-insensitive substring
+    keyword varchar,
+    expires_at timestamptz,
+    deleted boolean not null default false,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now(),
+    constraint mutes_single_target check (num_nonnulls(muted_user_id, muted_community_id, keyword) = 1)
+);
+
+create unique index if not exists mutes_user_id_target_idx on mutes(user_id, coalesce(muted_user_id, muted_community_id, keyword)) where not deleted;
+
+-- Posts hidden from a user by their active mutes: posts by a muted user, posts containing a muted keyword,
+-- and posts featuring tokens from a muted community
+create or replace view muted_posts as
+with active_mutes as (
+    select * from mutes where not deleted and (expires_at is null or expires_at > now())
+)
+select m.user_id, p.id post_id
+from active_mutes m
+join posts p on p.actor_id = m.muted_user_id and not p.deleted
+union all
+select m.user_id, p.id post_id
+from active_mutes m
+join posts p on m.keyword is not null and position(m.keyword in lower(p.caption)) > 0 and not p.deleted
+union all
+select m.user_id, p.id post_id
+from active_mutes m
+join communities c on c.id = m.muted_community_id and c.community_type = 0 and not c.deleted
+join posts p on c.contract_id = any(p.contract_ids) and not p.deleted
+union all
+select m.user_id, p.id post_id
+from active_mutes m
+join token_community_memberships tcm on tcm.community_id = m.muted_community_id and not tcm.deleted
+join tokens t on t.token_definition_id = tcm.token_definition_id and not t.deleted
+join posts p on t.id = any(p.token_ids) and not p.deleted;
@@ -0,0 +1,4 @@
+alter table posts add column if not exists comment_restriction varchar not null default 'EVERYONE';
+
+-- Hidden comments are only visible to the commenter
+alter table comments add column if not exists hidden boolean not null default false;
@@ -0,0 +1,22 @@
+create table if not exists post_hashtags (
+    post_id varchar(255) not null references posts(id),
+    -- Tags are stored lowercased and without the leading #
+    tag varchar not null,
+    created_at timestamptz not null default now(),
+    primary key (post_id, tag)
+);
+
+create index if not exists post_hashtags_tag_created_at_idx on post_hashtags(tag, created_at desc);
+create index if not exists post_hashtags_created_at_idx on post_hashtags(created_at);
+
+create table if not exists topic_follows (
+    id varchar(255) primary key,
+    user_id varchar(255) not null references users(id),
+    tag varchar not null,
+    deleted boolean not null default false,
+    created_at timestamptz not null default now(),
+    last_updated timestamptz not null default now()
+);
+
+create unique index if not exists topic_follows_user_id_tag_idx on topic_follows(user_id, tag) where not deleted;
+create index if not exists topic_follows_tag_idx on topic_follows(tag) where not deleted;
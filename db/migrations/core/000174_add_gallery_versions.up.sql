@@ -0,0 +1,15 @@
+create table if not exists gallery_versions (
+    id varchar(255) primary key,
+    gallery_id varchar(255) not null references galleries(id),
+    owner_user_id varchar(255) not null references users(id),
+    name varchar not null default '',
+    description varchar not null default '',
+    -- The gallery's collections in display order, including each collection's tokens, layout and token settings
+    collections jsonb not null,
+    published boolean not null default false,
+    restored_from_version_id varchar(255) references gallery_versions(id),
+    deleted boolean not null default false,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists gallery_versions_gallery_id_created_at_idx on gallery_versions(gallery_id, created_at desc) where not deleted;
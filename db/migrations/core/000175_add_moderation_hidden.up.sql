@@ -0,0 +1,3 @@
+-- Set when a moderator hides reported content. Unlike deleting or removing it, hiding can be revoked.
+alter table posts add column if not exists moderation_hidden boolean not null default false;
+alter table comments add column if not exists moderation_hidden boolean not null default false;
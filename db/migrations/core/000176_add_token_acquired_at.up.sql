@@ -0,0 +1,2 @@
+-- When the owner first acquired the token, as reported by the provider. Null if the provider doesn't report it.
+alter table tokens add column if not exists acquired_at timestamptz;
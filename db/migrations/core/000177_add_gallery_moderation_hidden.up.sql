@@ -0,0 +1,2 @@
+-- Set when a moderator hides a reported gallery, separately from the owner's own hidden flag.
+alter table galleries add column if not exists moderation_hidden boolean not null default false;
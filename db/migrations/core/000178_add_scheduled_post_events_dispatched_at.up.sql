@@ -0,0 +1,3 @@
+-- Set once a published scheduled post's events have been dispatched, so a failed dispatch can be retried.
+alter table scheduled_posts add column if not exists events_dispatched_at timestamptz;
+update scheduled_posts set events_dispatched_at = published_at where post_id is not null and events_dispatched_at is null;
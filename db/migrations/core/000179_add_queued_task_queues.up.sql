@@ -0,0 +1,7 @@
+-- Tracks when each local task queue may next dispatch a task, so that a queue's dispatch rate is shared by every process dispatching from it.
+create table if not exists queued_task_queues (
+  queue varchar primary key,
+  next_dispatch_at timestamptz not null,
+  created_at timestamptz not null default current_timestamp,
+  last_updated timestamptz not null default current_timestamp
+);
@@ -149,6 +149,7 @@ select posts.*
     where community_data.community_type = 0
         and community_data.contract_id = any(posts.contract_ids)
         and posts.deleted = false
+        and posts.moderation_hidden = false
         and (posts.created_at, posts.id) < (@cur_before_time, @cur_before_id::dbid)
         and (posts.created_at, posts.id) > (@cur_after_time, @cur_after_id::dbid)
     order by
@@ -168,6 +169,7 @@ select posts.*
             and not token_community_memberships.deleted
     where community_data.community_type = 1
       and posts.deleted = false
+      and posts.moderation_hidden = false
       and (posts.created_at, posts.id) < (@cur_before_time, @cur_before_id::dbid)
       and (posts.created_at, posts.id) > (@cur_after_time, @cur_after_id::dbid)
     order by
@@ -190,6 +192,7 @@ community_posts as (
             where community_data.community_type = 0
                 and community_data.contract_id = any(posts.contract_ids)
                 and posts.deleted = false
+                and posts.moderation_hidden = false
     )
 
     union all
@@ -203,6 +206,7 @@ community_posts as (
                     and not token_community_memberships.deleted
             where community_data.community_type = 1
               and posts.deleted = false
+              and posts.moderation_hidden = false
     )
 )
 
@@ -325,6 +329,8 @@ with community_data as (
     join token_definitions td on t.token_definition_id = td.id
     join users u on u.id = t.owner_user_id
     join contracts c on t.contract_id = c.id
+    left join contract_spam_scores css on css.contract_id = c.id
+    left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where cd.community_type = 0
     and t.displayable
     and t.deleted = false
@@ -334,6 +340,7 @@ where cd.community_type = 0
     and u.universal = false
     and (t.created_at,t.id) < (@cur_before_time::timestamptz, @cur_before_id::dbid)
     and (t.created_at,t.id) > (@cur_after_time::timestamptz, @cur_after_id::dbid)
+    and (sqlc.narg('max_spam_score')::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= sqlc.narg('max_spam_score')::int)
 order by case when @paging_forward::bool then (t.created_at,t.id) end asc,
          case when not @paging_forward::bool then (t.created_at,t.id) end desc
 limit sqlc.arg('limit'))
@@ -345,6 +352,8 @@ union all
     join token_definitions td on td.id = t.token_definition_id
     join users u on u.id = t.owner_user_id
     join contracts c on t.contract_id = c.id
+    left join contract_spam_scores css on css.contract_id = c.id
+    left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where cd.community_type != 0
     and tcm.community_id = cd.community_id
     and t.displayable
@@ -356,6 +365,7 @@ where cd.community_type != 0
     and u.universal = false
     and (t.created_at,t.id) < (@cur_before_time::timestamptz, @cur_before_id::dbid)
     and (t.created_at,t.id) > (@cur_after_time::timestamptz, @cur_after_id::dbid)
+    and (sqlc.narg('max_spam_score')::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= sqlc.narg('max_spam_score')::int)
 order by case when @paging_forward::bool then (t.created_at,t.id) end asc,
          case when not @paging_forward::bool then (t.created_at,t.id) end desc
 limit sqlc.arg('limit'));
@@ -374,13 +384,16 @@ select sum(u.count) from (
         join token_definitions td on t.token_definition_id = td.id
         join users u on u.id = t.owner_user_id
         join contracts c on t.contract_id = c.id
+        left join contract_spam_scores css on css.contract_id = c.id
+        left join token_definition_spam_scores tss on tss.token_definition_id = td.id
     where cd.community_type = 0
         and t.displayable
         and t.deleted = false
         and c.deleted = false
         and td.deleted = false
         and u.deleted = false
-        and u.universal = false)
+        and u.universal = false
+        and (sqlc.narg('max_spam_score')::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= sqlc.narg('max_spam_score')::int))
 
     union all
 
@@ -389,6 +402,8 @@ select sum(u.count) from (
         join token_definitions td on td.id = t.token_definition_id
         join users u on u.id = t.owner_user_id
         join contracts c on t.contract_id = c.id
+        left join contract_spam_scores css on css.contract_id = c.id
+        left join token_definition_spam_scores tss on tss.token_definition_id = td.id
     where cd.community_type != 0
         and tcm.community_id = cd.community_id
         and t.displayable
@@ -397,7 +412,8 @@ select sum(u.count) from (
         and c.deleted = false
         and td.deleted = false
         and u.deleted = false
-        and u.universal = false)
+        and u.universal = false
+        and (sqlc.narg('max_spam_score')::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= sqlc.narg('max_spam_score')::int))
 ) u;
 
 -- name: UpsertCommunityCreators :many
@@ -0,0 +1,24 @@
+-- name: InsertGalleryVersion :one
+insert into gallery_versions (id, gallery_id, owner_user_id, name, description, collections, published, restored_from_version_id)
+values (@id, @gallery_id, @owner_user_id, @name, @description, @collections, @published, sqlc.narg('restored_from_version_id'))
+returning *;
+
+-- name: GetGalleryVersionByID :one
+select * from gallery_versions where id = @id and not deleted;
+
+-- name: GetLatestGalleryVersion :one
+select * from gallery_versions where gallery_id = @gallery_id and not deleted order by created_at desc, id desc limit 1;
+
+-- name: GetGalleryVersionsByGalleryID :many
+select * from gallery_versions where gallery_id = @gallery_id and not deleted order by created_at desc, id desc limit sqlc.arg('limit');
+
+-- name: GetOwnedTokenIDs :many
+select id from tokens where id = any(@token_ids::dbid[]) and owner_user_id = @owner_user_id and not deleted;
+
+-- name: SetGalleryVersionPublished :exec
+update gallery_versions set published = true where id = @id and not deleted;
+
+-- name: RestoreCollection :execrows
+update collections
+set deleted = false, name = @name, collectors_note = @collectors_note, nfts = @nfts, layout = @layout, token_settings = @token_settings, hidden = @hidden, last_updated = now()
+where id = @id and gallery_id = @gallery_id and owner_user_id = @owner_user_id;
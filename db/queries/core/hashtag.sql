@@ -0,0 +1,57 @@
+-- name: InsertPostHashtags :exec
+insert into post_hashtags (post_id, tag) select @post_id, unnest(@tags::varchar[]) on conflict do nothing;
+
+-- name: DeleteStalePostHashtags :exec
+-- Tags that are still in the caption are kept so that editing a post doesn't reset when they were added
+delete from post_hashtags where post_id = @post_id and not (tag = any(@tags::varchar[]));
+
+-- name: GetHashtagsByPostID :many
+select tag from post_hashtags where post_id = @post_id order by tag;
+
+-- name: PaginatePostsByHashtag :many
+select p.* from posts p
+    join post_hashtags h on h.post_id = p.id
+    left join feed_blocklist fb on p.actor_id = fb.user_id and not fb.deleted and fb.active
+where h.tag = @tag
+    and not p.deleted
+    and not p.moderation_hidden
+    and (fb.user_id is null or @viewer_id = fb.user_id)
+    and (p.created_at, p.id) < (@cur_before_time, @cur_before_id::dbid)
+    and (p.created_at, p.id) > (@cur_after_time, @cur_after_id::dbid)
+    and not exists (select 1 from muted_posts mp where mp.user_id = @viewer_id and mp.post_id = p.id)
+    and not exists (select 1 from user_blocklist ub where ub.user_id = @viewer_id and ub.blocked_user_id = p.actor_id and not ub.deleted and ub.active)
+order by
+    case when sqlc.arg('paging_forward')::bool then (p.created_at, p.id) end asc,
+    case when not sqlc.arg('paging_forward')::bool then (p.created_at, p.id) end desc
+limit sqlc.arg('limit');
+
+-- name: CountPostsByHashtag :one
+select count(*) from post_hashtags h
+    join posts p on p.id = h.post_id
+    left join feed_blocklist fb on p.actor_id = fb.user_id and not fb.deleted and fb.active
+where h.tag = @tag and not p.deleted and not p.moderation_hidden and fb.user_id is null;
+
+-- name: GetTrendingHashtags :many
+-- Tags are ranked by how many different users posted them so that a single user can't trend a tag on their own
+select h.tag, count(*) as total_posts, count(distinct p.actor_id) as total_authors
+from post_hashtags h
+    join posts p on p.id = h.post_id and not p.deleted and not p.moderation_hidden
+    left join feed_blocklist fb on p.actor_id = fb.user_id and not fb.deleted and fb.active
+where h.created_at > @window_start
+    and fb.user_id is null
+    and not exists (select 1 from user_blocklist ub where ub.user_id = @viewer_id and ub.blocked_user_id = p.actor_id and not ub.deleted and ub.active)
+group by h.tag
+order by total_authors desc, total_posts desc, h.tag
+limit sqlc.arg('limit');
+
+-- name: FollowTopic :exec
+insert into topic_follows (id, user_id, tag) values (@id, @user_id, @tag) on conflict (user_id, tag) where not deleted do nothing;
+
+-- name: UnfollowTopic :exec
+update topic_follows set deleted = true, last_updated = now() where user_id = @user_id and tag = @tag and not deleted;
+
+-- name: IsFollowingTopic :one
+select exists(select 1 from topic_follows where user_id = @user_id and tag = @tag and not deleted);
+
+-- name: GetFollowedTopicsByUserID :many
+select tag from topic_follows where user_id = @user_id and not deleted order by created_at desc;
@@ -0,0 +1,59 @@
+-- name: InsertReport :one
+-- Reporting the same thing again doesn't reopen a report that a moderator has already resolved
+insert into reports (id, reporter_id, subject_type, subject_id, reason) values (@id, sqlc.narg('reporter')::text, @subject_type, @subject_id, @reason)
+on conflict (subject_type, subject_id, reporter_id, reason) where not deleted do update set last_updated = now()
+returning *;
+
+-- name: CountUnresolvedReportersBySubject :one
+select count(distinct reporter_id) from reports where subject_type = @subject_type and subject_id = @subject_id and resolved_at is null and not deleted;
+
+-- name: GetModerationQueue :many
+select subject_type, subject_id,
+    count(*) total_reports,
+    count(distinct reporter_id) total_reporters,
+    array_agg(distinct reason)::varchar[] reasons,
+    min(created_at)::timestamptz first_reported_at,
+    max(last_updated)::timestamptz last_reported_at
+from reports
+where resolved_at is null and not deleted
+    and (sqlc.narg('subject_type')::varchar is null or subject_type = sqlc.narg('subject_type'))
+group by subject_type, subject_id
+order by count(distinct reporter_id) desc, max(last_updated) desc
+limit @limit;
+
+-- name: ResolveReportsBySubject :exec
+update reports set resolved_at = now(), last_updated = now() where subject_type = @subject_type and subject_id = @subject_id and resolved_at is null and not deleted;
+
+-- name: InsertModerationAction :one
+insert into moderation_actions (id, subject_type, subject_id, user_id, action, reason, note, moderator, automated, expires_at)
+values (@id, @subject_type, @subject_id, @user_id, @action, sqlc.narg('reason')::varchar, sqlc.narg('note'), sqlc.narg('moderator'), @automated, sqlc.narg('expires_at'))
+returning *;
+
+-- name: GetModerationActions :many
+select * from moderation_actions
+where (sqlc.narg('subject_type')::varchar is null or subject_type = sqlc.narg('subject_type'))
+    and (sqlc.narg('subject_id')::varchar is null or subject_id = sqlc.narg('subject_id'))
+    and (sqlc.narg('user_id')::varchar is null or user_id = sqlc.narg('user_id'))
+order by created_at desc, id desc
+limit @limit;
+
+-- name: RevokeModerationAction :one
+update moderation_actions set revoked_at = now() where id = @id and action in ('HIDE', 'SUSPEND', 'BAN') and revoked_at is null returning *;
+
+-- name: GetActiveSuspensionByUserID :one
+select * from moderation_actions
+where user_id = @user_id and action in ('SUSPEND', 'BAN') and revoked_at is null and (expires_at is null or expires_at > now())
+order by expires_at desc nulls first
+limit 1;
+
+-- name: IsSubjectHidden :one
+select exists(select 1 from moderation_actions where subject_type = @subject_type and subject_id = @subject_id and action = 'HIDE' and revoked_at is null);
+
+-- name: SetPostModerationHidden :exec
+update posts set moderation_hidden = @hidden, last_updated = now() where id = @id;
+
+-- name: SetCommentModerationHidden :exec
+update comments set moderation_hidden = @hidden, last_updated = now() where id = @id;
+
+-- name: SetGalleryModerationHidden :exec
+update galleries set moderation_hidden = @hidden, last_updated = now() where id = @id;
@@ -0,0 +1,31 @@
+-- name: InsertMute :one
+insert into mutes (id, user_id, muted_user_id, muted_community_id, keyword, expires_at)
+values (@id, @user_id, sqlc.narg('muted_user_id')::varchar, sqlc.narg('muted_community_id')::varchar, sqlc.narg('keyword')::varchar, sqlc.narg('expires_at')::timestamptz)
+on conflict (user_id, coalesce(muted_user_id, muted_community_id, keyword)) where not deleted
+    do update set expires_at = excluded.expires_at, last_updated = now()
+returning *;
+
+-- name: DeleteMuteByIDAndUserID :one
+update mutes set deleted = true, last_updated = now() where id = @id and user_id = @user_id and not deleted returning id;
+
+-- name: GetActiveMutesByUserID :many
+select * from mutes where user_id = @user_id and not deleted and (expires_at is null or expires_at > now()) order by created_at desc;
+
+-- name: GetMutedFeedEntityIDs :many
+-- Reposts and quote posts are muted when the post they share is muted or when the user who reposted it is muted
+select distinct e.id from unnest(@entity_ids::varchar[]) e(id)
+    left join reposts r on r.id = e.id
+where exists (select 1 from muted_posts mp where mp.user_id = @user_id and mp.post_id = coalesce(r.post_id, e.id))
+    or exists (select 1 from mutes m where m.user_id = @user_id and m.muted_user_id = r.actor_id and not m.deleted and (m.expires_at is null or m.expires_at > now()));
+
+-- name: IsNotificationMuted :one
+select exists(
+    select 1 from mutes m
+    where m.user_id = @owner_id
+        and not m.deleted
+        and (m.expires_at is null or m.expires_at > now())
+        and (m.muted_user_id in (select actor_id from events where id = any(@event_ids::varchar[]))
+            or m.muted_user_id = (select actor_id from comments where id = sqlc.narg('comment_id')::varchar)
+            or m.muted_community_id = sqlc.narg('community_id')::varchar
+            or position(m.keyword in lower((select comment from comments where id = sqlc.narg('comment_id')::varchar))) > 0)
+);
@@ -55,19 +55,19 @@ SELECT * FROM users WHERE (traits->$1::text) IS NOT NULL AND deleted = false;
 SELECT * FROM galleries WHERE id = $1 AND deleted = false;
 
 -- name: GetGalleryByIdBatch :batchone
-SELECT * FROM galleries WHERE id = $1 AND deleted = false;
+SELECT * FROM galleries WHERE id = $1 AND deleted = false AND moderation_hidden = false;
 
 -- name: GetGalleryByCollectionId :one
 SELECT g.* FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false;
 
 -- name: GetGalleryByCollectionIdBatch :batchone
-SELECT g.* FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false;
+SELECT g.* FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false AND g.moderation_hidden = false;
 
 -- name: GetGalleriesByUserId :many
 SELECT * FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position;
 
 -- name: GetGalleriesByUserIdBatch :batchmany
-SELECT * FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position;
+SELECT * FROM galleries WHERE owner_user_id = $1 AND deleted = false AND moderation_hidden = false order by position;
 
 -- name: GetCollectionById :one
 SELECT * FROM collections WHERE id = $1 AND deleted = false;
@@ -421,12 +421,15 @@ select sqlc.embed(t), sqlc.embed(td), sqlc.embed(c)
 from tokens t
 join token_definitions td on t.token_definition_id = td.id
 join contracts c on c.id = td.contract_id
+left join contract_spam_scores css on css.contract_id = c.id
+left join token_definition_spam_scores tss on tss.token_definition_id = td.id
 where t.owner_user_id = @owner_user_id
     and t.deleted = false
     and t.displayable
     and ((@include_holder::bool and t.is_holder_token) or (@include_creator::bool and t.is_creator_token))
     and td.deleted = false
     and c.deleted = false
+    and (sqlc.narg('max_spam_score')::int is null or least(coalesce(css.score, 0) + coalesce(tss.score, 0), 100) <= sqlc.narg('max_spam_score')::int)
 order by t.created_at desc, td.name desc, t.id desc;
 
 -- name: CreateUserEvent :one
@@ -550,12 +553,20 @@ order by
 limit sqlc.arg('limit');
 
 -- name: PaginatePersonalFeedByUserID :many
-select fe.* from feed_entities fe, follows fl
-    where fl.deleted = false
-      and fe.actor_id = fl.followee
-      and fl.follower = sqlc.arg('follower')
+select fe.* from feed_entities fe
+    where (exists (select 1 from follows fl where fl.follower = sqlc.arg('follower') and fl.followee = fe.actor_id and fl.deleted = false)
+        -- Posts from authors that aren't followed only come through topics, so they're held to the same
+        -- blocklist as the trending feed
+        or (exists (select 1 from post_hashtags h join topic_follows tf on tf.tag = h.tag
+                where h.post_id = fe.id and tf.user_id = sqlc.arg('follower') and not tf.deleted)
+            and not exists (select 1 from feed_blocklist fb where fb.user_id = fe.actor_id and not fb.deleted and fb.active)))
       and (fe.created_at, fe.id) < (@cur_before_time, @cur_before_id::dbid)
       and (fe.created_at, fe.id) > (@cur_after_time, @cur_after_id::dbid)
+      and not exists (select 1 from mutes m where m.user_id = sqlc.arg('follower') and m.muted_user_id = fe.actor_id and not m.deleted and (m.expires_at is null or m.expires_at > now()))
+      -- Reposts and quote posts are checked against the post they share, which also covers muting its author
+      and not exists (select 1 from muted_posts mp where mp.user_id = sqlc.arg('follower')
+            and mp.post_id = coalesce((select r.post_id from reposts r where r.id = fe.id), fe.id))
+      and not exists (select 1 from user_blocklist ub where ub.user_id = sqlc.arg('follower') and ub.blocked_user_id = fe.actor_id and not ub.deleted and ub.active)
 order by
     case when sqlc.arg('paging_forward')::bool then (fe.created_at, fe.id) end asc,
     case when not sqlc.arg('paging_forward')::bool then (fe.created_at, fe.id) end desc
@@ -568,19 +579,21 @@ where actor_id = sqlc.arg('user_id')
         and (created_at, id) < (@cur_before_time, @cur_before_id::dbid)
         and (created_at, id) > (@cur_after_time, @cur_after_id::dbid)
         and not posts.deleted
+        and not posts.moderation_hidden
 order by
     case when sqlc.arg('paging_forward')::bool then (created_at, id) end asc,
     case when not sqlc.arg('paging_forward')::bool then (created_at, id) end desc
 limit sqlc.arg('limit');
 
 -- name: CountPostsByUserID :one
-select count(*) from posts where actor_id = $1 and not posts.deleted;
+select count(*) from posts where actor_id = $1 and not posts.deleted and not posts.moderation_hidden;
 
 -- name: PaginatePostsByContractID :batchmany
 SELECT posts.*
 FROM posts
 WHERE @contract_id::dbid = ANY(posts.contract_ids)
 AND posts.deleted = false
+AND posts.moderation_hidden = false
 AND (posts.created_at, posts.id) < (@cur_before_time, @cur_before_id::dbid)
 AND (posts.created_at, posts.id) > (@cur_after_time, @cur_after_id::dbid)
 ORDER BY
@@ -601,6 +614,7 @@ with valid_post_ids as (
             and token_definitions.deleted = false
     WHERE sqlc.arg('contract_id') = ANY(posts.contract_ids)
       AND posts.deleted = false
+      AND posts.moderation_hidden = false
 )
 SELECT posts.* from posts
     join valid_post_ids on posts.id = valid_post_ids.id
@@ -615,7 +629,8 @@ LIMIT sqlc.arg('limit');
 select count(*)
 from posts
 where @contract_id::dbid = any(posts.contract_ids)
-and posts.deleted = false;
+and posts.deleted = false
+and posts.moderation_hidden = false;
 
 -- name: GetFeedEventsByIds :many
 SELECT * FROM feed_events WHERE id = ANY(@ids::varchar(255)[]) AND deleted = false;
@@ -624,14 +639,14 @@ SELECT * FROM feed_events WHERE id = ANY(@ids::varchar(255)[]) AND deleted = fal
 select posts.*
 from posts
 join unnest(@post_ids::varchar[]) with ordinality t(id, pos) using(id)
-where not posts.deleted and t.pos > @cur_after_pos::int and t.pos < @cur_before_pos::int
+where not posts.deleted and not posts.moderation_hidden and t.pos > @cur_after_pos::int and t.pos < @cur_before_pos::int
 order by t.pos asc;
 
 -- name: GetEventByIdBatch :batchone
 SELECT * FROM feed_events WHERE id = $1 AND deleted = false;
 
 -- name: GetPostByIdBatch :batchone
-SELECT * FROM posts WHERE id = $1 AND deleted = false;
+SELECT * FROM posts WHERE id = $1 AND deleted = false AND moderation_hidden = false;
 
 -- name: GetPostByID :one
 SELECT * FROM posts WHERE id = $1 AND deleted = false;
@@ -746,10 +761,10 @@ SELECT * FROM comments WHERE id = $1 AND deleted = false;
 SELECT * from comments WHERE id = ANY(@comment_ids::dbid[]) AND deleted = false;
 
 -- name: GetCommentByCommentIDBatch :batchone
-SELECT * FROM comments WHERE id = $1 AND deleted = false; 
+SELECT * FROM comments WHERE id = $1 AND deleted = false AND moderation_hidden = false; 
 
 -- name: PaginateCommentsByFeedEventIDBatch :batchmany
-SELECT * FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND reply_to is null AND deleted = false
+SELECT * FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND reply_to is null AND deleted = false AND moderation_hidden = false
     AND (created_at, id) < (@cur_before_time, @cur_before_id::dbid)
     AND (created_at, id) > (@cur_after_time, @cur_after_id::dbid)
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (created_at, id) END ASC,
@@ -757,24 +772,32 @@ SELECT * FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND reply
     LIMIT sqlc.arg('limit');
 
 -- name: CountCommentsByFeedEventIDBatch :batchone
-SELECT count(*) FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND reply_to is null AND deleted = false;
+SELECT count(*) FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND reply_to is null AND deleted = false AND moderation_hidden = false;
 
 -- name: CountCommentsAndRepliesByFeedEventID :one
-SELECT count(*) FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND deleted = false;
+SELECT count(*) FROM comments WHERE feed_event_id = sqlc.arg('feed_event_id') AND deleted = false AND moderation_hidden = false;
 
 -- name: PaginateCommentsByPostIDBatch :batchmany
 SELECT * FROM comments WHERE post_id = sqlc.arg('post_id') AND reply_to is null AND deleted = false
     AND (created_at, id) < (@cur_before_time, @cur_before_id::dbid)
     AND (created_at, id) > (@cur_after_time, @cur_after_id::dbid)
+    AND NOT EXISTS (SELECT 1 FROM mutes m WHERE m.user_id = sqlc.arg('viewer_id') AND NOT m.deleted AND (m.expires_at IS NULL OR m.expires_at > now())
+        AND (m.muted_user_id = comments.actor_id OR position(m.keyword in lower(comments.comment)) > 0))
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = sqlc.arg('viewer_id'))
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (created_at, id) END ASC,
              CASE WHEN NOT sqlc.arg('paging_forward')::bool THEN (created_at, id) END DESC
     LIMIT sqlc.arg('limit');
 
 -- name: CountCommentsByPostIDBatch :batchone
-SELECT count(*) FROM comments WHERE post_id = sqlc.arg('post_id') AND reply_to is null AND deleted = false;
+SELECT count(*) FROM comments WHERE post_id = sqlc.arg('post_id') AND reply_to is null AND deleted = false
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = sqlc.arg('viewer_id'));
 
 -- name: CountCommentsAndRepliesByPostID :one
-SELECT count(*) FROM comments WHERE post_id = sqlc.arg('post_id') AND deleted = false;
+SELECT count(*) FROM comments WHERE post_id = sqlc.arg('post_id') AND deleted = false
+    AND NOT moderation_hidden
+    AND (NOT hidden OR actor_id = sqlc.arg('viewer_id'));
 
 -- name: PaginateRepliesByCommentIDBatch :batchmany
 SELECT * FROM comments c 
@@ -787,6 +810,10 @@ WHERE
     AND c.deleted = false
     AND (c.created_at, c.id) < (@cur_before_time, @cur_before_id::dbid)
     AND (c.created_at, c.id) > (@cur_after_time, @cur_after_id::dbid)
+    AND NOT EXISTS (SELECT 1 FROM mutes m WHERE m.user_id = sqlc.arg('viewer_id') AND NOT m.deleted AND (m.expires_at IS NULL OR m.expires_at > now())
+        AND (m.muted_user_id = c.actor_id OR position(m.keyword in lower(c.comment)) > 0))
+    AND NOT c.moderation_hidden
+    AND (NOT c.hidden OR c.actor_id = sqlc.arg('viewer_id'))
 ORDER BY 
     CASE 
         WHEN sqlc.arg('paging_forward')::bool THEN (c.created_at, c.id) 
@@ -804,7 +831,9 @@ WHERE
         THEN c.top_level_comment_id = sqlc.arg('comment_id') 
         ELSE c.reply_to = sqlc.arg('comment_id') 
     END
-    AND c.deleted = false;
+    AND c.deleted = false
+    AND c.moderation_hidden = false
+    AND (NOT c.hidden OR c.actor_id = sqlc.arg('viewer_id'));
 
 -- name: GetUserNotifications :many
 SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false
@@ -952,7 +981,7 @@ SELECT interactions.created_At, interactions.id, interactions.tag FROM (
     SELECT t.created_at, t.id, sqlc.arg('admire_tag')::int as tag FROM admires t WHERE sqlc.arg('admire_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.deleted = false
         AND (sqlc.arg('admire_tag'), t.created_at, t.id) < (sqlc.arg('cur_before_tag')::int, @cur_before_time, @cur_before_id::dbid) AND (sqlc.arg('admire_tag'), t.created_at, t.id) > (sqlc.arg('cur_after_tag')::int, @cur_after_time, @cur_after_id::dbid)
                                                                     UNION
-    SELECT t.created_at, t.id, sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.reply_to is null AND t.deleted = false
+    SELECT t.created_at, t.id, sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
         AND (sqlc.arg('comment_tag'), t.created_at, t.id) < (sqlc.arg('cur_before_tag')::int, @cur_before_time, @cur_before_id::dbid) AND (sqlc.arg('comment_tag'), t.created_at, t.id) > (sqlc.arg('cur_after_tag')::int, @cur_after_time, @cur_after_id::dbid)
 ) as interactions
 
@@ -963,14 +992,15 @@ LIMIT sqlc.arg('limit');
 -- name: CountInteractionsByFeedEventIDBatch :batchmany
 SELECT count(*), sqlc.arg('admire_tag')::int as tag FROM admires t WHERE sqlc.arg('admire_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.deleted = false
                                                         UNION
-SELECT count(*), sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.reply_to is null AND t.deleted = false;
+SELECT count(*), sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.feed_event_id = sqlc.arg('feed_event_id') AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false;
 
 -- name: PaginateInteractionsByPostIDBatch :batchmany
 SELECT interactions.created_At, interactions.id, interactions.tag FROM (
     SELECT t.created_at, t.id, sqlc.arg('admire_tag')::int as tag FROM admires t WHERE sqlc.arg('admire_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.deleted = false
         AND (sqlc.arg('admire_tag'), t.created_at, t.id) < (sqlc.arg('cur_before_tag')::int, @cur_before_time, @cur_before_id::dbid) AND (sqlc.arg('admire_tag'), t.created_at, t.id) > (sqlc.arg('cur_after_tag')::int, @cur_after_time, @cur_after_id::dbid)
                                                                     UNION
-    SELECT t.created_at, t.id, sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.reply_to is null AND t.deleted = false
+    SELECT t.created_at, t.id, sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
+        AND (NOT t.hidden OR t.actor_id = sqlc.arg('viewer_id'))
         AND (sqlc.arg('comment_tag'), t.created_at, t.id) < (sqlc.arg('cur_before_tag')::int, @cur_before_time, @cur_before_id::dbid) AND (sqlc.arg('comment_tag'), t.created_at, t.id) > (sqlc.arg('cur_after_tag')::int, @cur_after_time, @cur_after_id::dbid)
 ) as interactions
 
@@ -981,7 +1011,8 @@ LIMIT sqlc.arg('limit');
 -- name: CountInteractionsByPostIDBatch :batchmany
 SELECT count(*), sqlc.arg('admire_tag')::int as tag FROM admires t WHERE sqlc.arg('admire_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.deleted = false
                                                         UNION
-SELECT count(*), sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.reply_to is null AND t.deleted = false;
+SELECT count(*), sqlc.arg('comment_tag')::int as tag FROM comments t WHERE sqlc.arg('comment_tag') != 0 AND t.post_id = sqlc.arg('post_id') AND t.reply_to is null AND t.deleted = false AND t.moderation_hidden = false
+    AND (NOT t.hidden OR t.actor_id = sqlc.arg('viewer_id'));
 
 -- name: GetAdmireByActorIDAndFeedEventID :batchone
 SELECT * FROM admires WHERE actor_id = $1 AND feed_event_id = $2 AND deleted = false;
@@ -1004,6 +1035,18 @@ returning id;
 -- name: DeletePostByID :exec
 update posts set deleted = true where id = $1;
 
+-- name: UpdatePost :exec
+update posts set caption = @caption, user_mint_url = @user_mint_url, edited_at = now(), last_updated = now() where id = @id and not deleted;
+
+-- name: SetPostCommentRestriction :exec
+update posts set comment_restriction = @comment_restriction, last_updated = now() where id = @id and not deleted;
+
+-- name: InsertPostEdit :exec
+insert into post_edits (id, post_id, actor_id, caption, user_mint_url) values (@id, @post_id, @actor_id, @caption, @user_mint_url);
+
+-- name: GetPostEditsByPostID :many
+select * from post_edits where post_id = $1 order by created_at desc, id desc;
+
 -- for some reason this query will not allow me to use @tags for $1
 -- name: GetUsersWithEmailNotificationsOnForEmailType :many
 select u.* from pii.user_view u
@@ -1490,6 +1533,87 @@ with insert_job(id) as (
 -- Always return the new media that was inserted, even if its inactive so the pipeline can report metrics accurately
 select sqlc.embed(token_medias) from insert_new_media token_medias;
 
+-- name: InsertTokenPipelineResultsWithExistingMedia :one
+with insert_job(id) as (
+    insert into token_processing_jobs (id, token_properties, pipeline_metadata, processing_cause, processor_version)
+    values (@processing_job_id, @token_properties, @pipeline_metadata, @processing_cause, @processor_version)
+    returning id
+)
+, update_token_definition as (
+    update token_definitions
+    set metadata = @new_metadata::jsonb,
+        name = @new_name,
+        description = @new_description,
+        last_updated = now(),
+        token_media_id = @token_media_id
+    where (chain, contract_address, token_id) = (@chain, @contract_address, @token_id) and not deleted
+)
+select tm.* from token_medias tm where tm.id = @token_media_id and not tm.deleted;
+
+-- name: GetActiveMediaBySourceKey :one
+select tm.*
+from token_media_sources s
+join token_medias tm on s.token_media_id = tm.id
+where s.source_key = @source_key
+    and tm.active
+    and not tm.deleted;
+
+-- name: UpsertTokenMediaSource :exec
+insert into token_media_sources (source_key, token_media_id) values (@source_key, @token_media_id)
+on conflict (source_key) do update set token_media_id = excluded.token_media_id, last_updated = now();
+
+-- name: GetContractSpamSignals :many
+with displayed_tokens as (
+    -- Tokens from these contracts that their owners display in a collection, found by unnesting the holders' collections once
+    select distinct dt.id as token_id
+    from collections col
+    cross join unnest(col.nfts) as u(token_id)
+    join tokens dt on dt.id = u.token_id and dt.owner_user_id = col.owner_user_id
+    where dt.contract_id = any(@contract_ids::varchar[])
+        and not dt.deleted
+        and not col.deleted
+        and col.owner_user_id in (select owner_user_id from tokens where contract_id = any(@contract_ids::varchar[]) and is_holder_token and not deleted)
+)
+select c.id as contract_id,
+    c.name,
+    c.description,
+    c.is_provider_marked_spam,
+    c.created_at,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token) as holders,
+    count(distinct t.owner_user_id) filter (where t.is_user_marked_spam) as spam_voters,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token and coalesce(t.acquired_at, t.created_at) < f.first_seen + interval '2 days') as early_holders,
+    count(distinct t.owner_user_id) filter (where t.is_holder_token and d.token_id is not null) as displaying_holders
+from contracts c
+left join tokens t on t.contract_id = c.id and not t.deleted
+left join displayed_tokens d on d.token_id = t.id
+left join lateral (
+    select min(coalesce(tokens.acquired_at, tokens.created_at)) as first_seen from tokens where tokens.contract_id = c.id and tokens.is_holder_token and not tokens.deleted
+) f on true
+where c.id = any(@contract_ids::varchar[]) and not c.deleted
+group by c.id, f.first_seen;
+
+-- name: GetContractIDsToScoreForSpam :many
+select c.id
+from contracts c
+left join contract_spam_scores s on s.contract_id = c.id
+where not c.deleted and (s.contract_id is null or s.last_updated < @scored_before)
+order by s.last_updated nulls first, c.id
+limit @limit;
+
+-- name: UpsertContractSpamScore :exec
+insert into contract_spam_scores (contract_id, score, reasons) values (@contract_id, @score, @reasons)
+on conflict (contract_id) do update set score = excluded.score, reasons = excluded.reasons, last_updated = now();
+
+-- name: UpsertTokenDefinitionSpamScore :exec
+insert into token_definition_spam_scores (token_definition_id, score, reasons) values (@token_definition_id, @score, @reasons)
+on conflict (token_definition_id) do update set score = excluded.score, reasons = excluded.reasons, last_updated = now();
+
+-- name: GetContractSpamScoreByContractIDBatch :batchone
+select * from contract_spam_scores where contract_id = $1;
+
+-- name: GetTokenDefinitionSpamScoreByIDBatch :batchone
+select * from token_definition_spam_scores where token_definition_id = $1;
+
 -- name: InsertSpamContracts :exec
 with insert_spam_contracts as (
     insert into alchemy_spam_contracts (id, chain, address, created_at, is_spam) (
@@ -1808,6 +1932,9 @@ select * from mentions where post_id = @post_id and not deleted;
 -- name: GetMentionByID :one
 select * from mentions where id = @id and not deleted;
 
+-- name: DeleteMentionsByPostID :many
+update mentions set deleted = true where post_id = @post_id and not deleted returning *;
+
 -- name: GetUsersWithoutSocials :many
 select u.id, w.address, u.pii_socials->>'Lens' is null, u.pii_socials->>'Farcaster' is null from pii.user_view u join wallets w on w.id = any(u.wallets) where u.deleted = false and w.chain = 0 and w.deleted = false and u.universal = false and (u.pii_socials->>'Lens' is null or u.pii_socials->>'Farcaster' is null) order by u.created_at desc;
 
@@ -1901,10 +2028,12 @@ RETURNING ID;
 -- name: RemoveComment :exec
 UPDATE comments SET REMOVED = TRUE, COMMENT = 'comment removed' WHERE ID = $1;
 
--- name: ReportPost :one
-with offending_post as (select id from posts where posts.id = @post_id and not deleted)
-insert into reported_posts (id, post_id, reporter_id, reason) (select @id, offending_post.id, sqlc.narg(reporter)::text, sqlc.narg(reason) from offending_post)
-on conflict(post_id, reporter_id, reason) where not deleted do update set last_updated = now() returning id;
+-- name: SetCommentHidden :exec
+update comments set hidden = @hidden, last_updated = now() where id = @comment_id and not deleted;
+
+-- name: GetFollowStatus :one
+select exists(select 1 from follows where follower = @user_id and followee = @other_user_id and not deleted)::bool as following,
+    exists(select 1 from follows where follower = @other_user_id and followee = @user_id and not deleted)::bool as followed_by;
 
 -- name: BlockUser :one
 with user_to_block as (select id from users where users.id = @blocked_user_id and not deleted and not universal)
@@ -1982,7 +2111,7 @@ select sqlc.embed(g),
        cg2.token_medias as all_medias,
        cg2.token_media_last_updated::timestamptz[] as all_media_last_updated,
        (-cg.gallery_relevance)::float8 as relevance from community_galleries cg
-    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden
+    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden and not g.moderation_hidden
     join community_galleries cg2 on cg2.gallery_id = cg.gallery_id and cg2.community_id is null
 where cg.community_id = @community_id
     and (cg.user_id != @relative_to_user_id, cg.community_id, -cg.gallery_relevance, cg.gallery_id) < (@cur_before_is_not_relative_user::bool, @community_id, @cur_before_relevance::float8, @cur_before_id::dbid)
@@ -1993,7 +2122,7 @@ limit sqlc.arg('limit');
 
 -- name: CountGalleriesDisplayingCommunityIDBatch :batchone
 select count(*) from community_galleries cg
-    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden
+    join galleries g on cg.gallery_id = g.id and not g.deleted and not g.hidden and not g.moderation_hidden
 where cg.community_id = @community_id;
 
 -- name: SetPersonaByUserID :exec
@@ -2049,3 +2178,88 @@ update highlight_mint_claims set last_updated = now(), status = $1, minted_token
 
 -- name: UpdateHighlightMintClaimStatusMediaProcessing :one
 update highlight_mint_claims set last_updated = now(), status = $1, internal_token_id = $2 where id = @id returning *;
+
+-- name: GetIndexerCheckpoint :one
+select * from indexer_checkpoints where chain = @chain and not deleted;
+
+-- name: UpsertIndexerCheckpoint :exec
+insert into indexer_checkpoints (id, chain, block_number, block_hash) values (@id, @chain, @block_number, @block_hash)
+on conflict (chain) where not deleted do update set block_number = excluded.block_number, block_hash = excluded.block_hash, last_updated = now();
+
+-- name: InsertQueuedTask :exec
+insert into queued_tasks (id, queue, url, method, headers, body, scheduled_at, dispatch_deadline_ms) values (@id, @queue, @url, @method, @headers, @body, @scheduled_at, @dispatch_deadline_ms);
+
+-- name: LockQueuedTaskClaims :exec
+-- Serializes claims across processes until the end of the transaction, so that claims don't race each other past a queue's limits.
+select pg_advisory_xact_lock(hashtext('queued_tasks'));
+
+-- name: GetQueuedTaskQueueStates :many
+select t.queue, (count(*) filter (where t.locked_until > now()))::int as in_flight, s.next_dispatch_at
+from queued_tasks t
+left join queued_task_queues s on s.queue = t.queue
+where t.dead_lettered_at is null
+group by t.queue, s.next_dispatch_at;
+
+-- name: UpsertQueuedTaskQueue :exec
+insert into queued_task_queues (queue, next_dispatch_at) values (@queue, @next_dispatch_at)
+on conflict (queue) do update set next_dispatch_at = excluded.next_dispatch_at, last_updated = now();
+
+-- name: ClaimQueuedTasks :many
+-- Claims the earliest due tasks, taking at most queue_limits[i] tasks from queues[i]. Tasks of queues that aren't listed aren't claimed.
+with limits as (
+    select unnest(@queues::varchar[]) as queue, unnest(@queue_limits::int[]) as queue_limit
+),
+ranked as (
+    select t.id, t.scheduled_at, row_number() over (partition by t.queue order by t.scheduled_at) as position, l.queue_limit
+    from queued_tasks t
+    join limits l on l.queue = t.queue
+    where t.dead_lettered_at is null and t.scheduled_at <= now() and (t.locked_until is null or t.locked_until <= now())
+),
+due as (
+    select queued_tasks.id from queued_tasks
+    join ranked on ranked.id = queued_tasks.id
+    where ranked.position <= ranked.queue_limit
+    order by ranked.scheduled_at
+    limit sqlc.arg('limit')
+    for update of queued_tasks skip locked
+)
+update queued_tasks set attempts = queued_tasks.attempts + 1, locked_until = now() + (queued_tasks.dispatch_deadline_ms + 60000) * interval '1 millisecond', last_updated = now()
+from due where queued_tasks.id = due.id
+returning queued_tasks.*;
+
+-- name: DeleteQueuedTask :exec
+delete from queued_tasks where id = @id;
+
+-- name: RetryQueuedTask :exec
+update queued_tasks set scheduled_at = @scheduled_at, locked_until = null, last_error = @last_error::varchar, last_updated = now() where id = @id;
+
+-- name: DeadLetterQueuedTask :exec
+update queued_tasks set dead_lettered_at = now(), locked_until = null, last_error = @last_error::varchar, last_updated = now() where id = @id;
+
+-- name: UpsertTokenProcessingFailure :exec
+insert into token_processing_failures (id, token_definition_id, chain, contract_address, processing_cause, error_class, error_message, attempts, attempt_history, last_processing_job_id, dead_lettered_at)
+values (@id, @token_definition_id, @chain, @contract_address, @processing_cause, @error_class, @error_message, @attempts, @attempt_history, @last_processing_job_id, case when @dead_lettered::bool then now() end)
+on conflict (token_definition_id) where not deleted do update set
+    processing_cause = case when excluded.attempts = 1 then excluded.processing_cause else token_processing_failures.processing_cause end,
+    error_class = excluded.error_class,
+    error_message = excluded.error_message,
+    attempts = excluded.attempts,
+    attempt_history = token_processing_failures.attempt_history || excluded.attempt_history,
+    last_processing_job_id = excluded.last_processing_job_id,
+    dead_lettered_at = excluded.dead_lettered_at,
+    last_updated = now();
+
+-- name: GetDeadLetteredTokenProcessingFailures :many
+select sqlc.embed(f), j.pipeline_metadata
+from token_processing_failures f
+left join token_processing_jobs j on j.id = f.last_processing_job_id
+where f.dead_lettered_at is not null
+    and not f.deleted
+    and (sqlc.narg('chain')::int is null or f.chain = sqlc.narg('chain'))
+    and (sqlc.narg('contract_address')::varchar is null or f.contract_address = sqlc.narg('contract_address'))
+    and (sqlc.narg('error_class')::varchar is null or f.error_class = sqlc.narg('error_class'))
+order by f.dead_lettered_at desc, f.id desc
+limit sqlc.arg('limit');
+
+-- name: MarkTokenProcessingFailuresReplayed :exec
+update token_processing_failures set dead_lettered_at = null, replayed_at = now(), last_updated = now() where id = any(@ids::varchar[]) and not deleted;
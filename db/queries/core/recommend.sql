@@ -88,6 +88,6 @@ select
   , coalesce(p.actor_id = (select id from gallery_user), false)::bool is_gallery_post
 from t2 feed_entity_scores
 join t4 using(id)
-join posts p on feed_entity_scores.id = p.id and not p.deleted
+join posts p on feed_entity_scores.id = p.id and not p.deleted and not p.moderation_hidden
 left join feed_blocklist fb on p.actor_id = fb.user_id and not fb.deleted and fb.active
 where (fb.user_id is null or @viewer_id = fb.user_id);
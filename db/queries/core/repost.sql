@@ -0,0 +1,20 @@
+-- name: InsertRepost :one
+-- A repost that already exists is returned instead of conflicting with it, since a user can only repost a post once
+insert into reposts (id, actor_id, post_id, caption) values (@id, @actor_id, @post_id, sqlc.narg('caption'))
+on conflict (actor_id, post_id) where not deleted and caption is null do update set last_updated = reposts.last_updated
+returning *;
+
+-- name: GetRepostByID :one
+select * from reposts where id = @id and not deleted;
+
+-- name: GetRepostByActorIDAndPostID :batchone
+select * from reposts where actor_id = @actor_id and post_id = @post_id and caption is null and not deleted;
+
+-- name: DeleteRepostByID :exec
+update reposts set deleted = true, last_updated = now() where id = @id;
+
+-- name: GetRepostByIdBatch :batchone
+select * from reposts where id = @id and not deleted;
+
+-- name: CountRepostsByPostIDBatch :batchone
+select count(*) from reposts where post_id = @post_id and not deleted;
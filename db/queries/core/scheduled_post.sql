@@ -0,0 +1,35 @@
+-- name: InsertScheduledPost :one
+insert into scheduled_posts (id, actor_id, token_ids, caption, user_mint_url, mentions, publish_at) values (@id, @actor_id, @token_ids, @caption, @user_mint_url, @mentions, @publish_at) returning *;
+
+-- name: GetScheduledPostByID :one
+select * from scheduled_posts where id = @id and not deleted and post_id is null;
+
+-- name: GetScheduledPostForPublish :one
+-- Returns posts that are waiting to be published, and published posts whose events haven't been dispatched yet
+select * from scheduled_posts where id = @id and not deleted and (post_id is null or events_dispatched_at is null) for update;
+
+-- name: GetScheduledPostsByActorID :many
+select * from scheduled_posts where actor_id = @actor_id and not deleted and post_id is null order by publish_at, id;
+
+-- name: UpdateScheduledPost :one
+update scheduled_posts
+set token_ids = @token_ids,
+    caption = @caption,
+    user_mint_url = @user_mint_url,
+    mentions = @mentions,
+    publish_at = @publish_at,
+    last_updated = now()
+where id = @id and not deleted and post_id is null
+returning *;
+
+-- name: CancelScheduledPost :exec
+update scheduled_posts set deleted = true, last_updated = now() where id = @id and not deleted and post_id is null;
+
+-- name: SetScheduledPostPublished :exec
+update scheduled_posts set post_id = @post_id, published_at = now(), last_updated = now() where id = @id;
+
+-- name: SetScheduledPostEventsDispatched :exec
+update scheduled_posts set events_dispatched_at = now(), last_updated = now() where id = @id;
+
+-- name: GetMentionsForScheduledPost :many
+select m.* from mentions m join scheduled_posts s on s.post_id = m.post_id where s.id = @id and not m.deleted;
@@ -104,6 +104,7 @@ with tokens_insert as (
     , last_synced
     , token_definition_id
     , contract_id
+    , acquired_at
   ) (
     select
       bulk_upsert.id
@@ -120,6 +121,7 @@ with tokens_insert as (
       , now()
       , bulk_upsert.token_definition_id
       , bulk_upsert.contract_id
+      , bulk_upsert.acquired_at
     from (
       select unnest(@token_dbid::varchar[]) as id
         , unnest(@token_version::int[]) as version
@@ -136,6 +138,8 @@ with tokens_insert as (
         , unnest(@token_chain::int[]) as chain
         , unnest(@token_definition_id::varchar[]) as token_definition_id
         , unnest(@token_contract_id::varchar[]) as contract_id
+        -- Tokens without an acquisition time are sent as the zero time
+        , nullif(unnest(@token_acquired_at::timestamptz[]), '0001-01-01 00:00:00+00') as acquired_at
     ) bulk_upsert
   )
   on conflict (owner_user_id, token_definition_id) where deleted = false
@@ -148,6 +152,7 @@ with tokens_insert as (
     , last_updated = excluded.last_updated
     , last_synced = greatest(excluded.last_synced,tokens.last_synced)
     , contract_id = excluded.contract_id
+    , acquired_at = least(tokens.acquired_at, excluded.acquired_at)
   returning *
 )
 select sqlc.embed(tokens), sqlc.embed(token_definitions), sqlc.embed(contracts)
@@ -176,3 +181,33 @@ where
   and t.deleted = false
   and td.deleted = false
   and t.last_synced < @timestamp;
+
+-- name: RemoveWalletFromTokensByIdentifiers :execrows
+update tokens t
+set owned_by_wallets = array_remove(t.owned_by_wallets, @wallet_id::varchar),
+    last_updated = now()
+from token_definitions td
+where t.owner_user_id = @owner_user_id
+  and t.token_definition_id = td.id
+  and td.chain = @chain
+  and (td.contract_address, td.token_id) in (select unnest(@contract_addresses::varchar[]), unnest(@token_ids::varchar[]))
+  and t.owned_by_wallets @> array[@wallet_id::varchar]
+  and t.deleted = false
+  and td.deleted = false;
+
+-- name: GetWalletSyncCheckpointsByUserID :many
+select * from wallet_sync_checkpoints where owner_user_id = @owner_user_id and chain = any(@chains::int[]) and not deleted;
+
+-- name: UpsertWalletSyncCheckpoint :exec
+insert into wallet_sync_checkpoints (id, wallet_id, owner_user_id, chain, block_number, provider_cursor, synced_at, full_synced_at)
+values (@id, @wallet_id, @owner_user_id, @chain, sqlc.narg('block_number'), sqlc.narg('provider_cursor'), @synced_at, case when @is_full_sync::bool then @synced_at else null end)
+on conflict (wallet_id, chain) where not deleted do update set
+  owner_user_id = excluded.owner_user_id,
+  block_number = excluded.block_number,
+  provider_cursor = excluded.provider_cursor,
+  synced_at = excluded.synced_at,
+  full_synced_at = coalesce(excluded.full_synced_at, wallet_sync_checkpoints.full_synced_at),
+  last_updated = now();
+
+-- name: DeleteWalletSyncCheckpointsByWalletIDs :exec
+update wallet_sync_checkpoints set deleted = true, last_updated = now() where wallet_id = any(@wallet_ids::varchar[]) and not deleted;
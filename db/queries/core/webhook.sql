@@ -0,0 +1,81 @@
+-- name: CreateWebhook :one
+insert into webhooks (id, owner_id, community_id, url, secret, event_types)
+values (@id, @owner_id, sqlc.narg('community_id')::varchar, @url, @secret, @event_types)
+returning *;
+
+-- name: GetWebhookByID :one
+select * from webhooks where id = $1 and not deleted;
+
+-- name: GetWebhooksByOwnerID :many
+select * from webhooks where owner_id = $1 and not deleted order by created_at desc, id desc;
+
+-- name: CountWebhooksByOwnerID :one
+select count(*) from webhooks where owner_id = $1 and not deleted;
+
+-- name: UpdateWebhook :one
+update webhooks
+set url = coalesce(sqlc.narg('url')::varchar, url),
+    event_types = coalesce(sqlc.narg('event_types')::varchar[], event_types),
+    enabled = coalesce(sqlc.narg('enabled')::bool, enabled),
+    last_updated = now()
+where id = @id and not deleted
+returning *;
+
+-- name: DeleteWebhook :exec
+update webhooks set deleted = true, last_updated = now() where id = $1;
+
+-- name: GetEnabledWebhooksForUserEvent :many
+select * from webhooks
+where owner_id = @owner_id and community_id is null and @event_type::varchar = any(event_types) and enabled and not deleted;
+
+-- name: GetEnabledWebhooksForCommunityEvent :many
+select * from webhooks
+where community_id = @community_id and @event_type::varchar = any(event_types) and enabled and not deleted;
+
+-- name: IsCommunityCreator :one
+-- Matches creators the same way as GetCreatorsByCommunityID
+select exists(
+    select 1 from community_creators cc
+        left join wallets w on
+            w.deleted = false and
+            w.l1_chain = cc.creator_address_l1_chain and
+            cc.creator_address = w.address
+        join users u on
+            u.deleted = false and
+            u.universal = false and
+            (
+                (cc.creator_user_id is not null and cc.creator_user_id = u.id)
+                or
+                (cc.creator_user_id is null and w.address is not null and array[w.id] <@ u.wallets)
+            )
+    where cc.community_id = @community_id
+        and cc.deleted = false
+        and u.id = @user_id
+);
+
+-- name: CreateWebhookDelivery :one
+insert into webhook_deliveries (id, webhook_id, event_type, payload) values (@id, @webhook_id, @event_type, @payload) returning *;
+
+-- name: GetWebhookDeliveryByID :one
+select * from webhook_deliveries where id = $1;
+
+-- name: UpdateWebhookDeliveryAttempt :one
+update webhook_deliveries
+set status = @status,
+    attempts = attempts + 1,
+    response_status = sqlc.narg('response_status')::int,
+    error = sqlc.narg('error')::varchar,
+    last_updated = now()
+where id = @id
+returning *;
+
+-- name: PaginateWebhookDeliveriesByWebhookID :many
+select * from webhook_deliveries where webhook_id = @webhook_id
+    and (created_at, id) < (@cur_before_time, @cur_before_id::dbid)
+    and (created_at, id) > (@cur_after_time, @cur_after_id::dbid)
+    order by case when @paging_forward::bool then (created_at, id) end asc,
+             case when not @paging_forward::bool then (created_at, id) end desc
+    limit sqlc.arg('limit');
+
+-- name: CountWebhookDeliveriesByWebhookID :one
+select count(*) from webhook_deliveries where webhook_id = $1;
@@ -22,6 +22,7 @@ const (
 	ChainPoap     Chain = "POAP"
 	ChainZora     Chain = "Zora"
 	ChainBase     Chain = "Base"
+	ChainSolana   Chain = "Solana"
 )
 
 // __communityDigestEntityQueryInput is used internally by genqlient
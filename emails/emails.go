@@ -66,7 +66,7 @@ func coreInitServer() *gin.Engine {
 	lock := redis.NewLockClient(redis.NewCache(redis.NotificationLockCache))
 	psub := gcp.NewClient(context.Background())
 	t := task.NewClient(context.Background())
-	b := store.NewBucketStorer(rpc.NewStorageClient(context.Background()), env.GetString("CONFIGURATION_BUCKET"))
+	b := store.NewBucketStorer(rpc.NewObjectStore(context.Background()), env.GetString("CONFIGURATION_BUCKET"))
 	gql := graphql.NewClient(env.GetString("GALLERY_API"), http.DefaultClient)
 
 	return handlersInitServer(router, loaders, queries, sendgridClient, r, &b, psub, t, lock, &gql)
@@ -105,6 +105,7 @@ func setDefaults() {
 	viper.SetDefault("TASK_QUEUE_HOST", "localhost:8123")
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "[::1]:8085")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_REALTIME", "dev-realtime")
 	viper.SetDefault("GCLOUD_PUSH_NOTIFICATIONS_QUEUE", "projects/gallery-local/locations/here/queues/push-notifications")
 	viper.SetDefault("PUSH_NOTIFICATIONS_SECRET", "push-notifications-secret")
 	viper.SetDefault("PUSH_NOTIFICATIONS_URL", "http://localhost:8000")
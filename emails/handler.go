@@ -18,6 +18,7 @@ import (
 	"github.com/mikeydub/go-gallery/middleware"
 	"github.com/mikeydub/go-gallery/service/limiters"
 	"github.com/mikeydub/go-gallery/service/notifications"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/redis"
 	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/task"
@@ -63,7 +64,7 @@ func handlersInitServer(router *gin.Engine, loaders *dataloader.Loaders, q *core
 
 func useEventHandler(q *coredb.Queries, p *pubsub.Client, t *task.Client, l *redislock.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		event.AddTo(c, false, notifications.New(q, p, t, l, false), q, t, nil)
+		event.AddTo(c, false, notifications.New(q, p, t, l, false), realtime.New(p, false), q, t, nil)
 		c.Next()
 	}
 }
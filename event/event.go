@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/gin-gonic/gin"
@@ -18,9 +19,11 @@ import (
 	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/service/tracing"
+	"github.com/mikeydub/go-gallery/service/webhooks"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/mikeydub/go-gallery/validate"
 )
@@ -36,7 +39,7 @@ const (
 )
 
 // Register specific event handlers
-func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications.NotificationHandlers, queries *db.Queries, taskClient *task.Client, neynarAPI *farcaster.NeynarAPI) {
+func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications.NotificationHandlers, rt *realtime.Hub, queries *db.Queries, taskClient *task.Client, neynarAPI *farcaster.NeynarAPI) {
 	dataloaders := dataloader.NewLoaders(context.Background(), queries, disableDataloaderCaching, tracing.DataloaderPreFetchHook, tracing.DataloaderPostFetchHook)
 	sender := newEventSender(queries)
 
@@ -68,6 +71,8 @@ func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications
 	sender.addDelayedHandler(notifications, persist.ActionViewedGallery, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionCommentedOnFeedEvent, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionCommentedOnPost, notificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionRepostedPost, notificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionQuotedPost, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionReplyToComment, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionMentionUser, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionMentionCommunity, notificationHandler)
@@ -78,8 +83,26 @@ func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications
 	sender.addDelayedHandler(notifications, persist.ActionAnnouncement, &announcementNotificationHandler{notif})
 	sender.addDelayedHandler(notifications, persist.ActionUserCreated, userCreatedNotificationHandler{notif, queries, dataloaders, neynarAPI})
 
+	live := newEventDispatcher()
+	realtimeHandler := realtimeHandler{dataloaders: dataloaders, hub: rt}
+	sender.addDelayedHandler(live, persist.ActionCommentedOnFeedEvent, realtimeHandler)
+	sender.addDelayedHandler(live, persist.ActionCommentedOnPost, realtimeHandler)
+	sender.addDelayedHandler(live, persist.ActionReplyToComment, realtimeHandler)
+	sender.addDelayedHandler(live, persist.ActionAdmiredFeedEvent, realtimeHandler)
+	sender.addDelayedHandler(live, persist.ActionAdmiredPost, realtimeHandler)
+	sender.addDelayedHandler(live, persist.ActionUserPosted, realtimeHandler)
+
+	hooks := newEventDispatcher()
+	webhookHandler := webhookHandler{dataloaders: dataloaders, queries: queries, tc: taskClient}
+	sender.addDelayedHandler(hooks, persist.ActionUserPosted, webhookHandler)
+	sender.addDelayedHandler(hooks, persist.ActionUserFollowedUsers, webhookHandler)
+	sender.addDelayedHandler(hooks, persist.ActionTokensAddedToCollection, webhookHandler)
+	sender.addDelayedHandler(hooks, persist.ActionNewTokensReceived, webhookHandler)
+
 	sender.feed = feed
 	sender.notifications = notifications
+	sender.realtime = live
+	sender.webhooks = hooks
 	ctx.Set(eventSenderContextKey, &sender)
 }
 
@@ -158,10 +181,28 @@ func dispatchDelayed(ctx context.Context, event db.Event) error {
 		return err
 	}
 
-	eg, ctx := errgroup.WithContext(ctx)
-	eg.Go(func() error { return sender.feed.dispatchDelayed(ctx, *e) })
-	eg.Go(func() error { return sender.notifications.dispatchDelayed(ctx, *e) })
-	return eg.Wait()
+	// Realtime updates and webhooks are best effort, so they run outside of the errgroup and their errors are only
+	// reported. Otherwise a failing webhook could cancel feed or notification delivery.
+	var wg sync.WaitGroup
+	for _, d := range []*eventDispatcher{sender.realtime, sender.webhooks} {
+		d := d
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.dispatchDelayed(ctx, *e); err != nil {
+				logger.For(ctx).Error(err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}()
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error { return sender.feed.dispatchDelayed(egCtx, *e) })
+	eg.Go(func() error { return sender.notifications.dispatchDelayed(egCtx, *e) })
+	err = eg.Wait()
+
+	wg.Wait()
+	return err
 }
 
 // dispatchImmediate flushes the event immediately to its registered handlers.
@@ -198,6 +239,14 @@ func dispatchImmediate(ctx context.Context, events []db.Event) (*db.FeedEvent, e
 			sentryutil.ReportError(ctx, err)
 		}
 
+		// Webhooks are sent the same way for events with and without captions
+		for _, e := range persistedEvents {
+			if err := sender.webhooks.dispatchDelayed(ctx, e); err != nil {
+				logger.For(ctx).Error(err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}
+
 	}()
 
 	feedEvent, err := sender.feed.dispatchImmediate(ctx, persistedEvents)
@@ -256,6 +305,8 @@ type registedActions map[persist.Action]struct{}
 type eventSender struct {
 	feed          *eventDispatcher
 	notifications *eventDispatcher
+	realtime      *eventDispatcher
+	webhooks      *eventDispatcher
 	registry      map[sendType]registedActions
 	queries       *db.Queries
 	eventRepo     postgres.EventRepository
@@ -536,6 +587,14 @@ func (h notificationHandler) findOwnerForNotificationFromEvent(ctx context.Conte
 			}
 			return comment.ActorID, nil
 		}
+	case persist.ResourceTypePost:
+		if event.Action == persist.ActionRepostedPost || event.Action == persist.ActionQuotedPost {
+			post, err := h.dataloaders.GetPostByIdBatch.Load(event.PostID)
+			if err != nil {
+				return "", err
+			}
+			return post.ActorID, nil
+		}
 	case persist.ResourceTypeUser:
 		return event.SubjectID, nil
 	case persist.ResourceTypeToken:
@@ -582,6 +641,8 @@ func (h notificationHandler) createNotificationDataForEvent(event db.Event) (dat
 		data.NewTokenQuantity = event.Data.NewTokenQuantity
 	case persist.ActionReplyToComment:
 		data.OriginalCommentID = event.SubjectID
+	case persist.ActionRepostedPost, persist.ActionQuotedPost:
+		data.RepostID = event.SubjectID
 	case persist.ActionTopActivityBadgeReceived:
 		data.ActivityBadgeThreshold = event.Data.ActivityBadgeThreshold
 		data.NewTopActiveUser = event.Data.NewTopActiveUser
@@ -605,6 +666,122 @@ func (h announcementNotificationHandler) handleDelayed(ctx context.Context, e db
 	})
 }
 
+// realtimeHandler publishes events to subscribers of the resource they happened on.
+type realtimeHandler struct {
+	dataloaders *dataloader.Loaders
+	hub         *realtime.Hub
+}
+
+func (h realtimeHandler) handleDelayed(ctx context.Context, e db.Event) error {
+	msg := realtime.Message{
+		ActorID:     persist.NullStrToDBID(e.ActorID),
+		PostID:      e.PostID,
+		FeedEventID: e.FeedEventID,
+		CommentID:   e.CommentID,
+		AdmireID:    e.AdmireID,
+	}
+
+	switch e.Action {
+	case persist.ActionUserPosted:
+		msg.Kind = realtime.KindPostCreated
+		msg.Channel = realtime.UserPostsChannel(e.UserID)
+		return h.hub.Publish(ctx, msg)
+	case persist.ActionCommentedOnFeedEvent, persist.ActionCommentedOnPost:
+		// Replies are published by their ActionReplyToComment event, which is always dispatched
+		comment, err := h.dataloaders.GetCommentByCommentIDBatch.Load(e.CommentID)
+		if err != nil {
+			return err
+		}
+		if comment.ReplyTo != "" {
+			return nil
+		}
+		msg.Kind = realtime.KindCommentAdded
+	case persist.ActionReplyToComment:
+		msg.Kind = realtime.KindCommentAdded
+	case persist.ActionAdmiredFeedEvent, persist.ActionAdmiredPost:
+		msg.Kind = realtime.KindAdmireAdded
+	default:
+		return nil
+	}
+
+	if e.PostID != "" {
+		msg.Channel = realtime.PostChannel(e.PostID)
+	} else if e.FeedEventID != "" {
+		msg.Channel = realtime.FeedEventChannel(e.FeedEventID)
+	} else {
+		return nil
+	}
+
+	return h.hub.Publish(ctx, msg)
+}
+
+// webhookHandler sends events to the webhooks registered for them
+type webhookHandler struct {
+	dataloaders *dataloader.Loaders
+	queries     *db.Queries
+	tc          *task.Client
+}
+
+func (h webhookHandler) handleDelayed(ctx context.Context, e db.Event) error {
+	switch e.Action {
+	case persist.ActionUserPosted:
+		return h.dispatchForUser(ctx, e.UserID, webhooks.EventPostCreated, webhooks.PostCreatedData{PostID: e.PostID, UserID: e.UserID})
+	case persist.ActionUserFollowedUsers:
+		return h.dispatchForUser(ctx, e.SubjectID, webhooks.EventUserFollowed, webhooks.UserFollowedData{UserID: e.SubjectID, FollowerID: e.UserID})
+	case persist.ActionTokensAddedToCollection:
+		actorID := persist.NullStrToDBID(e.ActorID)
+		return h.dispatchForUser(ctx, actorID, webhooks.EventTokensAddedToGallery, webhooks.TokensAddedToGalleryData{
+			UserID:       actorID,
+			GalleryID:    e.GalleryID,
+			CollectionID: e.CollectionID,
+			TokenIDs:     e.Data.CollectionTokenIDs,
+		})
+	case persist.ActionNewTokensReceived:
+		return h.dispatchNewTokenHolder(ctx, e)
+	default:
+		return nil
+	}
+}
+
+func (h webhookHandler) dispatchForUser(ctx context.Context, userID persist.DBID, eventType string, data any) error {
+	hooks, err := h.queries.GetEnabledWebhooksForUserEvent(ctx, db.GetEnabledWebhooksForUserEventParams{
+		OwnerID:   userID,
+		EventType: eventType,
+	})
+	if err != nil {
+		return err
+	}
+	return webhooks.Dispatch(ctx, h.queries, h.tc, hooks, eventType, data)
+}
+
+func (h webhookHandler) dispatchNewTokenHolder(ctx context.Context, e db.Event) error {
+	token, err := h.dataloaders.GetTokenByIdIgnoreDisplayableBatch.Load(e.TokenID)
+	if err != nil {
+		return err
+	}
+
+	communities, err := h.dataloaders.GetCommunitiesByTokenDefinitionID.Load(token.TokenDefinition.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, community := range communities {
+		hooks, err := h.queries.GetEnabledWebhooksForCommunityEvent(ctx, db.GetEnabledWebhooksForCommunityEventParams{
+			CommunityID: community.ID,
+			EventType:   webhooks.EventNewTokenHolder,
+		})
+		if err != nil {
+			return err
+		}
+		data := webhooks.NewTokenHolderData{CommunityID: community.ID, TokenID: e.TokenID, HolderID: e.UserID}
+		if err := webhooks.Dispatch(ctx, h.queries, h.tc, hooks, webhooks.EventNewTokenHolder, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // slackHandler posts events to Slack
 type slackHandler struct{ tc *task.Client }
 
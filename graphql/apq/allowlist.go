@@ -0,0 +1,144 @@
+package apq
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/mikeydub/go-gallery/service/auth/basicauth"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/metric"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	ModeOpen   = "open"
+	ModeLocked = "locked"
+
+	errQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+	metricUnknownHash  = "graphql_apq_unknown_hash"
+
+	// allowlistRefreshInterval is how often the allowed hashes are reloaded from the cache
+	allowlistRefreshInterval = 30 * time.Second
+)
+
+// Allowlist is a gqlgen extension that only allows queries from an uploaded frontend build manifest when its mode
+// is locked. Internal tools that authenticate with a service token can still run any query. In either mode,
+// queries that aren't in a manifest are reported.
+type Allowlist struct {
+	Cache *APQCache
+	Mode  string
+
+	metrics     metric.MetricReporter
+	mu          sync.RWMutex
+	allowed     map[string]bool
+	refreshedAt time.Time
+}
+
+var _ interface {
+	gqlgen.HandlerExtension
+	gqlgen.OperationParameterMutator
+} = &Allowlist{}
+
+func (a *Allowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (a *Allowlist) Validate(schema gqlgen.ExecutableSchema) error {
+	a.metrics = metric.NewLogMetricReporter()
+	return nil
+}
+
+func (a *Allowlist) MutateOperationParameters(ctx context.Context, rawParams *gqlgen.RawParams) *gqlerror.Error {
+	if rawParams.Query == "" {
+		return nil
+	}
+
+	hash := computeQueryHash(rawParams.Query)
+	if a.isAllowed(ctx, hash) {
+		return nil
+	}
+
+	a.metrics.Record(ctx, metric.Measure{Name: metricUnknownHash, Value: 1},
+		metric.LogOptions.WithTags(map[string]string{
+			"hash":          hash,
+			"operationName": rawParams.OperationName,
+			"mode":          a.Mode,
+		}),
+	)
+
+	if a.Mode != ModeLocked || canBypass(ctx) {
+		return nil
+	}
+
+	err := gqlerror.Errorf("query is not allowed")
+	errcode.Set(err, errQueryNotAllowed)
+	return err
+}
+
+func (a *Allowlist) isAllowed(ctx context.Context, hash string) bool {
+	a.mu.RLock()
+	allowed, stale := a.allowed, time.Since(a.refreshedAt) > allowlistRefreshInterval
+	a.mu.RUnlock()
+
+	if stale {
+		allowed = a.refresh(ctx)
+	}
+
+	return allowed[hash]
+}
+
+func (a *Allowlist) refresh(ctx context.Context) map[string]bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Another request may have refreshed while this one was waiting
+	if time.Since(a.refreshedAt) <= allowlistRefreshInterval {
+		return a.allowed
+	}
+
+	allowed, err := a.Cache.AllowedHashes(ctx)
+	if err != nil {
+		// Keep using the last known allowlist, and try again after the next interval
+		logger.For(ctx).Errorf("failed to refresh persisted query allowlist: %s", err)
+	} else {
+		a.allowed = allowed
+	}
+
+	a.refreshedAt = time.Now()
+	return a.allowed
+}
+
+// bypassTokenTypes are the service tokens that can run queries that aren't in a manifest. Logged in users can't
+// bypass the allowlist, since anyone can sign up.
+var bypassTokenTypes = []basicauth.AuthTokenType{
+	basicauth.AuthTokenTypeRetool,
+	basicauth.AuthTokenTypeMonitoring,
+	// The build pipeline has to be able to upload the manifest for a new build before its queries are allowed
+	basicauth.AuthTokenTypeFrontendBuild,
+}
+
+// canBypass returns true if the request is from an internal tool
+func canBypass(ctx context.Context) bool {
+	gc := util.GetGinContext(ctx)
+	if gc == nil {
+		return false
+	}
+
+	if _, _, ok := gc.Request.BasicAuth(); !ok {
+		return false
+	}
+
+	return basicauth.AuthorizeHeaderForAllowedTypes(ctx, bypassTokenTypes)
+}
+
+func computeQueryHash(query string) string {
+	b := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,84 @@
+package apq
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/auth/basicauth"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	allowedQuery = "query AllowedQuery { viewer { __typename } }"
+	unknownQuery = "query UnknownQuery { viewer { __typename } }"
+)
+
+func TestAllowlist(t *testing.T) {
+	viper.Set("BASIC_AUTH_TOKEN_RETOOL", "retool-token")
+	viper.Set("BASIC_AUTH_TOKEN_MONITORING", "monitoring-token")
+	viper.Set("FRONTEND_APQ_UPLOAD_AUTH_TOKEN", "frontend-build-token")
+	t.Cleanup(func() {
+		viper.Set("BASIC_AUTH_TOKEN_RETOOL", "")
+		viper.Set("BASIC_AUTH_TOKEN_MONITORING", "")
+		viper.Set("FRONTEND_APQ_UPLOAD_AUTH_TOKEN", "")
+	})
+
+	tests := []struct {
+		name          string
+		mode          string
+		query         string
+		authorization string
+		expectAllowed bool
+	}{
+		{name: "open mode allows unknown queries", mode: ModeOpen, query: unknownQuery, expectAllowed: true},
+		{name: "locked mode allows manifest queries", mode: ModeLocked, query: allowedQuery, expectAllowed: true},
+		{name: "locked mode rejects unknown queries", mode: ModeLocked, query: unknownQuery, expectAllowed: false},
+		{name: "retool token bypasses the lock", mode: ModeLocked, query: unknownQuery, authorization: basicauth.MakeHeader(nil, "retool-token"), expectAllowed: true},
+		{name: "monitoring token bypasses the lock", mode: ModeLocked, query: unknownQuery, authorization: basicauth.MakeHeader(nil, "monitoring-token"), expectAllowed: true},
+		{name: "frontend build token bypasses the lock", mode: ModeLocked, query: unknownQuery, authorization: basicauth.MakeHeader(nil, "frontend-build-token"), expectAllowed: true},
+		{name: "wrong token doesn't bypass the lock", mode: ModeLocked, query: unknownQuery, authorization: basicauth.MakeHeader(nil, "wrong-token"), expectAllowed: false},
+		{name: "empty token doesn't bypass the lock", mode: ModeLocked, query: unknownQuery, authorization: basicauth.MakeHeader(nil, ""), expectAllowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Allowlist{
+				Mode:        tt.mode,
+				allowed:     map[string]bool{computeQueryHash(allowedQuery): true},
+				refreshedAt: time.Now(),
+			}
+			a.Validate(nil)
+
+			err := a.MutateOperationParameters(requestContext(tt.authorization), &gqlgen.RawParams{Query: tt.query})
+			if tt.expectAllowed {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.Equal(t, errQueryNotAllowed, err.Extensions["code"])
+			}
+		})
+	}
+}
+
+func TestUploadManifestRejectsMismatchedHashes(t *testing.T) {
+	c := &APQCache{}
+	manifest := `{"` + computeQueryHash(unknownQuery) + `": "` + allowedQuery + `"}`
+	err := c.UploadManifest(context.Background(), "v1", manifest)
+	assert.ErrorIs(t, err, ErrQueryHashMismatch)
+}
+
+func requestContext(authorization string) context.Context {
+	gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+	gc.Request = httptest.NewRequest("POST", "/glry/graphql/query", nil)
+	if authorization != "" {
+		gc.Request.Header.Set("Authorization", authorization)
+	}
+	return context.WithValue(context.Background(), util.GinContextKey, gc)
+}
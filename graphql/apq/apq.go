@@ -3,10 +3,32 @@ package apq
 import (
 	"context"
 	"encoding/json"
-	"github.com/mikeydub/go-gallery/service/redis"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
+
+	"github.com/mikeydub/go-gallery/service/redis"
+)
+
+const (
+	manifestIndexKey  = "manifests"
+	manifestKeyPrefix = "manifest:"
+	// manifestTTL is how long queries from a manifest are kept. It's refreshed whenever a manifest is uploaded.
+	manifestTTL = time.Hour * 24 * 90
+	// activeManifests is the number of most recently uploaded manifests whose queries are allowed. Older
+	// builds are kept active for a while so that clients that haven't reloaded can keep working.
+	activeManifests = 10
 )
 
+// manifestEntry is an uploaded manifest in the manifest index
+type manifestEntry struct {
+	Version    string    `json:"version"`
+	UploadedAt time.Time `json:"uploadedAt"`
+}
+
+var ErrQueryHashMismatch = errors.New("persisted query hash doesn't match the sha256 hash of its query")
+
 type APQCache struct {
 	Cache *redis.Cache
 }
@@ -48,3 +70,119 @@ func (c *APQCache) UploadPersistedQueries(ctx context.Context, persistedQueriesS
 
 	return nil
 }
+
+// UploadManifest saves the persisted queries of a frontend build and registers them under version, so that
+// they're allowed when the allowlist is locked
+func (c *APQCache) UploadManifest(ctx context.Context, version string, persistedQueriesString string) error {
+	if version == "" {
+		return errors.New("manifest version is required")
+	}
+
+	persistedQueries := map[string]string{}
+
+	err := json.Unmarshal([]byte(persistedQueriesString), &persistedQueries)
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]string, 0, len(persistedQueries))
+	queries := make(map[string]any, len(persistedQueries))
+	for hash, queryText := range persistedQueries {
+		// Queries are allowed by the hash of their text, so a manifest can't be trusted to hash them itself
+		if computeQueryHash(queryText) != hash {
+			return fmt.Errorf("%w: %s", ErrQueryHashMismatch, hash)
+		}
+		hashes = append(hashes, hash)
+		queries[hash] = queryText
+	}
+
+	if err := c.Cache.MSetWithTTL(ctx, queries, manifestTTL); err != nil {
+		return err
+	}
+
+	manifest, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Cache.Set(ctx, manifestKeyPrefix+version, manifest, manifestTTL); err != nil {
+		return err
+	}
+
+	entries, err := c.manifestIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Re-uploading a version moves it to the front
+	updated := []manifestEntry{{Version: version, UploadedAt: time.Now()}}
+	for _, e := range entries {
+		if e.Version != version {
+			updated = append(updated, e)
+		}
+	}
+
+	index, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+
+	return c.Cache.Set(ctx, manifestIndexKey, index, 0)
+}
+
+// AllowedHashes returns the hashes of the queries in the active manifests
+func (c *APQCache) AllowedHashes(ctx context.Context) (map[string]bool, error) {
+	entries, err := c.manifestIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) > activeManifests {
+		entries = entries[:activeManifests]
+	}
+
+	allowed := make(map[string]bool)
+
+	for _, e := range entries {
+		manifest, err := c.Cache.Get(ctx, manifestKeyPrefix+e.Version)
+		if err != nil {
+			if _, ok := err.(redis.ErrKeyNotFound); ok {
+				continue
+			}
+			return nil, err
+		}
+
+		var hashes []string
+		if err := json.Unmarshal(manifest, &hashes); err != nil {
+			return nil, err
+		}
+
+		for _, h := range hashes {
+			allowed[h] = true
+		}
+	}
+
+	return allowed, nil
+}
+
+// manifestIndex returns the uploaded manifests, most recent first
+func (c *APQCache) manifestIndex(ctx context.Context) ([]manifestEntry, error) {
+	index, err := c.Cache.Get(ctx, manifestIndexKey)
+	if err != nil {
+		if _, ok := err.(redis.ErrKeyNotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []manifestEntry
+	if err := json.Unmarshal(index, &entries); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].UploadedAt.After(entries[j].UploadedAt)
+	})
+
+	return entries, nil
+}
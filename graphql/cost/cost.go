@@ -0,0 +1,181 @@
+package cost
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Config determines how much an operation costs
+type Config struct {
+	// MaxCost is the most that a single operation is allowed to cost
+	MaxCost int
+	// DefaultPageSize is the number of items assumed for a connection that isn't given a page size
+	DefaultPageSize int
+	// DefaultListSize is the number of items assumed for a list that doesn't take a page size
+	DefaultListSize int
+	// FieldCosts are added to the cost of fields that are expensive to resolve, keyed by "Type.field"
+	FieldCosts map[string]int
+}
+
+// defaultFieldCosts are fields that are expensive to resolve regardless of what's selected from them
+var defaultFieldCosts = map[string]int{
+	"Query.globalFeed":        10,
+	"Query.trendingFeed":      20,
+	"Query.curatedFeed":       20,
+	"Query.trendingUsers":     10,
+	"Query.searchUsers":       10,
+	"Query.searchGalleries":   10,
+	"Query.searchCommunities": 10,
+	"Viewer.feed":             20,
+	"GalleryUser.feed":        10,
+}
+
+// DefaultConfig returns a config with the default field costs and list sizes
+func DefaultConfig(maxCost int) Config {
+	return Config{
+		MaxCost:         maxCost,
+		DefaultPageSize: 20,
+		DefaultListSize: 10,
+		FieldCosts:      defaultFieldCosts,
+	}
+}
+
+// pageSizeArgs are arguments that limit how many items a field returns
+var pageSizeArgs = []string{"first", "last", "limit"}
+
+// Calculate returns the cost of an operation. Each object that's expected to be returned costs one plus the
+// cost of what's selected from it, and scalars cost one if they have their own resolver.
+func Calculate(schema *ast.Schema, op *ast.OperationDefinition, vars map[string]interface{}, config Config) int {
+	w := walker{schema: schema, vars: vars, config: config}
+	return w.selectionSetCost(op.SelectionSet)
+}
+
+type walker struct {
+	schema *ast.Schema
+	vars   map[string]interface{}
+	config Config
+}
+
+func (w walker) selectionSetCost(selectionSet ast.SelectionSet) int {
+	var cost int
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			cost = safeAdd(cost, w.fieldCost(s))
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				cost = safeAdd(cost, w.selectionSetCost(s.Definition.SelectionSet))
+			}
+		case *ast.InlineFragment:
+			cost = safeAdd(cost, w.selectionSetCost(s.SelectionSet))
+		}
+	}
+	return cost
+}
+
+func (w walker) fieldCost(f *ast.Field) int {
+	if f.Definition == nil || f.ObjectDefinition == nil {
+		return 0
+	}
+
+	// Introspection is served from memory
+	if strings.HasPrefix(f.Definition.Type.Name(), "__") {
+		return 0
+	}
+
+	cost := w.config.FieldCosts[f.ObjectDefinition.Name+"."+f.Name]
+
+	if def := w.schema.Types[f.Definition.Type.Name()]; def != nil {
+		switch def.Kind {
+		case ast.Object, ast.Interface, ast.Union:
+			// Each item costs one, plus whatever is selected from it
+			itemCost := safeAdd(1, w.selectionSetCost(f.SelectionSet))
+			return safeAdd(cost, safeMul(w.multiplier(f), itemCost))
+		}
+	}
+
+	// Scalars are free unless they have their own resolver
+	if d := f.Definition.Directives.ForName("goField"); d != nil {
+		if arg := d.Arguments.ForName("forceResolver"); arg != nil && arg.Value.Raw == "true" {
+			return safeAdd(cost, 1)
+		}
+	}
+
+	return cost
+}
+
+func (w walker) multiplier(f *ast.Field) int {
+	// Items in a connection were already counted by the field that returned it
+	if strings.HasSuffix(f.ObjectDefinition.Name, "Connection") {
+		return 1
+	}
+
+	args := f.ArgumentMap(w.vars)
+
+	size := 0
+	for _, name := range pageSizeArgs {
+		if n, ok := toInt(args[name]); ok && n > size {
+			size = n
+		}
+	}
+
+	if size > 0 {
+		return size
+	}
+
+	if strings.HasSuffix(f.Definition.Type.Name(), "Connection") {
+		return w.config.DefaultPageSize
+	}
+
+	if f.Definition.Type.Elem != nil {
+		return w.config.DefaultListSize
+	}
+
+	return 1
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// safeAdd adds a and b, saturating instead of overflowing so that a query can't wrap its cost around
+func safeAdd(a, b int) int {
+	if a < 0 {
+		a = 0
+	}
+	if b < 0 {
+		b = 0
+	}
+	if c := a + b; c >= a {
+		return c
+	}
+	return maxInt
+}
+
+// safeMul multiplies a and b, saturating instead of overflowing
+func safeMul(a, b int) int {
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	if a > maxInt/b {
+		return maxInt
+	}
+	return a * b
+}
@@ -0,0 +1,89 @@
+package cost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const testSchema = `
+directive @goField(forceResolver: Boolean, name: String) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+
+type Query {
+  user(id: ID!): User
+  search(query: String!): [User]
+}
+
+type User {
+  id: ID!
+  name: String
+  followerCount: Int @goField(forceResolver: true)
+  galleries: [Gallery]
+  tokens(first: Int, last: Int): TokensConnection
+}
+
+type Gallery {
+  id: ID!
+  name: String
+}
+
+type TokensConnection {
+  edges: [TokenEdge]
+}
+
+type TokenEdge {
+  node: Token
+}
+
+type Token {
+  id: ID!
+  owner: User
+}
+`
+
+func calculate(t *testing.T, query string, vars map[string]interface{}, config Config) int {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+	doc, errs := gqlparser.LoadQuery(schema, query)
+	require.Empty(t, errs)
+	return Calculate(schema, doc.Operations[0], vars, config)
+}
+
+func TestCalculate(t *testing.T) {
+	config := Config{MaxCost: 1000, DefaultPageSize: 20, DefaultListSize: 10, FieldCosts: map[string]int{"Query.search": 5}}
+
+	t.Run("scalars without resolvers are free", func(t *testing.T) {
+		assert.Equal(t, 1, calculate(t, `{ user(id: "1") { id name } }`, nil, config))
+	})
+
+	t.Run("scalars with resolvers cost one", func(t *testing.T) {
+		assert.Equal(t, 2, calculate(t, `{ user(id: "1") { followerCount } }`, nil, config))
+	})
+
+	t.Run("lists multiply by the default list size", func(t *testing.T) {
+		assert.Equal(t, 11, calculate(t, `{ user(id: "1") { galleries { name } } }`, nil, config))
+	})
+
+	t.Run("connections multiply by the page size", func(t *testing.T) {
+		query := `query($n: Int) { user(id: "1") { tokens(first: $n) { edges { node { owner { id } } } } } }`
+		// user + n * (connection + edges + node + owner)
+		assert.Equal(t, 1+5*4, calculate(t, query, map[string]interface{}{"n": 5}, config))
+		assert.Equal(t, 1+20*4, calculate(t, query, nil, config))
+	})
+
+	t.Run("field costs are added", func(t *testing.T) {
+		assert.Equal(t, 5+10*(1+10), calculate(t, `{ search(query: "a") { galleries { id } } }`, nil, config))
+	})
+
+	t.Run("fragments are counted", func(t *testing.T) {
+		query := `{ user(id: "1") { ...F } } fragment F on User { followerCount }`
+		assert.Equal(t, 2, calculate(t, query, nil, config))
+	})
+}
+
+func TestSafeMul(t *testing.T) {
+	assert.Equal(t, maxInt, safeMul(maxInt/2, 3))
+	assert.Equal(t, 0, safeMul(-1, 3))
+}
@@ -0,0 +1,132 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/mikeydub/go-gallery/service/auth"
+	"github.com/mikeydub/go-gallery/service/limiters"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	extensionName     = "CostLimit"
+	responseExtension = "cost"
+	errCostLimit      = "COST_LIMIT_EXCEEDED"
+	errBudgetExceeded = "COST_BUDGET_EXCEEDED"
+)
+
+// Stats are the cost of an operation and are returned in the response's extensions
+type Stats struct {
+	Cost    int `json:"cost"`
+	MaxCost int `json:"maxCost"`
+}
+
+// Limit is a gqlgen extension that rejects operations that cost too much, and charges the cost of
+// each operation against a rolling budget for the user, or for the IP if the request isn't authenticated
+type Limit struct {
+	Config     Config
+	UserBudget *limiters.KeyRateLimiter
+	IPBudget   *limiters.KeyRateLimiter
+
+	schema *ast.Schema
+}
+
+var _ interface {
+	gqlgen.HandlerExtension
+	gqlgen.OperationContextMutator
+	gqlgen.ResponseInterceptor
+} = &Limit{}
+
+func (l *Limit) ExtensionName() string {
+	return extensionName
+}
+
+func (l *Limit) Validate(schema gqlgen.ExecutableSchema) error {
+	if l.Config.MaxCost <= 0 {
+		return fmt.Errorf("max cost must be greater than zero")
+	}
+	l.schema = schema.Schema()
+	return nil
+}
+
+func (l *Limit) MutateOperationContext(ctx context.Context, rc *gqlgen.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	cost := Calculate(l.schema, op, rc.Variables, l.Config)
+	rc.Stats.SetExtension(extensionName, &Stats{Cost: cost, MaxCost: l.Config.MaxCost})
+
+	if cost > l.Config.MaxCost {
+		err := gqlerror.Errorf("operation has a cost of %d, which exceeds the limit of %d", cost, l.Config.MaxCost)
+		errcode.Set(err, errCostLimit)
+		return err
+	}
+
+	budget, key := l.budgetFor(ctx)
+	if budget == nil || cost == 0 {
+		return nil
+	}
+
+	canContinue, tryAgainAfter, err := budget.TakeForKey(ctx, key, int64(cost))
+	if err != nil {
+		// Don't fail requests because the limiter is unavailable
+		logger.For(ctx).Warnf("failed to charge operation cost to budget: %s", err)
+		return nil
+	}
+
+	if !canContinue {
+		err := gqlerror.Errorf("cost budget exceeded, try again in %s", tryAgainAfter)
+		errcode.Set(err, errBudgetExceeded)
+		err.Extensions["retryAfter"] = int(tryAgainAfter.Seconds()) + 1
+		return err
+	}
+
+	return nil
+}
+
+func (l *Limit) InterceptResponse(ctx context.Context, next gqlgen.ResponseHandler) *gqlgen.Response {
+	resp := next(ctx)
+	if resp == nil {
+		return resp
+	}
+
+	if stats := GetStats(ctx); stats != nil {
+		if resp.Extensions == nil {
+			resp.Extensions = make(map[string]interface{})
+		}
+		resp.Extensions[responseExtension] = stats
+	}
+
+	return resp
+}
+
+func (l *Limit) budgetFor(ctx context.Context) (*limiters.KeyRateLimiter, string) {
+	gc := util.GetGinContext(ctx)
+	if gc == nil {
+		return nil, ""
+	}
+
+	if auth.GetUserAuthedFromCtx(gc) {
+		return l.UserBudget, auth.GetUserIDFromCtx(gc).String()
+	}
+
+	return l.IPBudget, gc.ClientIP()
+}
+
+// GetStats returns the cost of the current operation, or nil if it hasn't been calculated
+func GetStats(ctx context.Context) *Stats {
+	if !gqlgen.HasOperationContext(ctx) {
+		return nil
+	}
+	s, _ := gqlgen.GetOperationContext(ctx).Stats.GetExtension(extensionName).(*Stats)
+	return s
+}
@@ -24,6 +24,7 @@ type Loaders struct {
 	CountInteractionsByFeedEventIDBatch                  *CountInteractionsByFeedEventIDBatch
 	CountInteractionsByPostIDBatch                       *CountInteractionsByPostIDBatch
 	CountRepliesByCommentIDBatch                         *CountRepliesByCommentIDBatch
+	CountRepostsByPostIDBatch                            *CountRepostsByPostIDBatch
 	GetAdmireByActorIDAndCommentID                       *GetAdmireByActorIDAndCommentID
 	GetAdmireByActorIDAndFeedEventID                     *GetAdmireByActorIDAndFeedEventID
 	GetAdmireByActorIDAndPostID                          *GetAdmireByActorIDAndPostID
@@ -38,6 +39,7 @@ type Loaders struct {
 	GetCommunityByIDBatch                                *GetCommunityByIDBatch
 	GetCommunityByKey                                    *GetCommunityByKey
 	GetContractByChainAddressBatch                       *GetContractByChainAddressBatch
+	GetContractSpamScoreByContractIDBatch                *GetContractSpamScoreByContractIDBatch
 	GetContractsDisplayedByUserIDBatch                   *GetContractsDisplayedByUserIDBatch
 	GetCreatedContractsBatchPaginate                     *GetCreatedContractsBatchPaginate
 	GetCreatorsByCommunityID                             *GetCreatorsByCommunityID
@@ -60,6 +62,8 @@ type Loaders struct {
 	GetPostByIdBatch                                     *GetPostByIdBatch
 	GetPostsByIdsPaginateBatch                           *GetPostsByIdsPaginateBatch
 	GetProfileImageByIdBatch                             *GetProfileImageByIdBatch
+	GetRepostByActorIDAndPostID                          *GetRepostByActorIDAndPostID
+	GetRepostByIdBatch                                   *GetRepostByIdBatch
 	GetSharedCommunitiesBatchPaginate                    *GetSharedCommunitiesBatchPaginate
 	GetSharedFollowersBatchPaginate                      *GetSharedFollowersBatchPaginate
 	GetTokenByIdBatch                                    *GetTokenByIdBatch
@@ -68,6 +72,7 @@ type Loaders struct {
 	GetTokenByUserTokenIdentifiersIgnoreDisplayableBatch *GetTokenByUserTokenIdentifiersIgnoreDisplayableBatch
 	GetTokenDefinitionByIdBatch                          *GetTokenDefinitionByIdBatch
 	GetTokenDefinitionByTokenDbidBatch                   *GetTokenDefinitionByTokenDbidBatch
+	GetTokenDefinitionSpamScoreByIDBatch                 *GetTokenDefinitionSpamScoreByIDBatch
 	GetTokensByCollectionIdBatch                         *GetTokensByCollectionIdBatch
 	GetTokensByUserIdBatch                               *GetTokensByUserIdBatch
 	GetTokensByWalletIdsBatch                            *GetTokensByWalletIdsBatch
@@ -112,6 +117,7 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 	loaders.CountInteractionsByFeedEventIDBatch = newCountInteractionsByFeedEventIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadCountInteractionsByFeedEventIDBatch(q), preFetchHook, postFetchHook)
 	loaders.CountInteractionsByPostIDBatch = newCountInteractionsByPostIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadCountInteractionsByPostIDBatch(q), preFetchHook, postFetchHook)
 	loaders.CountRepliesByCommentIDBatch = newCountRepliesByCommentIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadCountRepliesByCommentIDBatch(q), preFetchHook, postFetchHook)
+	loaders.CountRepostsByPostIDBatch = newCountRepostsByPostIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadCountRepostsByPostIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetAdmireByActorIDAndCommentID = newGetAdmireByActorIDAndCommentID(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetAdmireByActorIDAndCommentID(q), preFetchHook, postFetchHook)
 	loaders.GetAdmireByActorIDAndFeedEventID = newGetAdmireByActorIDAndFeedEventID(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetAdmireByActorIDAndFeedEventID(q), preFetchHook, postFetchHook)
 	loaders.GetAdmireByActorIDAndPostID = newGetAdmireByActorIDAndPostID(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetAdmireByActorIDAndPostID(q), preFetchHook, postFetchHook)
@@ -126,6 +132,7 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 	loaders.GetCommunityByIDBatch = newGetCommunityByIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetCommunityByIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetCommunityByKey = newGetCommunityByKey(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetCommunityByKey(q), preFetchHook, postFetchHook)
 	loaders.GetContractByChainAddressBatch = newGetContractByChainAddressBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetContractByChainAddressBatch(q), preFetchHook, postFetchHook)
+	loaders.GetContractSpamScoreByContractIDBatch = newGetContractSpamScoreByContractIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetContractSpamScoreByContractIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetContractsDisplayedByUserIDBatch = newGetContractsDisplayedByUserIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetContractsDisplayedByUserIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetCreatedContractsBatchPaginate = newGetCreatedContractsBatchPaginate(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetCreatedContractsBatchPaginate(q), preFetchHook, postFetchHook)
 	loaders.GetCreatorsByCommunityID = newGetCreatorsByCommunityID(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetCreatorsByCommunityID(q), preFetchHook, postFetchHook)
@@ -148,6 +155,8 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 	loaders.GetPostByIdBatch = newGetPostByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetPostByIdBatch(q), preFetchHook, postFetchHook)
 	loaders.GetPostsByIdsPaginateBatch = newGetPostsByIdsPaginateBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetPostsByIdsPaginateBatch(q), preFetchHook, postFetchHook)
 	loaders.GetProfileImageByIdBatch = newGetProfileImageByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetProfileImageByIdBatch(q), preFetchHook, postFetchHook)
+	loaders.GetRepostByActorIDAndPostID = newGetRepostByActorIDAndPostID(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetRepostByActorIDAndPostID(q), preFetchHook, postFetchHook)
+	loaders.GetRepostByIdBatch = newGetRepostByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetRepostByIdBatch(q), preFetchHook, postFetchHook)
 	loaders.GetSharedCommunitiesBatchPaginate = newGetSharedCommunitiesBatchPaginate(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetSharedCommunitiesBatchPaginate(q), preFetchHook, postFetchHook)
 	loaders.GetSharedFollowersBatchPaginate = newGetSharedFollowersBatchPaginate(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetSharedFollowersBatchPaginate(q), preFetchHook, postFetchHook)
 	loaders.GetTokenByIdBatch = newGetTokenByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokenByIdBatch(q), preFetchHook, postFetchHook)
@@ -156,6 +165,7 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 	loaders.GetTokenByUserTokenIdentifiersIgnoreDisplayableBatch = newGetTokenByUserTokenIdentifiersIgnoreDisplayableBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokenByUserTokenIdentifiersIgnoreDisplayableBatch(q), preFetchHook, postFetchHook)
 	loaders.GetTokenDefinitionByIdBatch = newGetTokenDefinitionByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokenDefinitionByIdBatch(q), preFetchHook, postFetchHook)
 	loaders.GetTokenDefinitionByTokenDbidBatch = newGetTokenDefinitionByTokenDbidBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokenDefinitionByTokenDbidBatch(q), preFetchHook, postFetchHook)
+	loaders.GetTokenDefinitionSpamScoreByIDBatch = newGetTokenDefinitionSpamScoreByIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokenDefinitionSpamScoreByIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetTokensByCollectionIdBatch = newGetTokensByCollectionIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokensByCollectionIdBatch(q), preFetchHook, postFetchHook)
 	loaders.GetTokensByUserIdBatch = newGetTokensByUserIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokensByUserIdBatch(q), preFetchHook, postFetchHook)
 	loaders.GetTokensByWalletIdsBatch = newGetTokensByWalletIdsBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetTokensByWalletIdsBatch(q), preFetchHook, postFetchHook)
@@ -378,6 +388,9 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 			loaders.GetPostByIdBatch.Prime(loaders.GetPostByIdBatch.getKeyForResult(entry), entry)
 		}
 	})
+	loaders.GetRepostByActorIDAndPostID.RegisterResultSubscriber(func(result coredb.Repost) {
+		loaders.GetRepostByIdBatch.Prime(loaders.GetRepostByIdBatch.getKeyForResult(result), result)
+	})
 	loaders.GetFrameTokensByCommunityID.RegisterResultSubscriber(func(result []coredb.GetFrameTokensByCommunityIDRow) {
 		for _, entry := range result {
 			loaders.GetTokenDefinitionByIdBatch.Prime(loaders.GetTokenDefinitionByIdBatch.getKeyForResult(entry.TokenDefinition), entry.TokenDefinition)
@@ -683,8 +696,8 @@ func loadCountCommentsByFeedEventIDBatch(q *coredb.Queries) func(context.Context
 	}
 }
 
-func loadCountCommentsByPostIDBatch(q *coredb.Queries) func(context.Context, *CountCommentsByPostIDBatch, []persist.DBID) ([]int64, []error) {
-	return func(ctx context.Context, d *CountCommentsByPostIDBatch, params []persist.DBID) ([]int64, []error) {
+func loadCountCommentsByPostIDBatch(q *coredb.Queries) func(context.Context, *CountCommentsByPostIDBatch, []coredb.CountCommentsByPostIDBatchParams) ([]int64, []error) {
+	return func(ctx context.Context, d *CountCommentsByPostIDBatch, params []coredb.CountCommentsByPostIDBatchParams) ([]int64, []error) {
 		results := make([]int64, len(params))
 		errors := make([]error, len(params))
 
@@ -753,8 +766,8 @@ func loadCountInteractionsByPostIDBatch(q *coredb.Queries) func(context.Context,
 	}
 }
 
-func loadCountRepliesByCommentIDBatch(q *coredb.Queries) func(context.Context, *CountRepliesByCommentIDBatch, []persist.DBID) ([]int64, []error) {
-	return func(ctx context.Context, d *CountRepliesByCommentIDBatch, params []persist.DBID) ([]int64, []error) {
+func loadCountRepliesByCommentIDBatch(q *coredb.Queries) func(context.Context, *CountRepliesByCommentIDBatch, []coredb.CountRepliesByCommentIDBatchParams) ([]int64, []error) {
+	return func(ctx context.Context, d *CountRepliesByCommentIDBatch, params []coredb.CountRepliesByCommentIDBatchParams) ([]int64, []error) {
 		results := make([]int64, len(params))
 		errors := make([]error, len(params))
 
@@ -772,6 +785,25 @@ func loadCountRepliesByCommentIDBatch(q *coredb.Queries) func(context.Context, *
 	}
 }
 
+func loadCountRepostsByPostIDBatch(q *coredb.Queries) func(context.Context, *CountRepostsByPostIDBatch, []persist.DBID) ([]int64, []error) {
+	return func(ctx context.Context, d *CountRepostsByPostIDBatch, params []persist.DBID) ([]int64, []error) {
+		results := make([]int64, len(params))
+		errors := make([]error, len(params))
+
+		b := q.CountRepostsByPostIDBatch(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r int64, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
 func loadGetAdmireByActorIDAndCommentID(q *coredb.Queries) func(context.Context, *GetAdmireByActorIDAndCommentID, []coredb.GetAdmireByActorIDAndCommentIDParams) ([]coredb.Admire, []error) {
 	return func(ctx context.Context, d *GetAdmireByActorIDAndCommentID, params []coredb.GetAdmireByActorIDAndCommentIDParams) ([]coredb.Admire, []error) {
 		results := make([]coredb.Admire, len(params))
@@ -1026,6 +1058,25 @@ func loadGetContractByChainAddressBatch(q *coredb.Queries) func(context.Context,
 	}
 }
 
+func loadGetContractSpamScoreByContractIDBatch(q *coredb.Queries) func(context.Context, *GetContractSpamScoreByContractIDBatch, []persist.DBID) ([]coredb.ContractSpamScore, []error) {
+	return func(ctx context.Context, d *GetContractSpamScoreByContractIDBatch, params []persist.DBID) ([]coredb.ContractSpamScore, []error) {
+		results := make([]coredb.ContractSpamScore, len(params))
+		errors := make([]error, len(params))
+
+		b := q.GetContractSpamScoreByContractIDBatch(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r coredb.ContractSpamScore, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
 func loadGetContractsDisplayedByUserIDBatch(q *coredb.Queries) func(context.Context, *GetContractsDisplayedByUserIDBatch, []persist.DBID) ([][]coredb.Contract, []error) {
 	return func(ctx context.Context, d *GetContractsDisplayedByUserIDBatch, params []persist.DBID) ([][]coredb.Contract, []error) {
 		results := make([][]coredb.Contract, len(params))
@@ -1402,6 +1453,44 @@ func loadGetProfileImageByIdBatch(q *coredb.Queries) func(context.Context, *GetP
 	}
 }
 
+func loadGetRepostByActorIDAndPostID(q *coredb.Queries) func(context.Context, *GetRepostByActorIDAndPostID, []coredb.GetRepostByActorIDAndPostIDParams) ([]coredb.Repost, []error) {
+	return func(ctx context.Context, d *GetRepostByActorIDAndPostID, params []coredb.GetRepostByActorIDAndPostIDParams) ([]coredb.Repost, []error) {
+		results := make([]coredb.Repost, len(params))
+		errors := make([]error, len(params))
+
+		b := q.GetRepostByActorIDAndPostID(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r coredb.Repost, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
+func loadGetRepostByIdBatch(q *coredb.Queries) func(context.Context, *GetRepostByIdBatch, []persist.DBID) ([]coredb.Repost, []error) {
+	return func(ctx context.Context, d *GetRepostByIdBatch, params []persist.DBID) ([]coredb.Repost, []error) {
+		results := make([]coredb.Repost, len(params))
+		errors := make([]error, len(params))
+
+		b := q.GetRepostByIdBatch(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r coredb.Repost, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
 func loadGetSharedCommunitiesBatchPaginate(q *coredb.Queries) func(context.Context, *GetSharedCommunitiesBatchPaginate, []coredb.GetSharedCommunitiesBatchPaginateParams) ([][]coredb.GetSharedCommunitiesBatchPaginateRow, []error) {
 	return func(ctx context.Context, d *GetSharedCommunitiesBatchPaginate, params []coredb.GetSharedCommunitiesBatchPaginateParams) ([][]coredb.GetSharedCommunitiesBatchPaginateRow, []error) {
 		results := make([][]coredb.GetSharedCommunitiesBatchPaginateRow, len(params))
@@ -1548,6 +1637,25 @@ func loadGetTokenDefinitionByTokenDbidBatch(q *coredb.Queries) func(context.Cont
 	}
 }
 
+func loadGetTokenDefinitionSpamScoreByIDBatch(q *coredb.Queries) func(context.Context, *GetTokenDefinitionSpamScoreByIDBatch, []persist.DBID) ([]coredb.TokenDefinitionSpamScore, []error) {
+	return func(ctx context.Context, d *GetTokenDefinitionSpamScoreByIDBatch, params []persist.DBID) ([]coredb.TokenDefinitionSpamScore, []error) {
+		results := make([]coredb.TokenDefinitionSpamScore, len(params))
+		errors := make([]error, len(params))
+
+		b := q.GetTokenDefinitionSpamScoreByIDBatch(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r coredb.TokenDefinitionSpamScore, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
 func loadGetTokensByCollectionIdBatch(q *coredb.Queries) func(context.Context, *GetTokensByCollectionIdBatch, []coredb.GetTokensByCollectionIdBatchParams) ([][]coredb.Token, []error) {
 	return func(ctx context.Context, d *GetTokensByCollectionIdBatch, params []coredb.GetTokensByCollectionIdBatchParams) ([][]coredb.Token, []error) {
 		results := make([][]coredb.Token, len(params))
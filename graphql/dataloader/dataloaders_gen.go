@@ -215,7 +215,7 @@ func newCountCommentsByFeedEventIDBatch(
 
 // CountCommentsByPostIDBatch batches and caches requests
 type CountCommentsByPostIDBatch struct {
-	generator.Dataloader[persist.DBID, int64]
+	generator.Dataloader[coredb.CountCommentsByPostIDBatchParams, int64]
 }
 
 // newCountCommentsByPostIDBatch creates a new CountCommentsByPostIDBatch with the given settings, functions, and options
@@ -225,13 +225,13 @@ func newCountCommentsByPostIDBatch(
 	batchTimeout time.Duration,
 	cacheResults bool,
 	publishResults bool,
-	fetch func(context.Context, *CountCommentsByPostIDBatch, []persist.DBID) ([]int64, []error),
+	fetch func(context.Context, *CountCommentsByPostIDBatch, []coredb.CountCommentsByPostIDBatchParams) ([]int64, []error),
 	preFetchHook PreFetchHook,
 	postFetchHook PostFetchHook,
 ) *CountCommentsByPostIDBatch {
 	d := &CountCommentsByPostIDBatch{}
 
-	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]int64, []error) {
+	fetchWithHooks := func(ctx context.Context, keys []coredb.CountCommentsByPostIDBatchParams) ([]int64, []error) {
 		// Allow the preFetchHook to modify and return a new context
 		if preFetchHook != nil {
 			ctx = preFetchHook(ctx, "CountCommentsByPostIDBatch")
@@ -363,7 +363,7 @@ func newCountInteractionsByPostIDBatch(
 
 // CountRepliesByCommentIDBatch batches and caches requests
 type CountRepliesByCommentIDBatch struct {
-	generator.Dataloader[persist.DBID, int64]
+	generator.Dataloader[coredb.CountRepliesByCommentIDBatchParams, int64]
 }
 
 // newCountRepliesByCommentIDBatch creates a new CountRepliesByCommentIDBatch with the given settings, functions, and options
@@ -373,13 +373,13 @@ func newCountRepliesByCommentIDBatch(
 	batchTimeout time.Duration,
 	cacheResults bool,
 	publishResults bool,
-	fetch func(context.Context, *CountRepliesByCommentIDBatch, []persist.DBID) ([]int64, []error),
+	fetch func(context.Context, *CountRepliesByCommentIDBatch, []coredb.CountRepliesByCommentIDBatchParams) ([]int64, []error),
 	preFetchHook PreFetchHook,
 	postFetchHook PostFetchHook,
 ) *CountRepliesByCommentIDBatch {
 	d := &CountRepliesByCommentIDBatch{}
 
-	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]int64, []error) {
+	fetchWithHooks := func(ctx context.Context, keys []coredb.CountRepliesByCommentIDBatchParams) ([]int64, []error) {
 		// Allow the preFetchHook to modify and return a new context
 		if preFetchHook != nil {
 			ctx = preFetchHook(ctx, "CountRepliesByCommentIDBatch")
@@ -398,6 +398,43 @@ func newCountRepliesByCommentIDBatch(
 	return d
 }
 
+// CountRepostsByPostIDBatch batches and caches requests
+type CountRepostsByPostIDBatch struct {
+	generator.Dataloader[persist.DBID, int64]
+}
+
+// newCountRepostsByPostIDBatch creates a new CountRepostsByPostIDBatch with the given settings, functions, and options
+func newCountRepostsByPostIDBatch(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *CountRepostsByPostIDBatch, []persist.DBID) ([]int64, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *CountRepostsByPostIDBatch {
+	d := &CountRepostsByPostIDBatch{}
+
+	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]int64, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "CountRepostsByPostIDBatch")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "CountRepostsByPostIDBatch")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
 // GetAdmireByActorIDAndCommentID batches and caches requests
 type GetAdmireByActorIDAndCommentID struct {
 	generator.Dataloader[coredb.GetAdmireByActorIDAndCommentIDParams, coredb.Admire]
@@ -932,6 +969,47 @@ func newGetContractByChainAddressBatch(
 	return d
 }
 
+// GetContractSpamScoreByContractIDBatch batches and caches requests
+type GetContractSpamScoreByContractIDBatch struct {
+	generator.Dataloader[persist.DBID, coredb.ContractSpamScore]
+}
+
+// newGetContractSpamScoreByContractIDBatch creates a new GetContractSpamScoreByContractIDBatch with the given settings, functions, and options
+func newGetContractSpamScoreByContractIDBatch(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *GetContractSpamScoreByContractIDBatch, []persist.DBID) ([]coredb.ContractSpamScore, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *GetContractSpamScoreByContractIDBatch {
+	d := &GetContractSpamScoreByContractIDBatch{}
+
+	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]coredb.ContractSpamScore, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "GetContractSpamScoreByContractIDBatch")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "GetContractSpamScoreByContractIDBatch")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
+func (*GetContractSpamScoreByContractIDBatch) getKeyForResult(result coredb.ContractSpamScore) persist.DBID {
+	return result.ContractID
+}
+
 // GetContractsDisplayedByUserIDBatch batches and caches requests
 type GetContractsDisplayedByUserIDBatch struct {
 	generator.Dataloader[persist.DBID, []coredb.Contract]
@@ -1774,6 +1852,84 @@ func newGetProfileImageByIdBatch(
 	return d
 }
 
+// GetRepostByActorIDAndPostID batches and caches requests
+type GetRepostByActorIDAndPostID struct {
+	generator.Dataloader[coredb.GetRepostByActorIDAndPostIDParams, coredb.Repost]
+}
+
+// newGetRepostByActorIDAndPostID creates a new GetRepostByActorIDAndPostID with the given settings, functions, and options
+func newGetRepostByActorIDAndPostID(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *GetRepostByActorIDAndPostID, []coredb.GetRepostByActorIDAndPostIDParams) ([]coredb.Repost, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *GetRepostByActorIDAndPostID {
+	d := &GetRepostByActorIDAndPostID{}
+
+	fetchWithHooks := func(ctx context.Context, keys []coredb.GetRepostByActorIDAndPostIDParams) ([]coredb.Repost, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "GetRepostByActorIDAndPostID")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "GetRepostByActorIDAndPostID")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
+// GetRepostByIdBatch batches and caches requests
+type GetRepostByIdBatch struct {
+	generator.Dataloader[persist.DBID, coredb.Repost]
+}
+
+// newGetRepostByIdBatch creates a new GetRepostByIdBatch with the given settings, functions, and options
+func newGetRepostByIdBatch(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *GetRepostByIdBatch, []persist.DBID) ([]coredb.Repost, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *GetRepostByIdBatch {
+	d := &GetRepostByIdBatch{}
+
+	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]coredb.Repost, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "GetRepostByIdBatch")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "GetRepostByIdBatch")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
+func (*GetRepostByIdBatch) getKeyForResult(result coredb.Repost) persist.DBID {
+	return result.ID
+}
+
 // GetSharedCommunitiesBatchPaginate batches and caches requests
 type GetSharedCommunitiesBatchPaginate struct {
 	generator.Dataloader[coredb.GetSharedCommunitiesBatchPaginateParams, []coredb.GetSharedCommunitiesBatchPaginateRow]
@@ -2078,6 +2234,47 @@ func (*GetTokenDefinitionByTokenDbidBatch) getKeyForResult(result coredb.TokenDe
 	return result.ID
 }
 
+// GetTokenDefinitionSpamScoreByIDBatch batches and caches requests
+type GetTokenDefinitionSpamScoreByIDBatch struct {
+	generator.Dataloader[persist.DBID, coredb.TokenDefinitionSpamScore]
+}
+
+// newGetTokenDefinitionSpamScoreByIDBatch creates a new GetTokenDefinitionSpamScoreByIDBatch with the given settings, functions, and options
+func newGetTokenDefinitionSpamScoreByIDBatch(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *GetTokenDefinitionSpamScoreByIDBatch, []persist.DBID) ([]coredb.TokenDefinitionSpamScore, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *GetTokenDefinitionSpamScoreByIDBatch {
+	d := &GetTokenDefinitionSpamScoreByIDBatch{}
+
+	fetchWithHooks := func(ctx context.Context, keys []persist.DBID) ([]coredb.TokenDefinitionSpamScore, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "GetTokenDefinitionSpamScoreByIDBatch")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "GetTokenDefinitionSpamScoreByIDBatch")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
+func (*GetTokenDefinitionSpamScoreByIDBatch) getKeyForResult(result coredb.TokenDefinitionSpamScore) persist.DBID {
+	return result.TokenDefinitionID
+}
+
 // GetTokensByCollectionIdBatch batches and caches requests
 type GetTokensByCollectionIdBatch struct {
 	generator.Dataloader[coredb.GetTokensByCollectionIdBatchParams, []coredb.Token]
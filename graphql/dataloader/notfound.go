@@ -28,11 +28,11 @@ func (*CountCommentsByFeedEventIDBatch) getNotFoundError(key persist.DBID) error
 	return pgx.ErrNoRows
 }
 
-func (*CountCommentsByPostIDBatch) getNotFoundError(key persist.DBID) error {
+func (*CountCommentsByPostIDBatch) getNotFoundError(key coredb.CountCommentsByPostIDBatchParams) error {
 	return pgx.ErrNoRows
 }
 
-func (*CountRepliesByCommentIDBatch) getNotFoundError(key persist.DBID) error {
+func (*CountRepliesByCommentIDBatch) getNotFoundError(key coredb.CountRepliesByCommentIDBatchParams) error {
 	return pgx.ErrNoRows
 }
 
@@ -48,6 +48,10 @@ func (*GetAdmireByActorIDAndTokenID) getNotFoundError(key coredb.GetAdmireByActo
 	return persist.ErrAdmireNotFoundByActorIDTokenID{ActorID: key.ActorID, TokenID: key.TokenID}
 }
 
+func (*CountRepostsByPostIDBatch) getNotFoundError(key persist.DBID) error {
+	return pgx.ErrNoRows
+}
+
 func (*GetAdmireByActorIDAndCommentID) getNotFoundError(key coredb.GetAdmireByActorIDAndCommentIDParams) error {
 	return persist.ErrAdmireNotFoundByActorIDCommentID{ActorID: key.ActorID, CommentID: key.CommentID}
 }
@@ -68,6 +72,10 @@ func (*GetContractByChainAddressBatch) getNotFoundError(key coredb.GetContractBy
 	return persist.ErrContractNotFoundByAddress{Address: key.Address, Chain: key.Chain}
 }
 
+func (*GetContractSpamScoreByContractIDBatch) getNotFoundError(key persist.DBID) error {
+	return pgx.ErrNoRows
+}
+
 func (*GetEventByIdBatch) getNotFoundError(key persist.DBID) error {
 	return persist.ErrFeedEventNotFoundByID{ID: key}
 }
@@ -96,6 +104,14 @@ func (*GetProfileImageByIdBatch) getNotFoundError(key coredb.GetProfileImageById
 	return persist.ErrProfileImageNotFound{Err: pgx.ErrNoRows, ProfileImageID: key.ID}
 }
 
+func (*GetRepostByActorIDAndPostID) getNotFoundError(key coredb.GetRepostByActorIDAndPostIDParams) error {
+	return persist.ErrRepostNotFoundByActorIDPostID{ActorID: key.ActorID, PostID: key.PostID}
+}
+
+func (*GetRepostByIdBatch) getNotFoundError(key persist.DBID) error {
+	return persist.ErrRepostNotFoundByID{ID: key}
+}
+
 func (*GetTokenByIdBatch) getNotFoundError(key persist.DBID) error {
 	return persist.ErrTokenNotFoundByID{ID: key}
 }
@@ -126,6 +142,10 @@ func (*GetTokenByUserTokenIdentifiersIgnoreDisplayableBatch) getNotFoundError(ke
 	}
 }
 
+func (*GetTokenDefinitionSpamScoreByIDBatch) getNotFoundError(key persist.DBID) error {
+	return pgx.ErrNoRows
+}
+
 func (*GetUserByAddressAndL1Batch) getNotFoundError(key coredb.GetUserByAddressAndL1BatchParams) error {
 	return persist.ErrUserNotFound{L1ChainAddress: persist.NewL1ChainAddress(key.Address, persist.Chain(key.L1Chain))}
 }
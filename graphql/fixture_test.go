@@ -104,7 +104,7 @@ func useCloudTasksDirectDispatch(t *testing.T) {
 	t.Setenv("CLOUD_TASKS_DIRECT_DISPATCH_ENABLED", "true")
 }
 
-// useNotificationTopics is a fixture that creates dummy PubSub topics for notifications
+// useNotificationTopics is a fixture that creates dummy PubSub topics for notifications and realtime messages
 func useNotificationTopics(t *testing.T) {
 	t.Helper()
 	usePubSub(t)
@@ -120,6 +120,11 @@ func useNotificationTopics(t *testing.T) {
 	_, err = client.CreateTopic(ctx, updatedNotificationsTopic)
 	require.NoError(t, err)
 	t.Setenv("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", updatedNotificationsTopic)
+
+	realtimeTopic := "realtime" + persist.GenerateID().String()
+	_, err = client.CreateTopic(ctx, realtimeTopic)
+	require.NoError(t, err)
+	t.Setenv("PUBSUB_TOPIC_REALTIME", realtimeTopic)
 }
 
 // useCloudTasks starts a running Cloud Tasks emulator
@@ -64,6 +64,7 @@ const (
 	ChainPoap     Chain = "POAP"
 	ChainZora     Chain = "Zora"
 	ChainBase     Chain = "Base"
+	ChainSolana   Chain = "Solana"
 )
 
 type ChainAddressInput struct {
@@ -127,6 +128,15 @@ func (v *CollectionTokenSettingsInput) GetRenderLive() bool { return v.RenderLiv
 // GetHighDefinition returns CollectionTokenSettingsInput.HighDefinition, and is useful for accessing the field via an interface.
 func (v *CollectionTokenSettingsInput) GetHighDefinition() bool { return v.HighDefinition }
 
+type CommentRestriction string
+
+const (
+	CommentRestrictionEveryone  CommentRestriction = "EVERYONE"
+	CommentRestrictionFollowers CommentRestriction = "FOLLOWERS"
+	CommentRestrictionMutuals   CommentRestriction = "MUTUALS"
+	CommentRestrictionNobody    CommentRestriction = "NOBODY"
+)
+
 type CreateCollectionInGalleryInput struct {
 	Name           string                         `json:"name"`
 	CollectorsNote string                         `json:"collectorsNote"`
@@ -761,6 +771,14 @@ type __disconnectSocialAccountInput struct {
 // GetAccountType returns __disconnectSocialAccountInput.AccountType, and is useful for accessing the field via an interface.
 func (v *__disconnectSocialAccountInput) GetAccountType() SocialAccountType { return v.AccountType }
 
+// __followUserMutationInput is used internally by genqlient
+type __followUserMutationInput struct {
+	UserId persist.DBID `json:"userId"`
+}
+
+// GetUserId returns __followUserMutationInput.UserId, and is useful for accessing the field via an interface.
+func (v *__followUserMutationInput) GetUserId() persist.DBID { return v.UserId }
+
 // __globalFeedQueryInput is used internally by genqlient
 type __globalFeedQueryInput struct {
 	First        *int `json:"first"`
@@ -773,6 +791,14 @@ func (v *__globalFeedQueryInput) GetFirst() *int { return v.First }
 // GetIncludePosts returns __globalFeedQueryInput.IncludePosts, and is useful for accessing the field via an interface.
 func (v *__globalFeedQueryInput) GetIncludePosts() bool { return v.IncludePosts }
 
+// __hideCommentMutationInput is used internally by genqlient
+type __hideCommentMutationInput struct {
+	CommentId persist.DBID `json:"commentId"`
+}
+
+// GetCommentId returns __hideCommentMutationInput.CommentId, and is useful for accessing the field via an interface.
+func (v *__hideCommentMutationInput) GetCommentId() persist.DBID { return v.CommentId }
+
 // __loginMutationInput is used internally by genqlient
 type __loginMutationInput struct {
 	AuthMechanism AuthMechanism `json:"authMechanism"`
@@ -789,6 +815,14 @@ type __moveCollectionToGalleryInput struct {
 // GetInput returns __moveCollectionToGalleryInput.Input, and is useful for accessing the field via an interface.
 func (v *__moveCollectionToGalleryInput) GetInput() MoveCollectionToGalleryInput { return v.Input }
 
+// __postCommentsQueryInput is used internally by genqlient
+type __postCommentsQueryInput struct {
+	Id persist.DBID `json:"id"`
+}
+
+// GetId returns __postCommentsQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__postCommentsQueryInput) GetId() persist.DBID { return v.Id }
+
 // __postTokensInput is used internally by genqlient
 type __postTokensInput struct {
 	Input PostTokensInput `json:"input"`
@@ -821,6 +855,20 @@ type __removeUserWalletsMutationInput struct {
 // GetWalletIds returns __removeUserWalletsMutationInput.WalletIds, and is useful for accessing the field via an interface.
 func (v *__removeUserWalletsMutationInput) GetWalletIds() []persist.DBID { return v.WalletIds }
 
+// __setPostCommentRestrictionMutationInput is used internally by genqlient
+type __setPostCommentRestrictionMutationInput struct {
+	PostId      persist.DBID       `json:"postId"`
+	Restriction CommentRestriction `json:"restriction"`
+}
+
+// GetPostId returns __setPostCommentRestrictionMutationInput.PostId, and is useful for accessing the field via an interface.
+func (v *__setPostCommentRestrictionMutationInput) GetPostId() persist.DBID { return v.PostId }
+
+// GetRestriction returns __setPostCommentRestrictionMutationInput.Restriction, and is useful for accessing the field via an interface.
+func (v *__setPostCommentRestrictionMutationInput) GetRestriction() CommentRestriction {
+	return v.Restriction
+}
+
 // __syncTokensMutationInput is used internally by genqlient
 type __syncTokensMutationInput struct {
 	Chains        []Chain `json:"chains"`
@@ -1787,6 +1835,7 @@ func (v *admirePostMutationAdmirePostAdmirePostPayload) GetPost() *admirePostMut
 // admirePostMutationAdmirePostErrInvalidInput
 // admirePostMutationAdmirePostErrNotAuthorized
 // admirePostMutationAdmirePostErrPostNotFound
+// admirePostMutationAdmirePostErrRateLimited
 type admirePostMutationAdmirePostAdmirePostPayloadOrError interface {
 	implementsGraphQLInterfaceadmirePostMutationAdmirePostAdmirePostPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
@@ -1801,6 +1850,8 @@ func (v *admirePostMutationAdmirePostErrNotAuthorized) implementsGraphQLInterfac
 }
 func (v *admirePostMutationAdmirePostErrPostNotFound) implementsGraphQLInterfaceadmirePostMutationAdmirePostAdmirePostPayloadOrError() {
 }
+func (v *admirePostMutationAdmirePostErrRateLimited) implementsGraphQLInterfaceadmirePostMutationAdmirePostAdmirePostPayloadOrError() {
+}
 
 func __unmarshaladmirePostMutationAdmirePostAdmirePostPayloadOrError(b []byte, v *admirePostMutationAdmirePostAdmirePostPayloadOrError) error {
 	if string(b) == "null" {
@@ -1828,6 +1879,9 @@ func __unmarshaladmirePostMutationAdmirePostAdmirePostPayloadOrError(b []byte, v
 	case "ErrPostNotFound":
 		*v = new(admirePostMutationAdmirePostErrPostNotFound)
 		return json.Unmarshal(b, *v)
+	case "ErrRateLimited":
+		*v = new(admirePostMutationAdmirePostErrRateLimited)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing AdmirePostPayloadOrError.__typename")
@@ -1873,6 +1927,14 @@ func __marshaladmirePostMutationAdmirePostAdmirePostPayloadOrError(v *admirePost
 			*admirePostMutationAdmirePostErrPostNotFound
 		}{typename, v}
 		return json.Marshal(result)
+	case *admirePostMutationAdmirePostErrRateLimited:
+		typename = "ErrRateLimited"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*admirePostMutationAdmirePostErrRateLimited
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -1925,6 +1987,18 @@ func (v *admirePostMutationAdmirePostErrPostNotFound) GetTypename() *string { re
 // GetMessage returns admirePostMutationAdmirePostErrPostNotFound.Message, and is useful for accessing the field via an interface.
 func (v *admirePostMutationAdmirePostErrPostNotFound) GetMessage() string { return v.Message }
 
+// admirePostMutationAdmirePostErrRateLimited includes the requested fields of the GraphQL type ErrRateLimited.
+type admirePostMutationAdmirePostErrRateLimited struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns admirePostMutationAdmirePostErrRateLimited.Typename, and is useful for accessing the field via an interface.
+func (v *admirePostMutationAdmirePostErrRateLimited) GetTypename() *string { return v.Typename }
+
+// GetMessage returns admirePostMutationAdmirePostErrRateLimited.Message, and is useful for accessing the field via an interface.
+func (v *admirePostMutationAdmirePostErrRateLimited) GetMessage() string { return v.Message }
+
 // admirePostMutationResponse is returned by admirePostMutation on success.
 type admirePostMutationResponse struct {
 	AdmirePost *admirePostMutationAdmirePostAdmirePostPayloadOrError `json:"-"`
@@ -2505,8 +2579,9 @@ func (v *commentOnFeedEventMutationResponse) __premarshalJSON() (*__premarshalco
 
 // commentOnPostMutationCommentOnPostCommentOnPostPayload includes the requested fields of the GraphQL type CommentOnPostPayload.
 type commentOnPostMutationCommentOnPostCommentOnPostPayload struct {
-	Typename *string                                                     `json:"__typename"`
-	Post     *commentOnPostMutationCommentOnPostCommentOnPostPayloadPost `json:"post"`
+	Typename *string                                                        `json:"__typename"`
+	Post     *commentOnPostMutationCommentOnPostCommentOnPostPayloadPost    `json:"post"`
+	Comment  *commentOnPostMutationCommentOnPostCommentOnPostPayloadComment `json:"comment"`
 }
 
 // GetTypename returns commentOnPostMutationCommentOnPostCommentOnPostPayload.Typename, and is useful for accessing the field via an interface.
@@ -2519,12 +2594,29 @@ func (v *commentOnPostMutationCommentOnPostCommentOnPostPayload) GetPost() *comm
 	return v.Post
 }
 
+// GetComment returns commentOnPostMutationCommentOnPostCommentOnPostPayload.Comment, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostCommentOnPostPayload) GetComment() *commentOnPostMutationCommentOnPostCommentOnPostPayloadComment {
+	return v.Comment
+}
+
+// commentOnPostMutationCommentOnPostCommentOnPostPayloadComment includes the requested fields of the GraphQL type Comment.
+type commentOnPostMutationCommentOnPostCommentOnPostPayloadComment struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns commentOnPostMutationCommentOnPostCommentOnPostPayloadComment.Dbid, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostCommentOnPostPayloadComment) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
 // commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError includes the requested fields of the GraphQL interface CommentOnPostPayloadOrError.
 //
 // commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError is implemented by the following types:
 // commentOnPostMutationCommentOnPostCommentOnPostPayload
+// commentOnPostMutationCommentOnPostErrCommentsRestricted
 // commentOnPostMutationCommentOnPostErrInvalidInput
 // commentOnPostMutationCommentOnPostErrNotAuthorized
+// commentOnPostMutationCommentOnPostErrRateLimited
 type commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError interface {
 	implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
@@ -2533,10 +2625,14 @@ type commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError interface {
 
 func (v *commentOnPostMutationCommentOnPostCommentOnPostPayload) implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError() {
 }
+func (v *commentOnPostMutationCommentOnPostErrCommentsRestricted) implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError() {
+}
 func (v *commentOnPostMutationCommentOnPostErrInvalidInput) implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError() {
 }
 func (v *commentOnPostMutationCommentOnPostErrNotAuthorized) implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError() {
 }
+func (v *commentOnPostMutationCommentOnPostErrRateLimited) implementsGraphQLInterfacecommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError() {
+}
 
 func __unmarshalcommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError(b []byte, v *commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError) error {
 	if string(b) == "null" {
@@ -2555,12 +2651,18 @@ func __unmarshalcommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError(b
 	case "CommentOnPostPayload":
 		*v = new(commentOnPostMutationCommentOnPostCommentOnPostPayload)
 		return json.Unmarshal(b, *v)
+	case "ErrCommentsRestricted":
+		*v = new(commentOnPostMutationCommentOnPostErrCommentsRestricted)
+		return json.Unmarshal(b, *v)
 	case "ErrInvalidInput":
 		*v = new(commentOnPostMutationCommentOnPostErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
 		*v = new(commentOnPostMutationCommentOnPostErrNotAuthorized)
 		return json.Unmarshal(b, *v)
+	case "ErrRateLimited":
+		*v = new(commentOnPostMutationCommentOnPostErrRateLimited)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing CommentOnPostPayloadOrError.__typename")
@@ -2582,6 +2684,14 @@ func __marshalcommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError(v *c
 			*commentOnPostMutationCommentOnPostCommentOnPostPayload
 		}{typename, v}
 		return json.Marshal(result)
+	case *commentOnPostMutationCommentOnPostErrCommentsRestricted:
+		typename = "ErrCommentsRestricted"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*commentOnPostMutationCommentOnPostErrCommentsRestricted
+		}{typename, v}
+		return json.Marshal(result)
 	case *commentOnPostMutationCommentOnPostErrInvalidInput:
 		typename = "ErrInvalidInput"
 
@@ -2598,6 +2708,14 @@ func __marshalcommentOnPostMutationCommentOnPostCommentOnPostPayloadOrError(v *c
 			*commentOnPostMutationCommentOnPostErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
+	case *commentOnPostMutationCommentOnPostErrRateLimited:
+		typename = "ErrRateLimited"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*commentOnPostMutationCommentOnPostErrRateLimited
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -2616,6 +2734,22 @@ func (v *commentOnPostMutationCommentOnPostCommentOnPostPayloadPost) GetDbid() p
 	return v.Dbid
 }
 
+// commentOnPostMutationCommentOnPostErrCommentsRestricted includes the requested fields of the GraphQL type ErrCommentsRestricted.
+type commentOnPostMutationCommentOnPostErrCommentsRestricted struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns commentOnPostMutationCommentOnPostErrCommentsRestricted.Typename, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostErrCommentsRestricted) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns commentOnPostMutationCommentOnPostErrCommentsRestricted.Message, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostErrCommentsRestricted) GetMessage() string {
+	return v.Message
+}
+
 // commentOnPostMutationCommentOnPostErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
 type commentOnPostMutationCommentOnPostErrInvalidInput struct {
 	Typename *string `json:"__typename"`
@@ -2640,6 +2774,18 @@ func (v *commentOnPostMutationCommentOnPostErrNotAuthorized) GetTypename() *stri
 // GetMessage returns commentOnPostMutationCommentOnPostErrNotAuthorized.Message, and is useful for accessing the field via an interface.
 func (v *commentOnPostMutationCommentOnPostErrNotAuthorized) GetMessage() string { return v.Message }
 
+// commentOnPostMutationCommentOnPostErrRateLimited includes the requested fields of the GraphQL type ErrRateLimited.
+type commentOnPostMutationCommentOnPostErrRateLimited struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns commentOnPostMutationCommentOnPostErrRateLimited.Typename, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostErrRateLimited) GetTypename() *string { return v.Typename }
+
+// GetMessage returns commentOnPostMutationCommentOnPostErrRateLimited.Message, and is useful for accessing the field via an interface.
+func (v *commentOnPostMutationCommentOnPostErrRateLimited) GetMessage() string { return v.Message }
+
 // commentOnPostMutationResponse is returned by commentOnPostMutation on success.
 type commentOnPostMutationResponse struct {
 	CommentOnPost *commentOnPostMutationCommentOnPostCommentOnPostPayloadOrError `json:"-"`
@@ -4728,6 +4874,271 @@ func (v *disconnectSocialAccountResponse) __premarshalJSON() (*__premarshaldisco
 	return &retval, nil
 }
 
+// followUserMutationFollowUserErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type followUserMutationFollowUserErrAuthenticationFailed struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns followUserMutationFollowUserErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrAuthenticationFailed) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns followUserMutationFollowUserErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrAuthenticationFailed) GetMessage() string { return v.Message }
+
+// followUserMutationFollowUserErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type followUserMutationFollowUserErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns followUserMutationFollowUserErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns followUserMutationFollowUserErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrInvalidInput) GetMessage() string { return v.Message }
+
+// followUserMutationFollowUserErrRateLimited includes the requested fields of the GraphQL type ErrRateLimited.
+type followUserMutationFollowUserErrRateLimited struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns followUserMutationFollowUserErrRateLimited.Typename, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrRateLimited) GetTypename() *string { return v.Typename }
+
+// GetMessage returns followUserMutationFollowUserErrRateLimited.Message, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrRateLimited) GetMessage() string { return v.Message }
+
+// followUserMutationFollowUserErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type followUserMutationFollowUserErrUserNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns followUserMutationFollowUserErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrUserNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns followUserMutationFollowUserErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserErrUserNotFound) GetMessage() string { return v.Message }
+
+// followUserMutationFollowUserFollowUserPayload includes the requested fields of the GraphQL type FollowUserPayload.
+type followUserMutationFollowUserFollowUserPayload struct {
+	Typename *string                                                       `json:"__typename"`
+	User     *followUserMutationFollowUserFollowUserPayloadUserGalleryUser `json:"user"`
+}
+
+// GetTypename returns followUserMutationFollowUserFollowUserPayload.Typename, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserFollowUserPayload) GetTypename() *string { return v.Typename }
+
+// GetUser returns followUserMutationFollowUserFollowUserPayload.User, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserFollowUserPayload) GetUser() *followUserMutationFollowUserFollowUserPayloadUserGalleryUser {
+	return v.User
+}
+
+// followUserMutationFollowUserFollowUserPayloadOrError includes the requested fields of the GraphQL interface FollowUserPayloadOrError.
+//
+// followUserMutationFollowUserFollowUserPayloadOrError is implemented by the following types:
+// followUserMutationFollowUserErrAuthenticationFailed
+// followUserMutationFollowUserErrInvalidInput
+// followUserMutationFollowUserErrRateLimited
+// followUserMutationFollowUserErrUserNotFound
+// followUserMutationFollowUserFollowUserPayload
+type followUserMutationFollowUserFollowUserPayloadOrError interface {
+	implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *followUserMutationFollowUserErrAuthenticationFailed) implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError() {
+}
+func (v *followUserMutationFollowUserErrInvalidInput) implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError() {
+}
+func (v *followUserMutationFollowUserErrRateLimited) implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError() {
+}
+func (v *followUserMutationFollowUserErrUserNotFound) implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError() {
+}
+func (v *followUserMutationFollowUserFollowUserPayload) implementsGraphQLInterfacefollowUserMutationFollowUserFollowUserPayloadOrError() {
+}
+
+func __unmarshalfollowUserMutationFollowUserFollowUserPayloadOrError(b []byte, v *followUserMutationFollowUserFollowUserPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrAuthenticationFailed":
+		*v = new(followUserMutationFollowUserErrAuthenticationFailed)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(followUserMutationFollowUserErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrRateLimited":
+		*v = new(followUserMutationFollowUserErrRateLimited)
+		return json.Unmarshal(b, *v)
+	case "ErrUserNotFound":
+		*v = new(followUserMutationFollowUserErrUserNotFound)
+		return json.Unmarshal(b, *v)
+	case "FollowUserPayload":
+		*v = new(followUserMutationFollowUserFollowUserPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing FollowUserPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for followUserMutationFollowUserFollowUserPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalfollowUserMutationFollowUserFollowUserPayloadOrError(v *followUserMutationFollowUserFollowUserPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *followUserMutationFollowUserErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*followUserMutationFollowUserErrAuthenticationFailed
+		}{typename, v}
+		return json.Marshal(result)
+	case *followUserMutationFollowUserErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*followUserMutationFollowUserErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *followUserMutationFollowUserErrRateLimited:
+		typename = "ErrRateLimited"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*followUserMutationFollowUserErrRateLimited
+		}{typename, v}
+		return json.Marshal(result)
+	case *followUserMutationFollowUserErrUserNotFound:
+		typename = "ErrUserNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*followUserMutationFollowUserErrUserNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *followUserMutationFollowUserFollowUserPayload:
+		typename = "FollowUserPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*followUserMutationFollowUserFollowUserPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for followUserMutationFollowUserFollowUserPayloadOrError: "%T"`, v)
+	}
+}
+
+// followUserMutationFollowUserFollowUserPayloadUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type followUserMutationFollowUserFollowUserPayloadUserGalleryUser struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns followUserMutationFollowUserFollowUserPayloadUserGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *followUserMutationFollowUserFollowUserPayloadUserGalleryUser) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// followUserMutationResponse is returned by followUserMutation on success.
+type followUserMutationResponse struct {
+	FollowUser *followUserMutationFollowUserFollowUserPayloadOrError `json:"-"`
+}
+
+// GetFollowUser returns followUserMutationResponse.FollowUser, and is useful for accessing the field via an interface.
+func (v *followUserMutationResponse) GetFollowUser() *followUserMutationFollowUserFollowUserPayloadOrError {
+	return v.FollowUser
+}
+
+func (v *followUserMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*followUserMutationResponse
+		FollowUser json.RawMessage `json:"followUser"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.followUserMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.FollowUser
+		src := firstPass.FollowUser
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(followUserMutationFollowUserFollowUserPayloadOrError)
+			err = __unmarshalfollowUserMutationFollowUserFollowUserPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal followUserMutationResponse.FollowUser: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalfollowUserMutationResponse struct {
+	FollowUser json.RawMessage `json:"followUser"`
+}
+
+func (v *followUserMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *followUserMutationResponse) __premarshalJSON() (*__premarshalfollowUserMutationResponse, error) {
+	var retval __premarshalfollowUserMutationResponse
+
+	{
+
+		dst := &retval.FollowUser
+		src := v.FollowUser
+		if src != nil {
+			var err error
+			*dst, err = __marshalfollowUserMutationFollowUserFollowUserPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal followUserMutationResponse.FollowUser: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
 // getAuthNonceMutationGetAuthNonce includes the requested fields of the GraphQL type AuthNonce.
 type getAuthNonceMutationGetAuthNonce struct {
 	Typename *string `json:"__typename"`
@@ -5037,6 +5448,7 @@ func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetD
 // globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
 // globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
 // globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodePost
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost
 // The GraphQL type's documentation follows.
 //
 // Can return posts as well
@@ -5056,6 +5468,8 @@ func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) impl
 }
 func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodePost) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
 
 func __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
 	if string(b) == "null" {
@@ -5086,6 +5500,9 @@ func __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEven
 	case "Post":
 		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodePost)
 		return json.Unmarshal(b, *v)
+	case "Repost":
+		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing FeedEventOrError.__typename")
@@ -5139,6 +5556,14 @@ func __marshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventO
 			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodePost
 		}{typename, v}
 		return json.Marshal(result)
+	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost:
+		typename = "Repost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -5163,6 +5588,16 @@ func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodePost) GetDbid()
 	return v.Dbid
 }
 
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost includes the requested fields of the GraphQL type Repost.
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost.Typename, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeRepost) GetTypename() *string {
+	return v.Typename
+}
+
 // globalFeedQueryResponse is returned by globalFeedQuery on success.
 type globalFeedQueryResponse struct {
 	GlobalFeed *globalFeedQueryGlobalFeedFeedConnection `json:"globalFeed"`
@@ -5173,8 +5608,247 @@ func (v *globalFeedQueryResponse) GetGlobalFeed() *globalFeedQueryGlobalFeedFeed
 	return v.GlobalFeed
 }
 
-// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
-type loginMutationLoginErrAuthenticationFailed struct {
+// hideCommentMutationHideCommentErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type hideCommentMutationHideCommentErrAuthenticationFailed struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns hideCommentMutationHideCommentErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrAuthenticationFailed) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns hideCommentMutationHideCommentErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrAuthenticationFailed) GetMessage() string { return v.Message }
+
+// hideCommentMutationHideCommentErrCommentNotFound includes the requested fields of the GraphQL type ErrCommentNotFound.
+type hideCommentMutationHideCommentErrCommentNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns hideCommentMutationHideCommentErrCommentNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrCommentNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns hideCommentMutationHideCommentErrCommentNotFound.Message, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrCommentNotFound) GetMessage() string { return v.Message }
+
+// hideCommentMutationHideCommentErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type hideCommentMutationHideCommentErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns hideCommentMutationHideCommentErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns hideCommentMutationHideCommentErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentErrInvalidInput) GetMessage() string { return v.Message }
+
+// hideCommentMutationHideCommentHideCommentPayload includes the requested fields of the GraphQL type HideCommentPayload.
+type hideCommentMutationHideCommentHideCommentPayload struct {
+	Typename *string                                                  `json:"__typename"`
+	Comment  *hideCommentMutationHideCommentHideCommentPayloadComment `json:"comment"`
+}
+
+// GetTypename returns hideCommentMutationHideCommentHideCommentPayload.Typename, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentHideCommentPayload) GetTypename() *string { return v.Typename }
+
+// GetComment returns hideCommentMutationHideCommentHideCommentPayload.Comment, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentHideCommentPayload) GetComment() *hideCommentMutationHideCommentHideCommentPayloadComment {
+	return v.Comment
+}
+
+// hideCommentMutationHideCommentHideCommentPayloadComment includes the requested fields of the GraphQL type Comment.
+type hideCommentMutationHideCommentHideCommentPayloadComment struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns hideCommentMutationHideCommentHideCommentPayloadComment.Dbid, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationHideCommentHideCommentPayloadComment) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// hideCommentMutationHideCommentHideCommentPayloadOrError includes the requested fields of the GraphQL interface HideCommentPayloadOrError.
+//
+// hideCommentMutationHideCommentHideCommentPayloadOrError is implemented by the following types:
+// hideCommentMutationHideCommentErrAuthenticationFailed
+// hideCommentMutationHideCommentErrCommentNotFound
+// hideCommentMutationHideCommentErrInvalidInput
+// hideCommentMutationHideCommentHideCommentPayload
+type hideCommentMutationHideCommentHideCommentPayloadOrError interface {
+	implementsGraphQLInterfacehideCommentMutationHideCommentHideCommentPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *hideCommentMutationHideCommentErrAuthenticationFailed) implementsGraphQLInterfacehideCommentMutationHideCommentHideCommentPayloadOrError() {
+}
+func (v *hideCommentMutationHideCommentErrCommentNotFound) implementsGraphQLInterfacehideCommentMutationHideCommentHideCommentPayloadOrError() {
+}
+func (v *hideCommentMutationHideCommentErrInvalidInput) implementsGraphQLInterfacehideCommentMutationHideCommentHideCommentPayloadOrError() {
+}
+func (v *hideCommentMutationHideCommentHideCommentPayload) implementsGraphQLInterfacehideCommentMutationHideCommentHideCommentPayloadOrError() {
+}
+
+func __unmarshalhideCommentMutationHideCommentHideCommentPayloadOrError(b []byte, v *hideCommentMutationHideCommentHideCommentPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrAuthenticationFailed":
+		*v = new(hideCommentMutationHideCommentErrAuthenticationFailed)
+		return json.Unmarshal(b, *v)
+	case "ErrCommentNotFound":
+		*v = new(hideCommentMutationHideCommentErrCommentNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(hideCommentMutationHideCommentErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "HideCommentPayload":
+		*v = new(hideCommentMutationHideCommentHideCommentPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing HideCommentPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for hideCommentMutationHideCommentHideCommentPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalhideCommentMutationHideCommentHideCommentPayloadOrError(v *hideCommentMutationHideCommentHideCommentPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *hideCommentMutationHideCommentErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*hideCommentMutationHideCommentErrAuthenticationFailed
+		}{typename, v}
+		return json.Marshal(result)
+	case *hideCommentMutationHideCommentErrCommentNotFound:
+		typename = "ErrCommentNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*hideCommentMutationHideCommentErrCommentNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *hideCommentMutationHideCommentErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*hideCommentMutationHideCommentErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *hideCommentMutationHideCommentHideCommentPayload:
+		typename = "HideCommentPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*hideCommentMutationHideCommentHideCommentPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for hideCommentMutationHideCommentHideCommentPayloadOrError: "%T"`, v)
+	}
+}
+
+// hideCommentMutationResponse is returned by hideCommentMutation on success.
+type hideCommentMutationResponse struct {
+	HideComment *hideCommentMutationHideCommentHideCommentPayloadOrError `json:"-"`
+}
+
+// GetHideComment returns hideCommentMutationResponse.HideComment, and is useful for accessing the field via an interface.
+func (v *hideCommentMutationResponse) GetHideComment() *hideCommentMutationHideCommentHideCommentPayloadOrError {
+	return v.HideComment
+}
+
+func (v *hideCommentMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*hideCommentMutationResponse
+		HideComment json.RawMessage `json:"hideComment"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.hideCommentMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.HideComment
+		src := firstPass.HideComment
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(hideCommentMutationHideCommentHideCommentPayloadOrError)
+			err = __unmarshalhideCommentMutationHideCommentHideCommentPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal hideCommentMutationResponse.HideComment: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalhideCommentMutationResponse struct {
+	HideComment json.RawMessage `json:"hideComment"`
+}
+
+func (v *hideCommentMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *hideCommentMutationResponse) __premarshalJSON() (*__premarshalhideCommentMutationResponse, error) {
+	var retval __premarshalhideCommentMutationResponse
+
+	{
+
+		dst := &retval.HideComment
+		src := v.HideComment
+		if src != nil {
+			var err error
+			*dst, err = __marshalhideCommentMutationHideCommentHideCommentPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal hideCommentMutationResponse.HideComment: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type loginMutationLoginErrAuthenticationFailed struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
@@ -5929,23 +6603,300 @@ func __marshalnotificationsForViewerQueryViewerViewerOrError(v *notificationsFor
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*notificationsForViewerQueryViewerErrNotAuthorized
+			*notificationsForViewerQueryViewerErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *notificationsForViewerQueryViewer:
+		typename = "Viewer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*notificationsForViewerQueryViewer
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%T"`, v)
+	}
+}
+
+// postCommentsQueryPostByIdErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type postCommentsQueryPostByIdErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns postCommentsQueryPostByIdErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns postCommentsQueryPostByIdErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdErrInvalidInput) GetMessage() string { return v.Message }
+
+// postCommentsQueryPostByIdErrPostNotFound includes the requested fields of the GraphQL type ErrPostNotFound.
+type postCommentsQueryPostByIdErrPostNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns postCommentsQueryPostByIdErrPostNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdErrPostNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns postCommentsQueryPostByIdErrPostNotFound.Message, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdErrPostNotFound) GetMessage() string { return v.Message }
+
+// postCommentsQueryPostByIdPost includes the requested fields of the GraphQL type Post.
+type postCommentsQueryPostByIdPost struct {
+	Typename      *string                                                          `json:"__typename"`
+	TotalComments *int                                                             `json:"totalComments"`
+	Comments      *postCommentsQueryPostByIdPostCommentsPostCommentsConnection     `json:"comments"`
+	Interactions  *postCommentsQueryPostByIdPostInteractionsInteractionsConnection `json:"interactions"`
+}
+
+// GetTypename returns postCommentsQueryPostByIdPost.Typename, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPost) GetTypename() *string { return v.Typename }
+
+// GetTotalComments returns postCommentsQueryPostByIdPost.TotalComments, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPost) GetTotalComments() *int { return v.TotalComments }
+
+// GetComments returns postCommentsQueryPostByIdPost.Comments, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPost) GetComments() *postCommentsQueryPostByIdPostCommentsPostCommentsConnection {
+	return v.Comments
+}
+
+// GetInteractions returns postCommentsQueryPostByIdPost.Interactions, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPost) GetInteractions() *postCommentsQueryPostByIdPostInteractionsInteractionsConnection {
+	return v.Interactions
+}
+
+// postCommentsQueryPostByIdPostCommentsPostCommentsConnection includes the requested fields of the GraphQL type PostCommentsConnection.
+type postCommentsQueryPostByIdPostCommentsPostCommentsConnection struct {
+	PageInfo postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo                `json:"pageInfo"`
+	Edges    []*postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge `json:"edges"`
+}
+
+// GetPageInfo returns postCommentsQueryPostByIdPostCommentsPostCommentsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostCommentsPostCommentsConnection) GetPageInfo() postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns postCommentsQueryPostByIdPostCommentsPostCommentsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostCommentsPostCommentsConnection) GetEdges() []*postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge {
+	return v.Edges
+}
+
+// postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge includes the requested fields of the GraphQL type PostCommentEdge.
+type postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge struct {
+	Node *postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment `json:"node"`
+}
+
+// GetNode returns postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge.Node, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdge) GetNode() *postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment {
+	return v.Node
+}
+
+// postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment includes the requested fields of the GraphQL type Comment.
+type postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment.Dbid, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostCommentsPostCommentsConnectionEdgesPostCommentEdgeNodeComment) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo struct {
+	Total *int `json:"total"`
+}
+
+// GetTotal returns postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo.Total, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostCommentsPostCommentsConnectionPageInfo) GetTotal() *int {
+	return v.Total
+}
+
+// postCommentsQueryPostByIdPostInteractionsInteractionsConnection includes the requested fields of the GraphQL type InteractionsConnection.
+type postCommentsQueryPostByIdPostInteractionsInteractionsConnection struct {
+	PageInfo postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetPageInfo returns postCommentsQueryPostByIdPostInteractionsInteractionsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostInteractionsInteractionsConnection) GetPageInfo() postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo struct {
+	Total *int `json:"total"`
+}
+
+// GetTotal returns postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo.Total, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryPostByIdPostInteractionsInteractionsConnectionPageInfo) GetTotal() *int {
+	return v.Total
+}
+
+// postCommentsQueryPostByIdPostOrError includes the requested fields of the GraphQL interface PostOrError.
+//
+// postCommentsQueryPostByIdPostOrError is implemented by the following types:
+// postCommentsQueryPostByIdErrInvalidInput
+// postCommentsQueryPostByIdErrPostNotFound
+// postCommentsQueryPostByIdPost
+type postCommentsQueryPostByIdPostOrError interface {
+	implementsGraphQLInterfacepostCommentsQueryPostByIdPostOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *postCommentsQueryPostByIdErrInvalidInput) implementsGraphQLInterfacepostCommentsQueryPostByIdPostOrError() {
+}
+func (v *postCommentsQueryPostByIdErrPostNotFound) implementsGraphQLInterfacepostCommentsQueryPostByIdPostOrError() {
+}
+func (v *postCommentsQueryPostByIdPost) implementsGraphQLInterfacepostCommentsQueryPostByIdPostOrError() {
+}
+
+func __unmarshalpostCommentsQueryPostByIdPostOrError(b []byte, v *postCommentsQueryPostByIdPostOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrInvalidInput":
+		*v = new(postCommentsQueryPostByIdErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrPostNotFound":
+		*v = new(postCommentsQueryPostByIdErrPostNotFound)
+		return json.Unmarshal(b, *v)
+	case "Post":
+		*v = new(postCommentsQueryPostByIdPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PostOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for postCommentsQueryPostByIdPostOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalpostCommentsQueryPostByIdPostOrError(v *postCommentsQueryPostByIdPostOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *postCommentsQueryPostByIdErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*postCommentsQueryPostByIdErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *postCommentsQueryPostByIdErrPostNotFound:
+		typename = "ErrPostNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*postCommentsQueryPostByIdErrPostNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *notificationsForViewerQueryViewer:
-		typename = "Viewer"
+	case *postCommentsQueryPostByIdPost:
+		typename = "Post"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*notificationsForViewerQueryViewer
+			*postCommentsQueryPostByIdPost
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%T"`, v)
+			`unexpected concrete type for postCommentsQueryPostByIdPostOrError: "%T"`, v)
+	}
+}
+
+// postCommentsQueryResponse is returned by postCommentsQuery on success.
+type postCommentsQueryResponse struct {
+	PostById *postCommentsQueryPostByIdPostOrError `json:"-"`
+}
+
+// GetPostById returns postCommentsQueryResponse.PostById, and is useful for accessing the field via an interface.
+func (v *postCommentsQueryResponse) GetPostById() *postCommentsQueryPostByIdPostOrError {
+	return v.PostById
+}
+
+func (v *postCommentsQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*postCommentsQueryResponse
+		PostById json.RawMessage `json:"postById"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.postCommentsQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.PostById
+		src := firstPass.PostById
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(postCommentsQueryPostByIdPostOrError)
+			err = __unmarshalpostCommentsQueryPostByIdPostOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal postCommentsQueryResponse.PostById: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalpostCommentsQueryResponse struct {
+	PostById json.RawMessage `json:"postById"`
+}
+
+func (v *postCommentsQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *postCommentsQueryResponse) __premarshalJSON() (*__premarshalpostCommentsQueryResponse, error) {
+	var retval __premarshalpostCommentsQueryResponse
+
+	{
+
+		dst := &retval.PostById
+		src := v.PostById
+		if src != nil {
+			var err error
+			*dst, err = __marshalpostCommentsQueryPostByIdPostOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal postCommentsQueryResponse.PostById: %w", err)
+			}
+		}
 	}
+	return &retval, nil
 }
 
 // postTokensPostTokensErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
@@ -6806,70 +7757,327 @@ func (v *removeUserWalletsMutationResponse) GetRemoveUserWallets() *removeUserWa
 	return v.RemoveUserWallets
 }
 
-func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*removeUserWalletsMutationResponse
+		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.removeUserWalletsMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RemoveUserWallets
+		src := firstPass.RemoveUserWallets
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
+			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalremoveUserWalletsMutationResponse struct {
+	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+}
+
+func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
+	var retval __premarshalremoveUserWalletsMutationResponse
+
+	{
+
+		dst := &retval.RemoveUserWallets
+		src := v.RemoveUserWallets
+		if src != nil {
+			var err error
+			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// setPostCommentRestrictionMutationResponse is returned by setPostCommentRestrictionMutation on success.
+type setPostCommentRestrictionMutationResponse struct {
+	SetPostCommentRestriction *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError `json:"-"`
+}
+
+// GetSetPostCommentRestriction returns setPostCommentRestrictionMutationResponse.SetPostCommentRestriction, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationResponse) GetSetPostCommentRestriction() *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError {
+	return v.SetPostCommentRestriction
+}
+
+func (v *setPostCommentRestrictionMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*setPostCommentRestrictionMutationResponse
+		SetPostCommentRestriction json.RawMessage `json:"setPostCommentRestriction"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.setPostCommentRestrictionMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SetPostCommentRestriction
+		src := firstPass.SetPostCommentRestriction
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError)
+			err = __unmarshalsetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal setPostCommentRestrictionMutationResponse.SetPostCommentRestriction: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalsetPostCommentRestrictionMutationResponse struct {
+	SetPostCommentRestriction json.RawMessage `json:"setPostCommentRestriction"`
+}
+
+func (v *setPostCommentRestrictionMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *setPostCommentRestrictionMutationResponse) __premarshalJSON() (*__premarshalsetPostCommentRestrictionMutationResponse, error) {
+	var retval __premarshalsetPostCommentRestrictionMutationResponse
+
+	{
+
+		dst := &retval.SetPostCommentRestriction
+		src := v.SetPostCommentRestriction
+		if src != nil {
+			var err error
+			*dst, err = __marshalsetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal setPostCommentRestrictionMutationResponse.SetPostCommentRestriction: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed) GetMessage() string {
+	return v.Message
+}
+
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound includes the requested fields of the GraphQL type ErrPostNotFound.
+type setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound.Message, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound) GetMessage() string {
+	return v.Message
+}
+
+// setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload includes the requested fields of the GraphQL type SetPostCommentRestrictionPayload.
+type setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload struct {
+	Typename *string                                                                                         `json:"__typename"`
+	Post     *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost `json:"post"`
+}
+
+// GetTypename returns setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload.Typename, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetPost returns setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload.Post, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload) GetPost() *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost {
+	return v.Post
+}
+
+// setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError includes the requested fields of the GraphQL interface SetPostCommentRestrictionPayloadOrError.
+//
+// setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError is implemented by the following types:
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput
+// setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound
+// setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload
+type setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError interface {
+	implementsGraphQLInterfacesetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed) implementsGraphQLInterfacesetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError() {
+}
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput) implementsGraphQLInterfacesetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError() {
+}
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound) implementsGraphQLInterfacesetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError() {
+}
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload) implementsGraphQLInterfacesetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError() {
+}
+
+func __unmarshalsetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError(b []byte, v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrAuthenticationFailed":
+		*v = new(setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrPostNotFound":
+		*v = new(setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound)
+		return json.Unmarshal(b, *v)
+	case "SetPostCommentRestrictionPayload":
+		*v = new(setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SetPostCommentRestrictionPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalsetPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError(v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
 
-	if string(b) == "null" {
-		return nil
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*setPostCommentRestrictionMutationSetPostCommentRestrictionErrAuthenticationFailed
+		}{typename, v}
+		return json.Marshal(result)
+	case *setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput:
+		typename = "ErrInvalidInput"
 
-	var firstPass struct {
-		*removeUserWalletsMutationResponse
-		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.removeUserWalletsMutationResponse = v
+		result := struct {
+			TypeName string `json:"__typename"`
+			*setPostCommentRestrictionMutationSetPostCommentRestrictionErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound:
+		typename = "ErrPostNotFound"
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*setPostCommentRestrictionMutationSetPostCommentRestrictionErrPostNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload:
+		typename = "SetPostCommentRestrictionPayload"
 
-	{
-		dst := &v.RemoveUserWallets
-		src := firstPass.RemoveUserWallets
-		if len(src) != 0 && string(src) != "null" {
-			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
-			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
-				src, *dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
-			}
-		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadOrError: "%T"`, v)
 	}
-	return nil
 }
 
-type __premarshalremoveUserWalletsMutationResponse struct {
-	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+// setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost includes the requested fields of the GraphQL type Post.
+type setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost struct {
+	Dbid               persist.DBID       `json:"dbid"`
+	CommentRestriction CommentRestriction `json:"commentRestriction"`
 }
 
-func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetDbid returns setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost.Dbid, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost) GetDbid() persist.DBID {
+	return v.Dbid
 }
 
-func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
-	var retval __premarshalremoveUserWalletsMutationResponse
-
-	{
-
-		dst := &retval.RemoveUserWallets
-		src := v.RemoveUserWallets
-		if src != nil {
-			var err error
-			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
-				src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
-			}
-		}
-	}
-	return &retval, nil
+// GetCommentRestriction returns setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost.CommentRestriction, and is useful for accessing the field via an interface.
+func (v *setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayloadPost) GetCommentRestriction() CommentRestriction {
+	return v.CommentRestriction
 }
 
 // syncTokensMutationResponse is returned by syncTokensMutation on success.
@@ -6960,6 +8168,18 @@ func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetTypename() *string { r
 // GetMessage returns syncTokensMutationSyncTokensErrNotAuthorized.Message, and is useful for accessing the field via an interface.
 func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetMessage() string { return v.Message }
 
+// syncTokensMutationSyncTokensErrRateLimited includes the requested fields of the GraphQL type ErrRateLimited.
+type syncTokensMutationSyncTokensErrRateLimited struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns syncTokensMutationSyncTokensErrRateLimited.Typename, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrRateLimited) GetTypename() *string { return v.Typename }
+
+// GetMessage returns syncTokensMutationSyncTokensErrRateLimited.Message, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrRateLimited) GetMessage() string { return v.Message }
+
 // syncTokensMutationSyncTokensErrSyncFailed includes the requested fields of the GraphQL type ErrSyncFailed.
 type syncTokensMutationSyncTokensErrSyncFailed struct {
 	Typename *string `json:"__typename"`
@@ -6990,6 +8210,7 @@ func (v *syncTokensMutationSyncTokensSyncTokensPayload) GetViewer() *syncTokensM
 //
 // syncTokensMutationSyncTokensSyncTokensPayloadOrError is implemented by the following types:
 // syncTokensMutationSyncTokensErrNotAuthorized
+// syncTokensMutationSyncTokensErrRateLimited
 // syncTokensMutationSyncTokensErrSyncFailed
 // syncTokensMutationSyncTokensSyncTokensPayload
 type syncTokensMutationSyncTokensSyncTokensPayloadOrError interface {
@@ -7000,6 +8221,8 @@ type syncTokensMutationSyncTokensSyncTokensPayloadOrError interface {
 
 func (v *syncTokensMutationSyncTokensErrNotAuthorized) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
 }
+func (v *syncTokensMutationSyncTokensErrRateLimited) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
+}
 func (v *syncTokensMutationSyncTokensErrSyncFailed) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
 }
 func (v *syncTokensMutationSyncTokensSyncTokensPayload) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
@@ -7022,6 +8245,9 @@ func __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(b []byte, v
 	case "ErrNotAuthorized":
 		*v = new(syncTokensMutationSyncTokensErrNotAuthorized)
 		return json.Unmarshal(b, *v)
+	case "ErrRateLimited":
+		*v = new(syncTokensMutationSyncTokensErrRateLimited)
+		return json.Unmarshal(b, *v)
 	case "ErrSyncFailed":
 		*v = new(syncTokensMutationSyncTokensErrSyncFailed)
 		return json.Unmarshal(b, *v)
@@ -7049,6 +8275,14 @@ func __marshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(v *syncTokens
 			*syncTokensMutationSyncTokensErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
+	case *syncTokensMutationSyncTokensErrRateLimited:
+		typename = "ErrRateLimited"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*syncTokensMutationSyncTokensErrRateLimited
+		}{typename, v}
+		return json.Marshal(result)
 	case *syncTokensMutationSyncTokensErrSyncFailed:
 		typename = "ErrSyncFailed"
 
@@ -8044,6 +9278,7 @@ func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
 // trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
 // trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
 // trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodePost
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost
 // The GraphQL type's documentation follows.
 //
 // Can return posts as well
@@ -8063,6 +9298,8 @@ func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
 }
 func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodePost) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
 
 func __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
 	if string(b) == "null" {
@@ -8093,6 +9330,9 @@ func __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeed
 	case "Post":
 		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodePost)
 		return json.Unmarshal(b, *v)
+	case "Repost":
+		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing FeedEventOrError.__typename")
@@ -8146,6 +9386,14 @@ func __marshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEv
 			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodePost
 		}{typename, v}
 		return json.Marshal(result)
+	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost:
+		typename = "Repost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -8170,6 +9418,16 @@ func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodePost) GetDb
 	return v.Dbid
 }
 
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost includes the requested fields of the GraphQL type Repost.
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost.Typename, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeRepost) GetTypename() *string {
+	return v.Typename
+}
+
 // trendingUsersQueryResponse is returned by trendingUsersQuery on success.
 type trendingUsersQueryResponse struct {
 	TrendingUsers *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError `json:"-"`
@@ -11421,6 +12679,7 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodePost
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost
 // The GraphQL type's documentation follows.
 //
 // Can return posts as well
@@ -11440,6 +12699,8 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 }
 func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodePost) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
 
 func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
 	if string(b) == "null" {
@@ -11470,6 +12731,9 @@ func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeN
 	case "Post":
 		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodePost)
 		return json.Unmarshal(b, *v)
+	case "Repost":
+		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing FeedEventOrError.__typename")
@@ -11527,6 +12791,14 @@ func __marshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNod
 			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodePost
 		}{typename, v}
 		return json.Marshal(result)
+	case *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost:
+		typename = "Repost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -11545,6 +12817,16 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodePost
 	return v.Typename
 }
 
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost includes the requested fields of the GraphQL type Repost.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeRepost) GetTypename() *string {
+	return v.Typename
+}
+
 // viewerQueryViewerUserGalleryUserSocialAccounts includes the requested fields of the GraphQL type SocialAccounts.
 type viewerQueryViewerUserGalleryUserSocialAccounts struct {
 	Twitter *viewerQueryViewerUserGalleryUserSocialAccountsTwitterTwitterSocialAccount `json:"twitter"`
@@ -11929,6 +13211,9 @@ mutation commentOnPostMutation ($postId: DBID!, $comment: String!) {
 			post {
 				dbid
 			}
+			comment {
+				dbid
+			}
 		}
 	}
 }
@@ -12312,6 +13597,50 @@ func disconnectSocialAccount(
 	return &data_, err_
 }
 
+// The query or mutation executed by followUserMutation.
+const followUserMutation_Operation = `
+mutation followUserMutation ($userId: DBID!) {
+	followUser(userId: $userId) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on FollowUserPayload {
+			user {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func followUserMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	userId persist.DBID,
+) (*followUserMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "followUserMutation",
+		Query:  followUserMutation_Operation,
+		Variables: &__followUserMutationInput{
+			UserId: userId,
+		},
+	}
+	var err_ error
+
+	var data_ followUserMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getAuthNonceMutation.
 const getAuthNonceMutation_Operation = `
 mutation getAuthNonceMutation {
@@ -12398,6 +13727,50 @@ func globalFeedQuery(
 	return &data_, err_
 }
 
+// The query or mutation executed by hideCommentMutation.
+const hideCommentMutation_Operation = `
+mutation hideCommentMutation ($commentId: DBID!) {
+	hideComment(commentId: $commentId) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on HideCommentPayload {
+			comment {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func hideCommentMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	commentId persist.DBID,
+) (*hideCommentMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "hideCommentMutation",
+		Query:  hideCommentMutation_Operation,
+		Variables: &__hideCommentMutationInput{
+			CommentId: commentId,
+		},
+	}
+	var err_ error
+
+	var data_ hideCommentMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by loginMutation.
 const loginMutation_Operation = `
 mutation loginMutation ($authMechanism: AuthMechanism!) {
@@ -12574,6 +13947,63 @@ func notificationsForViewerQuery(
 	return &data_, err_
 }
 
+// The query or mutation executed by postCommentsQuery.
+const postCommentsQuery_Operation = `
+query postCommentsQuery ($id: DBID!) {
+	postById(id: $id) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on Post {
+			totalComments
+			comments(first: 10) {
+				pageInfo {
+					total
+				}
+				edges {
+					node {
+						dbid
+					}
+				}
+			}
+			interactions(first: 10) {
+				pageInfo {
+					total
+				}
+			}
+		}
+	}
+}
+`
+
+func postCommentsQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id persist.DBID,
+) (*postCommentsQueryResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "postCommentsQuery",
+		Query:  postCommentsQuery_Operation,
+		Variables: &__postCommentsQueryInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ postCommentsQueryResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by postTokens.
 const postTokens_Operation = `
 mutation postTokens ($input: PostTokensInput!) {
@@ -12756,6 +14186,53 @@ func removeUserWalletsMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by setPostCommentRestrictionMutation.
+const setPostCommentRestrictionMutation_Operation = `
+mutation setPostCommentRestrictionMutation ($postId: DBID!, $restriction: CommentRestriction!) {
+	setPostCommentRestriction(postId: $postId, restriction: $restriction) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on SetPostCommentRestrictionPayload {
+			post {
+				dbid
+				commentRestriction
+			}
+		}
+	}
+}
+`
+
+func setPostCommentRestrictionMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	postId persist.DBID,
+	restriction CommentRestriction,
+) (*setPostCommentRestrictionMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "setPostCommentRestrictionMutation",
+		Query:  setPostCommentRestrictionMutation_Operation,
+		Variables: &__setPostCommentRestrictionMutationInput{
+			PostId:      postId,
+			Restriction: restriction,
+		},
+	}
+	var err_ error
+
+	var data_ setPostCommentRestrictionMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by syncTokensMutation.
 const syncTokensMutation_Operation = `
 mutation syncTokensMutation ($chains: [Chain!], $incrementally: Boolean) {
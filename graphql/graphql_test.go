@@ -79,6 +79,8 @@ func testGraphQL(t *testing.T) {
 		{title: "should get trending users", run: testTrendingUsers, fixtures: []fixture{usePostgres, useRedis}},
 		{title: "should get trending feed events", run: testTrendingFeedEvents},
 		{title: "should delete a post", run: testDeletePost},
+		{title: "should restrict who can comment on a post", run: testCommentRestriction},
+		{title: "hidden comments should only be visible to their authors", run: testHiddenComments},
 		{title: "should get community with posts", run: testGetCommunity},
 		{title: "should delete collection in gallery update", run: testUpdateGalleryDeleteCollection},
 		{title: "should update user experiences", run: testUpdateUserExperiences},
@@ -716,6 +718,70 @@ func testDeletePost(t *testing.T) {
 	assert.False(t, util.Contains(actual, userF.PostIDs[0]))
 }
 
+func testCommentRestriction(t *testing.T) {
+	ctx := context.Background()
+	author := newUserWithTokensFixture(t)
+	authorC := authedHandlerClient(t, author.ID)
+	postID := createPost(t, ctx, authorC, PostTokensInput{TokenIds: author.TokenIDs})
+
+	follower := newUserFixture(t)
+	followerC := authedHandlerClient(t, follower.ID)
+	followUser(t, ctx, followerC, author.ID)
+
+	mutual := newUserFixture(t)
+	mutualC := authedHandlerClient(t, mutual.ID)
+	followUser(t, ctx, mutualC, author.ID)
+	followUser(t, ctx, authorC, mutual.ID)
+
+	stranger := newUserFixture(t)
+	strangerC := authedHandlerClient(t, stranger.ID)
+
+	commentOnPost(t, ctx, strangerC, postID, "everyone")
+
+	setPostCommentRestriction(t, ctx, authorC, postID, CommentRestrictionFollowers)
+	commentOnPost(t, ctx, followerC, postID, "followers")
+	commentOnPostRestricted(t, ctx, strangerC, postID)
+
+	setPostCommentRestriction(t, ctx, authorC, postID, CommentRestrictionMutuals)
+	commentOnPost(t, ctx, mutualC, postID, "mutuals")
+	commentOnPostRestricted(t, ctx, followerC, postID)
+
+	setPostCommentRestriction(t, ctx, authorC, postID, CommentRestrictionNobody)
+	commentOnPostRestricted(t, ctx, mutualC, postID)
+	commentOnPost(t, ctx, authorC, postID, "nobody")
+}
+
+func testHiddenComments(t *testing.T) {
+	ctx := context.Background()
+	author := newUserWithTokensFixture(t)
+	authorC := authedHandlerClient(t, author.ID)
+	postID := createPost(t, ctx, authorC, PostTokensInput{TokenIds: author.TokenIDs})
+
+	alice := newUserFixture(t)
+	aliceC := authedHandlerClient(t, alice.ID)
+	hiddenID := commentOnPost(t, ctx, aliceC, postID, "hidden")
+
+	bob := newUserFixture(t)
+	bobC := authedHandlerClient(t, bob.ID)
+	visibleID := commentOnPost(t, ctx, bobC, postID, "visible")
+
+	hideComment(t, ctx, authorC, hiddenID)
+
+	ids, total, totalComments, totalInteractions := postComments(t, ctx, aliceC, postID)
+	assert.ElementsMatch(t, []persist.DBID{hiddenID, visibleID}, ids)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 2, totalComments)
+	assert.Equal(t, 2, totalInteractions)
+
+	for _, c := range []genql.Client{bobC, authorC, defaultHandlerClient(t)} {
+		ids, total, totalComments, totalInteractions := postComments(t, ctx, c, postID)
+		assert.Equal(t, []persist.DBID{visibleID}, ids)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, 1, totalComments)
+		assert.Equal(t, 1, totalInteractions)
+	}
+}
+
 func testGetCommunity(t *testing.T) {
 	ctx := context.Background()
 	userF := newUserWithFeedEntitiesFixture(t)
@@ -1528,11 +1594,57 @@ func admireComment(t *testing.T, ctx context.Context, c genql.Client, commentID
 }
 
 // commentOnPost makes a GraphQL request to comment on a post
-func commentOnPost(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID, comment string) {
+func commentOnPost(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID, comment string) persist.DBID {
 	t.Helper()
 	resp, err := commentOnPostMutation(ctx, c, postID, comment)
 	require.NoError(t, err)
-	_ = (*resp.CommentOnPost).(*commentOnPostMutationCommentOnPostCommentOnPostPayload)
+	return (*resp.CommentOnPost).(*commentOnPostMutationCommentOnPostCommentOnPostPayload).Comment.Dbid
+}
+
+// commentOnPostRestricted makes a GraphQL request to comment on a post that the user isn't allowed to comment on
+func commentOnPostRestricted(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID) {
+	t.Helper()
+	resp, err := commentOnPostMutation(ctx, c, postID, "restricted")
+	require.NoError(t, err)
+	_ = (*resp.CommentOnPost).(*commentOnPostMutationCommentOnPostErrCommentsRestricted)
+}
+
+// setPostCommentRestriction makes a GraphQL request to set who can comment on a post
+func setPostCommentRestriction(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID, restriction CommentRestriction) {
+	t.Helper()
+	resp, err := setPostCommentRestrictionMutation(ctx, c, postID, restriction)
+	require.NoError(t, err)
+	payload := (*resp.SetPostCommentRestriction).(*setPostCommentRestrictionMutationSetPostCommentRestrictionSetPostCommentRestrictionPayload)
+	require.Equal(t, restriction, payload.Post.CommentRestriction)
+}
+
+// hideComment makes a GraphQL request to hide a comment
+func hideComment(t *testing.T, ctx context.Context, c genql.Client, commentID persist.DBID) {
+	t.Helper()
+	resp, err := hideCommentMutation(ctx, c, commentID)
+	require.NoError(t, err)
+	_ = (*resp.HideComment).(*hideCommentMutationHideCommentHideCommentPayload)
+}
+
+// followUser makes a GraphQL request to follow a user
+func followUser(t *testing.T, ctx context.Context, c genql.Client, userID persist.DBID) {
+	t.Helper()
+	resp, err := followUserMutation(ctx, c, userID)
+	require.NoError(t, err)
+	_ = (*resp.FollowUser).(*followUserMutationFollowUserFollowUserPayload)
+}
+
+// postComments makes a GraphQL request to return the comments on a post, the connection's total, and the post's total comments
+func postComments(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID) ([]persist.DBID, int, int, int) {
+	t.Helper()
+	resp, err := postCommentsQuery(ctx, c, postID)
+	require.NoError(t, err)
+	post := (*resp.PostById).(*postCommentsQueryPostByIdPost)
+	ids := make([]persist.DBID, len(post.Comments.Edges))
+	for i, edge := range post.Comments.Edges {
+		ids[i] = edge.Node.Dbid
+	}
+	return ids, util.FromPointer(post.Comments.PageInfo.Total), util.FromPointer(post.TotalComments), util.FromPointer(post.Interactions.PageInfo.Total)
 }
 
 func deletePost(t *testing.T, ctx context.Context, c genql.Client, postID persist.DBID) {
@@ -1630,6 +1742,7 @@ func handlerWithProviders(t *testing.T, submitter tokenmanage.Submitter, p multi
 	authRefreshCache := redis.NewCache(redis.AuthTokenForceRefreshCache)
 	tokenManageCache := redis.NewCache(redis.TokenManageCache)
 	oneTimeLoginCache := redis.NewCache(redis.OneTimeLoginCache)
+	suspensionCache := redis.NewCache(redis.SuspensionCache)
 	mintLimiter := limiters.NewKeyRateLimiter(ctx, redis.NewCache(redis.MintCache), "inAppMinting", 1, time.Minute*10)
 
 	publicapiF := func(ctx context.Context, disableDataloaderCaching bool) *publicapi.PublicAPI {
@@ -1642,7 +1755,7 @@ func handlerWithProviders(t *testing.T, submitter tokenmanage.Submitter, p multi
 			c.EthClient,
 			c.IPFSClient,
 			c.ArweaveClient,
-			c.StorageClient,
+			c.ObjectStore,
 			c.TaskClient,
 			nil, // throttler
 			c.SecretClient,
@@ -1652,6 +1765,7 @@ func handlerWithProviders(t *testing.T, submitter tokenmanage.Submitter, p multi
 			authRefreshCache,
 			tokenManageCache,  // tokenmanageCache
 			oneTimeLoginCache, // oneTimeLoginCache
+			suspensionCache,   // suspensionCache
 			c.MagicLinkClient,
 			nil,         // neynar
 			mintLimiter, // mintLimiter
@@ -80,6 +80,14 @@ func (r *Post) ID() GqlID {
 	return GqlID(fmt.Sprintf("Post:%s", r.Dbid))
 }
 
+func (r *Repost) ID() GqlID {
+	return GqlID(fmt.Sprintf("Repost:%s", r.Dbid))
+}
+
+func (r *ScheduledPost) ID() GqlID {
+	return GqlID(fmt.Sprintf("ScheduledPost:%s", r.Dbid))
+}
+
 func (r *SocialConnection) ID() GqlID {
 	return GqlID(fmt.Sprintf("SocialConnection:%s:%s", r.SocialID, r.SocialType))
 }
@@ -132,6 +140,10 @@ func (r *SomeoneRepliedToYourCommentNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("SomeoneRepliedToYourCommentNotification:%s", r.Dbid))
 }
 
+func (r *SomeoneRepostedYourPostNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SomeoneRepostedYourPostNotification:%s", r.Dbid))
+}
+
 func (r *SomeoneViewedYourGalleryNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("SomeoneViewedYourGalleryNotification:%s", r.Dbid))
 }
@@ -169,6 +181,10 @@ func (r *Wallet) ID() GqlID {
 	return GqlID(fmt.Sprintf("Wallet:%s", r.Dbid))
 }
 
+func (r *Webhook) ID() GqlID {
+	return GqlID(fmt.Sprintf("Webhook:%s", r.Dbid))
+}
+
 func (r *YouReceivedTopActivityBadgeNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("YouReceivedTopActivityBadgeNotification:%s", r.Dbid))
 }
@@ -189,6 +205,8 @@ type NodeFetcher struct {
 	OnMerchToken                                       func(ctx context.Context, tokenId string) (*MerchToken, error)
 	OnNewTokensNotification                            func(ctx context.Context, dbid persist.DBID) (*NewTokensNotification, error)
 	OnPost                                             func(ctx context.Context, dbid persist.DBID) (*Post, error)
+	OnRepost                                           func(ctx context.Context, dbid persist.DBID) (*Repost, error)
+	OnScheduledPost                                    func(ctx context.Context, dbid persist.DBID) (*ScheduledPost, error)
 	OnSocialConnection                                 func(ctx context.Context, socialId string, socialType persist.SocialProvider) (*SocialConnection, error)
 	OnSomeoneAdmiredYourCommentNotification            func(ctx context.Context, dbid persist.DBID) (*SomeoneAdmiredYourCommentNotification, error)
 	OnSomeoneAdmiredYourFeedEventNotification          func(ctx context.Context, dbid persist.DBID) (*SomeoneAdmiredYourFeedEventNotification, error)
@@ -202,6 +220,7 @@ type NodeFetcher struct {
 	OnSomeoneMentionedYourCommunityNotification        func(ctx context.Context, dbid persist.DBID) (*SomeoneMentionedYourCommunityNotification, error)
 	OnSomeonePostedYourWorkNotification                func(ctx context.Context, dbid persist.DBID) (*SomeonePostedYourWorkNotification, error)
 	OnSomeoneRepliedToYourCommentNotification          func(ctx context.Context, dbid persist.DBID) (*SomeoneRepliedToYourCommentNotification, error)
+	OnSomeoneRepostedYourPostNotification              func(ctx context.Context, dbid persist.DBID) (*SomeoneRepostedYourPostNotification, error)
 	OnSomeoneViewedYourGalleryNotification             func(ctx context.Context, dbid persist.DBID) (*SomeoneViewedYourGalleryNotification, error)
 	OnSomeoneYouFollowOnFarcasterJoinedNotification    func(ctx context.Context, dbid persist.DBID) (*SomeoneYouFollowOnFarcasterJoinedNotification, error)
 	OnSomeoneYouFollowPostedTheirFirstPostNotification func(ctx context.Context, dbid persist.DBID) (*SomeoneYouFollowPostedTheirFirstPostNotification, error)
@@ -209,6 +228,7 @@ type NodeFetcher struct {
 	OnTokenDefinition                                  func(ctx context.Context, dbid persist.DBID) (*TokenDefinition, error)
 	OnViewer                                           func(ctx context.Context, userId string) (*Viewer, error)
 	OnWallet                                           func(ctx context.Context, dbid persist.DBID) (*Wallet, error)
+	OnWebhook                                          func(ctx context.Context, dbid persist.DBID) (*Webhook, error)
 	OnYouReceivedTopActivityBadgeNotification          func(ctx context.Context, dbid persist.DBID) (*YouReceivedTopActivityBadgeNotification, error)
 }
 
@@ -297,6 +317,16 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Post' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnPost(ctx, persist.DBID(ids[0]))
+	case "Repost":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Repost' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnRepost(ctx, persist.DBID(ids[0]))
+	case "ScheduledPost":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'ScheduledPost' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnScheduledPost(ctx, persist.DBID(ids[0]))
 	case "SocialConnection":
 		if len(ids) != 2 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SocialConnection' type requires 2 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -362,6 +392,11 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneRepliedToYourCommentNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnSomeoneRepliedToYourCommentNotification(ctx, persist.DBID(ids[0]))
+	case "SomeoneRepostedYourPostNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneRepostedYourPostNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSomeoneRepostedYourPostNotification(ctx, persist.DBID(ids[0]))
 	case "SomeoneViewedYourGalleryNotification":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneViewedYourGalleryNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -397,6 +432,11 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Wallet' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnWallet(ctx, persist.DBID(ids[0]))
+	case "Webhook":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Webhook' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnWebhook(ctx, persist.DBID(ids[0]))
 	case "YouReceivedTopActivityBadgeNotification":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'YouReceivedTopActivityBadgeNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -439,6 +479,10 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnNewTokensNotification")
 	case n.OnPost == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnPost")
+	case n.OnRepost == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnRepost")
+	case n.OnScheduledPost == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnScheduledPost")
 	case n.OnSocialConnection == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSocialConnection")
 	case n.OnSomeoneAdmiredYourCommentNotification == nil:
@@ -465,6 +509,8 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeonePostedYourWorkNotification")
 	case n.OnSomeoneRepliedToYourCommentNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneRepliedToYourCommentNotification")
+	case n.OnSomeoneRepostedYourPostNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneRepostedYourPostNotification")
 	case n.OnSomeoneViewedYourGalleryNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneViewedYourGalleryNotification")
 	case n.OnSomeoneYouFollowOnFarcasterJoinedNotification == nil:
@@ -479,6 +525,8 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnViewer")
 	case n.OnWallet == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnWallet")
+	case n.OnWebhook == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnWebhook")
 	case n.OnYouReceivedTopActivityBadgeNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnYouReceivedTopActivityBadgeNotification")
 	}
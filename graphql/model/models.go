@@ -115,6 +115,12 @@ type HelperSomeoneCommentedOnYourPostNotificationData struct {
 	NotificationData persist.NotificationData
 }
 
+type HelperSomeoneRepostedYourPostNotificationData struct {
+	OwnerID          persist.DBID
+	PostID           persist.DBID
+	NotificationData persist.NotificationData
+}
+
 type HelperSomeoneAdmiredYourFeedEventNotificationData struct {
 	OwnerID          persist.DBID
 	FeedEventID      persist.DBID
@@ -168,6 +174,25 @@ type HelperSomeoneYouFollowPostedTheirFirstPostNotificationData struct {
 	PostID persist.DBID
 }
 
+type HelperScheduledPostData struct {
+	TokenIDs persist.DBIDList
+	AuthorID persist.DBID
+}
+
+type HelperRepostData struct {
+	AuthorID persist.DBID
+	PostID   persist.DBID
+}
+
+type HelperWebhookData struct {
+	CommunityID persist.DBID
+}
+
+type HelperMuteData struct {
+	MutedUserID      persist.DBID
+	MutedCommunityID persist.DBID
+}
+
 type HelperNotificationsConnectionData struct {
 	UserId persist.DBID
 }
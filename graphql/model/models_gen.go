@@ -55,6 +55,10 @@ type BlockUserPayloadOrError interface {
 	IsBlockUserPayloadOrError()
 }
 
+type CancelScheduledPostPayloadOrError interface {
+	IsCancelScheduledPostPayloadOrError()
+}
+
 type CollectionByIDOrError interface {
 	IsCollectionByIDOrError()
 }
@@ -107,6 +111,10 @@ type CreateUserPayloadOrError interface {
 	IsCreateUserPayloadOrError()
 }
 
+type CreateWebhookPayloadOrError interface {
+	IsCreateWebhookPayloadOrError()
+}
+
 type DeleteCollectionPayloadOrError interface {
 	IsDeleteCollectionPayloadOrError()
 }
@@ -119,6 +127,14 @@ type DeletePostPayloadOrError interface {
 	IsDeletePostPayloadOrError()
 }
 
+type DeleteRepostPayloadOrError interface {
+	IsDeleteRepostPayloadOrError()
+}
+
+type DeleteWebhookPayloadOrError interface {
+	IsDeleteWebhookPayloadOrError()
+}
+
 type DisconnectSocialAccountPayloadOrError interface {
 	IsDisconnectSocialAccountPayloadOrError()
 }
@@ -148,6 +164,10 @@ type FollowAllSocialConnectionsPayloadOrError interface {
 	IsFollowAllSocialConnectionsPayloadOrError()
 }
 
+type FollowTopicPayloadOrError interface {
+	IsFollowTopicPayloadOrError()
+}
+
 type FollowUserPayloadOrError interface {
 	IsFollowUserPayloadOrError()
 }
@@ -164,6 +184,10 @@ type GalleryUserOrWallet interface {
 	IsGalleryUserOrWallet()
 }
 
+type GalleryVersionsPayloadOrError interface {
+	IsGalleryVersionsPayloadOrError()
+}
+
 type GenerateQRCodeLoginTokenPayloadOrError interface {
 	IsGenerateQRCodeLoginTokenPayloadOrError()
 }
@@ -178,6 +202,10 @@ type GroupedNotification interface {
 	IsGroupedNotification()
 }
 
+type HideCommentPayloadOrError interface {
+	IsHideCommentPayloadOrError()
+}
+
 type HighlightClaimMintPayloadOrError interface {
 	IsHighlightClaimMintPayloadOrError()
 }
@@ -218,10 +246,34 @@ type MintPremiumCardToWalletPayloadOrError interface {
 	IsMintPremiumCardToWalletPayloadOrError()
 }
 
+type ModeratePayloadOrError interface {
+	IsModeratePayloadOrError()
+}
+
+type ModerationActionsPayloadOrError interface {
+	IsModerationActionsPayloadOrError()
+}
+
+type ModerationQueuePayloadOrError interface {
+	IsModerationQueuePayloadOrError()
+}
+
 type MoveCollectionToGalleryPayloadOrError interface {
 	IsMoveCollectionToGalleryPayloadOrError()
 }
 
+type MuteCommunityPayloadOrError interface {
+	IsMuteCommunityPayloadOrError()
+}
+
+type MuteKeywordPayloadOrError interface {
+	IsMuteKeywordPayloadOrError()
+}
+
+type MuteUserPayloadOrError interface {
+	IsMuteUserPayloadOrError()
+}
+
 type Node interface {
 	IsNode()
 }
@@ -307,18 +359,50 @@ type RemoveUserWalletsPayloadOrError interface {
 	IsRemoveUserWalletsPayloadOrError()
 }
 
+type ReplayTokenProcessingFailuresPayloadOrError interface {
+	IsReplayTokenProcessingFailuresPayloadOrError()
+}
+
+type ReportCommentPayloadOrError interface {
+	IsReportCommentPayloadOrError()
+}
+
+type ReportGalleryPayloadOrError interface {
+	IsReportGalleryPayloadOrError()
+}
+
 type ReportPostPayloadOrError interface {
 	IsReportPostPayloadOrError()
 }
 
+type ReportUserPayloadOrError interface {
+	IsReportUserPayloadOrError()
+}
+
+type RepostPostPayloadOrError interface {
+	IsRepostPostPayloadOrError()
+}
+
 type ResendVerificationEmailPayloadOrError interface {
 	IsResendVerificationEmailPayloadOrError()
 }
 
+type RestoreGalleryVersionPayloadOrError interface {
+	IsRestoreGalleryVersionPayloadOrError()
+}
+
+type RevokeModerationActionPayloadOrError interface {
+	IsRevokeModerationActionPayloadOrError()
+}
+
 type RevokeRolesFromUserPayloadOrError interface {
 	IsRevokeRolesFromUserPayloadOrError()
 }
 
+type SchedulePostPayloadOrError interface {
+	IsSchedulePostPayloadOrError()
+}
+
 type SearchCommunitiesPayloadOrError interface {
 	IsSearchCommunitiesPayloadOrError()
 }
@@ -339,6 +423,10 @@ type SetPersonaPayloadOrError interface {
 	IsSetPersonaPayloadOrError()
 }
 
+type SetPostCommentRestrictionPayloadOrError interface {
+	IsSetPostCommentRestrictionPayloadOrError()
+}
+
 type SetProfileImagePayloadOrError interface {
 	IsSetProfileImagePayloadOrError()
 }
@@ -383,10 +471,26 @@ type SyncTokensPayloadOrError interface {
 	IsSyncTokensPayloadOrError()
 }
 
+type TestWebhookPayloadOrError interface {
+	IsTestWebhookPayloadOrError()
+}
+
 type TokenByIDOrError interface {
 	IsTokenByIDOrError()
 }
 
+type TokenProcessingFailuresPayloadOrError interface {
+	IsTokenProcessingFailuresPayloadOrError()
+}
+
+type TopicOrError interface {
+	IsTopicOrError()
+}
+
+type TrendingTopicsPayloadOrError interface {
+	IsTrendingTopicsPayloadOrError()
+}
+
 type TrendingUsersPayloadOrError interface {
 	IsTrendingUsersPayloadOrError()
 }
@@ -403,6 +507,10 @@ type UnfollowUserPayloadOrError interface {
 	IsUnfollowUserPayloadOrError()
 }
 
+type UnmutePayloadOrError interface {
+	IsUnmutePayloadOrError()
+}
+
 type UnregisterUserPushTokenPayloadOrError interface {
 	IsUnregisterUserPushTokenPayloadOrError()
 }
@@ -455,10 +563,18 @@ type UpdateGalleryPayloadOrError interface {
 	IsUpdateGalleryPayloadOrError()
 }
 
+type UpdatePostPayloadOrError interface {
+	IsUpdatePostPayloadOrError()
+}
+
 type UpdatePrimaryWalletPayloadOrError interface {
 	IsUpdatePrimaryWalletPayloadOrError()
 }
 
+type UpdateScheduledPostPayloadOrError interface {
+	IsUpdateScheduledPostPayloadOrError()
+}
+
 type UpdateSocialAccountDisplayedPayloadOrError interface {
 	IsUpdateSocialAccountDisplayedPayloadOrError()
 }
@@ -475,6 +591,10 @@ type UpdateUserInfoPayloadOrError interface {
 	IsUpdateUserInfoPayloadOrError()
 }
 
+type UpdateWebhookPayloadOrError interface {
+	IsUpdateWebhookPayloadOrError()
+}
+
 type UploadPersistedQueriesPayloadOrError interface {
 	IsUploadPersistedQueriesPayloadOrError()
 }
@@ -606,6 +726,9 @@ type AudioMedia struct {
 	MediaType        *string          `json:"mediaType"`
 	ContentRenderURL *string          `json:"contentRenderURL"`
 	Dimensions       *MediaDimensions `json:"dimensions"`
+	DurationSeconds  *float64         `json:"durationSeconds"`
+	Bitrate          *int             `json:"bitrate"`
+	WaveformURL      *string          `json:"waveformURL"`
 	FallbackMedia    *FallbackMedia   `json:"fallbackMedia"`
 }
 
@@ -647,6 +770,12 @@ type BlockUserPayload struct {
 
 func (BlockUserPayload) IsBlockUserPayloadOrError() {}
 
+type CancelScheduledPostPayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (CancelScheduledPostPayload) IsCancelScheduledPostPayloadOrError() {}
+
 type ChainAddressTokenInput struct {
 	ChainAddress *persist.ChainAddress `json:"chainAddress"`
 	// Refers to the id of the token in the contract either in decimal, or interpreted as hexadecimal when prefixed with '0x'
@@ -783,6 +912,7 @@ type Comment struct {
 	Replies      *CommentsConnection       `json:"replies"`
 	Source       CommentSource             `json:"source"`
 	Deleted      *bool                     `json:"deleted"`
+	Hidden       *bool                     `json:"hidden"`
 	ViewerAdmire *Admire                   `json:"viewerAdmire"`
 	Admires      *CommentAdmiresConnection `json:"admires"`
 }
@@ -909,6 +1039,7 @@ type Contract struct {
 	BadgeURL         *string               `json:"badgeURL"`
 	MintURL          *string               `json:"mintURL"`
 	IsSpam           *bool                 `json:"isSpam"`
+	SpamScore        *SpamScore            `json:"spamScore"`
 }
 
 func (Contract) IsNode() {}
@@ -986,6 +1117,19 @@ type CreateUserPayload struct {
 
 func (CreateUserPayload) IsCreateUserPayloadOrError() {}
 
+type CreateWebhookInput struct {
+	URL         string             `json:"url"`
+	EventTypes  []WebhookEventType `json:"eventTypes"`
+	CommunityID *persist.DBID      `json:"communityId"`
+}
+
+type CreateWebhookPayload struct {
+	Webhook *Webhook `json:"webhook"`
+	Secret  string   `json:"secret"`
+}
+
+func (CreateWebhookPayload) IsCreateWebhookPayloadOrError() {}
+
 type CreatedCommunitiesInput struct {
 	IncludeChains []persist.Chain `json:"includeChains"`
 }
@@ -1022,6 +1166,18 @@ type DeletePostPayload struct {
 
 func (DeletePostPayload) IsDeletePostPayloadOrError() {}
 
+type DeleteRepostPayload struct {
+	DeletedID *DeletedNode `json:"deletedId"`
+}
+
+func (DeleteRepostPayload) IsDeleteRepostPayloadOrError() {}
+
+type DeleteWebhookPayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (DeleteWebhookPayload) IsDeleteWebhookPayloadOrError() {}
+
 type DeletedNode struct {
 	Dbid persist.DBID `json:"dbid"`
 }
@@ -1091,20 +1247,27 @@ type ErrAuthenticationFailed struct {
 	Message string `json:"message"`
 }
 
-func (ErrAuthenticationFailed) IsAddUserWalletPayloadOrError()      {}
-func (ErrAuthenticationFailed) IsError()                            {}
-func (ErrAuthenticationFailed) IsLoginPayloadOrError()              {}
-func (ErrAuthenticationFailed) IsCreateUserPayloadOrError()         {}
-func (ErrAuthenticationFailed) IsFollowUserPayloadOrError()         {}
-func (ErrAuthenticationFailed) IsUnfollowUserPayloadOrError()       {}
-func (ErrAuthenticationFailed) IsAdmireFeedEventPayloadOrError()    {}
-func (ErrAuthenticationFailed) IsRemoveAdmirePayloadOrError()       {}
-func (ErrAuthenticationFailed) IsCommentOnFeedEventPayloadOrError() {}
-func (ErrAuthenticationFailed) IsRemoveCommentPayloadOrError()      {}
-func (ErrAuthenticationFailed) IsViewGalleryPayloadOrError()        {}
-func (ErrAuthenticationFailed) IsViewTokenPayloadOrError()          {}
-func (ErrAuthenticationFailed) IsSetProfileImagePayloadOrError()    {}
-func (ErrAuthenticationFailed) IsRemoveProfileImagePayloadOrError() {}
+func (ErrAuthenticationFailed) IsAddUserWalletPayloadOrError()             {}
+func (ErrAuthenticationFailed) IsError()                                   {}
+func (ErrAuthenticationFailed) IsLoginPayloadOrError()                     {}
+func (ErrAuthenticationFailed) IsCreateUserPayloadOrError()                {}
+func (ErrAuthenticationFailed) IsFollowUserPayloadOrError()                {}
+func (ErrAuthenticationFailed) IsUnfollowUserPayloadOrError()              {}
+func (ErrAuthenticationFailed) IsAdmireFeedEventPayloadOrError()           {}
+func (ErrAuthenticationFailed) IsRemoveAdmirePayloadOrError()              {}
+func (ErrAuthenticationFailed) IsCommentOnFeedEventPayloadOrError()        {}
+func (ErrAuthenticationFailed) IsRemoveCommentPayloadOrError()             {}
+func (ErrAuthenticationFailed) IsViewGalleryPayloadOrError()               {}
+func (ErrAuthenticationFailed) IsViewTokenPayloadOrError()                 {}
+func (ErrAuthenticationFailed) IsSetProfileImagePayloadOrError()           {}
+func (ErrAuthenticationFailed) IsRemoveProfileImagePayloadOrError()        {}
+func (ErrAuthenticationFailed) IsHideCommentPayloadOrError()               {}
+func (ErrAuthenticationFailed) IsSetPostCommentRestrictionPayloadOrError() {}
+func (ErrAuthenticationFailed) IsRepostPostPayloadOrError()                {}
+func (ErrAuthenticationFailed) IsDeleteRepostPayloadOrError()              {}
+func (ErrAuthenticationFailed) IsSchedulePostPayloadOrError()              {}
+func (ErrAuthenticationFailed) IsUpdatePostPayloadOrError()                {}
+func (ErrAuthenticationFailed) IsCreateWebhookPayloadOrError()             {}
 
 type ErrCollectionNotFound struct {
 	Message string `json:"message"`
@@ -1123,6 +1286,16 @@ type ErrCommentNotFound struct {
 func (ErrCommentNotFound) IsError()                       {}
 func (ErrCommentNotFound) IsRemoveCommentPayloadOrError() {}
 func (ErrCommentNotFound) IsAdmireCommentPayloadOrError() {}
+func (ErrCommentNotFound) IsHideCommentPayloadOrError()   {}
+func (ErrCommentNotFound) IsReportCommentPayloadOrError() {}
+func (ErrCommentNotFound) IsModeratePayloadOrError()      {}
+
+type ErrCommentsRestricted struct {
+	Message string `json:"message"`
+}
+
+func (ErrCommentsRestricted) IsCommentOnPostPayloadOrError() {}
+func (ErrCommentsRestricted) IsError()                       {}
 
 type ErrCommunityNotFound struct {
 	Message string `json:"message"`
@@ -1134,6 +1307,8 @@ func (ErrCommunityNotFound) IsCommunityByKeyOrError()
 func (ErrCommunityNotFound) IsPostComposerDraftDetailsPayloadOrError()                        {}
 func (ErrCommunityNotFound) IsError()                                                         {}
 func (ErrCommunityNotFound) IsSyncCreatedTokensForUsernameAndExistingContractPayloadOrError() {}
+func (ErrCommunityNotFound) IsMuteCommunityPayloadOrError()                                   {}
+func (ErrCommunityNotFound) IsCreateWebhookPayloadOrError()                                   {}
 
 type ErrDoesNotOwnRequiredToken struct {
 	Message string `json:"message"`
@@ -1177,6 +1352,16 @@ type ErrGalleryNotFound struct {
 func (ErrGalleryNotFound) IsError()                           {}
 func (ErrGalleryNotFound) IsGalleryByIDPayloadOrError()       {}
 func (ErrGalleryNotFound) IsViewerGalleryByIDPayloadOrError() {}
+func (ErrGalleryNotFound) IsGalleryVersionsPayloadOrError()   {}
+func (ErrGalleryNotFound) IsReportGalleryPayloadOrError()     {}
+func (ErrGalleryNotFound) IsModeratePayloadOrError()          {}
+
+type ErrGalleryVersionNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrGalleryVersionNotFound) IsError()                               {}
+func (ErrGalleryVersionNotFound) IsRestoreGalleryVersionPayloadOrError() {}
 
 type ErrHighlightChainNotSupported struct {
 	Message string `json:"message"`
@@ -1232,6 +1417,9 @@ func (ErrInvalidInput) IsCommunityByKeyOrError()
 func (ErrInvalidInput) IsPostOrError()                                                   {}
 func (ErrInvalidInput) IsSocialConnectionsOrError()                                      {}
 func (ErrInvalidInput) IsMerchTokensPayloadOrError()                                     {}
+func (ErrInvalidInput) IsTopicOrError()                                                  {}
+func (ErrInvalidInput) IsTrendingTopicsPayloadOrError()                                  {}
+func (ErrInvalidInput) IsFollowTopicPayloadOrError()                                     {}
 func (ErrInvalidInput) IsSearchUsersPayloadOrError()                                     {}
 func (ErrInvalidInput) IsSearchGalleriesPayloadOrError()                                 {}
 func (ErrInvalidInput) IsSearchCommunitiesPayloadOrError()                               {}
@@ -1271,6 +1459,8 @@ func (ErrInvalidInput) IsOptOutForRolesPayloadOrError()
 func (ErrInvalidInput) IsSetPersonaPayloadOrError()                                      {}
 func (ErrInvalidInput) IsRedeemMerchPayloadOrError()                                     {}
 func (ErrInvalidInput) IsSyncCreatedTokensForUsernameAndExistingContractPayloadOrError() {}
+func (ErrInvalidInput) IsTokenProcessingFailuresPayloadOrError()                         {}
+func (ErrInvalidInput) IsReplayTokenProcessingFailuresPayloadOrError()                   {}
 func (ErrInvalidInput) IsCreateGalleryPayloadOrError()                                   {}
 func (ErrInvalidInput) IsUpdateGalleryInfoPayloadOrError()                               {}
 func (ErrInvalidInput) IsUpdateGalleryHiddenPayloadOrError()                             {}
@@ -1279,6 +1469,8 @@ func (ErrInvalidInput) IsUpdateGalleryOrderPayloadOrError()
 func (ErrInvalidInput) IsUpdateFeaturedGalleryPayloadOrError()                           {}
 func (ErrInvalidInput) IsUpdateGalleryPayloadOrError()                                   {}
 func (ErrInvalidInput) IsPublishGalleryPayloadOrError()                                  {}
+func (ErrInvalidInput) IsGalleryVersionsPayloadOrError()                                 {}
+func (ErrInvalidInput) IsRestoreGalleryVersionPayloadOrError()                           {}
 func (ErrInvalidInput) IsUpdatePrimaryWalletPayloadOrError()                             {}
 func (ErrInvalidInput) IsUpdateUserExperiencePayloadOrError()                            {}
 func (ErrInvalidInput) IsMoveCollectionToGalleryPayloadOrError()                         {}
@@ -1295,11 +1487,34 @@ func (ErrInvalidInput) IsAdmirePostPayloadOrError()
 func (ErrInvalidInput) IsAdmireTokenPayloadOrError()                                     {}
 func (ErrInvalidInput) IsAdmireCommentPayloadOrError()                                   {}
 func (ErrInvalidInput) IsCommentOnPostPayloadOrError()                                   {}
+func (ErrInvalidInput) IsHideCommentPayloadOrError()                                     {}
+func (ErrInvalidInput) IsSetPostCommentRestrictionPayloadOrError()                       {}
 func (ErrInvalidInput) IsDeletePostPayloadOrError()                                      {}
+func (ErrInvalidInput) IsRepostPostPayloadOrError()                                      {}
+func (ErrInvalidInput) IsDeleteRepostPayloadOrError()                                    {}
+func (ErrInvalidInput) IsSchedulePostPayloadOrError()                                    {}
+func (ErrInvalidInput) IsUpdateScheduledPostPayloadOrError()                             {}
+func (ErrInvalidInput) IsCancelScheduledPostPayloadOrError()                             {}
+func (ErrInvalidInput) IsUpdatePostPayloadOrError()                                      {}
 func (ErrInvalidInput) IsReferralPostPreflightPayloadOrError()                           {}
 func (ErrInvalidInput) IsReportPostPayloadOrError()                                      {}
+func (ErrInvalidInput) IsReportCommentPayloadOrError()                                   {}
+func (ErrInvalidInput) IsReportUserPayloadOrError()                                      {}
+func (ErrInvalidInput) IsReportGalleryPayloadOrError()                                   {}
+func (ErrInvalidInput) IsModerationQueuePayloadOrError()                                 {}
+func (ErrInvalidInput) IsModerationActionsPayloadOrError()                               {}
+func (ErrInvalidInput) IsModeratePayloadOrError()                                        {}
+func (ErrInvalidInput) IsRevokeModerationActionPayloadOrError()                          {}
 func (ErrInvalidInput) IsBlockUserPayloadOrError()                                       {}
 func (ErrInvalidInput) IsUnblockUserPayloadOrError()                                     {}
+func (ErrInvalidInput) IsMuteUserPayloadOrError()                                        {}
+func (ErrInvalidInput) IsMuteCommunityPayloadOrError()                                   {}
+func (ErrInvalidInput) IsMuteKeywordPayloadOrError()                                     {}
+func (ErrInvalidInput) IsUnmutePayloadOrError()                                          {}
+func (ErrInvalidInput) IsCreateWebhookPayloadOrError()                                   {}
+func (ErrInvalidInput) IsUpdateWebhookPayloadOrError()                                   {}
+func (ErrInvalidInput) IsDeleteWebhookPayloadOrError()                                   {}
+func (ErrInvalidInput) IsTestWebhookPayloadOrError()                                     {}
 
 type ErrInvalidToken struct {
 	Message string `json:"message"`
@@ -1308,6 +1523,13 @@ type ErrInvalidToken struct {
 func (ErrInvalidToken) IsAuthorizationError() {}
 func (ErrInvalidToken) IsError()              {}
 
+type ErrMuteNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrMuteNotFound) IsError()                {}
+func (ErrMuteNotFound) IsUnmutePayloadOrError() {}
+
 type ErrNeedsToReconnectSocial struct {
 	SocialAccountType persist.SocialProvider `json:"socialAccountType"`
 	Message           string                 `json:"message"`
@@ -1370,6 +1592,8 @@ func (ErrNotAuthorized) IsSyncCreatedTokensForUsernameAndExistingContractPayload
 func (ErrNotAuthorized) IsBanUserFromFeedPayloadOrError()                                 {}
 func (ErrNotAuthorized) IsUnbanUserFromFeedPayloadOrError()                               {}
 func (ErrNotAuthorized) IsSetCommunityOverrideCreatorPayloadOrError()                     {}
+func (ErrNotAuthorized) IsTokenProcessingFailuresPayloadOrError()                         {}
+func (ErrNotAuthorized) IsReplayTokenProcessingFailuresPayloadOrError()                   {}
 func (ErrNotAuthorized) IsCreateGalleryPayloadOrError()                                   {}
 func (ErrNotAuthorized) IsUpdateGalleryInfoPayloadOrError()                               {}
 func (ErrNotAuthorized) IsUpdateGalleryHiddenPayloadOrError()                             {}
@@ -1378,6 +1602,8 @@ func (ErrNotAuthorized) IsUpdateGalleryOrderPayloadOrError()
 func (ErrNotAuthorized) IsUpdateFeaturedGalleryPayloadOrError()                           {}
 func (ErrNotAuthorized) IsUpdateGalleryPayloadOrError()                                   {}
 func (ErrNotAuthorized) IsPublishGalleryPayloadOrError()                                  {}
+func (ErrNotAuthorized) IsGalleryVersionsPayloadOrError()                                 {}
+func (ErrNotAuthorized) IsRestoreGalleryVersionPayloadOrError()                           {}
 func (ErrNotAuthorized) IsUpdatePrimaryWalletPayloadOrError()                             {}
 func (ErrNotAuthorized) IsAdminAddWalletPayloadOrError()                                  {}
 func (ErrNotAuthorized) IsUpdateUserExperiencePayloadOrError()                            {}
@@ -1397,8 +1623,16 @@ func (ErrNotAuthorized) IsAdmireTokenPayloadOrError()
 func (ErrNotAuthorized) IsAdmireCommentPayloadOrError()                                   {}
 func (ErrNotAuthorized) IsCommentOnPostPayloadOrError()                                   {}
 func (ErrNotAuthorized) IsDeletePostPayloadOrError()                                      {}
+func (ErrNotAuthorized) IsModerationQueuePayloadOrError()                                 {}
+func (ErrNotAuthorized) IsModerationActionsPayloadOrError()                               {}
+func (ErrNotAuthorized) IsModeratePayloadOrError()                                        {}
+func (ErrNotAuthorized) IsRevokeModerationActionPayloadOrError()                          {}
 func (ErrNotAuthorized) IsBlockUserPayloadOrError()                                       {}
 func (ErrNotAuthorized) IsUnblockUserPayloadOrError()                                     {}
+func (ErrNotAuthorized) IsMuteUserPayloadOrError()                                        {}
+func (ErrNotAuthorized) IsMuteCommunityPayloadOrError()                                   {}
+func (ErrNotAuthorized) IsMuteKeywordPayloadOrError()                                     {}
+func (ErrNotAuthorized) IsUnmutePayloadOrError()                                          {}
 func (ErrNotAuthorized) IsHighlightClaimMintPayloadOrError()                              {}
 func (ErrNotAuthorized) IsHighlightMintClaimStatusPayloadOrError()                        {}
 
@@ -1406,11 +1640,15 @@ type ErrPostNotFound struct {
 	Message string `json:"message"`
 }
 
-func (ErrPostNotFound) IsPostOrError()              {}
-func (ErrPostNotFound) IsError()                    {}
-func (ErrPostNotFound) IsFeedEventOrError()         {}
-func (ErrPostNotFound) IsAdmirePostPayloadOrError() {}
-func (ErrPostNotFound) IsReportPostPayloadOrError() {}
+func (ErrPostNotFound) IsPostOrError()                             {}
+func (ErrPostNotFound) IsError()                                   {}
+func (ErrPostNotFound) IsFeedEventOrError()                        {}
+func (ErrPostNotFound) IsAdmirePostPayloadOrError()                {}
+func (ErrPostNotFound) IsSetPostCommentRestrictionPayloadOrError() {}
+func (ErrPostNotFound) IsRepostPostPayloadOrError()                {}
+func (ErrPostNotFound) IsUpdatePostPayloadOrError()                {}
+func (ErrPostNotFound) IsReportPostPayloadOrError()                {}
+func (ErrPostNotFound) IsModeratePayloadOrError()                  {}
 
 type ErrPushTokenBelongsToAnotherUser struct {
 	Message string `json:"message"`
@@ -1420,6 +1658,34 @@ func (ErrPushTokenBelongsToAnotherUser) IsRegisterUserPushTokenPayloadOrError()
 func (ErrPushTokenBelongsToAnotherUser) IsUnregisterUserPushTokenPayloadOrError() {}
 func (ErrPushTokenBelongsToAnotherUser) IsError()                                 {}
 
+type ErrRateLimited struct {
+	Message           string `json:"message"`
+	RetryAfterSeconds int    `json:"retryAfterSeconds"`
+}
+
+func (ErrRateLimited) IsSyncTokensPayloadOrError()    {}
+func (ErrRateLimited) IsError()                       {}
+func (ErrRateLimited) IsFollowUserPayloadOrError()    {}
+func (ErrRateLimited) IsAdmirePostPayloadOrError()    {}
+func (ErrRateLimited) IsCommentOnPostPayloadOrError() {}
+func (ErrRateLimited) IsRepostPostPayloadOrError()    {}
+func (ErrRateLimited) IsTestWebhookPayloadOrError()   {}
+
+type ErrRepostNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrRepostNotFound) IsError()                      {}
+func (ErrRepostNotFound) IsDeleteRepostPayloadOrError() {}
+
+type ErrScheduledPostNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrScheduledPostNotFound) IsError()                             {}
+func (ErrScheduledPostNotFound) IsUpdateScheduledPostPayloadOrError() {}
+func (ErrScheduledPostNotFound) IsCancelScheduledPostPayloadOrError() {}
+
 type ErrSessionInvalidated struct {
 	Message string `json:"message"`
 }
@@ -1484,8 +1750,19 @@ func (ErrUserNotFound) IsUnfollowUserPayloadOrError()       {}
 func (ErrUserNotFound) IsAdminAddWalletPayloadOrError()     {}
 func (ErrUserNotFound) IsSetProfileImagePayloadOrError()    {}
 func (ErrUserNotFound) IsRemoveProfileImagePayloadOrError() {}
+func (ErrUserNotFound) IsReportUserPayloadOrError()         {}
+func (ErrUserNotFound) IsModeratePayloadOrError()           {}
 func (ErrUserNotFound) IsBlockUserPayloadOrError()          {}
 func (ErrUserNotFound) IsUnblockUserPayloadOrError()        {}
+func (ErrUserNotFound) IsMuteUserPayloadOrError()           {}
+
+type ErrUserSuspended struct {
+	Message string     `json:"message"`
+	Until   *time.Time `json:"until"`
+}
+
+func (ErrUserSuspended) IsAuthorizationError() {}
+func (ErrUserSuspended) IsError()              {}
 
 type ErrUsernameNotAvailable struct {
 	Message string `json:"message"`
@@ -1495,6 +1772,15 @@ func (ErrUsernameNotAvailable) IsUpdateUserInfoPayloadOrError() {}
 func (ErrUsernameNotAvailable) IsError()                        {}
 func (ErrUsernameNotAvailable) IsCreateUserPayloadOrError()     {}
 
+type ErrWebhookNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrWebhookNotFound) IsError()                       {}
+func (ErrWebhookNotFound) IsUpdateWebhookPayloadOrError() {}
+func (ErrWebhookNotFound) IsDeleteWebhookPayloadOrError() {}
+func (ErrWebhookNotFound) IsTestWebhookPayloadOrError()   {}
+
 type FallbackMedia struct {
 	MediaURL  *string `json:"mediaURL"`
 	MediaType *string `json:"mediaType"`
@@ -1588,6 +1874,13 @@ type FollowInfo struct {
 	FollowedBack *bool        `json:"followedBack"`
 }
 
+type FollowTopicPayload struct {
+	Topic  *Topic  `json:"topic"`
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (FollowTopicPayload) IsFollowTopicPayloadOrError() {}
+
 type FollowUserPayload struct {
 	Viewer *Viewer      `json:"viewer"`
 	User   *GalleryUser `json:"user"`
@@ -1709,6 +2002,31 @@ func (GalleryUser) IsMentionEntity()                     {}
 func (GalleryUser) IsAddRolesToUserPayloadOrError()      {}
 func (GalleryUser) IsRevokeRolesFromUserPayloadOrError() {}
 
+type GalleryVersion struct {
+	Dbid                  persist.DBID                `json:"dbid"`
+	Name                  *string                     `json:"name"`
+	Description           *string                     `json:"description"`
+	Collections           []*GalleryVersionCollection `json:"collections"`
+	Published             bool                        `json:"published"`
+	RestoredFromVersionID *persist.DBID               `json:"restoredFromVersionId"`
+	CreationTime          *time.Time                  `json:"creationTime"`
+	Changes               *persist.GalleryVersionDiff `json:"changes"`
+}
+
+type GalleryVersionCollection struct {
+	Dbid           persist.DBID   `json:"dbid"`
+	Name           *string        `json:"name"`
+	CollectorsNote *string        `json:"collectorsNote"`
+	Hidden         bool           `json:"hidden"`
+	TokenIds       []persist.DBID `json:"tokenIds"`
+}
+
+type GalleryVersionsPayload struct {
+	Versions []*GalleryVersion `json:"versions"`
+}
+
+func (GalleryVersionsPayload) IsGalleryVersionsPayloadOrError() {}
+
 type GenerateQRCodeLoginTokenPayload struct {
 	Token string `json:"token"`
 }
@@ -1721,6 +2039,7 @@ type GltfMedia struct {
 	MediaType        *string          `json:"mediaType"`
 	ContentRenderURL *string          `json:"contentRenderURL"`
 	Dimensions       *MediaDimensions `json:"dimensions"`
+	Model            *ModelInfo       `json:"model"`
 	FallbackMedia    *FallbackMedia   `json:"fallbackMedia"`
 }
 
@@ -1747,6 +2066,12 @@ type HTTPSProfileImage struct {
 	PreviewURLs *PreviewURLSet `json:"previewURLs"`
 }
 
+type HideCommentPayload struct {
+	Comment *Comment `json:"comment"`
+}
+
+func (HideCommentPayload) IsHideCommentPayloadOrError() {}
+
 type HighlightClaimMintInput struct {
 	CollectionID      string       `json:"collectionId"`
 	RecipientWalletID persist.DBID `json:"recipientWalletId"`
@@ -1927,6 +2252,75 @@ type MintPremiumCardToWalletPayload struct {
 
 func (MintPremiumCardToWalletPayload) IsMintPremiumCardToWalletPayloadOrError() {}
 
+type ModelBoundingBox struct {
+	MinX float64 `json:"minX"`
+	MinY float64 `json:"minY"`
+	MinZ float64 `json:"minZ"`
+	MaxX float64 `json:"maxX"`
+	MaxY float64 `json:"maxY"`
+	MaxZ float64 `json:"maxZ"`
+}
+
+type ModelInfo struct {
+	Format      string            `json:"format"`
+	BoundingBox *ModelBoundingBox `json:"boundingBox"`
+	PolyCount   *int              `json:"polyCount"`
+	VertexCount *int              `json:"vertexCount"`
+}
+
+type ModerateInput struct {
+	SubjectType persist.ReportSubjectType    `json:"subjectType"`
+	SubjectID   persist.DBID                 `json:"subjectId"`
+	Action      persist.ModerationActionType `json:"action"`
+	Reason      *persist.ReportReason        `json:"reason"`
+	Note        *string                      `json:"note"`
+	Moderator   *string                      `json:"moderator"`
+	SuspendDays *int                         `json:"suspendDays"`
+}
+
+type ModeratePayload struct {
+	Action *ModerationAction `json:"action"`
+}
+
+func (ModeratePayload) IsModeratePayloadOrError() {}
+
+type ModerationAction struct {
+	Dbid         persist.DBID                 `json:"dbid"`
+	SubjectType  persist.ReportSubjectType    `json:"subjectType"`
+	SubjectID    persist.DBID                 `json:"subjectId"`
+	UserID       *persist.DBID                `json:"userId"`
+	Action       persist.ModerationActionType `json:"action"`
+	Reason       *persist.ReportReason        `json:"reason"`
+	Note         *string                      `json:"note"`
+	Moderator    *string                      `json:"moderator"`
+	Automated    bool                         `json:"automated"`
+	ExpiresTime  *time.Time                   `json:"expiresTime"`
+	RevokedTime  *time.Time                   `json:"revokedTime"`
+	CreationTime time.Time                    `json:"creationTime"`
+}
+
+type ModerationActionsPayload struct {
+	Actions []*ModerationAction `json:"actions"`
+}
+
+func (ModerationActionsPayload) IsModerationActionsPayloadOrError() {}
+
+type ModerationQueueItem struct {
+	SubjectType       persist.ReportSubjectType `json:"subjectType"`
+	SubjectID         persist.DBID              `json:"subjectId"`
+	TotalReports      int                       `json:"totalReports"`
+	TotalReporters    int                       `json:"totalReporters"`
+	Reasons           []persist.ReportReason    `json:"reasons"`
+	FirstReportedTime time.Time                 `json:"firstReportedTime"`
+	LastReportedTime  time.Time                 `json:"lastReportedTime"`
+}
+
+type ModerationQueuePayload struct {
+	Items []*ModerationQueueItem `json:"items"`
+}
+
+func (ModerationQueuePayload) IsModerationQueuePayloadOrError() {}
+
 type MoveCollectionToGalleryInput struct {
 	SourceCollectionID persist.DBID `json:"sourceCollectionId"`
 	TargetGalleryID    persist.DBID `json:"targetGalleryId"`
@@ -1939,6 +2333,25 @@ type MoveCollectionToGalleryPayload struct {
 
 func (MoveCollectionToGalleryPayload) IsMoveCollectionToGalleryPayloadOrError() {}
 
+type Mute struct {
+	HelperMuteData
+	Dbid         persist.DBID `json:"dbid"`
+	User         *GalleryUser `json:"user"`
+	Community    *Community   `json:"community"`
+	Keyword      *string      `json:"keyword"`
+	ExpiresTime  *time.Time   `json:"expiresTime"`
+	CreationTime *time.Time   `json:"creationTime"`
+}
+
+type MutePayload struct {
+	Mute   *Mute   `json:"mute"`
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (MutePayload) IsMuteUserPayloadOrError()      {}
+func (MutePayload) IsMuteCommunityPayloadOrError() {}
+func (MutePayload) IsMuteKeywordPayloadOrError()   {}
+
 type NewTokensNotification struct {
 	HelperNewTokensNotificationData
 	Dbid         persist.DBID `json:"dbid"`
@@ -2033,19 +2446,25 @@ func (PDFMedia) IsMedia()        {}
 
 type Post struct {
 	HelperPostData
-	Dbid             persist.DBID            `json:"dbid"`
-	Author           *GalleryUser            `json:"author"`
-	CreationTime     *time.Time              `json:"creationTime"`
-	Tokens           []*Token                `json:"tokens"`
-	Caption          *string                 `json:"caption"`
-	Mentions         []*Mention              `json:"mentions"`
-	Admires          *PostAdmiresConnection  `json:"admires"`
-	Comments         *PostCommentsConnection `json:"comments"`
-	TotalComments    *int                    `json:"totalComments"`
-	Interactions     *InteractionsConnection `json:"interactions"`
-	ViewerAdmire     *Admire                 `json:"viewerAdmire"`
-	IsFirstPost      bool                    `json:"isFirstPost"`
-	UserAddedMintURL *string                 `json:"userAddedMintURL"`
+	Dbid               persist.DBID               `json:"dbid"`
+	Author             *GalleryUser               `json:"author"`
+	CreationTime       *time.Time                 `json:"creationTime"`
+	Tokens             []*Token                   `json:"tokens"`
+	Caption            *string                    `json:"caption"`
+	Mentions           []*Mention                 `json:"mentions"`
+	Admires            *PostAdmiresConnection     `json:"admires"`
+	Comments           *PostCommentsConnection    `json:"comments"`
+	TotalComments      *int                       `json:"totalComments"`
+	Interactions       *InteractionsConnection    `json:"interactions"`
+	ViewerAdmire       *Admire                    `json:"viewerAdmire"`
+	TotalReposts       *int                       `json:"totalReposts"`
+	ViewerRepost       *Repost                    `json:"viewerRepost"`
+	IsFirstPost        bool                       `json:"isFirstPost"`
+	UserAddedMintURL   *string                    `json:"userAddedMintURL"`
+	IsEdited           bool                       `json:"isEdited"`
+	LastEditedTime     *time.Time                 `json:"lastEditedTime"`
+	EditHistory        []*PostEdit                `json:"editHistory"`
+	CommentRestriction persist.CommentRestriction `json:"commentRestriction"`
 }
 
 func (Post) IsAdmireSource()     {}
@@ -2095,6 +2514,12 @@ type PostEdge struct {
 	Cursor *string     `json:"cursor"`
 }
 
+type PostEdit struct {
+	Caption          *string    `json:"caption"`
+	UserAddedMintURL *string    `json:"userAddedMintURL"`
+	CreationTime     *time.Time `json:"creationTime"`
+}
+
 type PostTokensInput struct {
 	TokenIds []persist.DBID  `json:"tokenIds"`
 	Caption  *string         `json:"caption"`
@@ -2239,18 +2664,103 @@ type RemoveUserWalletsPayload struct {
 
 func (RemoveUserWalletsPayload) IsRemoveUserWalletsPayloadOrError() {}
 
+type ReplayTokenProcessingFailuresPayload struct {
+	Replayed []*TokenProcessingFailure `json:"replayed"`
+}
+
+func (ReplayTokenProcessingFailuresPayload) IsReplayTokenProcessingFailuresPayloadOrError() {}
+
+type ReportCommentPayload struct {
+	CommentID persist.DBID `json:"commentId"`
+}
+
+func (ReportCommentPayload) IsReportCommentPayloadOrError() {}
+
+type ReportGalleryPayload struct {
+	GalleryID persist.DBID `json:"galleryId"`
+}
+
+func (ReportGalleryPayload) IsReportGalleryPayloadOrError() {}
+
 type ReportPostPayload struct {
 	PostID persist.DBID `json:"postId"`
 }
 
 func (ReportPostPayload) IsReportPostPayloadOrError() {}
 
+type ReportUserPayload struct {
+	UserID persist.DBID `json:"userId"`
+}
+
+func (ReportUserPayload) IsReportUserPayloadOrError() {}
+
+type Repost struct {
+	HelperRepostData
+	Dbid         persist.DBID `json:"dbid"`
+	Author       *GalleryUser `json:"author"`
+	Post         *Post        `json:"post"`
+	Caption      *string      `json:"caption"`
+	IsQuote      bool         `json:"isQuote"`
+	CreationTime *time.Time   `json:"creationTime"`
+}
+
+func (Repost) IsNode()             {}
+func (Repost) IsFeedEventOrError() {}
+
+type RepostPostPayload struct {
+	Viewer *Viewer `json:"viewer"`
+	Post   *Post   `json:"post"`
+	Repost *Repost `json:"repost"`
+}
+
+func (RepostPostPayload) IsRepostPostPayloadOrError() {}
+
 type ResendVerificationEmailPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
 
 func (ResendVerificationEmailPayload) IsResendVerificationEmailPayloadOrError() {}
 
+type RestoreGalleryVersionPayload struct {
+	Gallery *Gallery `json:"gallery"`
+}
+
+func (RestoreGalleryVersionPayload) IsRestoreGalleryVersionPayloadOrError() {}
+
+type RevokeModerationActionPayload struct {
+	Action *ModerationAction `json:"action"`
+}
+
+func (RevokeModerationActionPayload) IsRevokeModerationActionPayloadOrError() {}
+
+type SchedulePostInput struct {
+	TokenIds  []persist.DBID  `json:"tokenIds"`
+	Caption   *string         `json:"caption"`
+	Mentions  []*MentionInput `json:"mentions"`
+	MintURL   *string         `json:"mintURL"`
+	PublishAt time.Time       `json:"publishAt"`
+}
+
+type SchedulePostPayload struct {
+	ScheduledPost *ScheduledPost `json:"scheduledPost"`
+}
+
+func (SchedulePostPayload) IsSchedulePostPayloadOrError() {}
+
+type ScheduledPost struct {
+	HelperScheduledPostData
+	Dbid             persist.DBID `json:"dbid"`
+	Author           *GalleryUser `json:"author"`
+	Tokens           []*Token     `json:"tokens"`
+	Caption          *string      `json:"caption"`
+	UserAddedMintURL *string      `json:"userAddedMintURL"`
+	PublishAt        time.Time    `json:"publishAt"`
+	CreationTime     *time.Time   `json:"creationTime"`
+	LastUpdated      *time.Time   `json:"lastUpdated"`
+}
+
+func (ScheduledPost) IsNode() {}
+
 type SearchCommunitiesPayload struct {
 	Results []*CommunitySearchResult `json:"results"`
 }
@@ -2281,6 +2791,12 @@ type SetPersonaPayload struct {
 
 func (SetPersonaPayload) IsSetPersonaPayloadOrError() {}
 
+type SetPostCommentRestrictionPayload struct {
+	Post *Post `json:"post"`
+}
+
+func (SetPostCommentRestrictionPayload) IsSetPostCommentRestrictionPayloadOrError() {}
+
 type SetProfileImageInput struct {
 	TokenID       *persist.DBID         `json:"tokenId"`
 	WalletAddress *persist.ChainAddress `json:"walletAddress"`
@@ -2511,6 +3027,19 @@ type SomeoneRepliedToYourCommentNotification struct {
 func (SomeoneRepliedToYourCommentNotification) IsNotification() {}
 func (SomeoneRepliedToYourCommentNotification) IsNode()         {}
 
+type SomeoneRepostedYourPostNotification struct {
+	HelperSomeoneRepostedYourPostNotificationData
+	Dbid         persist.DBID `json:"dbid"`
+	Seen         *bool        `json:"seen"`
+	CreationTime *time.Time   `json:"creationTime"`
+	UpdatedTime  *time.Time   `json:"updatedTime"`
+	Repost       *Repost      `json:"repost"`
+	Post         *Post        `json:"post"`
+}
+
+func (SomeoneRepostedYourPostNotification) IsNotification() {}
+func (SomeoneRepostedYourPostNotification) IsNode()         {}
+
 type SomeoneViewedYourGalleryNotification struct {
 	HelperSomeoneViewedYourGalleryNotificationData
 	Dbid               persist.DBID                      `json:"dbid"`
@@ -2551,6 +3080,18 @@ type SomeoneYouFollowPostedTheirFirstPostNotification struct {
 func (SomeoneYouFollowPostedTheirFirstPostNotification) IsNotification() {}
 func (SomeoneYouFollowPostedTheirFirstPostNotification) IsNode()         {}
 
+type SpamReason struct {
+	Reason string  `json:"reason"`
+	Weight int     `json:"weight"`
+	Detail *string `json:"detail"`
+}
+
+type SpamScore struct {
+	Score   int           `json:"score"`
+	IsSpam  bool          `json:"isSpam"`
+	Reasons []*SpamReason `json:"reasons"`
+}
+
 type SyncCreatedTokensForExistingContractInput struct {
 	ContractID persist.DBID `json:"contractId"`
 }
@@ -2610,6 +3151,12 @@ type SyncingMedia struct {
 func (SyncingMedia) IsMediaSubtype() {}
 func (SyncingMedia) IsMedia()        {}
 
+type TestWebhookPayload struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
+func (TestWebhookPayload) IsTestWebhookPayloadOrError() {}
+
 type TextMedia struct {
 	PreviewURLs      *PreviewURLSet   `json:"previewURLs"`
 	MediaURL         *string          `json:"mediaURL"`
@@ -2686,6 +3233,7 @@ type TokenDefinition struct {
 	Communities   []*Community   `json:"communities"`
 	ExternalURL   *string        `json:"externalUrl"`
 	MintURL       *string        `json:"mintUrl"`
+	SpamScore     *SpamScore     `json:"spamScore"`
 }
 
 func (TokenDefinition) IsNode() {}
@@ -2713,6 +3261,42 @@ type TokenHoldersConnection struct {
 	PageInfo *PageInfo          `json:"pageInfo"`
 }
 
+type TokenProcessingAttempt struct {
+	Attempt         int           `json:"attempt"`
+	ProcessingCause string        `json:"processingCause"`
+	ErrorClass      string        `json:"errorClass"`
+	ErrorMessage    string        `json:"errorMessage"`
+	ProcessingJobID *persist.DBID `json:"processingJobId"`
+	FailedAt        time.Time     `json:"failedAt"`
+}
+
+type TokenProcessingFailure struct {
+	Dbid                persist.DBID              `json:"dbid"`
+	TokenDefinitionID   persist.DBID              `json:"tokenDefinitionId"`
+	Chain               *persist.Chain            `json:"chain"`
+	ContractAddress     *persist.Address          `json:"contractAddress"`
+	ProcessingCause     string                    `json:"processingCause"`
+	ErrorClass          string                    `json:"errorClass"`
+	ErrorMessage        string                    `json:"errorMessage"`
+	Attempts            int                       `json:"attempts"`
+	AttemptHistory      []*TokenProcessingAttempt `json:"attemptHistory"`
+	FailedPipelineSteps []string                  `json:"failedPipelineSteps"`
+	DeadLetteredAt      *time.Time                `json:"deadLetteredAt"`
+}
+
+type TokenProcessingFailuresFilterInput struct {
+	Chain           *persist.Chain   `json:"chain"`
+	ContractAddress *persist.Address `json:"contractAddress"`
+	ErrorClass      *string          `json:"errorClass"`
+	Limit           *int             `json:"limit"`
+}
+
+type TokenProcessingFailuresPayload struct {
+	Failures []*TokenProcessingFailure `json:"failures"`
+}
+
+func (TokenProcessingFailuresPayload) IsTokenProcessingFailuresPayloadOrError() {}
+
 type TokenProfileImage struct {
 	Token *Token `json:"token"`
 }
@@ -2736,6 +3320,26 @@ type TokensConnection struct {
 	PageInfo *PageInfo    `json:"pageInfo"`
 }
 
+type Topic struct {
+	Tag               string           `json:"tag"`
+	Posts             *PostsConnection `json:"posts"`
+	TotalPosts        *int             `json:"totalPosts"`
+	ViewerIsFollowing *bool            `json:"viewerIsFollowing"`
+}
+
+func (Topic) IsTopicOrError() {}
+
+type TrendingTopicsInput struct {
+	Report Window `json:"report"`
+	Limit  *int   `json:"limit"`
+}
+
+type TrendingTopicsPayload struct {
+	Topics []*Topic `json:"topics"`
+}
+
+func (TrendingTopicsPayload) IsTrendingTopicsPayloadOrError() {}
+
 type TrendingUsersInput struct {
 	Report Window `json:"report"`
 }
@@ -2793,6 +3397,12 @@ type UnknownMedia struct {
 func (UnknownMedia) IsMediaSubtype() {}
 func (UnknownMedia) IsMedia()        {}
 
+type UnmutePayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (UnmutePayload) IsUnmutePayloadOrError() {}
+
 type UnregisterUserPushTokenPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -2953,12 +3563,40 @@ type UpdateGalleryPayload struct {
 
 func (UpdateGalleryPayload) IsUpdateGalleryPayloadOrError() {}
 
+type UpdatePostInput struct {
+	PostID   persist.DBID    `json:"postId"`
+	Caption  *string         `json:"caption"`
+	Mentions []*MentionInput `json:"mentions"`
+	MintURL  *string         `json:"mintURL"`
+}
+
+type UpdatePostPayload struct {
+	Post *Post `json:"post"`
+}
+
+func (UpdatePostPayload) IsUpdatePostPayloadOrError() {}
+
 type UpdatePrimaryWalletPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
 
 func (UpdatePrimaryWalletPayload) IsUpdatePrimaryWalletPayloadOrError() {}
 
+type UpdateScheduledPostInput struct {
+	ScheduledPostID persist.DBID    `json:"scheduledPostId"`
+	TokenIds        []persist.DBID  `json:"tokenIds"`
+	Caption         *string         `json:"caption"`
+	Mentions        []*MentionInput `json:"mentions"`
+	MintURL         *string         `json:"mintURL"`
+	PublishAt       *time.Time      `json:"publishAt"`
+}
+
+type UpdateScheduledPostPayload struct {
+	ScheduledPost *ScheduledPost `json:"scheduledPost"`
+}
+
+func (UpdateScheduledPostPayload) IsUpdateScheduledPostPayloadOrError() {}
+
 type UpdateSocialAccountDisplayedInput struct {
 	Type      persist.SocialProvider `json:"type"`
 	Displayed bool                   `json:"displayed"`
@@ -3004,8 +3642,22 @@ type UpdateUserInfoPayload struct {
 
 func (UpdateUserInfoPayload) IsUpdateUserInfoPayloadOrError() {}
 
+type UpdateWebhookInput struct {
+	WebhookID  persist.DBID       `json:"webhookId"`
+	URL        *string            `json:"url"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Enabled    *bool              `json:"enabled"`
+}
+
+type UpdateWebhookPayload struct {
+	Webhook *Webhook `json:"webhook"`
+}
+
+func (UpdateWebhookPayload) IsUpdateWebhookPayloadOrError() {}
+
 type UploadPersistedQueriesInput struct {
 	PersistedQueries *string `json:"persistedQueries"`
+	Version          *string `json:"version"`
 }
 
 type UploadPersistedQueriesPayload struct {
@@ -3128,6 +3780,10 @@ type Viewer struct {
 	Persona                 *persist.Persona         `json:"persona"`
 	SuggestedUsers          *UsersConnection         `json:"suggestedUsers"`
 	SuggestedUsersFarcaster *UsersConnection         `json:"suggestedUsersFarcaster"`
+	Webhooks                []*Webhook               `json:"webhooks"`
+	ScheduledPosts          []*ScheduledPost         `json:"scheduledPosts"`
+	Mutes                   []*Mute                  `json:"mutes"`
+	FollowedTopics          []*Topic                 `json:"followedTopics"`
 }
 
 func (Viewer) IsNode()          {}
@@ -3150,6 +3806,42 @@ type Wallet struct {
 func (Wallet) IsNode()                {}
 func (Wallet) IsGalleryUserOrWallet() {}
 
+type Webhook struct {
+	HelperWebhookData
+	Dbid         persist.DBID                 `json:"dbid"`
+	URL          string                       `json:"url"`
+	EventTypes   []WebhookEventType           `json:"eventTypes"`
+	Enabled      bool                         `json:"enabled"`
+	Community    *Community                   `json:"community"`
+	CreationTime *time.Time                   `json:"creationTime"`
+	LastUpdated  *time.Time                   `json:"lastUpdated"`
+	Deliveries   *WebhookDeliveriesConnection `json:"deliveries"`
+}
+
+func (Webhook) IsNode() {}
+
+type WebhookDeliveriesConnection struct {
+	Edges    []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo *PageInfo              `json:"pageInfo"`
+}
+
+type WebhookDelivery struct {
+	Dbid           persist.DBID          `json:"dbid"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus *int                  `json:"responseStatus"`
+	Error          *string               `json:"error"`
+	Payload        string                `json:"payload"`
+	CreationTime   *time.Time            `json:"creationTime"`
+	LastUpdated    *time.Time            `json:"lastUpdated"`
+}
+
+type WebhookDeliveryEdge struct {
+	Node   *WebhookDelivery `json:"node"`
+	Cursor *string          `json:"cursor"`
+}
+
 type YouReceivedTopActivityBadgeNotification struct {
 	Dbid         persist.DBID `json:"dbid"`
 	Seen         *bool        `json:"seen"`
@@ -3423,6 +4115,49 @@ func (e PreverifyEmailResult) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RateLimitKey string
+
+const (
+	RateLimitKeyUser RateLimitKey = "USER"
+	RateLimitKeyIP   RateLimitKey = "IP"
+	RateLimitKeyArg  RateLimitKey = "ARG"
+)
+
+var AllRateLimitKey = []RateLimitKey{
+	RateLimitKeyUser,
+	RateLimitKeyIP,
+	RateLimitKeyArg,
+}
+
+func (e RateLimitKey) IsValid() bool {
+	switch e {
+	case RateLimitKeyUser, RateLimitKeyIP, RateLimitKeyArg:
+		return true
+	}
+	return false
+}
+
+func (e RateLimitKey) String() string {
+	return string(e)
+}
+
+func (e *RateLimitKey) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RateLimitKey(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RateLimitKey", str)
+	}
+	return nil
+}
+
+func (e RateLimitKey) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TokenType string
 
 const (
@@ -3534,3 +4269,93 @@ func (e *UserExperienceType) UnmarshalGQL(v interface{}) error {
 func (e UserExperienceType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypePostCreated          WebhookEventType = "POST_CREATED"
+	WebhookEventTypeUserFollowed         WebhookEventType = "USER_FOLLOWED"
+	WebhookEventTypeTokensAddedToGallery WebhookEventType = "TOKENS_ADDED_TO_GALLERY"
+	WebhookEventTypeNewTokenHolder       WebhookEventType = "NEW_TOKEN_HOLDER"
+	WebhookEventTypeTest                 WebhookEventType = "TEST"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypePostCreated,
+	WebhookEventTypeUserFollowed,
+	WebhookEventTypeTokensAddedToGallery,
+	WebhookEventTypeNewTokenHolder,
+	WebhookEventTypeTest,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypePostCreated, WebhookEventTypeUserFollowed, WebhookEventTypeTokensAddedToGallery, WebhookEventTypeNewTokenHolder, WebhookEventTypeTest:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -58,6 +58,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"CancelScheduledPostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CancelScheduledPostPayloadOrError)
+		return obj, ok
+	},
+
 	"CollectionByIdOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(CollectionByIDOrError)
 		return obj, ok
@@ -123,6 +128,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"CreateWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"DeleteCollectionPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DeleteCollectionPayloadOrError)
 		return obj, ok
@@ -138,6 +148,16 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"DeleteRepostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteRepostPayloadOrError)
+		return obj, ok
+	},
+
+	"DeleteWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"DisconnectSocialAccountPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DisconnectSocialAccountPayloadOrError)
 		return obj, ok
@@ -173,6 +193,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"FollowTopicPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(FollowTopicPayloadOrError)
+		return obj, ok
+	},
+
 	"FollowUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(FollowUserPayloadOrError)
 		return obj, ok
@@ -193,6 +218,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"GalleryVersionsPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(GalleryVersionsPayloadOrError)
+		return obj, ok
+	},
+
 	"GenerateQRCodeLoginTokenPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(GenerateQRCodeLoginTokenPayloadOrError)
 		return obj, ok
@@ -208,6 +238,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"HideCommentPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(HideCommentPayloadOrError)
+		return obj, ok
+	},
+
 	"HighlightClaimMintPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(HighlightClaimMintPayloadOrError)
 		return obj, ok
@@ -258,11 +293,41 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"ModeratePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ModeratePayloadOrError)
+		return obj, ok
+	},
+
+	"ModerationActionsPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ModerationActionsPayloadOrError)
+		return obj, ok
+	},
+
+	"ModerationQueuePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ModerationQueuePayloadOrError)
+		return obj, ok
+	},
+
 	"MoveCollectionToGalleryPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(MoveCollectionToGalleryPayloadOrError)
 		return obj, ok
 	},
 
+	"MuteCommunityPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(MuteCommunityPayloadOrError)
+		return obj, ok
+	},
+
+	"MuteKeywordPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(MuteKeywordPayloadOrError)
+		return obj, ok
+	},
+
+	"MuteUserPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(MuteUserPayloadOrError)
+		return obj, ok
+	},
+
 	"Node": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(Node)
 		return obj, ok
@@ -368,21 +433,61 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"ReplayTokenProcessingFailuresPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReplayTokenProcessingFailuresPayloadOrError)
+		return obj, ok
+	},
+
+	"ReportCommentPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReportCommentPayloadOrError)
+		return obj, ok
+	},
+
+	"ReportGalleryPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReportGalleryPayloadOrError)
+		return obj, ok
+	},
+
 	"ReportPostPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(ReportPostPayloadOrError)
 		return obj, ok
 	},
 
+	"ReportUserPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReportUserPayloadOrError)
+		return obj, ok
+	},
+
+	"RepostPostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RepostPostPayloadOrError)
+		return obj, ok
+	},
+
 	"ResendVerificationEmailPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(ResendVerificationEmailPayloadOrError)
 		return obj, ok
 	},
 
+	"RestoreGalleryVersionPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RestoreGalleryVersionPayloadOrError)
+		return obj, ok
+	},
+
+	"RevokeModerationActionPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RevokeModerationActionPayloadOrError)
+		return obj, ok
+	},
+
 	"RevokeRolesFromUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(RevokeRolesFromUserPayloadOrError)
 		return obj, ok
 	},
 
+	"SchedulePostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SchedulePostPayloadOrError)
+		return obj, ok
+	},
+
 	"SearchCommunitiesPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(SearchCommunitiesPayloadOrError)
 		return obj, ok
@@ -408,6 +513,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"SetPostCommentRestrictionPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SetPostCommentRestrictionPayloadOrError)
+		return obj, ok
+	},
+
 	"SetProfileImagePayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(SetProfileImagePayloadOrError)
 		return obj, ok
@@ -463,11 +573,31 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"TestWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(TestWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"TokenByIdOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(TokenByIDOrError)
 		return obj, ok
 	},
 
+	"TokenProcessingFailuresPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(TokenProcessingFailuresPayloadOrError)
+		return obj, ok
+	},
+
+	"TopicOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(TopicOrError)
+		return obj, ok
+	},
+
+	"TrendingTopicsPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(TrendingTopicsPayloadOrError)
+		return obj, ok
+	},
+
 	"TrendingUsersPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(TrendingUsersPayloadOrError)
 		return obj, ok
@@ -488,6 +618,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UnmutePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UnmutePayloadOrError)
+		return obj, ok
+	},
+
 	"UnregisterUserPushTokenPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UnregisterUserPushTokenPayloadOrError)
 		return obj, ok
@@ -553,11 +688,21 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdatePostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdatePostPayloadOrError)
+		return obj, ok
+	},
+
 	"UpdatePrimaryWalletPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UpdatePrimaryWalletPayloadOrError)
 		return obj, ok
 	},
 
+	"UpdateScheduledPostPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateScheduledPostPayloadOrError)
+		return obj, ok
+	},
+
 	"UpdateSocialAccountDisplayedPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UpdateSocialAccountDisplayedPayloadOrError)
 		return obj, ok
@@ -578,6 +723,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdateWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"UploadPersistedQueriesPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UploadPersistedQueriesPayloadOrError)
 		return obj, ok
@@ -18,12 +18,15 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/mikeydub/go-gallery/graphql/model"
 	"github.com/mikeydub/go-gallery/service/auth"
+	"github.com/mikeydub/go-gallery/service/limiters"
 	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/redis"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/tracing"
 
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/segmentio/ksuid"
@@ -72,8 +75,13 @@ func MutationCachingHandler(newPublicAPI func(context.Context, bool) *publicapi.
 	return func(ctx context.Context, next gqlgen.Resolver) (res interface{}, err error) {
 		fc := gqlgen.GetFieldContext(ctx)
 
-		// If the current field isn't a top-level mutation, no handling is necessary
-		if fc == nil || fc.Field.ObjectDefinition == nil || fc.Field.ObjectDefinition.Name != "Mutation" {
+		// If the current field isn't a top-level mutation or subscription, no handling is necessary
+		if fc == nil || fc.Field.ObjectDefinition == nil {
+			return next(ctx)
+		}
+
+		objectName := fc.Field.ObjectDefinition.Name
+		if objectName != "Mutation" && objectName != "Subscription" {
 			return next(ctx)
 		}
 
@@ -81,6 +89,13 @@ func MutationCachingHandler(newPublicAPI func(context.Context, bool) *publicapi.
 		gc := util.MustGetGinContext(ctx)
 		requestContext := gc.Request.Context()
 
+		// Subscriptions resolve the same objects repeatedly for as long as they're open, so they
+		// never use caching. Otherwise, an object that changed would be returned as it was the
+		// first time it was loaded.
+		if objectName == "Subscription" {
+			return next(publicapi.PushTo(ctx, newPublicAPI(requestContext, true)))
+		}
+
 		// Get or create a new public API with caching disabled, and push it to our context
 		newAPI := new(publicapi.PublicAPI)
 		if existingAPI, ok := gc.Value(noCachePublicAPIContextKey).(*publicapi.PublicAPI); ok {
@@ -197,6 +212,154 @@ func FrontendBuildAuthDirectiveHandler() func(ctx context.Context, obj interface
 	}
 }
 
+// RateLimitDirectiveHandler limits how often a field can be called. Each field gets its own limiter, so limits
+// aren't shared between fields. The schema's @rateLimit arguments are checked when the handler is created, and
+// it panics if any of them are invalid so that a bad directive fails at startup instead of on a request.
+func RateLimitDirectiveHandler(schema *ast.Schema, cache *redis.Cache) func(ctx context.Context, obj interface{}, next gqlgen.Resolver, limit int, window string, key *model.RateLimitKey, arg *string) (res interface{}, err error) {
+	configs, err := rateLimitConfigs(schema)
+	if err != nil {
+		panic(err)
+	}
+
+	fieldLimiters := make(map[string]*limiters.KeyRateLimiter, len(configs))
+	for field, c := range configs {
+		fieldLimiters[field] = limiters.NewKeyRateLimiter(context.Background(), cache, field, int64(c.limit), c.window)
+	}
+
+	return func(ctx context.Context, obj interface{}, next gqlgen.Resolver, limit int, window string, key *model.RateLimitKey, arg *string) (res interface{}, err error) {
+		fc := gqlgen.GetFieldContext(ctx)
+		field := fc.Object + "." + fc.Field.Name
+
+		c, ok := configs[field]
+		if !ok {
+			return nil, fmt.Errorf("no @rateLimit found for %s", field)
+		}
+
+		canContinue, tryAgainAfter, err := fieldLimiters[field].ForKey(ctx, rateLimitKey(ctx, fc, c))
+		if err != nil {
+			// Don't fail requests because the limiter is unavailable
+			logger.For(ctx).Warnf("failed to rate limit %s: %s", field, err)
+			return next(ctx)
+		}
+
+		if canContinue {
+			return next(ctx)
+		}
+
+		rateLimited := model.ErrRateLimited{
+			Message:           fmt.Sprintf("rate limited, try again in %s", tryAgainAfter.Round(time.Second)),
+			RetryAfterSeconds: int(tryAgainAfter.Seconds()) + 1,
+		}
+
+		if converted, ok := model.ConvertToModelType(rateLimited, fc.Field.Definition.Type.NamedType); ok {
+			return converted, nil
+		}
+
+		return nil, errors.New(rateLimited.Message)
+	}
+}
+
+type rateLimitConfig struct {
+	limit  int
+	window time.Duration
+	key    model.RateLimitKey
+	arg    string
+}
+
+// rateLimitConfigs returns the parsed @rateLimit arguments of every field in the schema, keyed by "Object.field"
+func rateLimitConfigs(schema *ast.Schema) (map[string]rateLimitConfig, error) {
+	configs := make(map[string]rateLimitConfig)
+
+	for _, def := range schema.Types {
+		if def.Kind != ast.Object {
+			continue
+		}
+
+		for _, f := range def.Fields {
+			d := f.Directives.ForName("rateLimit")
+			if d == nil {
+				continue
+			}
+
+			field := def.Name + "." + f.Name
+			args := d.ArgumentMap(nil)
+			c := rateLimitConfig{key: model.RateLimitKeyUser}
+
+			if limit, ok := args["limit"].(int64); ok {
+				c.limit = int(limit)
+			}
+			if c.limit <= 0 {
+				return nil, fmt.Errorf("@rateLimit on %s must have a limit greater than zero", field)
+			}
+
+			window, _ := args["window"].(string)
+			w, err := time.ParseDuration(window)
+			if err != nil {
+				return nil, fmt.Errorf("invalid @rateLimit window %q on %s: %w", window, field, err)
+			}
+			if w <= 0 {
+				return nil, fmt.Errorf("@rateLimit on %s must have a window greater than zero", field)
+			}
+			c.window = w
+
+			if key, ok := args["key"].(string); ok {
+				c.key = model.RateLimitKey(key)
+			}
+			if !c.key.IsValid() {
+				return nil, fmt.Errorf("@rateLimit on %s uses unknown key %q", field, c.key)
+			}
+
+			arg, _ := args["arg"].(string)
+			if c.key == model.RateLimitKeyArg {
+				if arg == "" {
+					return nil, fmt.Errorf("@rateLimit on %s uses key ARG without an arg", field)
+				}
+				if f.Arguments.ForName(arg) == nil {
+					return nil, fmt.Errorf("@rateLimit on %s uses unknown arg %q", field, arg)
+				}
+				c.arg = arg
+			}
+
+			// Directives are applied in reverse order, so @rateLimit has to come first to run after @authRequired.
+			// Otherwise calls that fail authorization would still count towards the limit.
+			for _, other := range f.Directives {
+				if other == d {
+					break
+				}
+				if other.Name == "authRequired" {
+					return nil, fmt.Errorf("@rateLimit on %s must be listed before @authRequired", field)
+				}
+			}
+
+			configs[field] = c
+		}
+	}
+
+	return configs, nil
+}
+
+func rateLimitKey(ctx context.Context, fc *gqlgen.FieldContext, c rateLimitConfig) string {
+	gc := util.MustGetGinContext(ctx)
+
+	switch c.key {
+	case model.RateLimitKeyIP:
+		return "ip:" + gc.ClientIP()
+	case model.RateLimitKeyArg:
+		value := fc.Args[c.arg]
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && !v.IsNil() {
+			value = v.Elem().Interface()
+		}
+		return "arg:" + fmt.Sprint(value)
+	}
+
+	if auth.GetUserAuthedFromCtx(gc) {
+		return "user:" + auth.GetUserIDFromCtx(gc).String()
+	}
+
+	// Logged out users are limited by IP instead
+	return "ip:" + gc.ClientIP()
+}
+
 func AuthRequiredDirectiveHandler() func(ctx context.Context, obj interface{}, next gqlgen.Resolver) (res interface{}, err error) {
 
 	return func(ctx context.Context, obj interface{}, next gqlgen.Resolver) (res interface{}, err error) {
@@ -232,6 +395,22 @@ func AuthRequiredDirectiveHandler() func(ctx context.Context, obj interface{}, n
 			panic(fmt.Errorf("userID is empty, but no auth error occurred"))
 		}
 
+		// Suspended users can still browse, but can't make changes
+		if fc := gqlgen.GetFieldContext(ctx); fc != nil && fc.Object == "Mutation" {
+			suspension, err := publicapi.For(ctx).User.GetActiveSuspension(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if suspension != nil {
+				errorMsg := "user is suspended"
+				suspended := model.ErrUserSuspended{Message: errorMsg}
+				if suspension.ExpiresAt.Valid {
+					suspended.Until = &suspension.ExpiresAt.Time
+				}
+				return makeErrNotAuthorized(errorMsg, suspended), nil
+			}
+		}
+
 		return next(ctx)
 	}
 }
@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/mikeydub/go-gallery/graphql/generated"
+	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const rateLimitTestSchema = `
+directive @authRequired on FIELD_DEFINITION
+directive @rateLimit(limit: Int!, window: String!, key: RateLimitKey = USER, arg: String) on FIELD_DEFINITION
+
+enum RateLimitKey {
+  USER
+  IP
+  ARG
+}
+
+type Query {
+  ok: Int
+}
+
+type Mutation {
+`
+
+func loadRateLimitSchema(t *testing.T, field string) *ast.Schema {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: rateLimitTestSchema + field + "\n}"})
+	require.Nil(t, err)
+	return schema
+}
+
+func TestRateLimitConfigs(t *testing.T) {
+	t.Run("the schema's directives are valid", func(t *testing.T) {
+		configs, err := rateLimitConfigs(generated.NewExecutableSchema(generated.Config{}).Schema())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, configs)
+	})
+
+	t.Run("arguments are parsed", func(t *testing.T) {
+		schema := loadRateLimitSchema(t, `follow(userId: ID!): Int @rateLimit(limit: 3, window: "30s", key: ARG, arg: "userId") @authRequired`)
+		configs, err := rateLimitConfigs(schema)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]rateLimitConfig{
+			"Mutation.follow": {limit: 3, window: 30 * time.Second, key: model.RateLimitKeyArg, arg: "userId"},
+		}, configs)
+	})
+
+	t.Run("key defaults to user", func(t *testing.T) {
+		schema := loadRateLimitSchema(t, `follow: Int @rateLimit(limit: 3, window: "1m")`)
+		configs, err := rateLimitConfigs(schema)
+		require.NoError(t, err)
+		assert.Equal(t, model.RateLimitKeyUser, configs["Mutation.follow"].key)
+	})
+
+	invalid := []struct {
+		name  string
+		field string
+	}{
+		{"invalid window", `follow: Int @rateLimit(limit: 3, window: "soon")`},
+		{"zero limit", `follow: Int @rateLimit(limit: 0, window: "1m")`},
+		{"ARG without an arg", `follow(userId: ID!): Int @rateLimit(limit: 3, window: "1m", key: ARG)`},
+		{"unknown arg", `follow(userId: ID!): Int @rateLimit(limit: 3, window: "1m", key: ARG, arg: "id")`},
+		{"listed after @authRequired", `follow: Int @authRequired @rateLimit(limit: 3, window: "1m")`},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := rateLimitConfigs(loadRateLimitSchema(t, tc.field))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRateLimitKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+	gc.Request = httptest.NewRequest("POST", "/", nil)
+	gc.Request.RemoteAddr = "10.1.2.3:1234"
+	ctx := context.WithValue(context.Background(), util.GinContextKey, gc)
+
+	userID := "user1"
+	fc := &gqlgen.FieldContext{Args: map[string]interface{}{"userId": &userID}}
+
+	t.Run("logged out users are limited by IP", func(t *testing.T) {
+		assert.Equal(t, "ip:10.1.2.3", rateLimitKey(ctx, fc, rateLimitConfig{key: model.RateLimitKeyUser}))
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		assert.Equal(t, "ip:10.1.2.3", rateLimitKey(ctx, fc, rateLimitConfig{key: model.RateLimitKeyIP}))
+	})
+
+	t.Run("arg pointers are dereferenced", func(t *testing.T) {
+		assert.Equal(t, "arg:user1", rateLimitKey(ctx, fc, rateLimitConfig{key: model.RateLimitKeyArg, arg: "userId"}))
+	})
+}
@@ -368,8 +368,8 @@ func (r *communityResolver) Holders(ctx context.Context, obj *model.Community, b
 }
 
 // Tokens is the resolver for the tokens field.
-func (r *communityResolver) Tokens(ctx context.Context, obj *model.Community, before *string, after *string, first *int, last *int) (*model.TokensConnection, error) {
-	return resolveCommunityTokensByCommunityID(ctx, obj.Dbid, before, after, first, last)
+func (r *communityResolver) Tokens(ctx context.Context, obj *model.Community, before *string, after *string, first *int, last *int, maxSpamScore *int) (*model.TokensConnection, error) {
+	return resolveCommunityTokensByCommunityID(ctx, obj.Dbid, before, after, first, last, maxSpamScore)
 }
 
 // Posts is the resolver for the posts field.
@@ -462,7 +462,7 @@ func (r *communityResolver) Creator(ctx context.Context, obj *model.Community) (
 
 // TokensInCommunity is the resolver for the tokensInCommunity field.
 func (r *communityResolver) TokensInCommunity(ctx context.Context, obj *model.Community, before *string, after *string, first *int, last *int, onlyGalleryUsers *bool) (*model.TokensConnection, error) {
-	return resolveCommunityTokensByCommunityID(ctx, obj.Dbid, before, after, first, last)
+	return resolveCommunityTokensByCommunityID(ctx, obj.Dbid, before, after, first, last, nil)
 }
 
 // Owners is the resolver for the owners field.
@@ -527,6 +527,15 @@ func (r *communityResolver) ViewerIsMember(ctx context.Context, obj *model.Commu
 	return &isMember, nil
 }
 
+// SpamScore is the resolver for the spamScore field.
+func (r *contractResolver) SpamScore(ctx context.Context, obj *model.Contract) (*model.SpamScore, error) {
+	score, err := publicapi.For(ctx).Contract.GetSpamScoreByContractID(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+	return spamScoreToModel(score), nil
+}
+
 // Contract is the resolver for the contract field.
 func (r *contractCommunityResolver) Contract(ctx context.Context, obj *model.ContractCommunity) (*model.Contract, error) {
 	return resolveContractByContractID(ctx, obj.HelperContractCommunityData.Community.ContractID)
@@ -757,8 +766,8 @@ func (r *galleryUserResolver) SocialAccounts(ctx context.Context, obj *model.Gal
 }
 
 // Tokens is the resolver for the tokens field.
-func (r *galleryUserResolver) Tokens(ctx context.Context, obj *model.GalleryUser, ownershipFilter []persist.TokenOwnershipType) ([]*model.Token, error) {
-	tokens, err := publicapi.For(ctx).Token.GetTokensByUserID(ctx, obj.Dbid, ownershipFilter)
+func (r *galleryUserResolver) Tokens(ctx context.Context, obj *model.GalleryUser, ownershipFilter []persist.TokenOwnershipType, maxSpamScore *int) ([]*model.Token, error) {
+	tokens, err := publicapi.For(ctx).Token.GetTokensByUserID(ctx, obj.Dbid, ownershipFilter, maxSpamScore)
 
 	if err != nil {
 		return nil, err
@@ -1024,6 +1033,33 @@ func (r *mutationResolver) ReportPost(ctx context.Context, postID persist.DBID,
 	return model.ReportPostPayload{PostID: postID}, nil
 }
 
+// ReportComment is the resolver for the reportComment field.
+func (r *mutationResolver) ReportComment(ctx context.Context, commentID persist.DBID, reason persist.ReportReason) (model.ReportCommentPayloadOrError, error) {
+	err := publicapi.For(ctx).Interaction.ReportComment(ctx, commentID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return model.ReportCommentPayload{CommentID: commentID}, nil
+}
+
+// ReportUser is the resolver for the reportUser field.
+func (r *mutationResolver) ReportUser(ctx context.Context, userID persist.DBID, reason persist.ReportReason) (model.ReportUserPayloadOrError, error) {
+	err := publicapi.For(ctx).Interaction.ReportUser(ctx, userID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return model.ReportUserPayload{UserID: userID}, nil
+}
+
+// ReportGallery is the resolver for the reportGallery field.
+func (r *mutationResolver) ReportGallery(ctx context.Context, galleryID persist.DBID, reason persist.ReportReason) (model.ReportGalleryPayloadOrError, error) {
+	err := publicapi.For(ctx).Interaction.ReportGallery(ctx, galleryID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return model.ReportGalleryPayload{GalleryID: galleryID}, nil
+}
+
 // BlockUser is the resolver for the blockUser field.
 func (r *mutationResolver) BlockUser(ctx context.Context, userID persist.DBID) (model.BlockUserPayloadOrError, error) {
 	err := publicapi.For(ctx).User.BlockUser(ctx, userID)
@@ -1042,6 +1078,66 @@ func (r *mutationResolver) UnblockUser(ctx context.Context, userID persist.DBID)
 	return model.UnblockUserPayload{UserID: userID}, nil
 }
 
+// MuteUser is the resolver for the muteUser field.
+func (r *mutationResolver) MuteUser(ctx context.Context, userID persist.DBID, durationDays *int) (model.MuteUserPayloadOrError, error) {
+	mute, err := publicapi.For(ctx).User.MuteUser(ctx, userID, durationDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.MutePayload{Mute: muteToModel(*mute), Viewer: resolveViewer(ctx)}, nil
+}
+
+// MuteCommunity is the resolver for the muteCommunity field.
+func (r *mutationResolver) MuteCommunity(ctx context.Context, communityID persist.DBID, durationDays *int) (model.MuteCommunityPayloadOrError, error) {
+	mute, err := publicapi.For(ctx).User.MuteCommunity(ctx, communityID, durationDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.MutePayload{Mute: muteToModel(*mute), Viewer: resolveViewer(ctx)}, nil
+}
+
+// MuteKeyword is the resolver for the muteKeyword field.
+func (r *mutationResolver) MuteKeyword(ctx context.Context, keyword string, durationDays *int) (model.MuteKeywordPayloadOrError, error) {
+	mute, err := publicapi.For(ctx).User.MuteKeyword(ctx, keyword, durationDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.MutePayload{Mute: muteToModel(*mute), Viewer: resolveViewer(ctx)}, nil
+}
+
+// Unmute is the resolver for the unmute field.
+func (r *mutationResolver) Unmute(ctx context.Context, muteID persist.DBID) (model.UnmutePayloadOrError, error) {
+	err := publicapi.For(ctx).User.Unmute(ctx, muteID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.UnmutePayload{Viewer: resolveViewer(ctx)}, nil
+}
+
+// FollowTopic is the resolver for the followTopic field.
+func (r *mutationResolver) FollowTopic(ctx context.Context, tag string) (model.FollowTopicPayloadOrError, error) {
+	tag, err := publicapi.For(ctx).Topic.FollowTopic(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.FollowTopicPayload{Topic: topicToModel(tag), Viewer: resolveViewer(ctx)}, nil
+}
+
+// UnfollowTopic is the resolver for the unfollowTopic field.
+func (r *mutationResolver) UnfollowTopic(ctx context.Context, tag string) (model.FollowTopicPayloadOrError, error) {
+	tag, err := publicapi.For(ctx).Topic.UnfollowTopic(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.FollowTopicPayload{Topic: topicToModel(tag), Viewer: resolveViewer(ctx)}, nil
+}
+
 // UpdateGalleryCollections is the resolver for the updateGalleryCollections field.
 func (r *mutationResolver) UpdateGalleryCollections(ctx context.Context, input model.UpdateGalleryCollectionsInput) (model.UpdateGalleryCollectionsPayloadOrError, error) {
 	api := publicapi.For(ctx)
@@ -1744,6 +1840,48 @@ func (r *mutationResolver) CommentOnPost(ctx context.Context, postID persist.DBI
 	return output, nil
 }
 
+// HideComment is the resolver for the hideComment field.
+func (r *mutationResolver) HideComment(ctx context.Context, commentID persist.DBID) (model.HideCommentPayloadOrError, error) {
+	comment, err := publicapi.For(ctx).Interaction.HideComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.HideCommentPayload{
+		Comment: commentToModel(ctx, *comment),
+	}
+
+	return output, nil
+}
+
+// UnhideComment is the resolver for the unhideComment field.
+func (r *mutationResolver) UnhideComment(ctx context.Context, commentID persist.DBID) (model.HideCommentPayloadOrError, error) {
+	comment, err := publicapi.For(ctx).Interaction.UnhideComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.HideCommentPayload{
+		Comment: commentToModel(ctx, *comment),
+	}
+
+	return output, nil
+}
+
+// SetPostCommentRestriction is the resolver for the setPostCommentRestriction field.
+func (r *mutationResolver) SetPostCommentRestriction(ctx context.Context, postID persist.DBID, restriction persist.CommentRestriction) (model.SetPostCommentRestrictionPayloadOrError, error) {
+	post, err := publicapi.For(ctx).Feed.SetPostCommentRestriction(ctx, postID, restriction)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.SetPostCommentRestrictionPayload{
+		Post: postToModel(post),
+	}
+
+	return output, nil
+}
+
 // PostTokens is the resolver for the postTokens field.
 func (r *mutationResolver) PostTokens(ctx context.Context, input model.PostTokensInput) (model.PostTokensPayloadOrError, error) {
 	id, err := publicapi.For(ctx).Feed.PostTokens(ctx, input.TokenIds, input.Mentions, input.Caption, input.MintURL)
@@ -1806,6 +1944,81 @@ func (r *mutationResolver) DeletePost(ctx context.Context, postID persist.DBID)
 	return output, nil
 }
 
+// UpdatePost is the resolver for the updatePost field.
+func (r *mutationResolver) UpdatePost(ctx context.Context, input model.UpdatePostInput) (model.UpdatePostPayloadOrError, error) {
+	post, err := publicapi.For(ctx).Feed.UpdatePost(ctx, input.PostID, input.Mentions, input.Caption, input.MintURL)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.UpdatePostPayload{
+		Post: postToModel(post),
+	}
+
+	return output, nil
+}
+
+// SchedulePost is the resolver for the schedulePost field.
+func (r *mutationResolver) SchedulePost(ctx context.Context, input model.SchedulePostInput) (model.SchedulePostPayloadOrError, error) {
+	post, err := publicapi.For(ctx).Feed.SchedulePost(ctx, input.TokenIds, input.Mentions, input.Caption, input.MintURL, input.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SchedulePostPayload{ScheduledPost: scheduledPostToModel(post)}, nil
+}
+
+// UpdateScheduledPost is the resolver for the updateScheduledPost field.
+func (r *mutationResolver) UpdateScheduledPost(ctx context.Context, input model.UpdateScheduledPostInput) (model.UpdateScheduledPostPayloadOrError, error) {
+	post, err := publicapi.For(ctx).Feed.UpdateScheduledPost(ctx, input.ScheduledPostID, input.TokenIds, input.Mentions, input.Caption, input.MintURL, input.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateScheduledPostPayload{ScheduledPost: scheduledPostToModel(post)}, nil
+}
+
+// CancelScheduledPost is the resolver for the cancelScheduledPost field.
+func (r *mutationResolver) CancelScheduledPost(ctx context.Context, scheduledPostID persist.DBID) (model.CancelScheduledPostPayloadOrError, error) {
+	err := publicapi.For(ctx).Feed.CancelScheduledPost(ctx, scheduledPostID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CancelScheduledPostPayload{Viewer: resolveViewer(ctx)}, nil
+}
+
+// RepostPost is the resolver for the repostPost field.
+func (r *mutationResolver) RepostPost(ctx context.Context, postID persist.DBID, caption *string) (model.RepostPostPayloadOrError, error) {
+	repost, err := publicapi.For(ctx).Feed.RepostPost(ctx, postID, caption)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.RepostPostPayload{
+		Viewer: resolveViewer(ctx),
+		Post:   &model.Post{Dbid: postID},
+		Repost: repostToModel(*repost),
+	}
+
+	return output, nil
+}
+
+// DeleteRepost is the resolver for the deleteRepost field.
+func (r *mutationResolver) DeleteRepost(ctx context.Context, repostID persist.DBID) (model.DeleteRepostPayloadOrError, error) {
+	err := publicapi.For(ctx).Feed.DeleteRepost(ctx, repostID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.DeleteRepostPayload{
+		DeletedID: &model.DeletedNode{
+			Dbid: repostID,
+		},
+	}
+	return output, nil
+}
+
 // HighlightClaimMint is the resolver for the highlightClaimMint field.
 func (r *mutationResolver) HighlightClaimMint(ctx context.Context, input model.HighlightClaimMintInput) (model.HighlightClaimMintPayloadOrError, error) {
 	claimID, err := publicapi.For(ctx).Mint.ClaimHighlightMint(ctx, input.CollectionID, input.RecipientWalletID)
@@ -1878,6 +2091,19 @@ func (r *mutationResolver) PublishGallery(ctx context.Context, input model.Publi
 	}, nil
 }
 
+// RestoreGalleryVersion is the resolver for the restoreGalleryVersion field.
+func (r *mutationResolver) RestoreGalleryVersion(ctx context.Context, versionID persist.DBID) (model.RestoreGalleryVersionPayloadOrError, error) {
+	gallery, err := publicapi.For(ctx).Gallery.RestoreGalleryVersion(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.RestoreGalleryVersionPayload{
+		Gallery: galleryToModel(ctx, gallery),
+	}
+	return output, nil
+}
+
 // CreateGallery is the resolver for the createGallery field.
 func (r *mutationResolver) CreateGallery(ctx context.Context, input model.CreateGalleryInput) (model.CreateGalleryPayloadOrError, error) {
 	gallery, err := publicapi.For(ctx).Gallery.CreateGallery(ctx, input.Name, input.Description, input.Position)
@@ -2280,9 +2506,48 @@ func (r *mutationResolver) SetCommunityOverrideCreator(ctx context.Context, comm
 	return model.SetCommunityOverrideCreatorPayload{User: userToModel(ctx, *user)}, nil
 }
 
+// ReplayTokenProcessingFailures is the resolver for the replayTokenProcessingFailures field.
+func (r *mutationResolver) ReplayTokenProcessingFailures(ctx context.Context, filter *model.TokenProcessingFailuresFilterInput) (model.ReplayTokenProcessingFailuresPayloadOrError, error) {
+	if filter == nil {
+		filter = &model.TokenProcessingFailuresFilterInput{}
+	}
+
+	rows, err := publicapi.For(ctx).Admin.ReplayTokenProcessingFailures(ctx, filter.Chain, filter.ContractAddress, filter.ErrorClass, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ReplayTokenProcessingFailuresPayload{Replayed: tokenProcessingFailuresToModel(rows)}, nil
+}
+
+// Moderate is the resolver for the moderate field.
+func (r *mutationResolver) Moderate(ctx context.Context, input model.ModerateInput) (model.ModeratePayloadOrError, error) {
+	action, err := publicapi.For(ctx).Admin.Moderate(ctx, input.SubjectType, input.SubjectID, input.Action, input.Reason, input.Note, input.Moderator, input.SuspendDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ModeratePayload{Action: moderationActionToModel(*action)}, nil
+}
+
+// RevokeModerationAction is the resolver for the revokeModerationAction field.
+func (r *mutationResolver) RevokeModerationAction(ctx context.Context, actionID persist.DBID) (model.RevokeModerationActionPayloadOrError, error) {
+	action, err := publicapi.For(ctx).Admin.RevokeModerationAction(ctx, actionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.RevokeModerationActionPayload{Action: moderationActionToModel(*action)}, nil
+}
+
 // UploadPersistedQueries is the resolver for the uploadPersistedQueries field.
 func (r *mutationResolver) UploadPersistedQueries(ctx context.Context, input *model.UploadPersistedQueriesInput) (model.UploadPersistedQueriesPayloadOrError, error) {
-	err := publicapi.For(ctx).APQ.UploadPersistedQueries(ctx, *input.PersistedQueries)
+	var err error
+	if input.Version != nil {
+		err = publicapi.For(ctx).APQ.UploadManifest(ctx, *input.Version, *input.PersistedQueries)
+	} else {
+		err = publicapi.For(ctx).APQ.UploadPersistedQueries(ctx, *input.PersistedQueries)
+	}
 
 	if err != nil {
 		return nil, err
@@ -2346,6 +2611,65 @@ func (r *mutationResolver) GenerateQRCodeLoginToken(ctx context.Context) (model.
 	}, nil
 }
 
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input model.CreateWebhookInput) (model.CreateWebhookPayloadOrError, error) {
+	hook, err := publicapi.For(ctx).Webhook.CreateWebhook(ctx, input.URL, webhookEventTypesFromModel(input.EventTypes), input.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateWebhookPayload{
+		Webhook: webhookToModel(hook),
+		Secret:  hook.Secret,
+	}, nil
+}
+
+// UpdateWebhook is the resolver for the updateWebhook field.
+func (r *mutationResolver) UpdateWebhook(ctx context.Context, input model.UpdateWebhookInput) (model.UpdateWebhookPayloadOrError, error) {
+	hook, err := publicapi.For(ctx).Webhook.UpdateWebhook(ctx, input.WebhookID, input.URL, webhookEventTypesFromModel(input.EventTypes), input.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateWebhookPayload{Webhook: webhookToModel(hook)}, nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, webhookID persist.DBID) (model.DeleteWebhookPayloadOrError, error) {
+	err := publicapi.For(ctx).Webhook.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeleteWebhookPayload{Viewer: resolveViewer(ctx)}, nil
+}
+
+// TestWebhook is the resolver for the testWebhook field.
+func (r *mutationResolver) TestWebhook(ctx context.Context, webhookID persist.DBID) (model.TestWebhookPayloadOrError, error) {
+	delivery, err := publicapi.For(ctx).Webhook.TestWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TestWebhookPayload{Delivery: webhookDeliveryToModel(delivery)}, nil
+}
+
+// User is the resolver for the user field.
+func (r *muteResolver) User(ctx context.Context, obj *model.Mute) (*model.GalleryUser, error) {
+	if obj.MutedUserID == "" {
+		return nil, nil
+	}
+	return resolveGalleryUserByUserID(ctx, obj.MutedUserID)
+}
+
+// Community is the resolver for the community field.
+func (r *muteResolver) Community(ctx context.Context, obj *model.Mute) (*model.Community, error) {
+	if obj.MutedCommunityID == "" {
+		return nil, nil
+	}
+	return resolveCommunityByID(ctx, obj.MutedCommunityID)
+}
+
 // Token is the resolver for the token field.
 func (r *newTokensNotificationResolver) Token(ctx context.Context, obj *model.NewTokensNotification) (*model.Token, error) {
 	return resolveTokenByTokenID(ctx, obj.NotificationData.NewTokenID)
@@ -2470,6 +2794,41 @@ func (r *postResolver) ViewerAdmire(ctx context.Context, obj *model.Post) (*mode
 	return admireToModel(ctx, *admire), nil
 }
 
+// TotalReposts is the resolver for the totalReposts field.
+func (r *postResolver) TotalReposts(ctx context.Context, obj *model.Post) (*int, error) {
+	return publicapi.For(ctx).Feed.GetTotalRepostsByPostID(ctx, obj.Dbid)
+}
+
+// ViewerRepost is the resolver for the viewerRepost field.
+func (r *postResolver) ViewerRepost(ctx context.Context, obj *model.Post) (*model.Repost, error) {
+	api := publicapi.For(ctx)
+
+	// If the user isn't logged in, there is no viewer
+	if !api.User.IsUserLoggedIn(ctx) {
+		return nil, nil
+	}
+
+	userID := api.User.GetLoggedInUserId(ctx)
+
+	repost, err := api.Feed.GetRepostByActorIDAndPostID(ctx, userID, obj.Dbid)
+	if err != nil {
+		// Like viewerAdmire, this resolver doesn't return error types
+		return nil, nil
+	}
+
+	return repostToModel(*repost), nil
+}
+
+// EditHistory is the resolver for the editHistory field.
+func (r *postResolver) EditHistory(ctx context.Context, obj *model.Post) ([]*model.PostEdit, error) {
+	edits, err := publicapi.For(ctx).Feed.GetPostEditsByPostID(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MapWithoutError(edits, postEditToModel), nil
+}
+
 // Media is the resolver for the media field.
 func (r *postComposerDraftDetailsPayloadResolver) Media(ctx context.Context, obj *model.PostComposerDraftDetailsPayload, darkMode *persist.DarkMode) (model.MediaSubtype, error) {
 	highDef := false
@@ -2739,6 +3098,16 @@ func (r *queryResolver) ViewerGalleryByID(ctx context.Context, id persist.DBID)
 	return gallery, nil
 }
 
+// GalleryVersions is the resolver for the galleryVersions field.
+func (r *queryResolver) GalleryVersions(ctx context.Context, galleryID persist.DBID, limit *int) (model.GalleryVersionsPayloadOrError, error) {
+	versions, err := publicapi.For(ctx).Gallery.GetGalleryVersions(ctx, galleryID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.GalleryVersionsPayload{Versions: util.MapWithoutError(versions, galleryVersionToModel)}, nil
+}
+
 // TrendingUsers is the resolver for the trendingUsers field.
 func (r *queryResolver) TrendingUsers(ctx context.Context, input model.TrendingUsersInput) (model.TrendingUsersPayloadOrError, error) {
 	users, err := publicapi.For(ctx).Feed.TrendingUsers(ctx, input.Report)
@@ -2754,6 +3123,31 @@ func (r *queryResolver) TrendingUsers(ctx context.Context, input model.TrendingU
 	return model.TrendingUsersPayload{Users: result}, nil
 }
 
+// Topic is the resolver for the topic field.
+func (r *queryResolver) Topic(ctx context.Context, tag string) (model.TopicOrError, error) {
+	tag, err := publicapi.For(ctx).Topic.GetTopic(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return topicToModel(tag), nil
+}
+
+// TrendingTopics is the resolver for the trendingTopics field.
+func (r *queryResolver) TrendingTopics(ctx context.Context, input model.TrendingTopicsInput) (model.TrendingTopicsPayloadOrError, error) {
+	topics, err := publicapi.For(ctx).Topic.GetTrendingTopics(ctx, input.Report, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Topic, len(topics))
+	for i, t := range topics {
+		result[i] = topicToModel(t.Tag)
+	}
+
+	return model.TrendingTopicsPayload{Topics: result}, nil
+}
+
 // SearchUsers is the resolver for the searchUsers field.
 func (r *queryResolver) SearchUsers(ctx context.Context, query string, limit *int, usernameWeight *float64, bioWeight *float64) (model.SearchUsersPayloadOrError, error) {
 	limitParam := util.GetOptionalValue(limit, 100)
@@ -2853,6 +3247,45 @@ func (r *queryResolver) UsersByRole(ctx context.Context, role persist.Role, befo
 	}, nil
 }
 
+// TokenProcessingFailures is the resolver for the tokenProcessingFailures field.
+func (r *queryResolver) TokenProcessingFailures(ctx context.Context, filter *model.TokenProcessingFailuresFilterInput) (model.TokenProcessingFailuresPayloadOrError, error) {
+	if filter == nil {
+		filter = &model.TokenProcessingFailuresFilterInput{}
+	}
+
+	rows, err := publicapi.For(ctx).Admin.GetTokenProcessingFailures(ctx, filter.Chain, filter.ContractAddress, filter.ErrorClass, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.TokenProcessingFailuresPayload{Failures: tokenProcessingFailuresToModel(rows)}, nil
+}
+
+// ModerationQueue is the resolver for the moderationQueue field.
+func (r *queryResolver) ModerationQueue(ctx context.Context, subjectType *persist.ReportSubjectType, limit *int) (model.ModerationQueuePayloadOrError, error) {
+	rows, err := publicapi.For(ctx).Admin.GetModerationQueue(ctx, subjectType, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ModerationQueuePayload{Items: moderationQueueToModel(rows)}, nil
+}
+
+// ModerationActions is the resolver for the moderationActions field.
+func (r *queryResolver) ModerationActions(ctx context.Context, subjectType *persist.ReportSubjectType, subjectID *persist.DBID, userID *persist.DBID, limit *int) (model.ModerationActionsPayloadOrError, error) {
+	actions, err := publicapi.For(ctx).Admin.GetModerationActions(ctx, subjectType, subjectID, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.ModerationAction, len(actions))
+	for i, action := range actions {
+		models[i] = moderationActionToModel(action)
+	}
+
+	return model.ModerationActionsPayload{Actions: models}, nil
+}
+
 // SocialConnections is the resolver for the socialConnections field.
 func (r *queryResolver) SocialConnections(ctx context.Context, socialAccountType persist.SocialProvider, excludeAlreadyFollowing *bool, before *string, after *string, first *int, last *int) (*model.SocialConnectionsConnection, error) {
 	connections, pageInfo, err := publicapi.For(ctx).Social.GetConnectionsPaginate(ctx, socialAccountType, before, after, first, last, excludeAlreadyFollowing)
@@ -3016,6 +3449,31 @@ func (r *removeCommentPayloadResolver) Post(ctx context.Context, obj *model.Remo
 	return resolvePostByPostID(ctx, obj.Post.Dbid)
 }
 
+// Author is the resolver for the author field.
+func (r *repostResolver) Author(ctx context.Context, obj *model.Repost) (*model.GalleryUser, error) {
+	return resolveGalleryUserByUserID(ctx, obj.AuthorID)
+}
+
+// Post is the resolver for the post field.
+func (r *repostResolver) Post(ctx context.Context, obj *model.Repost) (*model.Post, error) {
+	return resolvePostByPostID(ctx, obj.HelperRepostData.PostID)
+}
+
+// Post is the resolver for the post field.
+func (r *repostPostPayloadResolver) Post(ctx context.Context, obj *model.RepostPostPayload) (*model.Post, error) {
+	return resolvePostByPostID(ctx, obj.Post.Dbid)
+}
+
+// Author is the resolver for the author field.
+func (r *scheduledPostResolver) Author(ctx context.Context, obj *model.ScheduledPost) (*model.GalleryUser, error) {
+	return resolveGalleryUserByUserID(ctx, obj.AuthorID)
+}
+
+// Tokens is the resolver for the tokens field.
+func (r *scheduledPostResolver) Tokens(ctx context.Context, obj *model.ScheduledPost) ([]*model.Token, error) {
+	return resolveTokensByTokenIDs(ctx, obj.TokenIDs)
+}
+
 // Tokens is the resolver for the tokens field.
 func (r *setSpamPreferencePayloadResolver) Tokens(ctx context.Context, obj *model.SetSpamPreferencePayload) ([]*model.Token, error) {
 	tokenIDs := make([]persist.DBID, len(obj.Tokens))
@@ -3171,6 +3629,16 @@ func (r *someoneRepliedToYourCommentNotificationResolver) OriginalComment(ctx co
 	return resolveCommentByCommentID(ctx, obj.NotificationData.OriginalCommentID)
 }
 
+// Repost is the resolver for the repost field.
+func (r *someoneRepostedYourPostNotificationResolver) Repost(ctx context.Context, obj *model.SomeoneRepostedYourPostNotification) (*model.Repost, error) {
+	return resolveRepostByID(ctx, obj.NotificationData.RepostID)
+}
+
+// Post is the resolver for the post field.
+func (r *someoneRepostedYourPostNotificationResolver) Post(ctx context.Context, obj *model.SomeoneRepostedYourPostNotification) (*model.Post, error) {
+	return resolvePostByPostID(ctx, obj.PostID)
+}
+
 // UserViewers is the resolver for the userViewers field.
 func (r *someoneViewedYourGalleryNotificationResolver) UserViewers(ctx context.Context, obj *model.SomeoneViewedYourGalleryNotification, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
 	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.AuthedViewerIDs, before, after, first, last)
@@ -3201,6 +3669,26 @@ func (r *subscriptionResolver) NotificationUpdated(ctx context.Context) (<-chan
 	return resolveUpdatedNotificationSubscription(ctx), nil
 }
 
+// CommentAdded is the resolver for the commentAdded field.
+func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID *persist.DBID, feedEventID *persist.DBID) (<-chan *model.Comment, error) {
+	return resolveCommentAddedSubscription(ctx, postID, feedEventID)
+}
+
+// AdmireAdded is the resolver for the admireAdded field.
+func (r *subscriptionResolver) AdmireAdded(ctx context.Context, postID *persist.DBID, feedEventID *persist.DBID) (<-chan *model.Admire, error) {
+	return resolveAdmireAddedSubscription(ctx, postID, feedEventID)
+}
+
+// FollowedUserPosted is the resolver for the followedUserPosted field.
+func (r *subscriptionResolver) FollowedUserPosted(ctx context.Context) (<-chan *model.Post, error) {
+	return resolveFollowedUserPostedSubscription(ctx)
+}
+
+// TokenProcessed is the resolver for the tokenProcessed field.
+func (r *subscriptionResolver) TokenProcessed(ctx context.Context, tokenDefinitionID persist.DBID) (<-chan *model.TokenDefinition, error) {
+	return resolveTokenProcessedSubscription(ctx, tokenDefinitionID)
+}
+
 // Owner is the resolver for the owner field.
 func (r *tokenResolver) Owner(ctx context.Context, obj *model.Token) (*model.GalleryUser, error) {
 	return resolveTokenOwnerByTokenID(ctx, obj.Dbid)
@@ -3472,6 +3960,15 @@ func (r *tokenDefinitionResolver) MintURL(ctx context.Context, obj *model.TokenD
 	return &mintURL, nil
 }
 
+// SpamScore is the resolver for the spamScore field.
+func (r *tokenDefinitionResolver) SpamScore(ctx context.Context, obj *model.TokenDefinition) (*model.SpamScore, error) {
+	score, err := publicapi.For(ctx).Token.GetSpamScoreByTokenDefinitionID(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+	return spamScoreToModel(score), nil
+}
+
 // Wallets is the resolver for the wallets field.
 func (r *tokenHolderResolver) Wallets(ctx context.Context, obj *model.TokenHolder) ([]*model.Wallet, error) {
 	wallets := make([]*model.Wallet, 0, len(obj.WalletIds))
@@ -3518,6 +4015,37 @@ func (r *tokensAddedToCollectionFeedEventDataResolver) NewTokens(ctx context.Con
 	return resolveCollectionTokensByTokenIDs(ctx, obj.CollectionID, obj.TokenIDs)
 }
 
+// Posts is the resolver for the posts field.
+func (r *topicResolver) Posts(ctx context.Context, obj *model.Topic, before *string, after *string, first *int, last *int) (*model.PostsConnection, error) {
+	posts, pageInfo, err := publicapi.For(ctx).Topic.PaginatePostsByTopic(ctx, obj.Tag, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := postsToConnection(ctx, posts, "", pageInfo)
+	return &connection, nil
+}
+
+// TotalPosts is the resolver for the totalPosts field.
+func (r *topicResolver) TotalPosts(ctx context.Context, obj *model.Topic) (*int, error) {
+	total, err := publicapi.For(ctx).Topic.CountPostsByTopic(ctx, obj.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &total, nil
+}
+
+// ViewerIsFollowing is the resolver for the viewerIsFollowing field.
+func (r *topicResolver) ViewerIsFollowing(ctx context.Context, obj *model.Topic) (*bool, error) {
+	following, err := publicapi.For(ctx).Topic.IsViewerFollowingTopic(ctx, obj.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &following, nil
+}
+
 // User is the resolver for the user field.
 func (r *unfollowUserPayloadResolver) User(ctx context.Context, obj *model.UnfollowUserPayload) (*model.GalleryUser, error) {
 	return resolveGalleryUserByUserID(ctx, obj.User.Dbid)
@@ -3655,11 +4183,44 @@ func (r *viewerResolver) SuggestedUsersFarcaster(ctx context.Context, obj *model
 	}, nil
 }
 
+// Webhooks is the resolver for the webhooks field.
+func (r *viewerResolver) Webhooks(ctx context.Context, obj *model.Viewer) ([]*model.Webhook, error) {
+	return resolveViewerWebhooks(ctx)
+}
+
+// ScheduledPosts is the resolver for the scheduledPosts field.
+func (r *viewerResolver) ScheduledPosts(ctx context.Context, obj *model.Viewer) ([]*model.ScheduledPost, error) {
+	return resolveViewerScheduledPosts(ctx)
+}
+
+// Mutes is the resolver for the mutes field.
+func (r *viewerResolver) Mutes(ctx context.Context, obj *model.Viewer) ([]*model.Mute, error) {
+	return resolveViewerMutes(ctx)
+}
+
+// FollowedTopics is the resolver for the followedTopics field.
+func (r *viewerResolver) FollowedTopics(ctx context.Context, obj *model.Viewer) ([]*model.Topic, error) {
+	return resolveViewerFollowedTopics(ctx)
+}
+
 // Tokens is the resolver for the tokens field.
 func (r *walletResolver) Tokens(ctx context.Context, obj *model.Wallet) ([]*model.Token, error) {
 	return resolveTokensByWalletID(ctx, obj.Dbid)
 }
 
+// Community is the resolver for the community field.
+func (r *webhookResolver) Community(ctx context.Context, obj *model.Webhook) (*model.Community, error) {
+	if obj.CommunityID == "" {
+		return nil, nil
+	}
+	return resolveCommunityByID(ctx, obj.CommunityID)
+}
+
+// Deliveries is the resolver for the deliveries field.
+func (r *webhookResolver) Deliveries(ctx context.Context, obj *model.Webhook, before *string, after *string, first *int, last *int) (*model.WebhookDeliveriesConnection, error) {
+	return resolveWebhookDeliveries(ctx, obj.Dbid, before, after, first, last)
+}
+
 // Address is the resolver for the address field.
 func (r *chainAddressInputResolver) Address(ctx context.Context, obj *persist.ChainAddress, data persist.Address) error {
 	return obj.GQLSetAddressFromResolver(data)
@@ -3752,6 +4313,9 @@ func (r *Resolver) CommentOnPostPayload() generated.CommentOnPostPayloadResolver
 // Community returns generated.CommunityResolver implementation.
 func (r *Resolver) Community() generated.CommunityResolver { return &communityResolver{r} }
 
+// Contract returns generated.ContractResolver implementation.
+func (r *Resolver) Contract() generated.ContractResolver { return &contractResolver{r} }
+
 // ContractCommunity returns generated.ContractCommunityResolver implementation.
 func (r *Resolver) ContractCommunity() generated.ContractCommunityResolver {
 	return &contractCommunityResolver{r}
@@ -3805,6 +4369,9 @@ func (r *Resolver) Mention() generated.MentionResolver { return &mentionResolver
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// Mute returns generated.MuteResolver implementation.
+func (r *Resolver) Mute() generated.MuteResolver { return &muteResolver{r} }
+
 // NewTokensNotification returns generated.NewTokensNotificationResolver implementation.
 func (r *Resolver) NewTokensNotification() generated.NewTokensNotificationResolver {
 	return &newTokensNotificationResolver{r}
@@ -3837,6 +4404,17 @@ func (r *Resolver) RemoveCommentPayload() generated.RemoveCommentPayloadResolver
 	return &removeCommentPayloadResolver{r}
 }
 
+// Repost returns generated.RepostResolver implementation.
+func (r *Resolver) Repost() generated.RepostResolver { return &repostResolver{r} }
+
+// RepostPostPayload returns generated.RepostPostPayloadResolver implementation.
+func (r *Resolver) RepostPostPayload() generated.RepostPostPayloadResolver {
+	return &repostPostPayloadResolver{r}
+}
+
+// ScheduledPost returns generated.ScheduledPostResolver implementation.
+func (r *Resolver) ScheduledPost() generated.ScheduledPostResolver { return &scheduledPostResolver{r} }
+
 // SetSpamPreferencePayload returns generated.SetSpamPreferencePayloadResolver implementation.
 func (r *Resolver) SetSpamPreferencePayload() generated.SetSpamPreferencePayloadResolver {
 	return &setSpamPreferencePayloadResolver{r}
@@ -3910,6 +4488,11 @@ func (r *Resolver) SomeoneRepliedToYourCommentNotification() generated.SomeoneRe
 	return &someoneRepliedToYourCommentNotificationResolver{r}
 }
 
+// SomeoneRepostedYourPostNotification returns generated.SomeoneRepostedYourPostNotificationResolver implementation.
+func (r *Resolver) SomeoneRepostedYourPostNotification() generated.SomeoneRepostedYourPostNotificationResolver {
+	return &someoneRepostedYourPostNotificationResolver{r}
+}
+
 // SomeoneViewedYourGalleryNotification returns generated.SomeoneViewedYourGalleryNotificationResolver implementation.
 func (r *Resolver) SomeoneViewedYourGalleryNotification() generated.SomeoneViewedYourGalleryNotificationResolver {
 	return &someoneViewedYourGalleryNotificationResolver{r}
@@ -3944,6 +4527,9 @@ func (r *Resolver) TokensAddedToCollectionFeedEventData() generated.TokensAddedT
 	return &tokensAddedToCollectionFeedEventDataResolver{r}
 }
 
+// Topic returns generated.TopicResolver implementation.
+func (r *Resolver) Topic() generated.TopicResolver { return &topicResolver{r} }
+
 // UnfollowUserPayload returns generated.UnfollowUserPayloadResolver implementation.
 func (r *Resolver) UnfollowUserPayload() generated.UnfollowUserPayloadResolver {
 	return &unfollowUserPayloadResolver{r}
@@ -3973,6 +4559,9 @@ func (r *Resolver) Viewer() generated.ViewerResolver { return &viewerResolver{r}
 // Wallet returns generated.WalletResolver implementation.
 func (r *Resolver) Wallet() generated.WalletResolver { return &walletResolver{r} }
 
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
 // ChainAddressInput returns generated.ChainAddressInputResolver implementation.
 func (r *Resolver) ChainAddressInput() generated.ChainAddressInputResolver {
 	return &chainAddressInputResolver{r}
@@ -3999,6 +4588,7 @@ type commentResolver struct{ *Resolver }
 type commentOnFeedEventPayloadResolver struct{ *Resolver }
 type commentOnPostPayloadResolver struct{ *Resolver }
 type communityResolver struct{ *Resolver }
+type contractResolver struct{ *Resolver }
 type contractCommunityResolver struct{ *Resolver }
 type createCollectionPayloadResolver struct{ *Resolver }
 type ensProfileImageResolver struct{ *Resolver }
@@ -4012,6 +4602,7 @@ type galleryUserResolver struct{ *Resolver }
 type highlightMintClaimStatusPayloadResolver struct{ *Resolver }
 type mentionResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type muteResolver struct{ *Resolver }
 type newTokensNotificationResolver struct{ *Resolver }
 type ownerAtBlockResolver struct{ *Resolver }
 type postResolver struct{ *Resolver }
@@ -4020,6 +4611,9 @@ type previewURLSetResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type removeAdmirePayloadResolver struct{ *Resolver }
 type removeCommentPayloadResolver struct{ *Resolver }
+type repostResolver struct{ *Resolver }
+type repostPostPayloadResolver struct{ *Resolver }
+type scheduledPostResolver struct{ *Resolver }
 type setSpamPreferencePayloadResolver struct{ *Resolver }
 type socialConnectionResolver struct{ *Resolver }
 type socialQueriesResolver struct{ *Resolver }
@@ -4035,6 +4629,7 @@ type someoneMentionedYouNotificationResolver struct{ *Resolver }
 type someoneMentionedYourCommunityNotificationResolver struct{ *Resolver }
 type someonePostedYourWorkNotificationResolver struct{ *Resolver }
 type someoneRepliedToYourCommentNotificationResolver struct{ *Resolver }
+type someoneRepostedYourPostNotificationResolver struct{ *Resolver }
 type someoneViewedYourGalleryNotificationResolver struct{ *Resolver }
 type someoneYouFollowOnFarcasterJoinedNotificationResolver struct{ *Resolver }
 type someoneYouFollowPostedTheirFirstPostNotificationResolver struct{ *Resolver }
@@ -4043,6 +4638,7 @@ type tokenResolver struct{ *Resolver }
 type tokenDefinitionResolver struct{ *Resolver }
 type tokenHolderResolver struct{ *Resolver }
 type tokensAddedToCollectionFeedEventDataResolver struct{ *Resolver }
+type topicResolver struct{ *Resolver }
 type unfollowUserPayloadResolver struct{ *Resolver }
 type updateCollectionTokensPayloadResolver struct{ *Resolver }
 type userCreatedFeedEventDataResolver struct{ *Resolver }
@@ -4050,5 +4646,6 @@ type userEmailResolver struct{ *Resolver }
 type userFollowedUsersFeedEventDataResolver struct{ *Resolver }
 type viewerResolver struct{ *Resolver }
 type walletResolver struct{ *Resolver }
+type webhookResolver struct{ *Resolver }
 type chainAddressInputResolver struct{ *Resolver }
 type chainPubKeyInputResolver struct{ *Resolver }
@@ -21,9 +21,11 @@ import (
 	"github.com/gammazero/workerpool"
 	"github.com/magiclabs/magic-admin-go/token"
 
+	admin "github.com/mikeydub/go-gallery/adminapi"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/debugtools"
 	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/graphql/sse"
 	"github.com/mikeydub/go-gallery/publicapi"
 	"github.com/mikeydub/go-gallery/service/auth"
 	"github.com/mikeydub/go-gallery/service/emails"
@@ -33,9 +35,12 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain/highlight"
 	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/rpc"
 	"github.com/mikeydub/go-gallery/service/socialauth"
+	"github.com/mikeydub/go-gallery/service/spam"
 	"github.com/mikeydub/go-gallery/service/twitter"
+	"github.com/mikeydub/go-gallery/service/webhooks"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/mikeydub/go-gallery/validate"
 )
@@ -65,6 +70,9 @@ var nodeFetcher = model.NodeFetcher{
 	OnPost:             resolvePostByPostID,
 	OnTokenDefinition:  resolveTokenDefinitionByID,
 	OnCommunity:        resolveCommunityByID,
+	OnWebhook:          resolveWebhookByID,
+	OnScheduledPost:    resolveScheduledPostByID,
+	OnRepost:           resolveRepostByID,
 
 	OnCollectionToken: func(ctx context.Context, tokenId string, collectionId string) (*model.CollectionToken, error) {
 		return resolveCollectionTokenByID(ctx, persist.DBID(tokenId), persist.DBID(collectionId))
@@ -75,6 +83,7 @@ var nodeFetcher = model.NodeFetcher{
 	OnSomeoneAdmiredYourPostNotification:               fetchNotificationByID[model.SomeoneAdmiredYourPostNotification],
 	OnSomeoneAdmiredYourCommentNotification:            fetchNotificationByID[model.SomeoneAdmiredYourCommentNotification],
 	OnSomeoneCommentedOnYourPostNotification:           fetchNotificationByID[model.SomeoneCommentedOnYourPostNotification],
+	OnSomeoneRepostedYourPostNotification:              fetchNotificationByID[model.SomeoneRepostedYourPostNotification],
 	OnSomeoneFollowedYouBackNotification:               fetchNotificationByID[model.SomeoneFollowedYouBackNotification],
 	OnSomeoneFollowedYouNotification:                   fetchNotificationByID[model.SomeoneFollowedYouNotification],
 	OnSomeoneViewedYourGalleryNotification:             fetchNotificationByID[model.SomeoneViewedYourGalleryNotification],
@@ -117,7 +126,7 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 	// TODO: Add model.ErrNotAuthorized mapping once auth handling is moved to the publicapi layer
 
 	switch {
-	case util.ErrorIs[auth.ErrAuthenticationFailed](err) || errors.Is(err, publicapi.ErrOnlyRemoveOwnAdmire) || errors.Is(err, publicapi.ErrOnlyRemoveOwnComment):
+	case util.ErrorIs[auth.ErrAuthenticationFailed](err) || errors.Is(err, publicapi.ErrOnlyRemoveOwnAdmire) || errors.Is(err, publicapi.ErrOnlyRemoveOwnComment) || errors.Is(err, publicapi.ErrOnlyEditOwnPost) || errors.Is(err, publicapi.ErrOnlyHideCommentsOnOwnPost) || errors.Is(err, publicapi.ErrOnlyRemoveOwnRepost) || errors.Is(err, publicapi.ErrNotCommunityCreator):
 		mappedErr = model.ErrAuthenticationFailed{Message: message}
 	case util.ErrorIs[auth.ErrDoesNotOwnRequiredNFT](err):
 		mappedErr = model.ErrDoesNotOwnRequiredToken{Message: message}
@@ -143,6 +152,8 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 		mappedErr = model.ErrCommentNotFound{Message: message}
 	case util.ErrorIs[persist.ErrPostNotFound](err):
 		mappedErr = model.ErrPostNotFound{Message: message}
+	case util.ErrorIs[persist.ErrRepostNotFound](err):
+		mappedErr = model.ErrRepostNotFound{Message: message}
 	case util.ErrorIs[publicapi.ErrTokenRefreshFailed](err):
 		mappedErr = model.ErrSyncFailed{Message: message}
 	case util.ErrorIs[validate.ErrInvalidInput](err):
@@ -158,8 +169,18 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 		mappedErr = model.ErrNeedsToReconnectSocial{SocialAccountType: persist.SocialProviderTwitter, Message: message}
 	case util.ErrorIs[persist.ErrPushTokenBelongsToAnotherUser](err):
 		mappedErr = model.ErrPushTokenBelongsToAnotherUser{Message: message}
-	case errors.Is(err, publicapi.ErrProfileImageTooManySources) || errors.Is(err, publicapi.ErrProfileImageUnknownSource):
+	case errors.Is(err, publicapi.ErrProfileImageTooManySources) || errors.Is(err, publicapi.ErrProfileImageUnknownSource) || errors.Is(err, publicapi.ErrTooManyWebhooks) || errors.Is(err, admin.ErrModerationActionNotRevocable):
 		mappedErr = model.ErrInvalidInput{Message: message}
+	case errors.Is(err, publicapi.ErrMuteNotFound):
+		mappedErr = model.ErrMuteNotFound{Message: message}
+	case errors.Is(err, publicapi.ErrCommentsRestricted):
+		mappedErr = model.ErrCommentsRestricted{Message: message}
+	case errors.Is(err, publicapi.ErrGalleryVersionNotFound):
+		mappedErr = model.ErrGalleryVersionNotFound{Message: message}
+	case errors.Is(err, publicapi.ErrWebhookNotFound):
+		mappedErr = model.ErrWebhookNotFound{Message: message}
+	case errors.Is(err, publicapi.ErrScheduledPostNotFound):
+		mappedErr = model.ErrScheduledPostNotFound{Message: message}
 	case errors.Is(err, publicapi.ErrProfileImageNotTokenOwner) || errors.Is(err, publicapi.ErrProfileImageNotWalletOwner):
 		mappedErr = model.ErrNotAuthorized{Message: message}
 	case errors.Is(err, auth.ErrEmailUnverified):
@@ -511,8 +532,8 @@ func resolveTokensByWalletID(ctx context.Context, walletID persist.DBID) ([]*mod
 	return tokensToModel(ctx, tokens), nil
 }
 
-func resolveCommunityTokensByCommunityID(ctx context.Context, communityID persist.DBID, before, after *string, first, last *int) (*model.TokensConnection, error) {
-	tokens, pageInfo, err := publicapi.For(ctx).Community.PaginateTokensByCommunityID(ctx, communityID, before, after, first, last)
+func resolveCommunityTokensByCommunityID(ctx context.Context, communityID persist.DBID, before, after *string, first, last *int, maxSpamScore *int) (*model.TokensConnection, error) {
+	tokens, pageInfo, err := publicapi.For(ctx).Community.PaginateTokensByCommunityID(ctx, communityID, before, after, first, last, maxSpamScore)
 	if err != nil {
 		return nil, err
 	}
@@ -980,6 +1001,20 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			Post:         nil, // handled by dedicated resolver
 			Comment:      nil, // handled by dedicated resolver
 		}, nil
+	case persist.ActionRepostedPost, persist.ActionQuotedPost:
+		return model.SomeoneRepostedYourPostNotification{
+			HelperSomeoneRepostedYourPostNotificationData: model.HelperSomeoneRepostedYourPostNotificationData{
+				OwnerID:          notif.OwnerID,
+				PostID:           notif.PostID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Repost:       nil, // handled by dedicated resolver
+			Post:         nil, // handled by dedicated resolver
+		}, nil
 	case persist.ActionUserFollowedUsers:
 		if !notif.Data.FollowedBack {
 			return model.SomeoneFollowedYouNotification{
@@ -1207,20 +1242,58 @@ func resolveNewNotificationSubscription(ctx context.Context) <-chan model.Notifi
 	result := make(chan model.Notification)
 
 	go func() {
+		replayed := make(map[persist.DBID]bool)
+
+		// Catch up a client that's resuming an event stream with the notifications it missed
+		if lastEventID := sse.LastEventID(ctx); lastEventID != "" {
+			missed, err := publicapi.For(ctx).Notifications.GetViewerNotificationsCreatedAfter(ctx, persist.DBID(lastEventID))
+			if err != nil {
+				logger.For(ctx).Warnf("failed to get missed notifications after %s: %s", lastEventID, err)
+			}
+			for _, notif := range missed {
+				asModel, err := notificationToModel(notif)
+				if err != nil {
+					logger.For(nil).Errorf("error converting notification to model: %v", err)
+					continue
+				}
+				sent := sse.Send(ctx, notif.ID.String(), func() bool {
+					select {
+					case result <- asModel:
+						return true
+					case <-ctx.Done():
+						return false
+					}
+				})
+				if !sent {
+					return
+				}
+				replayed[notif.ID] = true
+			}
+		}
+
 		for notif := range notifs {
+			// A notification created while missed notifications were being loaded could have been replayed already
+			if replayed[notif.ID] {
+				continue
+			}
+
 			// use async to prevent blocking the dispatcher
 			asModel, err := notificationToModel(notif)
 			if err != nil {
 				logger.For(nil).Errorf("error converting notification to model: %v", err)
 				return
 			}
-			select {
-			case result <- asModel:
-				logger.For(nil).Debug("sent new notification to subscription")
-			default:
-				logger.For(nil).Errorf("notification subscription channel full, dropping notification")
-				notifDispatcher.UnsubscribeNewNotificationsForUser(userID)
-			}
+			sse.Send(ctx, notif.ID.String(), func() bool {
+				select {
+				case result <- asModel:
+					logger.For(nil).Debug("sent new notification to subscription")
+					return true
+				default:
+					logger.For(nil).Errorf("notification subscription channel full, dropping notification")
+					notifDispatcher.UnsubscribeNewNotificationsForUser(userID)
+					return false
+				}
+			})
 		}
 	}()
 
@@ -1260,6 +1333,114 @@ func resolveUpdatedNotificationSubscription(ctx context.Context) <-chan model.No
 	return result
 }
 
+// feedEntityChannel returns the realtime channel for a post or feed event, exactly one of which must be provided
+func feedEntityChannel(ctx context.Context, postID *persist.DBID, feedEventID *persist.DBID) (string, error) {
+	if (postID == nil) == (feedEventID == nil) {
+		return "", validate.ErrInvalidInput{
+			Parameters: []string{"postId", "feedEventId"},
+			Reasons:    []string{"exactly one of postId or feedEventId must be provided", "exactly one of postId or feedEventId must be provided"},
+		}
+	}
+
+	if postID != nil {
+		if _, err := publicapi.For(ctx).Feed.GetPostById(ctx, *postID); err != nil {
+			return "", err
+		}
+		return realtime.PostChannel(*postID), nil
+	}
+
+	if _, err := publicapi.For(ctx).Feed.GetFeedEventById(ctx, *feedEventID); err != nil {
+		return "", err
+	}
+	return realtime.FeedEventChannel(*feedEventID), nil
+}
+
+// forwardRealtimeMessages resolves messages of the given kind to models and sends them to the returned channel
+// until the subscription ends. Messages that can't be resolved (e.g. because the comment was already deleted) are skipped.
+func forwardRealtimeMessages[T any](ctx context.Context, msgs <-chan realtime.Message, kind realtime.Kind, toModel func(realtime.Message) (T, error)) <-chan T {
+	result := make(chan T)
+
+	go func() {
+		defer close(result)
+		for msg := range msgs {
+			if msg.Kind != kind {
+				continue
+			}
+
+			m, err := toModel(msg)
+			if err != nil {
+				logger.For(ctx).Warnf("failed to resolve realtime message of kind=%s: %s", msg.Kind, err)
+				continue
+			}
+
+			select {
+			case result <- m:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+func resolveCommentAddedSubscription(ctx context.Context, postID *persist.DBID, feedEventID *persist.DBID) (<-chan *model.Comment, error) {
+	channel, err := feedEntityChannel(ctx, postID, feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := realtime.For(ctx).Subscribe(ctx, channel)
+
+	return forwardRealtimeMessages(ctx, msgs, realtime.KindCommentAdded, func(msg realtime.Message) (*model.Comment, error) {
+		return resolveCommentByCommentID(ctx, msg.CommentID)
+	}), nil
+}
+
+func resolveAdmireAddedSubscription(ctx context.Context, postID *persist.DBID, feedEventID *persist.DBID) (<-chan *model.Admire, error) {
+	channel, err := feedEntityChannel(ctx, postID, feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := realtime.For(ctx).Subscribe(ctx, channel)
+
+	return forwardRealtimeMessages(ctx, msgs, realtime.KindAdmireAdded, func(msg realtime.Message) (*model.Admire, error) {
+		return resolveAdmireByAdmireID(ctx, msg.AdmireID)
+	}), nil
+}
+
+func resolveFollowedUserPostedSubscription(ctx context.Context) (<-chan *model.Post, error) {
+	if authErr := auth.GetAuthErrorFromCtx(util.MustGetGinContext(ctx)); authErr != nil {
+		return nil, authErr
+	}
+
+	api := publicapi.For(ctx)
+	following, err := api.User.GetFollowingByUserId(ctx, api.User.GetLoggedInUserId(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	channels := util.MapWithoutError(following, func(u db.User) string { return realtime.UserPostsChannel(u.ID) })
+	msgs := realtime.For(ctx).Subscribe(ctx, channels...)
+
+	return forwardRealtimeMessages(ctx, msgs, realtime.KindPostCreated, func(msg realtime.Message) (*model.Post, error) {
+		return resolvePostByPostID(ctx, msg.PostID)
+	}), nil
+}
+
+func resolveTokenProcessedSubscription(ctx context.Context, tokenDefinitionID persist.DBID) (<-chan *model.TokenDefinition, error) {
+	if _, err := publicapi.For(ctx).Token.GetTokenDefinitionByID(ctx, tokenDefinitionID); err != nil {
+		return nil, err
+	}
+
+	msgs := realtime.For(ctx).Subscribe(ctx, realtime.TokenDefinitionChannel(tokenDefinitionID))
+
+	return forwardRealtimeMessages(ctx, msgs, realtime.KindTokenProcessed, func(msg realtime.Message) (*model.TokenDefinition, error) {
+		return resolveTokenDefinitionByID(ctx, msg.TokenDefinitionID)
+	}), nil
+}
+
 func resolveGroupNotificationUsersConnectionByUserIDs(ctx context.Context, userIDs persist.DBIDList, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
 	if len(userIDs) == 0 {
 		return &model.GroupNotificationUsersConnection{
@@ -1509,6 +1690,8 @@ func feedEntityToModel(event any) (model.FeedEventOrError, error) {
 	switch event := event.(type) {
 	case db.Post:
 		return postToModel(&event), nil
+	case db.Repost:
+		return repostToModel(event), nil
 	case db.FeedEvent:
 		var groupID sql.NullString
 		if event.GroupID.String != "" {
@@ -1812,21 +1995,187 @@ func postToModel(post *db.Post) *model.Post {
 		captionVal = util.ToPointer(html.UnescapeString(caption.(string)))
 	}
 
+	var lastEditedTime *time.Time
+	if post.EditedAt.Valid {
+		lastEditedTime = &post.EditedAt.Time
+	}
+
 	return &model.Post{
 		HelperPostData: model.HelperPostData{
 			TokenIDs: post.TokenIds,
 			AuthorID: post.ActorID,
 		},
+		Dbid:               post.ID,
+		Tokens:             nil, // handled by dedicated resolver
+		CreationTime:       &post.CreatedAt,
+		Caption:            captionVal,
+		Admires:            nil, // handled by dedicated resolver
+		Comments:           nil, // handled by dedicated resolver
+		Interactions:       nil, // handled by dedicated resolver
+		ViewerAdmire:       nil, // handled by dedicated resolver
+		IsFirstPost:        post.IsFirstPost,
+		UserAddedMintURL:   &post.UserMintUrl.String,
+		IsEdited:           post.EditedAt.Valid,
+		LastEditedTime:     lastEditedTime,
+		CommentRestriction: post.CommentRestriction,
+	}
+}
+
+func resolveScheduledPostByID(ctx context.Context, scheduledPostID persist.DBID) (*model.ScheduledPost, error) {
+	post, err := publicapi.For(ctx).Feed.GetScheduledPostByID(ctx, scheduledPostID)
+	if err != nil {
+		return nil, err
+	}
+	return scheduledPostToModel(post), nil
+}
+
+func resolveRepostByID(ctx context.Context, repostID persist.DBID) (*model.Repost, error) {
+	repost, err := publicapi.For(ctx).Feed.GetRepostByID(ctx, repostID)
+	if err != nil {
+		return nil, err
+	}
+	return repostToModel(*repost), nil
+}
+
+func resolveViewerScheduledPosts(ctx context.Context) ([]*model.ScheduledPost, error) {
+	posts, err := publicapi.For(ctx).Feed.GetViewerScheduledPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.MapWithoutError(posts, scheduledPostToModel), nil
+}
+
+func resolveViewerMutes(ctx context.Context) ([]*model.Mute, error) {
+	mutes, err := publicapi.For(ctx).User.GetViewerMutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.MapWithoutError(mutes, muteToModel), nil
+}
+
+func resolveViewerFollowedTopics(ctx context.Context) ([]*model.Topic, error) {
+	tags, err := publicapi.For(ctx).Topic.GetViewerFollowedTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.MapWithoutError(tags, topicToModel), nil
+}
+
+func topicToModel(tag string) *model.Topic {
+	return &model.Topic{
+		Tag:               tag,
+		Posts:             nil, // handled by dedicated resolver
+		TotalPosts:        nil, // handled by dedicated resolver
+		ViewerIsFollowing: nil, // handled by dedicated resolver
+	}
+}
+
+func galleryVersionToModel(version publicapi.GalleryVersion) *model.GalleryVersion {
+	collections := util.MapWithoutError(version.Collections, func(c persist.GalleryVersionCollection) *model.GalleryVersionCollection {
+		return &model.GalleryVersionCollection{
+			Dbid:           c.ID,
+			Name:           &c.Name,
+			CollectorsNote: &c.CollectorsNote,
+			Hidden:         c.Hidden,
+			TokenIds:       c.Tokens,
+		}
+	})
+
+	var restoredFrom *persist.DBID
+	if version.RestoredFromVersionID.Valid {
+		restoredFrom = util.ToPointer(persist.DBID(version.RestoredFromVersionID.String))
+	}
+
+	return &model.GalleryVersion{
+		Dbid:                  version.ID,
+		Name:                  &version.Name,
+		Description:           &version.Description,
+		Collections:           collections,
+		Published:             version.Published,
+		RestoredFromVersionID: restoredFrom,
+		CreationTime:          &version.CreatedAt,
+		Changes:               version.Changes,
+	}
+}
+
+func resolveTokensByTokenIDs(ctx context.Context, tokenIDs []persist.DBID) ([]*model.Token, error) {
+	result := make([]*model.Token, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		t, err := publicapi.For(ctx).Token.GetTokenByIdIgnoreDisplayable(ctx, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = tokenToModel(ctx, *t, nil)
+	}
+	return result, nil
+}
+
+func scheduledPostToModel(post db.ScheduledPost) *model.ScheduledPost {
+	var caption *string
+	if post.Caption.Valid {
+		caption = util.ToPointer(html.UnescapeString(post.Caption.String))
+	}
+
+	return &model.ScheduledPost{
+		HelperScheduledPostData: model.HelperScheduledPostData{
+			TokenIDs: post.TokenIds,
+			AuthorID: post.ActorID,
+		},
 		Dbid:             post.ID,
-		Tokens:           nil, // handled by dedicated resolver
+		Caption:          caption,
+		UserAddedMintURL: util.StringToPointerIfNotEmpty(post.UserMintUrl.String),
+		PublishAt:        post.PublishAt,
 		CreationTime:     &post.CreatedAt,
-		Caption:          captionVal,
-		Admires:          nil, // handled by dedicated resolver
-		Comments:         nil, // handled by dedicated resolver
-		Interactions:     nil, // handled by dedicated resolver
-		ViewerAdmire:     nil, // handled by dedicated resolver
-		IsFirstPost:      post.IsFirstPost,
-		UserAddedMintURL: &post.UserMintUrl.String,
+		LastUpdated:      &post.LastUpdated,
+	}
+}
+
+func muteToModel(mute db.Mute) *model.Mute {
+	var expiresTime *time.Time
+	if mute.ExpiresAt.Valid {
+		expiresTime = &mute.ExpiresAt.Time
+	}
+
+	return &model.Mute{
+		HelperMuteData: model.HelperMuteData{
+			MutedUserID:      mute.MutedUserID,
+			MutedCommunityID: mute.MutedCommunityID,
+		},
+		Dbid:         mute.ID,
+		Keyword:      util.StringToPointerIfNotEmpty(mute.Keyword.String),
+		ExpiresTime:  expiresTime,
+		CreationTime: &mute.CreatedAt,
+	}
+}
+
+func repostToModel(repost db.Repost) *model.Repost {
+	var caption *string
+	if repost.Caption.Valid {
+		caption = util.ToPointer(html.UnescapeString(repost.Caption.String))
+	}
+
+	return &model.Repost{
+		HelperRepostData: model.HelperRepostData{
+			AuthorID: repost.ActorID,
+			PostID:   repost.PostID,
+		},
+		Dbid:         repost.ID,
+		Caption:      caption,
+		IsQuote:      repost.Caption.Valid,
+		CreationTime: &repost.CreatedAt,
+	}
+}
+
+func postEditToModel(edit db.PostEdit) *model.PostEdit {
+	var caption *string
+	if edit.Caption.Valid {
+		caption = util.ToPointer(html.UnescapeString(edit.Caption.String))
+	}
+
+	return &model.PostEdit{
+		Caption:          caption,
+		UserAddedMintURL: util.StringToPointerIfNotEmpty(edit.UserMintUrl.String),
+		CreationTime:     &edit.CreatedAt,
 	}
 }
 
@@ -1972,6 +2321,7 @@ func commentToModel(ctx context.Context, comment db.Comment) *model.Comment {
 		Replies:      nil,                                       // handled by dedicated resolver
 		Source:       nil,                                       // handled by dedicated resolver
 		Deleted:      &comment.Removed,
+		Hidden:       &comment.Hidden,
 	}
 }
 
@@ -2576,7 +2926,7 @@ func getVideoMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia
 }
 
 func getAudioMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia *model.FallbackMedia) model.AudioMedia {
-	return model.AudioMedia{
+	result := model.AudioMedia{
 		PreviewURLs:      previewURLsFromTokenMedia(ctx, tokenMedia),
 		MediaURL:         util.ToPointer(tokenMedia.Media.MediaURL.String()),
 		MediaType:        (*string)(&tokenMedia.Media.MediaType),
@@ -2584,6 +2934,20 @@ func getAudioMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia
 		Dimensions:       mediaToDimensions(tokenMedia.Media.Dimensions),
 		FallbackMedia:    fallbackMedia,
 	}
+
+	if audio := tokenMedia.Media.Audio; audio != nil {
+		if audio.DurationSeconds > 0 {
+			result.DurationSeconds = &audio.DurationSeconds
+		}
+		if audio.Bitrate > 0 {
+			result.Bitrate = &audio.Bitrate
+		}
+		if audio.WaveformURL != "" {
+			result.WaveformURL = util.ToPointer(audio.WaveformURL.String())
+		}
+	}
+
+	return result
 }
 
 func getTextMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia *model.FallbackMedia) model.TextMedia {
@@ -2637,10 +3001,36 @@ func getGltfMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia *
 		MediaType:        (*string)(&tokenMedia.Media.MediaType),
 		ContentRenderURL: (*string)(&tokenMedia.Media.MediaURL),
 		Dimensions:       mediaToDimensions(tokenMedia.Media.Dimensions),
+		Model:            modelInfoToModel(tokenMedia.Media.Model),
 		FallbackMedia:    fallbackMedia,
 	}
 }
 
+func modelInfoToModel(info *persist.ModelInfo) *model.ModelInfo {
+	if info == nil {
+		return nil
+	}
+
+	result := &model.ModelInfo{
+		Format:      string(info.Format),
+		PolyCount:   &info.PolyCount,
+		VertexCount: &info.VertexCount,
+	}
+
+	if info.BoundingBox != nil {
+		result.BoundingBox = &model.ModelBoundingBox{
+			MinX: info.BoundingBox.Min[0],
+			MinY: info.BoundingBox.Min[1],
+			MinZ: info.BoundingBox.Min[2],
+			MaxX: info.BoundingBox.Max[0],
+			MaxY: info.BoundingBox.Max[1],
+			MaxZ: info.BoundingBox.Max[2],
+		}
+	}
+
+	return result
+}
+
 func getUnknownMedia(ctx context.Context, tokenMedia db.TokenMedia, fallbackMedia *model.FallbackMedia) model.UnknownMedia {
 	return model.UnknownMedia{
 		PreviewURLs:      previewURLsFromTokenMedia(ctx, tokenMedia),
@@ -2730,3 +3120,201 @@ func resolveCommunityCreatorsByCommunityID(ctx context.Context, communityID pers
 
 	return models, nil
 }
+
+func tokenProcessingFailuresToModel(rows []db.GetDeadLetteredTokenProcessingFailuresRow) []*model.TokenProcessingFailure {
+	failures := make([]*model.TokenProcessingFailure, len(rows))
+	for i, row := range rows {
+		failures[i] = tokenProcessingFailureToModel(row.TokenProcessingFailure, row.PipelineMetadata)
+	}
+	return failures
+}
+
+func moderationQueueToModel(rows []db.GetModerationQueueRow) []*model.ModerationQueueItem {
+	items := make([]*model.ModerationQueueItem, len(rows))
+	for i, row := range rows {
+		reasons := make([]persist.ReportReason, len(row.Reasons))
+		for j, reason := range row.Reasons {
+			reasons[j] = persist.ReportReason(reason)
+		}
+		items[i] = &model.ModerationQueueItem{
+			SubjectType:       row.SubjectType,
+			SubjectID:         row.SubjectID,
+			TotalReports:      int(row.TotalReports),
+			TotalReporters:    int(row.TotalReporters),
+			Reasons:           reasons,
+			FirstReportedTime: row.FirstReportedAt,
+			LastReportedTime:  row.LastReportedAt,
+		}
+	}
+	return items
+}
+
+func moderationActionToModel(a db.ModerationAction) *model.ModerationAction {
+	m := &model.ModerationAction{
+		Dbid:         a.ID,
+		SubjectType:  a.SubjectType,
+		SubjectID:    a.SubjectID,
+		Action:       a.Action,
+		Automated:    a.Automated,
+		CreationTime: a.CreatedAt,
+	}
+	if a.UserID != "" {
+		m.UserID = &a.UserID
+	}
+	if a.Reason != "" {
+		m.Reason = &a.Reason
+	}
+	if a.Note.Valid {
+		m.Note = &a.Note.String
+	}
+	if a.Moderator.Valid {
+		m.Moderator = &a.Moderator.String
+	}
+	if a.ExpiresAt.Valid {
+		m.ExpiresTime = &a.ExpiresAt.Time
+	}
+	if a.RevokedAt.Valid {
+		m.RevokedTime = &a.RevokedAt.Time
+	}
+	return m
+}
+
+func tokenProcessingFailureToModel(f db.TokenProcessingFailure, pipelineMetadata persist.PipelineMetadata) *model.TokenProcessingFailure {
+	history := make([]*model.TokenProcessingAttempt, len(f.AttemptHistory))
+	for i, attempt := range f.AttemptHistory {
+		history[i] = &model.TokenProcessingAttempt{
+			Attempt:         attempt.Attempt,
+			ProcessingCause: attempt.ProcessingCause.String(),
+			ErrorClass:      attempt.ErrorClass,
+			ErrorMessage:    attempt.ErrorMessage,
+			ProcessingJobID: util.ToPointer(attempt.ProcessingJobID),
+			FailedAt:        attempt.FailedAt,
+		}
+	}
+
+	var deadLetteredAt *time.Time
+	if f.DeadLetteredAt.Valid {
+		deadLetteredAt = &f.DeadLetteredAt.Time
+	}
+
+	return &model.TokenProcessingFailure{
+		Dbid:                f.ID,
+		TokenDefinitionID:   f.TokenDefinitionID,
+		Chain:               &f.Chain,
+		ContractAddress:     &f.ContractAddress,
+		ProcessingCause:     f.ProcessingCause.String(),
+		ErrorClass:          f.ErrorClass,
+		ErrorMessage:        f.ErrorMessage,
+		Attempts:            int(f.Attempts),
+		AttemptHistory:      history,
+		FailedPipelineSteps: pipelineMetadata.FailedSteps(),
+		DeadLetteredAt:      deadLetteredAt,
+	}
+}
+
+func spamScoreToModel(score *spam.Score) *model.SpamScore {
+	if score == nil {
+		return nil
+	}
+
+	return &model.SpamScore{
+		Score:  score.Score,
+		IsSpam: spam.IsSpam(score.Score),
+		Reasons: util.MapWithoutError(score.Reasons, func(r persist.SpamReason) *model.SpamReason {
+			return &model.SpamReason{
+				Reason: r.Reason,
+				Weight: r.Weight,
+				Detail: util.StringToPointerIfNotEmpty(r.Detail),
+			}
+		}),
+	}
+}
+
+var webhookEventTypes = map[model.WebhookEventType]string{
+	model.WebhookEventTypePostCreated:          webhooks.EventPostCreated,
+	model.WebhookEventTypeUserFollowed:         webhooks.EventUserFollowed,
+	model.WebhookEventTypeTokensAddedToGallery: webhooks.EventTokensAddedToGallery,
+	model.WebhookEventTypeNewTokenHolder:       webhooks.EventNewTokenHolder,
+	model.WebhookEventTypeTest:                 webhooks.EventTest,
+}
+
+func webhookEventTypesFromModel(eventTypes []model.WebhookEventType) []string {
+	if eventTypes == nil {
+		return nil
+	}
+	return util.MapWithoutError(eventTypes, func(t model.WebhookEventType) string { return webhookEventTypes[t] })
+}
+
+func webhookEventTypeToModel(eventType string) model.WebhookEventType {
+	for m, t := range webhookEventTypes {
+		if t == eventType {
+			return m
+		}
+	}
+	panic(fmt.Sprintf("unknown webhook event type: %s", eventType))
+}
+
+func resolveWebhookByID(ctx context.Context, webhookID persist.DBID) (*model.Webhook, error) {
+	hook, err := publicapi.For(ctx).Webhook.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	return webhookToModel(hook), nil
+}
+
+func resolveViewerWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	hooks, err := publicapi.For(ctx).Webhook.GetViewerWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.MapWithoutError(hooks, webhookToModel), nil
+}
+
+func resolveWebhookDeliveries(ctx context.Context, webhookID persist.DBID, before, after *string, first, last *int) (*model.WebhookDeliveriesConnection, error) {
+	deliveries, pageInfo, err := publicapi.For(ctx).Webhook.PaginateDeliveriesByWebhookID(ctx, webhookID, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := util.MapWithoutError(deliveries, func(d db.WebhookDelivery) *model.WebhookDeliveryEdge {
+		return &model.WebhookDeliveryEdge{Node: webhookDeliveryToModel(d)}
+	})
+
+	return &model.WebhookDeliveriesConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+func webhookToModel(hook db.Webhook) *model.Webhook {
+	return &model.Webhook{
+		HelperWebhookData: model.HelperWebhookData{
+			CommunityID: hook.CommunityID,
+		},
+		Dbid:         hook.ID,
+		URL:          hook.Url,
+		EventTypes:   util.MapWithoutError(hook.EventTypes, webhookEventTypeToModel),
+		Enabled:      hook.Enabled,
+		CreationTime: &hook.CreatedAt,
+		LastUpdated:  &hook.LastUpdated,
+	}
+}
+
+func webhookDeliveryToModel(d db.WebhookDelivery) *model.WebhookDelivery {
+	var responseStatus *int
+	if d.ResponseStatus.Valid {
+		responseStatus = util.ToPointer(int(d.ResponseStatus.Int32))
+	}
+
+	return &model.WebhookDelivery{
+		Dbid:           d.ID,
+		EventType:      webhookEventTypeToModel(d.EventType),
+		Status:         model.WebhookDeliveryStatus(strings.ToUpper(d.Status)),
+		Attempts:       int(d.Attempts),
+		ResponseStatus: responseStatus,
+		Error:          util.StringToPointerIfNotEmpty(d.Error.String),
+		Payload:        string(d.Payload.Bytes),
+		CreationTime:   &d.CreatedAt,
+		LastUpdated:    &d.LastUpdated,
+	}
+}
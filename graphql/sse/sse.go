@@ -0,0 +1,308 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+)
+
+const (
+	contentTypeEventStream = "text/event-stream"
+
+	// defaultHeartbeatInterval is short enough to keep most proxies and mobile networks from closing an idle stream
+	defaultHeartbeatInterval = 12 * time.Second
+
+	lastEventIDHeader     = "Last-Event-ID"
+	lastEventIDQueryParam = "lastEventId"
+)
+
+// Transport serves GraphQL operations over Server-Sent Events, following the "distinct connections" mode of the
+// graphql-sse protocol: each operation gets its own stream, results are sent as "next" events, and the stream ends
+// with a "complete" event. Operations can be sent as a JSON POST body or as GET query parameters, which lets
+// clients use EventSource. It has to be added before the GET and POST transports, which would otherwise handle
+// these requests.
+type Transport struct {
+	// HeartbeatInterval is how often a comment is written to keep idle streams open
+	HeartbeatInterval time.Duration
+}
+
+var _ gqlgen.Transport = Transport{}
+
+type lastEventIDContextKey struct{}
+type eventIDsContextKey struct{}
+
+// LastEventID returns the id of the last event a client received before it reconnected, or an empty string if the
+// operation isn't being resumed
+func LastEventID(ctx context.Context) string {
+	id, _ := ctx.Value(lastEventIDContextKey{}).(string)
+	return id
+}
+
+// Send calls send, which should send an item to a subscription's result channel and report whether the item was sent.
+// If the subscription is being served over SSE, the event the item is written in is given the id, which the client
+// sends back as the Last-Event-ID when it reconnects. A subscription that uses Send must use it for every item it sends.
+func Send(ctx context.Context, id string, send func() bool) bool {
+	ids, ok := ctx.Value(eventIDsContextKey{}).(*eventIDs)
+	if !ok {
+		return send()
+	}
+
+	// Items have to be sent in the same order their ids are queued
+	ids.sendMu.Lock()
+	defer ids.sendMu.Unlock()
+
+	ids.push(id)
+	if send() {
+		return true
+	}
+
+	ids.dropLast()
+	return false
+}
+
+// eventIDs are the ids of items that were sent to a subscription's result channel but haven't been written yet.
+// The channel is only read when the next result is requested, so ids are written in the order they're queued.
+type eventIDs struct {
+	sendMu sync.Mutex
+	mu     sync.Mutex
+	ids    []string
+}
+
+func (e *eventIDs) push(id string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.ids = append(e.ids, id)
+}
+
+func (e *eventIDs) pop() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.ids) == 0 {
+		return ""
+	}
+	id := e.ids[0]
+	e.ids = e.ids[1:]
+	return id
+}
+
+func (e *eventIDs) dropLast() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.ids) > 0 {
+		e.ids = e.ids[:len(e.ids)-1]
+	}
+}
+
+func (t Transport) Supports(r *http.Request) bool {
+	if !strings.Contains(r.Header.Get("Accept"), contentTypeEventStream) {
+		return false
+	}
+
+	if r.Method == http.MethodGet {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return r.Method == http.MethodPost && mediaType == "application/json"
+}
+
+func (t Transport) Do(w http.ResponseWriter, r *http.Request, exec gqlgen.GraphExecutor) {
+	ctx := r.Context()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	start := gqlgen.Now()
+	params, err := readParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	params.Headers = r.Header
+	params.ReadTime = gqlgen.TraceTiming{Start: start, End: gqlgen.Now()}
+
+	if id := lastEventID(r); id != "" {
+		ctx = context.WithValue(ctx, lastEventIDContextKey{}, id)
+	}
+	ids := &eventIDs{}
+	ctx = context.WithValue(ctx, eventIDsContextKey{}, ids)
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = gqlgen.WithOperationContext(ctx, rc)
+
+	// GET requests can be triggered cross-site without a preflight, so they can't be used to run mutations
+	if opErr == nil && r.Method != http.MethodPost && rc.Operation != nil && rc.Operation.Operation == ast.Mutation {
+		writeError(w, http.StatusMethodNotAllowed, "mutations must be sent with POST")
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Stop nginx and similar proxies from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &stream{w: w, flusher: flusher}
+	s.comment()
+
+	if opErr != nil {
+		s.next("", exec.DispatchError(ctx, opErr))
+		s.complete()
+		return
+	}
+
+	interval := t.HeartbeatInterval
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		s.heartbeat(heartbeatCtx, interval)
+	}()
+
+	// The response can't be written to once this handler returns
+	defer func() {
+		stopHeartbeat()
+		<-heartbeatDone
+	}()
+
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	for {
+		resp := responses(ctx)
+		if resp == nil {
+			break
+		}
+		s.next(ids.pop(), resp)
+	}
+
+	s.complete()
+}
+
+// stream writes events to the response. Writes are serialized so heartbeats can't interleave with results.
+type stream struct {
+	mu      sync.Mutex
+	w       io.Writer
+	flusher http.Flusher
+}
+
+func (s *stream) next(id string, resp *gqlgen.Response) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		logger.For(nil).Errorf("failed to marshal graphql response for event stream: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprint(s.w, "event: next\n")
+	if id != "" {
+		fmt.Fprintf(s.w, "id: %s\n", id)
+	}
+	fmt.Fprintf(s.w, "data: %s\n\n", b)
+	s.flusher.Flush()
+}
+
+func (s *stream) complete() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprint(s.w, "event: complete\ndata:\n\n")
+	s.flusher.Flush()
+}
+
+// comment writes an empty comment, which clients ignore
+func (s *stream) comment() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprint(s.w, ":\n\n")
+	s.flusher.Flush()
+}
+
+func (s *stream) heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.comment()
+		}
+	}
+}
+
+func readParams(r *http.Request) (*gqlgen.RawParams, error) {
+	params := &gqlgen.RawParams{}
+
+	if r.Method == http.MethodPost {
+		if err := jsonDecode(r.Body, params); err != nil {
+			return nil, fmt.Errorf("json request body could not be decoded: %s", err)
+		}
+		return params, nil
+	}
+
+	query := r.URL.Query()
+	params.Query = query.Get("query")
+	params.OperationName = query.Get("operationName")
+
+	if variables := query.Get("variables"); variables != "" {
+		if err := jsonDecode(strings.NewReader(variables), &params.Variables); err != nil {
+			return nil, fmt.Errorf("variables could not be decoded: %s", err)
+		}
+	}
+
+	if extensions := query.Get("extensions"); extensions != "" {
+		if err := jsonDecode(strings.NewReader(extensions), &params.Extensions); err != nil {
+			return nil, fmt.Errorf("extensions could not be decoded: %s", err)
+		}
+	}
+
+	return params, nil
+}
+
+// lastEventID returns the id the client last received. Browsers send the Last-Event-ID header when an EventSource
+// reconnects, and other clients can send it as a query parameter when they open a new stream.
+func lastEventID(r *http.Request) string {
+	if id := r.Header.Get(lastEventIDHeader); id != "" {
+		return id
+	}
+	if r.URL == nil {
+		return ""
+	}
+	return r.URL.Query().Get(lastEventIDQueryParam)
+}
+
+func jsonDecode(r io.Reader, val interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(val)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	b, _ := json.Marshal(&gqlgen.Response{Errors: gqlerror.List{{Message: msg}}})
+	w.Write(b)
+}
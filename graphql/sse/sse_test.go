@@ -0,0 +1,121 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupports(t *testing.T) {
+	post := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	post.Header.Set("Content-Type", "application/json")
+	assert.False(t, Transport{}.Supports(post))
+
+	post.Header.Set("Accept", "text/event-stream")
+	assert.True(t, Transport{}.Supports(post))
+
+	get := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	get.Header.Set("Accept", "text/event-stream")
+	assert.True(t, Transport{}.Supports(get))
+}
+
+func TestSubscribe(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(Transport{HeartbeatInterval: 10 * time.Millisecond})
+	h.AddTransport(transport.GET{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"?query="+url.QueryEscape("subscription { name }"), nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+	br := bufio.NewReader(res.Body)
+	readLine := func() string {
+		line, err := br.ReadString('\n')
+		require.NoError(t, err)
+		return line
+	}
+
+	// Heartbeats are sent while waiting for a result
+	assert.Equal(t, ":\n", readLine())
+	assert.Equal(t, "\n", readLine())
+	assert.Equal(t, ":\n", readLine())
+	assert.Equal(t, "\n", readLine())
+
+	go h.SendNextSubscriptionMessage()
+
+	var lines []string
+	for line := readLine(); line != "event: next\n"; line = readLine() {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, "data: {\"data\":{\"name\":\"test\"}}\n", readLine())
+	assert.Equal(t, "\n", readLine())
+
+	go h.SendCompleteSubscriptionMessage()
+
+	for line := readLine(); line != "event: complete\n"; line = readLine() {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, "data:\n", readLine())
+
+	// Anything else that was read must have been a heartbeat
+	assert.Equal(t, "", strings.Trim(strings.Join(lines, ""), ":\n"))
+}
+
+func TestRejectsMutationsOverGet(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(Transport{})
+	h.AddTransport(transport.POST{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	get, err := http.NewRequest(http.MethodGet, srv.URL+"?query="+url.QueryEscape("mutation { name }"), nil)
+	require.NoError(t, err)
+	get.Header.Set("Accept", "text/event-stream")
+
+	res, err := http.DefaultClient.Do(get)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+
+	post, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"query": "mutation { name }"}`))
+	require.NoError(t, err)
+	post.Header.Set("Accept", "text/event-stream")
+	post.Header.Set("Content-Type", "application/json")
+
+	res, err = http.DefaultClient.Do(post)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+}
+
+func TestSend(t *testing.T) {
+	ids := &eventIDs{}
+	ctx := context.WithValue(context.Background(), eventIDsContextKey{}, ids)
+	results := make(chan string, 1)
+
+	assert.True(t, Send(ctx, "a", func() bool { results <- "a"; return true }))
+	assert.False(t, Send(ctx, "b", func() bool { return false }))
+	assert.Equal(t, "a", ids.pop())
+	assert.Equal(t, "", ids.pop())
+
+	// Sending works the same without an event stream
+	assert.True(t, Send(context.Background(), "c", func() bool { return true }))
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jackc/pgx/v4"
+	"github.com/sirupsen/logrus"
+
+	"github.com/mikeydub/go-gallery/contracts"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/task"
+)
+
+var (
+	transferTopic       = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	transferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	transferBatchTopic  = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+// Filterers are only used to unpack logs, so they aren't bound to a contract
+var (
+	erc721Filterer, _  = contracts.NewIERC721Filterer(common.Address{}, nil)
+	erc1155Filterer, _ = contracts.NewIERC1155Filterer(common.Address{}, nil)
+)
+
+type transfer struct {
+	Contract  common.Address
+	From      common.Address
+	To        common.Address
+	TokenID   *big.Int
+	TokenType persist.TokenType
+}
+
+// transfersFromLogs decodes ERC-721 and ERC-1155 transfers from logs. Logs that aren't NFT transfers, such as
+// ERC-20 transfers which share a topic with ERC-721 transfers, are skipped.
+func transfersFromLogs(logs []types.Log) []transfer {
+	transfers := make([]transfer, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+		switch l.Topics[0] {
+		case transferTopic:
+			// ERC-20 transfers don't index the third argument
+			if len(l.Topics) != 4 {
+				continue
+			}
+			e, err := erc721Filterer.ParseTransfer(l)
+			if err != nil {
+				continue
+			}
+			transfers = append(transfers, transfer{Contract: l.Address, From: e.From, To: e.To, TokenID: e.Id, TokenType: persist.TokenTypeERC721})
+		case transferSingleTopic:
+			e, err := erc1155Filterer.ParseTransferSingle(l)
+			if err != nil {
+				continue
+			}
+			transfers = append(transfers, transfer{Contract: l.Address, From: e.From, To: e.To, TokenID: e.Id, TokenType: persist.TokenTypeERC1155})
+		case transferBatchTopic:
+			e, err := erc1155Filterer.ParseTransferBatch(l)
+			if err != nil {
+				continue
+			}
+			for _, id := range e.Ids {
+				transfers = append(transfers, transfer{Contract: l.Address, From: e.From, To: e.To, TokenID: id, TokenType: persist.TokenTypeERC1155})
+			}
+		}
+	}
+	return transfers
+}
+
+// walletSet is the set of wallets that belong to users
+type walletSet struct {
+	mu      sync.RWMutex
+	wallets map[persist.L1ChainAddress]bool
+}
+
+func (w *walletSet) refresh(ctx context.Context, q *coredb.Queries) error {
+	wallets, err := q.GetActiveWallets(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.For(ctx).Infof("resetting wallet set with %d wallets", len(wallets))
+
+	m := make(map[persist.L1ChainAddress]bool, len(wallets))
+	for _, wallet := range wallets {
+		m[persist.NewL1ChainAddress(wallet.Address, wallet.Chain)] = true
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.wallets = m
+	return nil
+}
+
+func (w *walletSet) contains(address persist.Address, chain persist.Chain) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.wallets[persist.NewL1ChainAddress(address, chain)]
+}
+
+// indexer tails NFT transfer logs and updates the tokens of users whose wallets sent or received a token
+type indexer struct {
+	chain         persist.Chain
+	ethClient     *ethclient.Client
+	queries       *coredb.Queries
+	taskClient    *task.Client
+	wallets       *walletSet
+	confirmations uint64
+	batchSize     uint64
+	reorgRewind   uint64
+	pollInterval  time.Duration
+}
+
+func newIndexer(chain persist.Chain, ethClient *ethclient.Client, queries *coredb.Queries, taskClient *task.Client, wallets *walletSet) *indexer {
+	return &indexer{
+		chain:         chain,
+		ethClient:     ethClient,
+		queries:       queries,
+		taskClient:    taskClient,
+		wallets:       wallets,
+		confirmations: uint64(env.GetInt("INDEXER_CONFIRMATIONS")),
+		batchSize:     uint64(env.GetInt("INDEXER_BLOCK_BATCH_SIZE")),
+		reorgRewind:   uint64(env.GetInt("INDEXER_REORG_REWIND")),
+		pollInterval:  env.GetDuration("INDEXER_POLL_INTERVAL"),
+	}
+}
+
+func (i *indexer) run(ctx context.Context) {
+	for {
+		if err := i.indexToHead(ctx); err != nil {
+			err = fmt.Errorf("error indexing chain=%s: %w", i.chain, err)
+			logger.For(ctx).Error(err)
+			sentryutil.ReportError(ctx, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(i.pollInterval):
+		}
+	}
+}
+
+// indexToHead indexes every block from the checkpoint up to the most recent block that is deep enough to be unlikely to reorg
+func (i *indexer) indexToHead(ctx context.Context) error {
+	head, err := i.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < i.confirmations {
+		return nil
+	}
+	safeHead := head - i.confirmations
+
+	from, err := i.startBlock(ctx, safeHead)
+	if err != nil {
+		return err
+	}
+
+	for from <= safeHead {
+		to := from + i.batchSize - 1
+		if to > safeHead {
+			to = safeHead
+		}
+		if err := i.indexRange(ctx, from, to); err != nil {
+			return err
+		}
+		from = to + 1
+	}
+
+	return nil
+}
+
+// startBlock returns the block to resume indexing from. If the checkpointed block is no longer part of the canonical chain, a reorg
+// deeper than the confirmation depth happened and the indexer rewinds so that the replaced blocks are indexed again.
+func (i *indexer) startBlock(ctx context.Context, safeHead uint64) (uint64, error) {
+	checkpoint, err := i.queries.GetIndexerCheckpoint(ctx, i.chain)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if start := env.GetInt("INDEXER_START_BLOCK"); start > 0 {
+			return uint64(start), nil
+		}
+		return safeHead, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	header, err := i.ethClient.HeaderByNumber(ctx, big.NewInt(checkpoint.BlockNumber))
+	if err != nil {
+		return 0, err
+	}
+
+	if header.Hash().Hex() == checkpoint.BlockHash {
+		return uint64(checkpoint.BlockNumber) + 1, nil
+	}
+
+	rewindTo := uint64(0)
+	if uint64(checkpoint.BlockNumber) > i.reorgRewind {
+		rewindTo = uint64(checkpoint.BlockNumber) - i.reorgRewind
+	}
+
+	logger.For(ctx).Warnf("checkpoint block=%d hash=%s was reorged to hash=%s, rewinding to block=%d", checkpoint.BlockNumber, checkpoint.BlockHash, header.Hash().Hex(), rewindTo)
+
+	return rewindTo, nil
+}
+
+// indexRange indexes the transfers in the inclusive block range and checkpoints the last block of the range
+func (i *indexer) indexRange(ctx context.Context, from, to uint64) error {
+	ctx = logger.NewContextWithFields(ctx, logrus.Fields{"fromBlock": from, "toBlock": to, "chain": i.chain})
+
+	logs, err := i.ethClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Topics:    [][]common.Hash{{transferTopic, transferSingleTopic, transferBatchTopic}},
+	})
+	if err != nil {
+		return err
+	}
+
+	balances, err := i.walletBalances(ctx, transfersFromLogs(logs), to)
+	if err != nil {
+		return err
+	}
+
+	messages := make(map[persist.DBID]task.TokenProcessingUserTokensMessage)
+	for address, tokens := range balances {
+		user, err := i.queries.GetUserByAddressAndL1(ctx, coredb.GetUserByAddressAndL1Params{
+			Address: address,
+			L1Chain: i.chain.L1Chain(),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The wallet set can be briefly out of date with the wallets table
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		message, ok := messages[user.ID]
+		if !ok {
+			message = task.TokenProcessingUserTokensMessage{UserID: user.ID, TokenIdentifiers: make(task.TokenIdentifiersQuantities)}
+		}
+		for tID, quantity := range tokens {
+			message.TokenIdentifiers[tID] = quantity
+		}
+		messages[user.ID] = message
+	}
+
+	for _, message := range messages {
+		if err := i.taskClient.CreateTaskForUserTokens(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	if len(messages) > 0 {
+		logger.For(ctx).Infof("enqueued token updates for %d user(s) from %d log(s)", len(messages), len(logs))
+	}
+
+	header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+	if err != nil {
+		return err
+	}
+
+	return i.queries.UpsertIndexerCheckpoint(ctx, coredb.UpsertIndexerCheckpointParams{
+		ID:          persist.GenerateID(),
+		Chain:       i.chain,
+		BlockNumber: int64(to),
+		BlockHash:   header.Hash().Hex(),
+	})
+}
+
+// walletBalances returns the balance that each known wallet holds of the tokens it sent or received, as of the given block
+func (i *indexer) walletBalances(ctx context.Context, transfers []transfer, block uint64) (map[persist.Address]task.TokenIdentifiersQuantities, error) {
+	balances := make(map[persist.Address]task.TokenIdentifiersQuantities)
+
+	for _, t := range transfers {
+		// Check the sender first so that the recipient wins for transfers to self
+		for _, wallet := range []common.Address{t.From, t.To} {
+			if wallet == (common.Address{}) {
+				continue
+			}
+
+			address := persist.Address(i.chain.NormalizeAddress(persist.Address(wallet.Hex())))
+			if !i.wallets.contains(address, i.chain) {
+				continue
+			}
+
+			tID := persist.TokenUniqueIdentifiers{
+				Chain:           i.chain,
+				ContractAddress: persist.Address(i.chain.NormalizeAddress(persist.Address(t.Contract.Hex()))),
+				TokenID:         persist.HexTokenID(t.TokenID.Text(16)),
+				OwnerAddress:    address,
+			}
+
+			if _, ok := balances[address]; !ok {
+				balances[address] = make(task.TokenIdentifiersQuantities)
+			}
+
+			// Logs are ordered, so the last transfer of an ERC-721 determines who holds it at the end of the range
+			if t.TokenType == persist.TokenTypeERC721 {
+				if wallet == t.To {
+					balances[address][tID] = persist.HexString("1")
+				} else {
+					balances[address][tID] = persist.HexString("0")
+				}
+				continue
+			}
+
+			if _, ok := balances[address][tID]; ok {
+				continue
+			}
+
+			balance, err := i.erc1155BalanceOf(ctx, t.Contract, wallet, t.TokenID, block)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// A contract that doesn't implement balanceOf correctly shouldn't hold up the rest of the range
+			if err != nil {
+				logger.For(ctx).Warnf("skipping balance of token=%s for wallet=%s: %s", tID, address, err)
+				continue
+			}
+			balances[address][tID] = persist.HexString(balance.Text(16))
+		}
+	}
+
+	// Wallets whose balances were all skipped have nothing to update
+	for address, tokens := range balances {
+		if len(tokens) == 0 {
+			delete(balances, address)
+		}
+	}
+
+	return balances, nil
+}
+
+func (i *indexer) erc1155BalanceOf(ctx context.Context, contract, wallet common.Address, tokenID *big.Int, block uint64) (*big.Int, error) {
+	caller, err := contracts.NewIERC1155Caller(contract, i.ethClient)
+	if err != nil {
+		return nil, err
+	}
+	return caller.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}, wallet, tokenID)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+func TestTransfersFromLogs(t *testing.T) {
+	contract := common.HexToAddress("0x1")
+	from := common.HexToAddress("0x2")
+	to := common.HexToAddress("0x3")
+	tokenID := common.BigToHash(big.NewInt(7))
+
+	batchData := common.FromHex(
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+			"00000000000000000000000000000000000000000000000000000000000000a0" +
+			"0000000000000000000000000000000000000000000000000000000000000002" +
+			"0000000000000000000000000000000000000000000000000000000000000008" +
+			"0000000000000000000000000000000000000000000000000000000000000009" +
+			"0000000000000000000000000000000000000000000000000000000000000002" +
+			"0000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000001",
+	)
+
+	logs := []types.Log{
+		// ERC-721 transfer
+		{Address: contract, Topics: []common.Hash{transferTopic, from.Hash(), to.Hash(), tokenID}},
+		// ERC-20 transfer, which shares the topic but doesn't index the amount
+		{Address: contract, Topics: []common.Hash{transferTopic, from.Hash(), to.Hash()}, Data: tokenID.Bytes()},
+		// ERC-1155 batch transfer
+		{Address: contract, Topics: []common.Hash{transferBatchTopic, from.Hash(), from.Hash(), to.Hash()}, Data: batchData},
+		// Removed by a reorg
+		{Address: contract, Topics: []common.Hash{transferTopic, from.Hash(), to.Hash(), tokenID}, Removed: true},
+	}
+
+	transfers := transfersFromLogs(logs)
+
+	assert.Len(t, transfers, 3)
+	assert.Equal(t, persist.TokenTypeERC721, transfers[0].TokenType)
+	assert.Equal(t, int64(7), transfers[0].TokenID.Int64())
+	assert.Equal(t, to, transfers[0].To)
+	assert.Equal(t, persist.TokenTypeERC1155, transfers[1].TokenType)
+	assert.Equal(t, int64(8), transfers[1].TokenID.Int64())
+	assert.Equal(t, int64(9), transfers[2].TokenID.Int64())
+	assert.Equal(t, from, transfers[2].From)
+}
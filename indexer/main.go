@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/auth"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/rpc"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+func main() {
+	setDefaults()
+	initSentry()
+	router := gin.Default()
+
+	logger.InitWithGCPDefaults()
+
+	pgx := postgres.NewPgxClient()
+	queries := coredb.New(pgx)
+
+	ctx := context.Background()
+
+	wallets := &walletSet{}
+	err := wallets.refresh(ctx, queries)
+	if err != nil {
+		panic(err)
+	}
+
+	// Health endpoint
+	router.GET("/health", util.HealthCheckHandler())
+
+	router.GET("/updateWallets", func(c *gin.Context) {
+		err := wallets.refresh(c, queries)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.String(http.StatusOK, "OK")
+	})
+
+	go func() {
+		// update the wallet set every 15 minutes
+		for {
+			time.Sleep(15 * time.Minute)
+			logger.For(ctx).Info("updating wallet set...")
+
+			err := wallets.refresh(ctx, queries)
+			if err != nil {
+				err := fmt.Errorf("error updating wallet set: %w", err)
+				logger.For(ctx).Error(err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}
+	}()
+
+	i := newIndexer(persist.ChainETH, rpc.NewEthClient(), queries, task.NewClient(ctx), wallets)
+	go i.run(ctx)
+
+	err = router.Run(":3000")
+	if err != nil {
+		err = fmt.Errorf("error running router: %w", err)
+		logger.For(ctx).Error(err)
+		sentryutil.ReportError(ctx, err)
+		panic(err)
+	}
+}
+
+func setDefaults() {
+	viper.SetDefault("ENV", "local")
+	viper.SetDefault("POSTGRES_HOST", "0.0.0.0")
+	viper.SetDefault("POSTGRES_PORT", 5432)
+	viper.SetDefault("POSTGRES_USER", "gallery_backend")
+	viper.SetDefault("POSTGRES_PASSWORD", "")
+	viper.SetDefault("POSTGRES_DB", "postgres")
+	viper.SetDefault("RPC_URL", "")
+	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
+	viper.SetDefault("TOKEN_PROCESSING_URL", "http://localhost:6500")
+	viper.SetDefault("INDEXER_CONFIRMATIONS", 12)
+	viper.SetDefault("INDEXER_BLOCK_BATCH_SIZE", 10)
+	viper.SetDefault("INDEXER_REORG_REWIND", 64)
+	viper.SetDefault("INDEXER_POLL_INTERVAL", "12s")
+	viper.SetDefault("INDEXER_START_BLOCK", 0)
+	viper.SetDefault("SENTRY_DSN", "")
+	viper.SetDefault("GAE_VERSION", "")
+	viper.SetDefault("SENTRY_TRACES_SAMPLE_RATE", 0.2)
+
+	viper.AutomaticEnv()
+
+	if env.GetString("ENV") != "local" {
+		logger.For(nil).Info("running in non-local environment, skipping environment configuration")
+	} else {
+		fi := "local"
+		if len(os.Args) > 1 {
+			fi = os.Args[1]
+		}
+		// The indexer submits tasks to tokenprocessing, so it shares its config
+		envFile := util.ResolveEnvFile("tokenprocessing", fi)
+		util.LoadEncryptedEnvFile(envFile)
+	}
+
+	if env.GetString("ENV") != "local" {
+		util.VarNotSetTo("SENTRY_DSN", "")
+		util.VarNotSetTo("RPC_URL", "")
+	}
+}
+
+func initSentry() {
+	if env.GetString("ENV") == "local" {
+		logger.For(nil).Info("skipping sentry init")
+		return
+	}
+
+	logger.For(nil).Info("initializing sentry...")
+
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:              env.GetString("SENTRY_DSN"),
+		Environment:      env.GetString("ENV"),
+		TracesSampleRate: env.GetFloat64("SENTRY_TRACES_SAMPLE_RATE"),
+		Release:          env.GetString("GAE_VERSION"),
+		AttachStacktrace: true,
+		BeforeSend: func(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
+			event = auth.ScrubEventCookies(event, hint)
+			return event
+		},
+	})
+
+	if err != nil {
+		logger.For(nil).Fatalf("failed to start sentry: %s", err)
+	}
+}
@@ -163,7 +163,7 @@ func (api CommunityAPI) PaginatePostsByCommunityID(ctx context.Context, communit
 	return paginator.Paginate(before, after, first, last)
 }
 
-func (api CommunityAPI) PaginateTokensByCommunityID(ctx context.Context, communityID persist.DBID, before, after *string, first, last *int) ([]db.Token, PageInfo, error) {
+func (api CommunityAPI) PaginateTokensByCommunityID(ctx context.Context, communityID persist.DBID, before, after *string, first, last *int, maxSpamScore *int) ([]db.Token, PageInfo, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"communityID": validate.WithTag(communityID, "required"),
@@ -183,13 +183,17 @@ func (api CommunityAPI) PaginateTokensByCommunityID(ctx context.Context, communi
 			CurBeforeID:   params.CursorBeforeID,
 			CurAfterTime:  params.CursorAfterTime,
 			CurAfterID:    params.CursorAfterID,
+			MaxSpamScore:  util.ToNullInt32(maxSpamScore),
 			PagingForward: params.PagingForward,
 		})
 		return util.MapWithoutError(results, func(r db.PaginateTokensByCommunityIDRow) db.Token { return r.Token }), err
 	}
 
 	countFunc := func() (int, error) {
-		total, err := api.queries.CountTokensByCommunityID(ctx, communityID)
+		total, err := api.queries.CountTokensByCommunityID(ctx, db.CountTokensByCommunityIDParams{
+			CommunityID:  communityID,
+			MaxSpamScore: util.ToNullInt32(maxSpamScore),
+		})
 		return int(total), err
 	}
 
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/spam"
 	"github.com/mikeydub/go-gallery/validate"
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
@@ -44,6 +46,26 @@ func (api ContractAPI) GetContractByID(ctx context.Context, contractID persist.D
 	return &contract, nil
 }
 
+// GetSpamScoreByContractID returns the spam score of a contract, or nil if it hasn't been scored yet
+func (api ContractAPI) GetSpamScoreByContractID(ctx context.Context, contractID persist.DBID) (*spam.Score, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"contractID": validate.WithTag(contractID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	score, err := api.loaders.GetContractSpamScoreByContractIDBatch.Load(contractID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &spam.Score{Score: int(score.Score), Reasons: score.Reasons}, nil
+}
+
 func (api ContractAPI) GetContractByAddress(ctx context.Context, contractAddress persist.ChainAddress) (*db.Contract, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
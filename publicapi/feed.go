@@ -4,6 +4,8 @@ import (
 	heappkg "container/heap"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -11,6 +13,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/event"
@@ -32,6 +36,15 @@ import (
 
 const trendingFeedCacheKey = "trending:feedEvents:all"
 
+// Cloud Tasks won't schedule a task more than 30 days in advance
+const maxScheduledPostDelay = 30 * 24 * time.Hour
+
+// publishLeeway is how early a scheduled post's task can run and still publish the post
+const publishLeeway = time.Minute
+
+var ErrScheduledPostNotFound = errors.New("scheduled post not found")
+var ErrOnlyRemoveOwnRepost = errors.New("only the actor who created the repost can remove it")
+
 var feedOpts = struct {
 	FreshnessFactor     float64 // extra weight added to a new post
 	FirstPostFactor     float64 // extra weight added to a first post
@@ -146,15 +159,6 @@ func (api FeedAPI) PostTokens(ctx context.Context, tokenIDs []persist.DBID, ment
 		return "", err
 	}
 
-	contracts, err := api.queries.GetContractsByTokenIDs(ctx, tokenIDs)
-	if err != nil {
-		return "", err
-	}
-
-	contractIDs := util.MapWithoutError(contracts, func(c db.Contract) persist.DBID {
-		return c.ID
-	})
-
 	tokens, errs := api.loaders.GetTokenByIdBatch.LoadAll(tokenIDs)
 	tokenDefinitionIDs := make([]persist.DBID, 0, len(tokens))
 	for i, err := range errs {
@@ -169,7 +173,35 @@ func (api FeedAPI) PostTokens(ctx context.Context, tokenIDs []persist.DBID, ment
 	}
 	defer tx.Rollback(ctx)
 
-	q := api.queries.WithTx(tx)
+	postID, dbMentions, err := insertPost(ctx, api.queries.WithTx(tx), actorID, tokenIDs, mentions, caption, mintURL)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = dispatchPostEvents(ctx, actorID, postID, dbMentions)
+	if err != nil {
+		return "", err
+	}
+
+	return postID, nil
+}
+
+// insertPost adds a post, its mentions and its hashtags using q, which is expected to be part of a transaction. Once the
+// transaction is committed, the post's events should be sent with dispatchPostEvents.
+func insertPost(ctx context.Context, q *db.Queries, actorID persist.DBID, tokenIDs []persist.DBID, mentions []*model.MentionInput, caption, mintURL *string) (persist.DBID, []db.Mention, error) {
+	contracts, err := q.GetContractsByTokenIDs(ctx, tokenIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	contractIDs := util.MapWithoutError(contracts, func(c db.Contract) persist.DBID {
+		return c.ID
+	})
 
 	postID, err := q.InsertPost(ctx, db.InsertPostParams{
 		ID:          persist.GenerateID(),
@@ -180,211 +212,832 @@ func (api FeedAPI) PostTokens(ctx context.Context, tokenIDs []persist.DBID, ment
 		UserMintUrl: util.ToNullStringEmptyNull(util.GetOptionalValue(mintURL, "")),
 	})
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	dbMentions, err := insertMentionsForPost(ctx, mentions, postID, q)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = insertPostHashtags(ctx, q, postID, caption)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return postID, dbMentions, nil
+}
+
+// dispatchPostEvents notifies the post's mentions and publishes the post to feeds
+func dispatchPostEvents(ctx context.Context, actorID, postID persist.DBID, mentions []db.Mention) error {
+	err := dispatchPostMentionEvents(ctx, actorID, postID, mentions)
+	if err != nil {
+		return err
+	}
+
+	err = event.Dispatch(ctx, db.Event{
+		ActorID:        persist.DBIDToNullStr(actorID),
+		Action:         persist.ActionUserPosted,
+		ResourceTypeID: persist.ResourceTypePost,
+		UserID:         actorID,
+		SubjectID:      postID,
+		PostID:         postID,
+	})
+	if err != nil {
+		sentryutil.ReportError(ctx, fmt.Errorf("error dispatching event: %w", err))
+		logger.For(ctx).Errorf("error dispatching event: %v", err)
+	}
+
+	return nil
+}
+
+func (api FeedAPI) ReferralPostToken(ctx context.Context, t persist.TokenIdentifiers, caption, mintURL *string) (persist.DBID, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"token": validate.WithTag(t, "required"),
+		// caption can be null but less than 2000 chars
+		"caption": validate.WithTag(caption, "max=2000"),
+		"mintURL": validate.WithTag(mintURL, "omitempty,http"),
+	}); err != nil {
+		return "", err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := api.loaders.GetTokenByUserTokenIdentifiersBatch.Load(db.GetTokenByUserTokenIdentifiersBatchParams{
+		OwnerID:         userID,
+		TokenID:         t.TokenID,
+		ContractAddress: t.ContractAddress,
+		Chain:           t.Chain,
+	})
+
+	var tokenID persist.DBID
+	var contractID persist.DBID
+
+	if err == nil {
+		// The token is already synced
+		tokenID = r.Token.ID
+		contractID = r.Contract.ID
+	} else if !util.ErrorIs[persist.ErrTokenNotFoundByUserTokenIdentifers](err) {
+		// Unexpected error
+		return "", err
+	} else {
+		// The token is not synced, so we need to find it
+		synced, err := api.multichainProvider.SyncTokenByUserWalletsAndTokenIdentifiersRetry(ctx, userID, t, retry.Retry{
+			MinWait:    2,
+			MaxWait:    4,
+			MaxRetries: 4,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		tokenID = synced.Instance.ID
+		contractID = synced.Contract.ID
+	}
+
+	postID, err := api.queries.InsertPost(ctx, db.InsertPostParams{
+		ID:          persist.GenerateID(),
+		TokenIds:    []persist.DBID{tokenID},
+		ContractIds: []persist.DBID{contractID},
+		ActorID:     userID,
+		Caption:     util.ToNullStringEmptyNull(util.GetOptionalValue(caption, "")),
+		UserMintUrl: util.ToNullStringEmptyNull(util.GetOptionalValue(mintURL, "")),
+	})
+	if err != nil {
+		return postID, err
+	}
+
+	err = insertPostHashtags(ctx, api.queries, postID, caption)
+	if err != nil {
+		return postID, err
+	}
+
+	err = event.Dispatch(ctx, db.Event{
+		ActorID:        persist.DBIDToNullStr(userID),
+		Action:         persist.ActionUserPosted,
+		ResourceTypeID: persist.ResourceTypePost,
+		UserID:         userID,
+		SubjectID:      postID,
+		PostID:         postID,
+	})
+	if err != nil {
+		sentryutil.ReportError(ctx, fmt.Errorf("error dispatching event: %w", err))
+		logger.For(ctx).Errorf("error dispatching event: %v", err)
+	}
+
+	return postID, nil
+}
+
+func (api FeedAPI) ReferralPostPreflight(ctx context.Context, t persist.TokenIdentifiers) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"address": validate.WithTag(t.ContractAddress, "required"),
+		"tokenID": validate.WithTag(t.TokenID, "required"),
+	}); err != nil {
+		return err
+	}
+	userID, _ := getAuthenticatedUserID(ctx)
+	return api.taskClient.CreateTaskForPostPreflight(ctx, task.PostPreflightMessage{Token: t, UserID: userID})
+}
+
+func insertMentionsForPost(ctx context.Context, mentions []*model.MentionInput, postID persist.DBID, q *db.Queries) ([]db.Mention, error) {
+	dbMentions, err := mentionInputsToMentions(ctx, mentions, q)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]db.Mention, len(dbMentions))
+
+	for i, m := range dbMentions {
+		var user, community sql.NullString
+		if m.UserID != "" {
+			user = sql.NullString{
+				String: m.UserID.String(),
+				Valid:  true,
+			}
+		} else if m.CommunityID != "" {
+			community = sql.NullString{
+				String: m.CommunityID.String(),
+				Valid:  true,
+			}
+		}
+		mid, err := q.InsertPostMention(ctx, db.InsertPostMentionParams{
+			ID:        persist.GenerateID(),
+			User:      user,
+			Community: community,
+			PostID:    postID,
+			Start:     m.Start,
+			Length:    m.Length,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = mid
+	}
+
+	return result, nil
+}
+
+func (api FeedAPI) DeletePostById(ctx context.Context, postID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID": validate.WithTag(postID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	err := api.queries.DeletePostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePost edits the caption, mentions and mint URL of one of the viewer's posts. Fields that are nil are left
+// unchanged, except that mentions are rebuilt whenever the caption changes, since their positions refer to the
+// caption. The previous version of the post is kept in its edit history, and only users and communities that
+// weren't already mentioned are notified. An edit that doesn't change anything isn't recorded.
+func (api FeedAPI) UpdatePost(ctx context.Context, postID persist.DBID, mentions []*model.MentionInput, caption, mintURL *string) (*db.Post, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID": validate.WithTag(postID, "required"),
+		// caption can be null but less than 2000 chars
+		"caption": validate.WithTag(caption, "max=2000"),
+		"mintURL": validate.WithTag(mintURL, "omitempty,http"),
+	}); err != nil {
+		return nil, err
+	}
+
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := api.loaders.GetPostByIdBatch.Load(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.ActorID != actorID {
+		return nil, ErrOnlyEditOwnPost
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := api.queries.WithTx(tx)
+
+	params := db.UpdatePostParams{
+		ID:          postID,
+		Caption:     post.Caption,
+		UserMintUrl: post.UserMintUrl,
+	}
+	if caption != nil {
+		params.Caption = util.ToNullStringEmptyNull(*caption)
+	}
+	if mintURL != nil {
+		params.UserMintUrl = util.ToNullStringEmptyNull(*mintURL)
+	}
+
+	captionChanged := params.Caption != post.Caption
+	changed := captionChanged || params.UserMintUrl != post.UserMintUrl
+
+	newMentions := make([]db.Mention, 0)
+	if mentions != nil || captionChanged {
+		oldMentions, err := q.DeleteMentionsByPostID(ctx, postID)
+		if err != nil {
+			return nil, err
+		}
+
+		// A caption edit without mentions leaves the post with none
+		dbMentions, err := insertMentionsForPost(ctx, mentions, postID, q)
+		if err != nil {
+			return nil, err
+		}
+
+		changed = changed || !sameMentions(oldMentions, dbMentions)
+
+		// Users and communities that were mentioned before the edit have already been notified
+		mentioned := make(map[persist.DBID]bool, len(oldMentions))
+		for _, mention := range oldMentions {
+			mentioned[mentionedID(mention)] = true
+		}
+
+		for _, mention := range dbMentions {
+			if !mentioned[mentionedID(mention)] {
+				newMentions = append(newMentions, mention)
+			}
+		}
+	}
+
+	// Nothing to record; the deferred rollback discards any mentions that were rewritten
+	if !changed {
+		return &post, nil
+	}
+
+	err = q.InsertPostEdit(ctx, db.InsertPostEditParams{
+		ID:          persist.GenerateID(),
+		PostID:      postID,
+		ActorID:     actorID,
+		Caption:     post.Caption,
+		UserMintUrl: post.UserMintUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.UpdatePost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if captionChanged {
+		err = insertPostHashtags(ctx, q, postID, caption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dispatchPostMentionEvents(ctx, actorID, postID, newMentions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the post directly, since the loader has cached the post from before it was edited
+	updated, err := api.queries.GetPostByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// SetPostCommentRestriction controls who besides the author may comment on one of the viewer's posts.
+func (api FeedAPI) SetPostCommentRestriction(ctx context.Context, postID persist.DBID, restriction persist.CommentRestriction) (*db.Post, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID":      validate.WithTag(postID, "required"),
+		"restriction": validate.WithTag(restriction, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := api.loaders.GetPostByIdBatch.Load(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.ActorID != actorID {
+		return nil, ErrOnlyEditOwnPost
+	}
+
+	err = api.queries.SetPostCommentRestriction(ctx, db.SetPostCommentRestrictionParams{
+		ID:                 postID,
+		CommentRestriction: restriction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	post.CommentRestriction = restriction
+	return &post, nil
+}
+
+func (api FeedAPI) GetPostEditsByPostID(ctx context.Context, postID persist.DBID) ([]db.PostEdit, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID": validate.WithTag(postID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	// The edit history is only visible to viewers who can see the post itself
+	_, err := api.loaders.GetPostByIdBatch.Load(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetPostEditsByPostID(ctx, postID)
+}
+
+func (api FeedAPI) GetRepostByID(ctx context.Context, repostID persist.DBID) (*db.Repost, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"repostID": validate.WithTag(repostID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	repost, err := api.loaders.GetRepostByIdBatch.Load(repostID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repost, nil
+}
+
+func (api FeedAPI) GetRepostByActorIDAndPostID(ctx context.Context, actorID persist.DBID, postID persist.DBID) (*db.Repost, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"actorID": validate.WithTag(actorID, "required"),
+		"postID":  validate.WithTag(postID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	repost, err := api.loaders.GetRepostByActorIDAndPostID.Load(db.GetRepostByActorIDAndPostIDParams{
+		ActorID: actorID,
+		PostID:  postID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &repost, nil
+}
+
+func (api FeedAPI) GetTotalRepostsByPostID(ctx context.Context, postID persist.DBID) (*int, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID": validate.WithTag(postID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	count, err := api.loaders.CountRepostsByPostIDBatch.Load(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.ToPointer(int(count)), nil
+}
+
+// RepostPost shares a post to the viewer's followers. If a caption is provided, the repost is a quote post.
+// Reposting a post that the viewer has already reposted returns the existing repost.
+func (api FeedAPI) RepostPost(ctx context.Context, postID persist.DBID, caption *string) (*db.Repost, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"postID": validate.WithTag(postID, "required"),
+		// caption can be null but less than 2000 chars
+		"caption": validate.WithTag(caption, "max=2000"),
+	}); err != nil {
+		return nil, err
+	}
+
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = api.GetPostById(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := util.ToNullStringEmptyNull(util.GetOptionalValue(caption, ""))
+	action := persist.ActionQuotedPost
+
+	if !quote.Valid {
+		action = persist.ActionRepostedPost
+
+		existing, err := api.GetRepostByActorIDAndPostID(ctx, actorID, postID)
+		if err == nil {
+			return existing, nil
+		}
+		if !util.ErrorIs[persist.ErrRepostNotFoundByActorIDPostID](err) {
+			return nil, err
+		}
+	}
+
+	repostID := persist.GenerateID()
+	repost, err := api.queries.InsertRepost(ctx, db.InsertRepostParams{
+		ID:      repostID,
+		ActorID: actorID,
+		PostID:  postID,
+		Caption: quote,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A concurrent request reposted the post first
+	if repost.ID != repostID {
+		return &repost, nil
+	}
+
+	err = event.Dispatch(ctx, db.Event{
+		ActorID:        persist.DBIDToNullStr(actorID),
+		Action:         action,
+		ResourceTypeID: persist.ResourceTypePost,
+		UserID:         actorID,
+		SubjectID:      repost.ID,
+		PostID:         postID,
+	})
+	if err != nil {
+		sentryutil.ReportError(ctx, fmt.Errorf("error dispatching event: %w", err))
+		logger.For(ctx).Errorf("error dispatching event: %v", err)
+	}
+
+	return &repost, nil
+}
+
+func (api FeedAPI) DeleteRepost(ctx context.Context, repostID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"repostID": validate.WithTag(repostID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	repost, err := api.loaders.GetRepostByIdBatch.Load(repostID)
+	if err != nil {
+		return err
+	}
+
+	if repost.ActorID != actorID {
+		return ErrOnlyRemoveOwnRepost
+	}
+
+	return api.queries.DeleteRepostByID(ctx, repostID)
+}
+
+func (api FeedAPI) GetViewerScheduledPosts(ctx context.Context) ([]db.ScheduledPost, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return api.queries.GetScheduledPostsByActorID(ctx, userID)
+}
+
+// GetScheduledPostByID returns one of the viewer's unpublished scheduled posts. Other users' scheduled posts are treated as not found.
+func (api FeedAPI) GetScheduledPostByID(ctx context.Context, scheduledPostID persist.DBID) (db.ScheduledPost, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"scheduledPostID": validate.WithTag(scheduledPostID, "required"),
+	}); err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	post, err := api.queries.GetScheduledPostByID(ctx, scheduledPostID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && post.ActorID != userID) {
+		return db.ScheduledPost{}, ErrScheduledPostNotFound
+	}
+
+	return post, err
+}
+
+// SchedulePost saves a post as a draft that is published at publishAt. It can be edited or cancelled until then.
+func (api FeedAPI) SchedulePost(ctx context.Context, tokenIDs []persist.DBID, mentions []*model.MentionInput, caption, mintURL *string, publishAt time.Time) (db.ScheduledPost, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"tokenIDs": validate.WithTag(tokenIDs, "required"),
+		// caption can be null but less than 2000 chars
+		"caption": validate.WithTag(caption, "max=2000"),
+		"mintURL": validate.WithTag(mintURL, "omitempty,http"),
+	}); err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	if err := validatePublishAt(publishAt); err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	mentionsJSON, err := scheduledPostMentions(ctx, api.queries, mentions)
+	if err != nil {
+		return db.ScheduledPost{}, err
+	}
+
+	// The post is only saved if its task is created, so that it can't be left without anything to publish it.
+	// If the task runs before the post is committed, it won't find the post and does nothing.
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.ScheduledPost{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	post, err := api.queries.WithTx(tx).InsertScheduledPost(ctx, db.InsertScheduledPostParams{
+		ID:          persist.GenerateID(),
+		ActorID:     actorID,
+		TokenIds:    tokenIDs,
+		Caption:     util.ToNullStringEmptyNull(util.GetOptionalValue(caption, "")),
+		UserMintUrl: util.ToNullStringEmptyNull(util.GetOptionalValue(mintURL, "")),
+		Mentions:    mentionsJSON,
+		PublishAt:   publishAt,
+	})
+	if err != nil {
+		return db.ScheduledPost{}, err
 	}
 
-	dbMentions, err := insertMentionsForPost(ctx, mentions, postID, q)
+	err = api.taskClient.CreateTaskForScheduledPost(ctx, task.ScheduledPostMessage{ScheduledPostID: post.ID}, time.Until(publishAt))
 	if err != nil {
-		return "", err
-	}
-	if len(dbMentions) > 0 {
-		for _, mention := range dbMentions {
-			switch {
-			case mention.UserID != "":
-				err = event.Dispatch(ctx, db.Event{
-					ActorID:        persist.DBIDToNullStr(actorID),
-					ResourceTypeID: persist.ResourceTypeUser,
-					SubjectID:      mention.UserID,
-					PostID:         postID,
-					UserID:         mention.UserID,
-					Action:         persist.ActionMentionUser,
-					MentionID:      mention.ID,
-				})
-				if err != nil {
-					return "", err
-				}
-			case mention.CommunityID != "":
-				err = event.Dispatch(ctx, db.Event{
-					ActorID:        persist.DBIDToNullStr(actorID),
-					ResourceTypeID: persist.ResourceTypeCommunity,
-					SubjectID:      mention.CommunityID,
-					PostID:         postID,
-					CommunityID:    mention.CommunityID,
-					Action:         persist.ActionMentionCommunity,
-					MentionID:      mention.ID,
-				})
-				if err != nil {
-					return "", err
-				}
-			default:
-				return "", fmt.Errorf("invalid mention type: %+v", mention)
-			}
-		}
+		return db.ScheduledPost{}, err
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		return "", err
-	}
-
-	err = event.Dispatch(ctx, db.Event{
-		ActorID:        persist.DBIDToNullStr(actorID),
-		Action:         persist.ActionUserPosted,
-		ResourceTypeID: persist.ResourceTypePost,
-		UserID:         actorID,
-		SubjectID:      postID,
-		PostID:         postID,
-	})
-	if err != nil {
-		sentryutil.ReportError(ctx, fmt.Errorf("error dispatching event: %w", err))
-		logger.For(ctx).Errorf("error dispatching event: %v", err)
+		return db.ScheduledPost{}, err
 	}
 
-	return postID, nil
+	return post, nil
 }
 
-func (api FeedAPI) ReferralPostToken(ctx context.Context, t persist.TokenIdentifiers, caption, mintURL *string) (persist.DBID, error) {
+// UpdateScheduledPost edits a scheduled post that hasn't been published yet. As with UpdatePost, fields that are nil
+// are left unchanged, except that the mentions are replaced whenever the caption changes.
+func (api FeedAPI) UpdateScheduledPost(ctx context.Context, scheduledPostID persist.DBID, tokenIDs []persist.DBID, mentions []*model.MentionInput, caption, mintURL *string, publishAt *time.Time) (db.ScheduledPost, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"token": validate.WithTag(t, "required"),
 		// caption can be null but less than 2000 chars
 		"caption": validate.WithTag(caption, "max=2000"),
 		"mintURL": validate.WithTag(mintURL, "omitempty,http"),
 	}); err != nil {
-		return "", err
+		return db.ScheduledPost{}, err
 	}
 
-	userID, err := getAuthenticatedUserID(ctx)
+	post, err := api.GetScheduledPostByID(ctx, scheduledPostID)
 	if err != nil {
-		return "", err
+		return db.ScheduledPost{}, err
 	}
 
-	r, err := api.loaders.GetTokenByUserTokenIdentifiersBatch.Load(db.GetTokenByUserTokenIdentifiersBatchParams{
-		OwnerID:         userID,
-		TokenID:         t.TokenID,
-		ContractAddress: t.ContractAddress,
-		Chain:           t.Chain,
-	})
+	params := db.UpdateScheduledPostParams{
+		ID:          post.ID,
+		TokenIds:    post.TokenIds,
+		Caption:     post.Caption,
+		UserMintUrl: post.UserMintUrl,
+		Mentions:    post.Mentions,
+		PublishAt:   post.PublishAt,
+	}
 
-	var tokenID persist.DBID
-	var contractID persist.DBID
+	if len(tokenIDs) > 0 {
+		params.TokenIds = tokenIDs
+	}
+	if caption != nil {
+		params.Caption = util.ToNullStringEmptyNull(*caption)
+	}
+	if mintURL != nil {
+		params.UserMintUrl = util.ToNullStringEmptyNull(*mintURL)
+	}
 
-	if err == nil {
-		// The token is already synced
-		tokenID = r.Token.ID
-		contractID = r.Contract.ID
-	} else if !util.ErrorIs[persist.ErrTokenNotFoundByUserTokenIdentifers](err) {
-		// Unexpected error
-		return "", err
-	} else {
-		// The token is not synced, so we need to find it
-		synced, err := api.multichainProvider.SyncTokenByUserWalletsAndTokenIdentifiersRetry(ctx, userID, t, retry.Retry{
-			MinWait:    2,
-			MaxWait:    4,
-			MaxRetries: 4,
-		})
+	rescheduled := publishAt != nil && !publishAt.Equal(post.PublishAt)
+	if rescheduled {
+		if err := validatePublishAt(*publishAt); err != nil {
+			return db.ScheduledPost{}, err
+		}
+		params.PublishAt = *publishAt
+	}
+
+	// Mentions refer to positions in the caption, so a caption edit without mentions leaves the post with none
+	if mentions != nil || params.Caption != post.Caption {
+		params.Mentions, err = scheduledPostMentions(ctx, api.queries, mentions)
 		if err != nil {
-			return "", err
+			return db.ScheduledPost{}, err
 		}
+	}
 
-		tokenID = synced.Instance.ID
-		contractID = synced.Contract.ID
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.ScheduledPost{}, err
 	}
+	defer tx.Rollback(ctx)
 
-	postID, err := api.queries.InsertPost(ctx, db.InsertPostParams{
-		ID:          persist.GenerateID(),
-		TokenIds:    []persist.DBID{tokenID},
-		ContractIds: []persist.DBID{contractID},
-		ActorID:     userID,
-		Caption:     util.ToNullStringEmptyNull(util.GetOptionalValue(caption, "")),
-		UserMintUrl: util.ToNullStringEmptyNull(util.GetOptionalValue(mintURL, "")),
-	})
+	post, err = api.queries.WithTx(tx).UpdateScheduledPost(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The post was published or cancelled while it was being edited
+		return db.ScheduledPost{}, ErrScheduledPostNotFound
+	}
 	if err != nil {
-		return postID, err
+		return db.ScheduledPost{}, err
 	}
 
-	err = event.Dispatch(ctx, db.Event{
-		ActorID:        persist.DBIDToNullStr(userID),
-		Action:         persist.ActionUserPosted,
-		ResourceTypeID: persist.ResourceTypePost,
-		UserID:         userID,
-		SubjectID:      postID,
-		PostID:         postID,
-	})
+	// The post's original task won't publish it if it runs before the new publish time
+	if rescheduled {
+		err = api.taskClient.CreateTaskForScheduledPost(ctx, task.ScheduledPostMessage{ScheduledPostID: post.ID}, time.Until(post.PublishAt))
+		if err != nil {
+			return db.ScheduledPost{}, err
+		}
+	}
+
+	err = tx.Commit(ctx)
 	if err != nil {
-		sentryutil.ReportError(ctx, fmt.Errorf("error dispatching event: %w", err))
-		logger.For(ctx).Errorf("error dispatching event: %v", err)
+		return db.ScheduledPost{}, err
 	}
 
-	return postID, nil
+	return post, nil
 }
 
-func (api FeedAPI) ReferralPostPreflight(ctx context.Context, t persist.TokenIdentifiers) error {
-	// Validate
-	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"address": validate.WithTag(t.ContractAddress, "required"),
-		"tokenID": validate.WithTag(t.TokenID, "required"),
-	}); err != nil {
+func (api FeedAPI) CancelScheduledPost(ctx context.Context, scheduledPostID persist.DBID) error {
+	post, err := api.GetScheduledPostByID(ctx, scheduledPostID)
+	if err != nil {
 		return err
 	}
-	userID, _ := getAuthenticatedUserID(ctx)
-	return api.taskClient.CreateTaskForPostPreflight(ctx, task.PostPreflightMessage{Token: t, UserID: userID})
+	return api.queries.CancelScheduledPost(ctx, post.ID)
 }
 
-func insertMentionsForPost(ctx context.Context, mentions []*model.MentionInput, postID persist.DBID, q *db.Queries) ([]db.Mention, error) {
-	dbMentions, err := mentionInputsToMentions(ctx, mentions, q)
+// PublishScheduledPost publishes a scheduled post once it is due. Posts that were cancelled, already published,
+// or rescheduled to a later time are ignored, since they're handled by another task or don't need one. A post's
+// events are dispatched after it is published, and are dispatched again if a previous attempt to send them failed.
+func PublishScheduledPost(ctx context.Context, repos *postgres.Repositories, queries *db.Queries, scheduledPostID persist.DBID) error {
+	tx, err := repos.BeginTx(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	result := make([]db.Mention, len(dbMentions))
+	defer tx.Rollback(ctx)
 
-	for i, m := range dbMentions {
-		var user, community sql.NullString
-		if m.UserID != "" {
-			user = sql.NullString{
-				String: m.UserID.String(),
-				Valid:  true,
-			}
-		} else if m.CommunityID != "" {
-			community = sql.NullString{
-				String: m.CommunityID.String(),
-				Valid:  true,
-			}
+	q := queries.WithTx(tx)
+
+	scheduled, err := q.GetScheduledPostForPublish(ctx, scheduledPostID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// An earlier attempt published the post but failed to dispatch its events. The row stays locked while the events
+	// are dispatched, so that only one attempt sends them.
+	if scheduled.PostID != "" {
+		dbMentions, err := q.GetMentionsForScheduledPost(ctx, scheduled.ID)
+		if err != nil {
+			return err
 		}
-		mid, err := q.InsertPostMention(ctx, db.InsertPostMentionParams{
-			ID:        persist.GenerateID(),
-			User:      user,
-			Community: community,
-			PostID:    postID,
-			Start:     m.Start,
-			Length:    m.Length,
-		})
+
+		err = dispatchScheduledPostEvents(ctx, q, scheduled, scheduled.PostID, dbMentions)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		result[i] = mid
+		return tx.Commit(ctx)
 	}
 
-	return result, nil
-}
+	if time.Until(scheduled.PublishAt) > publishLeeway {
+		return nil
+	}
 
-func (api FeedAPI) DeletePostById(ctx context.Context, postID persist.DBID) error {
-	// Validate
-	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"postID": validate.WithTag(postID, "required"),
-	}); err != nil {
+	var mentions []*model.MentionInput
+	if scheduled.Mentions.Status == pgtype.Present {
+		if err := json.Unmarshal(scheduled.Mentions.Bytes, &mentions); err != nil {
+			return err
+		}
+	}
+
+	// Tokens can be sold or transferred while the post is scheduled, so only the ones the user still owns are posted
+	ownedIDs, err := q.GetOwnedTokenIDs(ctx, db.GetOwnedTokenIDsParams{
+		TokenIds:    scheduled.TokenIds,
+		OwnerUserID: scheduled.ActorID,
+	})
+	if err != nil {
 		return err
 	}
 
-	err := api.queries.DeletePostByID(ctx, postID)
+	owned := make(map[persist.DBID]bool, len(ownedIDs))
+	for _, id := range ownedIDs {
+		owned[id] = true
+	}
+
+	tokenIDs := util.Filter(scheduled.TokenIds, func(id persist.DBID) bool { return owned[id] }, false)
+	if len(tokenIDs) == 0 {
+		logger.For(ctx).Warnf("cancelling scheduled post %s because its author no longer owns any of its tokens", scheduled.ID)
+		err = q.CancelScheduledPost(ctx, scheduled.ID)
+		if err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	caption := util.StringToPointerIfNotEmpty(scheduled.Caption.String)
+	mintURL := util.StringToPointerIfNotEmpty(scheduled.UserMintUrl.String)
+
+	postID, dbMentions, err := insertPost(ctx, q, scheduled.ActorID, tokenIDs, mentions, caption, mintURL)
+	if err != nil {
+		return err
+	}
+
+	err = q.SetScheduledPostPublished(ctx, db.SetScheduledPostPublishedParams{ID: scheduled.ID, PostID: postID})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return dispatchScheduledPostEvents(ctx, queries, scheduled, postID, dbMentions)
+}
+
+// dispatchScheduledPostEvents sends a published scheduled post's events and records that they were sent. If sending
+// fails, the error is returned so that the task is retried.
+func dispatchScheduledPostEvents(ctx context.Context, q *db.Queries, scheduled db.ScheduledPost, postID persist.DBID, mentions []db.Mention) error {
+	err := dispatchPostEvents(ctx, scheduled.ActorID, postID, mentions)
 	if err != nil {
 		return err
 	}
 
+	return q.SetScheduledPostEventsDispatched(ctx, scheduled.ID)
+}
+
+func validatePublishAt(publishAt time.Time) error {
+	now := time.Now()
+	if !publishAt.After(now) {
+		return validate.ErrInvalidInput{Parameters: []string{"publishAt"}, Reasons: []string{"must be in the future"}}
+	}
+	if publishAt.After(now.Add(maxScheduledPostDelay)) {
+		return validate.ErrInvalidInput{Parameters: []string{"publishAt"}, Reasons: []string{"must be within 30 days"}}
+	}
 	return nil
 }
 
+// scheduledPostMentions checks that a scheduled post's mentions are valid and returns them to be stored with the
+// post. The mentions are only added once the post is published.
+func scheduledPostMentions(ctx context.Context, q *db.Queries, mentions []*model.MentionInput) (pgtype.JSONB, error) {
+	if _, err := mentionInputsToMentions(ctx, mentions, q); err != nil {
+		return pgtype.JSONB{}, err
+	}
+
+	if mentions == nil {
+		mentions = []*model.MentionInput{}
+	}
+
+	b, err := json.Marshal(mentions)
+	if err != nil {
+		return pgtype.JSONB{}, err
+	}
+
+	return pgtype.JSONB{Bytes: b, Status: pgtype.Present}, nil
+}
+
 func (api FeedAPI) GetRawEventById(ctx context.Context, eventID persist.DBID) (*db.Event, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
@@ -625,6 +1278,12 @@ func (api FeedAPI) TrendingFeed(ctx context.Context, before *string, after *stri
 			return nil, PageInfo{}, err
 		}
 
+		// The cached feed is shared by every viewer, so mutes are applied after it's loaded
+		postTypes, postIDs, posts, err = api.withoutMutedPosts(ctx, postTypes, postIDs, posts)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+
 		// Create cursor from cached data
 		cursor := cursors.NewFeedPositionCursor()
 		cursor.CurrentPosition = 0
@@ -733,21 +1392,24 @@ func (api FeedAPI) ForYouFeed(ctx context.Context, before, after *string, first,
 		posts := make([]db.Post, len(interleaved))
 		postIDs := make([]persist.DBID, len(interleaved))
 		postTypes := make([]persist.FeedEntityType, len(interleaved))
-		positions := make(map[persist.DBID]int64, len(interleaved))
 
 		for i, e := range interleaved {
 			idx := len(interleaved) - i - 1
 			postIDs[idx] = e.Post.ID
 			posts[idx] = e.Post
 			postTypes[idx] = persist.FeedEntityType(e.FeedEntityScore.FeedEntityType)
-			positions[e.Post.ID] = int64(idx)
+		}
+
+		postTypes, postIDs, posts, err = api.withoutMutedPosts(ctx, postTypes, postIDs, posts)
+		if err != nil {
+			return nil, PageInfo{}, err
 		}
 
 		cursor := cursors.NewFeedPositionCursor()
 		cursor.CurrentPosition = 0
 		cursor.EntityTypes = postTypes
 		cursor.EntityIDs = postIDs
-		cursor.Positions = positions
+		cursor.Positions = sliceToMapIndex(postIDs)
 
 		paginator = api.paginatorFromResults(ctx, cursor, posts)
 	}
@@ -755,6 +1417,52 @@ func (api FeedAPI) ForYouFeed(ctx context.Context, before, after *string, first,
 	return paginator.paginate(before, after, first, last)
 }
 
+// withoutMutedPosts removes posts the viewer has muted from a ranked feed, along with reposts and quote posts of
+// them. posts may be nil if the feed's posts haven't been loaded yet.
+func (api FeedAPI) withoutMutedPosts(ctx context.Context, postTypes []persist.FeedEntityType, postIDs []persist.DBID, posts []db.Post) ([]persist.FeedEntityType, []persist.DBID, []db.Post, error) {
+	viewerID, _ := getAuthenticatedUserID(ctx)
+	if viewerID == "" || len(postIDs) == 0 {
+		return postTypes, postIDs, posts, nil
+	}
+
+	muted, err := api.queries.GetMutedFeedEntityIDs(ctx, db.GetMutedFeedEntityIDsParams{
+		EntityIds: util.MapWithoutError(postIDs, func(id persist.DBID) string { return id.String() }),
+		UserID:    viewerID,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(muted) == 0 {
+		return postTypes, postIDs, posts, nil
+	}
+
+	isMuted := make(map[persist.DBID]bool, len(muted))
+	for _, id := range muted {
+		isMuted[id] = true
+	}
+
+	keptTypes := make([]persist.FeedEntityType, 0, len(postIDs))
+	keptIDs := make([]persist.DBID, 0, len(postIDs))
+	var keptPosts []db.Post
+	if posts != nil {
+		keptPosts = make([]db.Post, 0, len(posts))
+	}
+
+	for i, id := range postIDs {
+		if isMuted[id] {
+			continue
+		}
+		keptTypes = append(keptTypes, postTypes[i])
+		keptIDs = append(keptIDs, id)
+		if posts != nil {
+			keptPosts = append(keptPosts, posts[i])
+		}
+	}
+
+	return keptTypes, keptIDs, keptPosts, nil
+}
+
 func (api FeedAPI) TrendingUsers(ctx context.Context, report model.Window) ([]db.User, error) {
 	ttl := time.Hour
 
@@ -796,6 +1504,8 @@ func feedCursor(i any) (time.Time, persist.DBID, error) {
 		return row.EventTime, row.ID, nil
 	case db.Post:
 		return row.CreatedAt, row.ID, nil
+	case db.Repost:
+		return row.CreatedAt, row.ID, nil
 	}
 	return time.Time{}, "", fmt.Errorf("node is not a feed entity: %T", i)
 }
@@ -820,10 +1530,13 @@ func loadFeedEntities(ctx context.Context, d *dataloader.Loaders, typs []persist
 	idToPosition := make(map[persist.DBID]int, len(ids))
 	eventsFetch := make([]persist.DBID, 0, len(ids))
 	postsFetch := make([]persist.DBID, 0, len(ids))
+	repostsFetch := make([]persist.DBID, 0, len(ids))
 	eventsDone := make(chan bool)
 	postsDone := make(chan bool)
+	repostsDone := make(chan bool)
 	eventsErr := make(chan int)
 	postsErr := make(chan int)
+	repostsErr := make(chan int)
 
 	for i := 0; i < len(typs); i++ {
 		id := ids[i]
@@ -833,6 +1546,8 @@ func loadFeedEntities(ctx context.Context, d *dataloader.Loaders, typs []persist
 			eventsFetch = append(eventsFetch, id)
 		case persist.PostTypeTag:
 			postsFetch = append(postsFetch, id)
+		case persist.RepostTypeTag, persist.QuotePostTypeTag:
+			repostsFetch = append(repostsFetch, id)
 		default:
 			logger.For(ctx).Warnf("unknown feed entity type %d", typs[i])
 		}
@@ -868,15 +1583,34 @@ func loadFeedEntities(ctx context.Context, d *dataloader.Loaders, typs []persist
 		close(postsErr)
 	}()
 
+	go func() {
+		batchResults, batchErrs := d.GetRepostByIdBatch.LoadAll(repostsFetch)
+		for i := 0; i < len(batchResults); i++ {
+			pos := idToPosition[repostsFetch[i]]
+			err := batchErrs[i]
+			entities[pos] = batchResults[i]
+			if err != nil && !util.ErrorIs[persist.ErrRepostNotFoundByID](err) {
+				logger.For(ctx).Errorf("failed to fetch repost %s: %s", repostsFetch[i], err)
+				repostsErr <- pos
+			}
+		}
+		close(repostsDone)
+		close(repostsErr)
+	}()
+
 	for pos := range eventsErr {
 		errored = append(errored, pos)
 	}
 	for pos := range postsErr {
 		errored = append(errored, pos)
 	}
+	for pos := range repostsErr {
+		errored = append(errored, pos)
+	}
 
 	<-eventsDone
 	<-postsDone
+	<-repostsDone
 
 	// Sort in descending order
 	sort.Slice(errored, func(i, j int) bool { return errored[i] > errored[j] })
@@ -1071,3 +1805,73 @@ func (f feedCache) Load(ctx context.Context) ([]persist.FeedEntityType, []persis
 	err = cur.Unpack(string(b))
 	return cur.EntityTypes, cur.EntityIDs, err
 }
+
+func dispatchPostMentionEvents(ctx context.Context, actorID, postID persist.DBID, mentions []db.Mention) error {
+	for _, mention := range mentions {
+		switch {
+		case mention.UserID != "":
+			err := event.Dispatch(ctx, db.Event{
+				ActorID:        persist.DBIDToNullStr(actorID),
+				ResourceTypeID: persist.ResourceTypeUser,
+				SubjectID:      mention.UserID,
+				PostID:         postID,
+				UserID:         mention.UserID,
+				Action:         persist.ActionMentionUser,
+				MentionID:      mention.ID,
+			})
+			if err != nil {
+				return err
+			}
+		case mention.CommunityID != "":
+			err := event.Dispatch(ctx, db.Event{
+				ActorID:        persist.DBIDToNullStr(actorID),
+				ResourceTypeID: persist.ResourceTypeCommunity,
+				SubjectID:      mention.CommunityID,
+				PostID:         postID,
+				CommunityID:    mention.CommunityID,
+				Action:         persist.ActionMentionCommunity,
+				MentionID:      mention.ID,
+			})
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid mention type: %+v", mention)
+		}
+	}
+	return nil
+}
+
+// sameMentions reports whether two sets of mentions refer to the same users and communities at the same positions.
+func sameMentions(a, b []db.Mention) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	type key struct {
+		id            persist.DBID
+		start, length sql.NullInt32
+	}
+
+	counts := make(map[key]int, len(a))
+	for _, m := range a {
+		counts[key{mentionedID(m), m.Start, m.Length}]++
+	}
+	for _, m := range b {
+		k := key{mentionedID(m), m.Start, m.Length}
+		if counts[k] == 0 {
+			return false
+		}
+		counts[k]--
+	}
+
+	return true
+}
+
+// mentionedID returns the ID of the user or community that a mention refers to
+func mentionedID(mention db.Mention) persist.DBID {
+	if mention.UserID != "" {
+		return mention.UserID
+	}
+	return mention.CommunityID
+}
@@ -98,6 +98,12 @@ func (api GalleryAPI) UpdateGallery(ctx context.Context, update model.UpdateGall
 
 	q := api.queries.WithTx(tx)
 
+	// Save any changes made since the last version (e.g. by updating a single collection) before applying the update
+	err = snapshotGallery(ctx, q, update.GalleryID, false, "")
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
 	// then delete collections
 	if len(update.DeletedCollections) > 0 {
 		err = q.DeleteCollections(ctx, util.StringersToStrings(update.DeletedCollections))
@@ -211,6 +217,11 @@ func (api GalleryAPI) UpdateGallery(ctx context.Context, update model.UpdateGall
 		}
 	}
 
+	err = snapshotGallery(ctx, q, update.GalleryID, false, "")
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return db.Gallery{}, err
@@ -241,7 +252,26 @@ func (api GalleryAPI) PublishGallery(ctx context.Context, update model.PublishGa
 		return err
 	}
 
-	_, err := publishEventGroup(ctx, update.EditID, persist.ActionGalleryUpdated, update.Caption)
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	gallery, err := api.loaders.GetGalleryByIdBatch.Load(update.GalleryID)
+	if err != nil {
+		return err
+	}
+
+	if gallery.OwnerUserID != userID {
+		return fmt.Errorf("user %s is not the owner of gallery %s", userID, update.GalleryID)
+	}
+
+	err = snapshotGallery(ctx, api.queries, update.GalleryID, true, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = publishEventGroup(ctx, update.EditID, persist.ActionGalleryUpdated, update.Caption)
 	if err != nil {
 		return err
 	}
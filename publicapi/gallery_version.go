@@ -0,0 +1,442 @@
+package publicapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+const (
+	defaultGalleryVersionsLimit = 20
+	maxGalleryVersionsLimit     = 100
+)
+
+var ErrGalleryVersionNotFound = errors.New("gallery version not found")
+
+// GalleryVersion is a saved state of a gallery along with what changed since the version before it
+type GalleryVersion struct {
+	db.GalleryVersion
+	// Changes is nil for the earliest version
+	Changes *persist.GalleryVersionDiff
+}
+
+// GetGalleryVersions returns the saved versions of one of the viewer's galleries, most recent first.
+func (api GalleryAPI) GetGalleryVersions(ctx context.Context, galleryID persist.DBID, limit *int) ([]GalleryVersion, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"galleryID": validate.WithTag(galleryID, "required"),
+		"limit":     validate.WithTag(limit, fmt.Sprintf("omitempty,gte=1,lte=%d", maxGalleryVersionsLimit)),
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gallery, err := api.loaders.GetGalleryByIdBatch.Load(galleryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if gallery.OwnerUserID != userID {
+		return nil, persist.ErrGalleryNotFound{ID: galleryID}
+	}
+
+	n := util.GetOptionalValue(limit, defaultGalleryVersionsLimit)
+
+	// Fetch one extra version so the oldest version returned can be diffed against the one before it
+	versions, err := api.queries.GetGalleryVersionsByGalleryID(ctx, db.GetGalleryVersionsByGalleryIDParams{
+		GalleryID: galleryID,
+		Limit:     int32(n + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]GalleryVersion, 0, n)
+	for i := 0; i < len(versions) && i < n; i++ {
+		result := GalleryVersion{GalleryVersion: versions[i]}
+		if i+1 < len(versions) {
+			result.Changes = util.ToPointer(diffGalleryVersions(versions[i+1], versions[i]))
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// RestoreGalleryVersion returns one of the viewer's galleries to a saved version. The gallery's current state is
+// saved first, so the restore can itself be undone.
+func (api GalleryAPI) RestoreGalleryVersion(ctx context.Context, versionID persist.DBID) (db.Gallery, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"versionID": validate.WithTag(versionID, "required"),
+	}); err != nil {
+		return db.Gallery{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	version, err := api.queries.GetGalleryVersionByID(ctx, versionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Gallery{}, ErrGalleryVersionNotFound
+	}
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	gallery, err := api.queries.GetGalleryById(ctx, version.GalleryID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && gallery.OwnerUserID != userID) {
+		return db.Gallery{}, ErrGalleryVersionNotFound
+	}
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	// Tokens the user has sold or transferred since the version was saved are left out of the restored collections
+	collections, err := api.withOwnedTokens(ctx, userID, version.Collections)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := api.queries.WithTx(tx)
+
+	err = snapshotGallery(ctx, q, gallery.ID, false, "")
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	current, err := q.GetCollectionsByGalleryId(ctx, gallery.ID)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	restored := make(map[persist.DBID]bool, len(collections))
+	for _, c := range collections {
+		restored[c.ID] = true
+	}
+
+	removed := make([]string, 0)
+	for _, c := range current {
+		if !restored[c.ID] {
+			removed = append(removed, c.ID.String())
+		}
+	}
+
+	if len(removed) > 0 {
+		err = q.DeleteCollections(ctx, removed)
+		if err != nil {
+			return db.Gallery{}, err
+		}
+	}
+
+	order := make(persist.DBIDList, len(collections))
+	for i, c := range collections {
+		order[i], err = restoreCollection(ctx, q, gallery, c)
+		if err != nil {
+			return db.Gallery{}, err
+		}
+	}
+
+	err = q.UpdateGalleryInfo(ctx, db.UpdateGalleryInfoParams{
+		ID:             gallery.ID,
+		Name:           version.Name,
+		NameSet:        true,
+		Description:    version.Description,
+		DescriptionSet: true,
+	})
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	err = q.UpdateGalleryCollections(ctx, db.UpdateGalleryCollectionsParams{
+		GalleryID:   gallery.ID,
+		Collections: order,
+	})
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	err = snapshotGallery(ctx, q, gallery.ID, false, versionID)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return db.Gallery{}, err
+	}
+
+	// Read the gallery directly, since the loader may have cached it from before it was restored
+	return api.queries.GetGalleryById(ctx, gallery.ID)
+}
+
+// withOwnedTokens drops the tokens that userID no longer owns from a version's collections
+func (api GalleryAPI) withOwnedTokens(ctx context.Context, userID persist.DBID, collections persist.GalleryVersionCollections) (persist.GalleryVersionCollections, error) {
+	tokenIDs := make([]persist.DBID, 0)
+	for _, c := range collections {
+		tokenIDs = append(tokenIDs, c.Tokens...)
+	}
+	tokenIDs = util.Dedupe(tokenIDs, false)
+
+	ownsAll, err := api.queries.CheckUserOwnsAllTokenDbids(ctx, db.CheckUserOwnsAllTokenDbidsParams{
+		OwnerUserID: userID,
+		TokenIds:    tokenIDs,
+	})
+	if err != nil || ownsAll {
+		return collections, err
+	}
+
+	ownedIDs, err := api.queries.GetOwnedTokenIDs(ctx, db.GetOwnedTokenIDsParams{
+		TokenIds:    tokenIDs,
+		OwnerUserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make(map[persist.DBID]bool, len(ownedIDs))
+	for _, id := range ownedIDs {
+		owned[id] = true
+	}
+
+	return util.MapWithoutError(collections, func(c persist.GalleryVersionCollection) persist.GalleryVersionCollection {
+		return keepTokens(c, owned)
+	}), nil
+}
+
+// keepTokens removes the tokens that aren't in keep from a collection, shifting its sections and whitespace to
+// match. Sections that are left without any tokens are removed.
+func keepTokens(c persist.GalleryVersionCollection, keep map[persist.DBID]bool) persist.GalleryVersionCollection {
+	// kept[i] is the number of tokens kept before position i
+	kept := make([]int, len(c.Tokens)+1)
+	tokens := make(persist.DBIDList, 0, len(c.Tokens))
+	for i, id := range c.Tokens {
+		kept[i+1] = kept[i]
+		if keep[id] {
+			kept[i+1]++
+			tokens = append(tokens, id)
+		}
+	}
+
+	shift := func(pos int) int {
+		if pos < 0 {
+			return 0
+		}
+		if pos > len(c.Tokens) {
+			return len(tokens)
+		}
+		return kept[pos]
+	}
+
+	layout := persist.TokenLayout{
+		Columns:    c.Layout.Columns,
+		Whitespace: shiftWhitespace(c.Layout.Whitespace, 0, shift),
+	}
+
+	if c.Layout.Sections != nil {
+		layout.Sections = make([]int, 0, len(c.Layout.Sections))
+	}
+	if c.Layout.SectionLayout != nil {
+		layout.SectionLayout = make([]persist.CollectionSectionLayout, 0, len(c.Layout.SectionLayout))
+	}
+
+	for i, start := range c.Layout.Sections {
+		end := len(c.Tokens)
+		if i+1 < len(c.Layout.Sections) {
+			end = c.Layout.Sections[i+1]
+		}
+
+		if start < end && shift(start) == shift(end) {
+			continue
+		}
+
+		layout.Sections = append(layout.Sections, shift(start))
+		if i < len(c.Layout.SectionLayout) {
+			section := c.Layout.SectionLayout[i]
+			section.Whitespace = shiftWhitespace(section.Whitespace, start, shift)
+			layout.SectionLayout = append(layout.SectionLayout, section)
+		}
+	}
+
+	// Every layout starts with a section, so the first one is kept empty if none of its tokens are left
+	if len(c.Layout.Sections) > 0 && len(layout.Sections) == 0 {
+		layout.Sections = append(layout.Sections, 0)
+		if len(c.Layout.SectionLayout) > 0 {
+			section := c.Layout.SectionLayout[0]
+			section.Whitespace = []int{}
+			layout.SectionLayout = append(layout.SectionLayout, section)
+		}
+	}
+
+	var settings map[persist.DBID]persist.CollectionTokenSettings
+	if c.TokenSettings != nil {
+		settings = make(map[persist.DBID]persist.CollectionTokenSettings, len(c.TokenSettings))
+		for id, s := range c.TokenSettings {
+			if keep[id] {
+				settings[id] = s
+			}
+		}
+	}
+
+	c.Tokens = tokens
+	c.Layout = layout
+	c.TokenSettings = settings
+	return c
+}
+
+// shiftWhitespace moves whitespace positions, which are relative to the token at offset, to where they fall once
+// tokens have been removed
+func shiftWhitespace(whitespace []int, offset int, shift func(int) int) []int {
+	if whitespace == nil {
+		return nil
+	}
+
+	shifted := make([]int, 0, len(whitespace))
+	for _, pos := range whitespace {
+		shifted = append(shifted, shift(offset+pos)-shift(offset))
+	}
+	return shifted
+}
+
+// restoreCollection puts a collection back the way it was in a gallery version, undeleting it if it has since been
+// deleted and recreating it if it no longer exists. If the collection has since been moved to another gallery, a copy
+// is created instead so that the other gallery keeps it. The ID of the restored collection is returned.
+func restoreCollection(ctx context.Context, q *db.Queries, gallery db.Gallery, c persist.GalleryVersionCollection) (persist.DBID, error) {
+	rows, err := q.RestoreCollection(ctx, db.RestoreCollectionParams{
+		ID:             c.ID,
+		GalleryID:      gallery.ID,
+		OwnerUserID:    gallery.OwnerUserID,
+		Name:           util.ToNullStringEmptyNull(c.Name),
+		CollectorsNote: util.ToNullStringEmptyNull(c.CollectorsNote),
+		Nfts:           c.Tokens,
+		Layout:         c.Layout,
+		TokenSettings:  c.TokenSettings,
+		Hidden:         c.Hidden,
+	})
+	if err != nil {
+		return "", err
+	}
+	if rows > 0 {
+		return c.ID, nil
+	}
+
+	// The collection either no longer exists or belongs to another gallery now, so it's given a new ID rather
+	// than conflicting with the existing row
+	return q.CreateCollection(ctx, db.CreateCollectionParams{
+		ID:             persist.GenerateID(),
+		Name:           util.ToNullStringEmptyNull(c.Name),
+		CollectorsNote: util.ToNullStringEmptyNull(c.CollectorsNote),
+		OwnerUserID:    gallery.OwnerUserID,
+		GalleryID:      gallery.ID,
+		Layout:         c.Layout,
+		Nfts:           c.Tokens,
+		Hidden:         c.Hidden,
+		TokenSettings:  c.TokenSettings,
+	})
+}
+
+// snapshotGallery saves the gallery's current name, description and collections as a new version using q. Nothing is
+// saved if the gallery hasn't changed since its latest version, unless the snapshot is the result of a restore.
+func snapshotGallery(ctx context.Context, q *db.Queries, galleryID persist.DBID, published bool, restoredFrom persist.DBID) error {
+	gallery, err := q.GetGalleryById(ctx, galleryID)
+	if err != nil {
+		return err
+	}
+
+	collections, err := q.GetCollectionsByGalleryId(ctx, galleryID)
+	if err != nil {
+		return err
+	}
+
+	snapshot := persist.GalleryVersionCollections(util.MapWithoutError(collections, func(c db.Collection) persist.GalleryVersionCollection {
+		return persist.GalleryVersionCollection{
+			ID:             c.ID,
+			Name:           c.Name.String,
+			CollectorsNote: c.CollectorsNote.String,
+			Hidden:         c.Hidden,
+			Tokens:         c.Nfts,
+			Layout:         c.Layout,
+			TokenSettings:  c.TokenSettings,
+		}
+	}))
+
+	if restoredFrom == "" {
+		latest, err := q.GetLatestGalleryVersion(ctx, galleryID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		if err == nil {
+			unchanged, err := sameGalleryVersion(latest, gallery, snapshot)
+			if err != nil {
+				return err
+			}
+
+			if unchanged {
+				if published && !latest.Published {
+					return q.SetGalleryVersionPublished(ctx, latest.ID)
+				}
+				return nil
+			}
+		}
+	}
+
+	_, err = q.InsertGalleryVersion(ctx, db.InsertGalleryVersionParams{
+		ID:                    persist.GenerateID(),
+		GalleryID:             galleryID,
+		OwnerUserID:           gallery.OwnerUserID,
+		Name:                  gallery.Name,
+		Description:           gallery.Description,
+		Collections:           snapshot,
+		Published:             published,
+		RestoredFromVersionID: util.ToNullStringEmptyNull(restoredFrom.String()),
+	})
+
+	return err
+}
+
+func sameGalleryVersion(version db.GalleryVersion, gallery db.Gallery, collections persist.GalleryVersionCollections) (bool, error) {
+	if version.Name != gallery.Name || version.Description != gallery.Description {
+		return false, nil
+	}
+
+	before, err := json.Marshal(version.Collections)
+	if err != nil {
+		return false, err
+	}
+
+	after, err := json.Marshal(collections)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(before, after), nil
+}
+
+func diffGalleryVersions(prev, cur db.GalleryVersion) persist.GalleryVersionDiff {
+	diff := cur.Collections.Diff(prev.Collections)
+	diff.NameChanged = cur.Name != prev.Name
+	diff.DescriptionChanged = cur.Description != prev.Description
+	return diff
+}
@@ -0,0 +1,203 @@
+package publicapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+func TestGalleryVersionDiff(t *testing.T) {
+	layout := persist.TokenLayout{Sections: []int{0}, SectionLayout: []persist.CollectionSectionLayout{{Columns: persist.NullInt32(3), Whitespace: []int{}}}}
+	collection := func(id persist.DBID, tokens ...persist.DBID) persist.GalleryVersionCollection {
+		return persist.GalleryVersionCollection{ID: id, Name: "name", Tokens: tokens, Layout: layout}
+	}
+
+	tests := []struct {
+		name     string
+		prev     db.GalleryVersion
+		cur      db.GalleryVersion
+		expected persist.GalleryVersionDiff
+	}{
+		{
+			name:     "no changes",
+			prev:     db.GalleryVersion{Name: "g", Collections: persist.GalleryVersionCollections{collection("a", "t1")}},
+			cur:      db.GalleryVersion{Name: "g", Collections: persist.GalleryVersionCollections{collection("a", "t1")}},
+			expected: emptyDiff(),
+		},
+		{
+			name: "name and description changed",
+			prev: db.GalleryVersion{Name: "g", Description: "d"},
+			cur:  db.GalleryVersion{Name: "h", Description: "e"},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.NameChanged = true
+				d.DescriptionChanged = true
+				return d
+			}(),
+		},
+		{
+			name: "collections added and removed",
+			prev: db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a"), collection("b")}},
+			cur:  db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("b"), collection("c")}},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.AddedCollections = []persist.DBID{"c"}
+				d.RemovedCollections = []persist.DBID{"a"}
+				return d
+			}(),
+		},
+		{
+			name: "collections reordered",
+			prev: db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a"), collection("b"), collection("c")}},
+			cur:  db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("c"), collection("a"), collection("b")}},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.CollectionsReordered = true
+				return d
+			}(),
+		},
+		{
+			name: "adding a collection isn't a reorder",
+			prev: db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a"), collection("b")}},
+			cur:  db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a"), collection("c"), collection("b")}},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.AddedCollections = []persist.DBID{"c"}
+				return d
+			}(),
+		},
+		{
+			name: "tokens added, removed and reordered",
+			prev: db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a", "t1", "t2", "t3")}},
+			cur:  db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a", "t3", "t1", "t4")}},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.ChangedCollections = []persist.GalleryVersionCollectionDiff{{
+					CollectionID:    "a",
+					TokensReordered: true,
+					AddedTokens:     []persist.DBID{"t4"},
+					RemovedTokens:   []persist.DBID{"t2"},
+				}}
+				return d
+			}(),
+		},
+		{
+			name: "collection settings changed",
+			prev: db.GalleryVersion{Collections: persist.GalleryVersionCollections{collection("a", "t1")}},
+			cur: db.GalleryVersion{Collections: persist.GalleryVersionCollections{{
+				ID:             "a",
+				Name:           "renamed",
+				CollectorsNote: "note",
+				Hidden:         true,
+				Tokens:         persist.DBIDList{"t1"},
+				Layout:         persist.TokenLayout{Sections: []int{0}, SectionLayout: []persist.CollectionSectionLayout{{Columns: persist.NullInt32(4), Whitespace: []int{}}}},
+				TokenSettings:  map[persist.DBID]persist.CollectionTokenSettings{"t1": {RenderLive: true}},
+			}}},
+			expected: func() persist.GalleryVersionDiff {
+				d := emptyDiff()
+				d.ChangedCollections = []persist.GalleryVersionCollectionDiff{{
+					CollectionID:          "a",
+					NameChanged:           true,
+					CollectorsNoteChanged: true,
+					HiddenChanged:         true,
+					LayoutChanged:         true,
+					TokenSettingsChanged:  true,
+					AddedTokens:           []persist.DBID{},
+					RemovedTokens:         []persist.DBID{},
+				}}
+				return d
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, diffGalleryVersions(tt.prev, tt.cur))
+		})
+	}
+}
+
+func TestKeepTokens(t *testing.T) {
+	sectionLayout := func(whitespace ...int) persist.CollectionSectionLayout {
+		return persist.CollectionSectionLayout{Columns: persist.NullInt32(3), Whitespace: append([]int{}, whitespace...)}
+	}
+
+	tests := []struct {
+		name     string
+		in       persist.GalleryVersionCollection
+		keep     []persist.DBID
+		expected persist.GalleryVersionCollection
+	}{
+		{
+			name: "all tokens kept",
+			in: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{"t1", "t2"},
+				Layout: persist.TokenLayout{Sections: []int{0, 1}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(1), sectionLayout()}},
+			},
+			keep: []persist.DBID{"t1", "t2"},
+			expected: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{"t1", "t2"},
+				Layout: persist.TokenLayout{Sections: []int{0, 1}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(1), sectionLayout()}},
+			},
+		},
+		{
+			name: "sections and whitespace are shifted",
+			in: persist.GalleryVersionCollection{
+				Tokens:        persist.DBIDList{"t1", "t2", "t3", "t4", "t5"},
+				Layout:        persist.TokenLayout{Sections: []int{0, 3}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(2, 3), sectionLayout(1)}},
+				TokenSettings: map[persist.DBID]persist.CollectionTokenSettings{"t1": {RenderLive: true}, "t2": {HighDefinition: true}},
+			},
+			keep: []persist.DBID{"t2", "t3", "t5"},
+			expected: persist.GalleryVersionCollection{
+				Tokens:        persist.DBIDList{"t2", "t3", "t5"},
+				Layout:        persist.TokenLayout{Sections: []int{0, 2}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(1, 2), sectionLayout(0)}},
+				TokenSettings: map[persist.DBID]persist.CollectionTokenSettings{"t2": {HighDefinition: true}},
+			},
+		},
+		{
+			name: "emptied sections are removed",
+			in: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{"t1", "t2", "t3"},
+				Layout: persist.TokenLayout{Sections: []int{0, 1, 2}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(), sectionLayout(1), sectionLayout()}},
+			},
+			keep: []persist.DBID{"t3"},
+			expected: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{"t3"},
+				Layout: persist.TokenLayout{Sections: []int{0}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout()}},
+			},
+		},
+		{
+			name: "first section is kept when no tokens are left",
+			in: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{"t1", "t2"},
+				Layout: persist.TokenLayout{Sections: []int{0, 1}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout(1), sectionLayout()}},
+			},
+			keep: []persist.DBID{},
+			expected: persist.GalleryVersionCollection{
+				Tokens: persist.DBIDList{},
+				Layout: persist.TokenLayout{Sections: []int{0}, SectionLayout: []persist.CollectionSectionLayout{sectionLayout()}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keep := make(map[persist.DBID]bool, len(tt.keep))
+			for _, id := range tt.keep {
+				keep[id] = true
+			}
+			assert.Equal(t, tt.expected, keepTokens(tt.in, keep))
+		})
+	}
+}
+
+func emptyDiff() persist.GalleryVersionDiff {
+	return persist.GalleryVersionDiff{
+		AddedCollections:   []persist.DBID{},
+		RemovedCollections: []persist.DBID{},
+		ChangedCollections: []persist.GalleryVersionCollectionDiff{},
+	}
+}
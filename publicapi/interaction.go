@@ -11,12 +11,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
-	"github.com/jackc/pgx/v4"
 
+	admin "github.com/mikeydub/go-gallery/adminapi"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/event"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 	"github.com/mikeydub/go-gallery/util"
@@ -25,6 +26,9 @@ import (
 
 var ErrOnlyRemoveOwnAdmire = errors.New("only the actor who created the admire can remove it")
 var ErrOnlyRemoveOwnComment = errors.New("only the actor who created the comment can remove it")
+var ErrOnlyEditOwnPost = errors.New("only the actor who created the post can edit it")
+var ErrOnlyHideCommentsOnOwnPost = errors.New("only the actor who created the post can hide its comments")
+var ErrCommentsRestricted = errors.New("the post's author has restricted who can comment on it")
 
 type interactionType int
 
@@ -201,6 +205,8 @@ func (api InteractionAPI) PaginateInteractionsByPostID(ctx context.Context, post
 		return nil, PageInfo{}, err
 	}
 
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
 	tags := map[interactionType]int32{
 		interactionTypeComment: 1,
 		interactionTypeAdmire:  2,
@@ -219,6 +225,7 @@ func (api InteractionAPI) PaginateInteractionsByPostID(ctx context.Context, post
 			PagingForward: params.PagingForward,
 			AdmireTag:     tags[interactionTypeAdmire],
 			CommentTag:    tags[interactionTypeComment],
+			ViewerID:      viewerID,
 		})
 	}
 
@@ -227,6 +234,7 @@ func (api InteractionAPI) PaginateInteractionsByPostID(ctx context.Context, post
 			PostID:     postID,
 			AdmireTag:  tags[interactionTypeAdmire],
 			CommentTag: tags[interactionTypeComment],
+			ViewerID:   viewerID,
 		})
 
 		total := 0
@@ -412,9 +420,12 @@ func (api InteractionAPI) PaginateRepliesByCommentID(ctx context.Context, commen
 		return nil, PageInfo{}, err
 	}
 
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
 	queryFunc := func(params TimeIDPagingParams) ([]db.Comment, error) {
 		return api.loaders.PaginateRepliesByCommentIDBatch.Load(db.PaginateRepliesByCommentIDBatchParams{
 			CommentID:     commentID,
+			ViewerID:      viewerID,
 			Limit:         params.Limit,
 			CurBeforeTime: params.CursorBeforeTime,
 			CurBeforeID:   params.CursorBeforeID,
@@ -425,7 +436,10 @@ func (api InteractionAPI) PaginateRepliesByCommentID(ctx context.Context, commen
 	}
 
 	countFunc := func() (int, error) {
-		total, err := api.loaders.CountRepliesByCommentIDBatch.Load(commentID)
+		total, err := api.loaders.CountRepliesByCommentIDBatch.Load(db.CountRepliesByCommentIDBatchParams{
+			CommentID: commentID,
+			ViewerID:  viewerID,
+		})
 		return int(total), err
 	}
 
@@ -450,7 +464,13 @@ func (api InteractionAPI) GetTotalCommentsByPostID(ctx context.Context, postID p
 		return nil, err
 	}
 
-	count, err := api.queries.CountCommentsAndRepliesByPostID(ctx, postID)
+	// Hidden comments are only counted for the users who wrote them
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
+	count, err := api.queries.CountCommentsAndRepliesByPostID(ctx, db.CountCommentsAndRepliesByPostIDParams{
+		PostID:   postID,
+		ViewerID: viewerID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -582,9 +602,14 @@ func (api InteractionAPI) PaginateCommentsByPostID(ctx context.Context, postID p
 		return nil, PageInfo{}, err
 	}
 
+	// Comments from muted users, or containing muted keywords, are filtered out for the viewer, as are
+	// comments the post's author has hidden unless the viewer wrote them
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
 	queryFunc := func(params TimeIDPagingParams) ([]db.Comment, error) {
 		return api.loaders.PaginateCommentsByPostIDBatch.Load(db.PaginateCommentsByPostIDBatchParams{
 			PostID:        postID,
+			ViewerID:      viewerID,
 			Limit:         params.Limit,
 			CurBeforeTime: params.CursorBeforeTime,
 			CurBeforeID:   params.CursorBeforeID,
@@ -595,7 +620,10 @@ func (api InteractionAPI) PaginateCommentsByPostID(ctx context.Context, postID p
 	}
 
 	countFunc := func() (int, error) {
-		total, err := api.loaders.CountCommentsByPostIDBatch.Load(postID)
+		total, err := api.loaders.CountCommentsByPostIDBatch.Load(db.CountCommentsByPostIDBatchParams{
+			PostID:   postID,
+			ViewerID: viewerID,
+		})
 		return int(total), err
 	}
 
@@ -922,9 +950,57 @@ func (api InteractionAPI) CommentOnPost(ctx context.Context, postID persist.DBID
 		return "", err
 	}
 
+	err := api.checkCommentRestriction(ctx, postID)
+	if err != nil {
+		return "", err
+	}
+
 	return api.comment(ctx, comment, "", postID, replyToID, mentions)
 }
 
+// checkCommentRestriction returns ErrCommentsRestricted if the post's author doesn't allow the viewer to comment on it.
+// Authors can always comment on their own posts.
+func (api InteractionAPI) checkCommentRestriction(ctx context.Context, postID persist.DBID) error {
+	actor, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	post, err := api.loaders.GetPostByIdBatch.Load(postID)
+	if err != nil {
+		return err
+	}
+
+	if post.ActorID == actor || post.CommentRestriction == persist.CommentRestrictionEveryone {
+		return nil
+	}
+
+	if post.CommentRestriction == persist.CommentRestrictionNobody {
+		return ErrCommentsRestricted
+	}
+
+	status, err := api.queries.GetFollowStatus(ctx, db.GetFollowStatusParams{
+		UserID:      actor,
+		OtherUserID: post.ActorID,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch post.CommentRestriction {
+	case persist.CommentRestrictionFollowers:
+		if status.Following {
+			return nil
+		}
+	case persist.CommentRestrictionMutuals:
+		if status.Following && status.FollowedBy {
+			return nil
+		}
+	}
+
+	return ErrCommentsRestricted
+}
+
 func (api InteractionAPI) comment(ctx context.Context, comment string, feedEventID, postID persist.DBID, replyToID *persist.DBID, mentions []*model.MentionInput) (persist.DBID, error) {
 	actor, err := getAuthenticatedUserID(ctx)
 	if err != nil {
@@ -1065,6 +1141,59 @@ func (api InteractionAPI) RemoveComment(ctx context.Context, commentID persist.D
 	return comment.FeedEventID, comment.PostID, api.queries.RemoveComment(ctx, commentID)
 }
 
+// HideComment hides a comment on one of the viewer's posts from everyone except the commenter.
+func (api InteractionAPI) HideComment(ctx context.Context, commentID persist.DBID) (*db.Comment, error) {
+	return api.setCommentHidden(ctx, commentID, true)
+}
+
+// UnhideComment reverses HideComment.
+func (api InteractionAPI) UnhideComment(ctx context.Context, commentID persist.DBID) (*db.Comment, error) {
+	return api.setCommentHidden(ctx, commentID, false)
+}
+
+func (api InteractionAPI) setCommentHidden(ctx context.Context, commentID persist.DBID, hidden bool) (*db.Comment, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"commentID": validate.WithTag(commentID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	actor, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := api.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.PostID == "" {
+		return nil, ErrOnlyHideCommentsOnOwnPost
+	}
+
+	post, err := api.loaders.GetPostByIdBatch.Load(comment.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.ActorID != actor {
+		return nil, ErrOnlyHideCommentsOnOwnPost
+	}
+
+	err = api.queries.SetCommentHidden(ctx, db.SetCommentHiddenParams{
+		CommentID: commentID,
+		Hidden:    hidden,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Hidden = hidden
+	return comment, nil
+}
+
 func (api InteractionAPI) GetMentionsByCommentID(ctx context.Context, commentID persist.DBID) ([]db.Mention, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
@@ -1095,17 +1224,82 @@ func (api InteractionAPI) ReportPost(ctx context.Context, postID persist.DBID, r
 	}); err != nil {
 		return err
 	}
+
+	if _, err := api.loaders.GetPostByIdBatch.Load(postID); err != nil {
+		return err
+	}
+
+	return api.report(ctx, persist.ReportSubjectTypePost, postID, reason)
+}
+
+func (api InteractionAPI) ReportComment(ctx context.Context, commentID persist.DBID, reason persist.ReportReason) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"commentID": validate.WithTag(commentID, "required"),
+		"reason":    validate.WithTag(reason, "required"),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := api.loaders.GetCommentByCommentIDBatch.Load(commentID); err != nil {
+		return err
+	}
+
+	return api.report(ctx, persist.ReportSubjectTypeComment, commentID, reason)
+}
+
+func (api InteractionAPI) ReportUser(ctx context.Context, userID persist.DBID, reason persist.ReportReason) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID": validate.WithTag(userID, "required"),
+		"reason": validate.WithTag(reason, "required"),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := api.loaders.GetUserByIdBatch.Load(userID); err != nil {
+		return err
+	}
+
+	return api.report(ctx, persist.ReportSubjectTypeUser, userID, reason)
+}
+
+func (api InteractionAPI) ReportGallery(ctx context.Context, galleryID persist.DBID, reason persist.ReportReason) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"galleryID": validate.WithTag(galleryID, "required"),
+		"reason":    validate.WithTag(reason, "required"),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := api.loaders.GetGalleryByIdBatch.Load(galleryID); err != nil {
+		return err
+	}
+
+	return api.report(ctx, persist.ReportSubjectTypeGallery, galleryID, reason)
+}
+
+func (api InteractionAPI) report(ctx context.Context, subjectType persist.ReportSubjectType, subjectID persist.DBID, reason persist.ReportReason) error {
 	userID, _ := getAuthenticatedUserID(ctx)
-	_, err := api.queries.ReportPost(ctx, db.ReportPostParams{
-		ID:       persist.GenerateID(),
-		PostID:   postID,
-		Reporter: util.ToNullString(userID.String(), true),
-		Reason:   reason,
+	_, err := api.queries.InsertReport(ctx, db.InsertReportParams{
+		ID:          persist.GenerateID(),
+		Reporter:    util.ToNullString(userID.String(), true),
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		Reason:      reason,
 	})
-	if err != nil && errors.Is(err, pgx.ErrNoRows) {
-		return persist.ErrPostNotFoundByID{ID: postID}
+	if err != nil {
+		return err
 	}
-	return err
+
+	// Failing to auto-hide shouldn't fail the report, since a moderator will still see it in the queue
+	err = admin.AutoHideReportedContent(ctx, api.repos, api.queries, subjectType, subjectID)
+	if err != nil {
+		logger.For(ctx).Errorf("failed to auto-hide reported %s %s: %s", subjectType, subjectID, err)
+	}
+
+	return nil
 }
 
 func mentionInputsToMentions(ctx context.Context, ms []*model.MentionInput, queries *db.Queries) ([]db.Mention, error) {
@@ -7,22 +7,22 @@ import (
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 
-	"cloud.google.com/go/storage"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/store"
 )
 
 type MiscAPI struct {
-	repos         *postgres.Repositories
-	queries       *db.Queries
-	loaders       *dataloader.Loaders
-	validator     *validator.Validate
-	ethClient     *ethclient.Client
-	storageClient *storage.Client
+	repos       *postgres.Repositories
+	queries     *db.Queries
+	loaders     *dataloader.Loaders
+	validator   *validator.Validate
+	ethClient   *ethclient.Client
+	objectStore store.Client
 }
 
 func (api MiscAPI) GetGeneralAllowlist(ctx context.Context) ([]persist.EthereumAddress, error) {
@@ -31,9 +31,7 @@ func (api MiscAPI) GetGeneralAllowlist(ctx context.Context) ([]persist.EthereumA
 	bucket := env.GetString("SNAPSHOT_BUCKET")
 	logger.For(ctx).Infof("Proxying snapshot from bucket %s", bucket)
 
-	obj := api.storageClient.Bucket(env.GetString("SNAPSHOT_BUCKET")).Object("snapshot.json")
-
-	r, err := obj.NewReader(ctx)
+	r, err := api.objectStore.Bucket(bucket).NewReader(ctx, "snapshot.json")
 	if err != nil {
 		return nil, err
 	}
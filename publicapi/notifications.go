@@ -85,3 +85,43 @@ func (api NotificationsAPI) ClearUserNotifications(ctx context.Context) ([]db.No
 	}
 	return api.queries.ClearNotificationsForUser(ctx, userID)
 }
+
+// maxMissedNotifications is the most notifications that are replayed to a subscriber that reconnects
+const maxMissedNotifications = 50
+
+// GetViewerNotificationsCreatedAfter returns the viewer's notifications that were created after the given notification,
+// oldest first. It's used to catch up subscribers that were briefly disconnected.
+func (api NotificationsAPI) GetViewerNotificationsCreatedAfter(ctx context.Context, notificationID persist.DBID) ([]db.Notification, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID":         validate.WithTag(userID, "required"),
+		"notificationID": validate.WithTag(notificationID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	last, err := api.queries.GetNotificationByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't reveal anything about another user's notifications
+	if last.OwnerID != userID {
+		return []db.Notification{}, nil
+	}
+
+	return api.queries.GetUserNotifications(ctx, db.GetUserNotificationsParams{
+		OwnerID:       userID,
+		Limit:         maxMissedNotifications,
+		CurBeforeTime: defaultCursorBeforeTime,
+		CurBeforeID:   defaultCursorBeforeID,
+		CurAfterTime:  last.CreatedAt,
+		CurAfterID:    last.ID,
+		PagingForward: true,
+	})
+}
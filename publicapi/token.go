@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/event"
@@ -18,6 +19,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/spam"
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/service/tokenmanage"
 	"github.com/mikeydub/go-gallery/util"
@@ -272,7 +274,7 @@ func (api TokenAPI) GetTokensByWalletID(ctx context.Context, walletID persist.DB
 // GetTokensByUserID returns all tokens owned by a user. ownershipFilter is optional and may be nil or empty,
 // which will cause all tokens to be returned. If filter values are provided, only the tokens matching the
 // filter will be returned.
-func (api TokenAPI) GetTokensByUserID(ctx context.Context, userID persist.DBID, ownershipFilter []persist.TokenOwnershipType) ([]db.Token, error) {
+func (api TokenAPI) GetTokensByUserID(ctx context.Context, userID persist.DBID, ownershipFilter []persist.TokenOwnershipType, maxSpamScore *int) ([]db.Token, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"userID": validate.WithTag(userID, "required"),
@@ -281,7 +283,8 @@ func (api TokenAPI) GetTokensByUserID(ctx context.Context, userID persist.DBID,
 	}
 
 	params := db.GetTokensByUserIdBatchParams{
-		OwnerUserID: userID,
+		OwnerUserID:  userID,
+		MaxSpamScore: util.ToNullInt32(maxSpamScore),
 	}
 
 	if len(ownershipFilter) > 0 {
@@ -625,6 +628,47 @@ func (api TokenAPI) GetTokenDefinitionByID(ctx context.Context, id persist.DBID)
 	return api.loaders.GetTokenDefinitionByIdBatch.Load(id)
 }
 
+// GetSpamScoreByTokenDefinitionID returns the spam score of a token definition combined with the score of its contract,
+// or nil if neither has been scored yet
+func (api TokenAPI) GetSpamScoreByTokenDefinitionID(ctx context.Context, tokenDefinitionID persist.DBID) (*spam.Score, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"tokenDefinitionID": validate.WithTag(tokenDefinitionID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	td, err := api.loaders.GetTokenDefinitionByIdBatch.Load(tokenDefinitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]spam.Score, 0, 2)
+
+	contractScore, err := api.loaders.GetContractSpamScoreByContractIDBatch.Load(td.ContractID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if err == nil {
+		scores = append(scores, spam.Score{Score: int(contractScore.Score), Reasons: contractScore.Reasons})
+	}
+
+	tokenScore, err := api.loaders.GetTokenDefinitionSpamScoreByIDBatch.Load(tokenDefinitionID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if err == nil {
+		scores = append(scores, spam.Score{Score: int(tokenScore.Score), Reasons: tokenScore.Reasons})
+	}
+
+	if len(scores) == 0 {
+		return nil, nil
+	}
+
+	combined := spam.Combine(scores...)
+	return &combined, nil
+}
+
 func (api TokenAPI) GetCommunitiesByTokenDefinitionID(ctx context.Context, tokenDefinitionID persist.DBID) ([]db.Community, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
@@ -0,0 +1,227 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+const (
+	maxHashtagLength      = 100
+	maxHashtagsPerPost    = 30
+	defaultTrendingTopics = 20
+	maxTrendingTopics     = 100
+
+	hashtagCharacterClass  = `[\p{L}\p{N}_]`
+	hashtagPrecedingFilter = `[^\p{L}\p{N}_&#/]`
+)
+
+// hashtagPattern matches a # at the start of the caption or after a character that can't be part of a word,
+// a URL fragment or an HTML entity
+var hashtagPattern = regexp.MustCompile(fmt.Sprintf(`(?:^|%s)#(%s+)`, hashtagPrecedingFilter, hashtagCharacterClass))
+
+var topicTagPattern = regexp.MustCompile(fmt.Sprintf(`^%s{1,%d}$`, hashtagCharacterClass, maxHashtagLength))
+
+type TopicAPI struct {
+	queries   *db.Queries
+	loaders   *dataloader.Loaders
+	validator *validator.Validate
+}
+
+// GetTopic normalizes a tag so that "#Art" and "art" refer to the same topic.
+func (api TopicAPI) GetTopic(ctx context.Context, tag string) (string, error) {
+	return normalizeTopicTag(tag)
+}
+
+func (api TopicAPI) PaginatePostsByTopic(ctx context.Context, tag string, before, after *string, first, last *int) ([]db.Post, PageInfo, error) {
+	tag, err := normalizeTopicTag(tag)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	// Posts the viewer has muted are filtered out
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
+	queryFunc := func(params TimeIDPagingParams) ([]db.Post, error) {
+		return api.queries.PaginatePostsByHashtag(ctx, db.PaginatePostsByHashtagParams{
+			Tag:           tag,
+			ViewerID:      viewerID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountPostsByHashtag(ctx, tag)
+		return int(total), err
+	}
+
+	cursorFunc := func(p db.Post) (time.Time, persist.DBID, error) {
+		return p.CreatedAt, p.ID, nil
+	}
+
+	paginator := TimeIDPaginator[db.Post]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.Paginate(before, after, first, last)
+}
+
+func (api TopicAPI) CountPostsByTopic(ctx context.Context, tag string) (int, error) {
+	tag, err := normalizeTopicTag(tag)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := api.queries.CountPostsByHashtag(ctx, tag)
+	return int(total), err
+}
+
+// GetTrendingTopics returns the tags posted by the most users within the report window, most popular first.
+func (api TopicAPI) GetTrendingTopics(ctx context.Context, report model.Window, limit *int) ([]db.GetTrendingHashtagsRow, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"limit": validate.WithTag(limit, fmt.Sprintf("omitempty,gte=1,lte=%d", maxTrendingTopics)),
+	}); err != nil {
+		return nil, err
+	}
+
+	// Tags are counted without posts from users the viewer has blocked
+	viewerID, _ := getAuthenticatedUserID(ctx)
+
+	params := db.GetTrendingHashtagsParams{ViewerID: viewerID, Limit: defaultTrendingTopics}
+	if report.Name != "ALL_TIME" {
+		params.WindowStart = time.Now().Add(-report.Duration)
+	}
+	if limit != nil {
+		params.Limit = int32(*limit)
+	}
+
+	return api.queries.GetTrendingHashtags(ctx, params)
+}
+
+// IsViewerFollowingTopic returns false if the viewer isn't logged in.
+func (api TopicAPI) IsViewerFollowingTopic(ctx context.Context, tag string) (bool, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return false, nil
+	}
+
+	tag, err = normalizeTopicTag(tag)
+	if err != nil {
+		return false, err
+	}
+
+	return api.queries.IsFollowingTopic(ctx, db.IsFollowingTopicParams{UserID: userID, Tag: tag})
+}
+
+func (api TopicAPI) GetViewerFollowedTopics(ctx context.Context) ([]string, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetFollowedTopicsByUserID(ctx, userID)
+}
+
+// FollowTopic adds posts tagged with the topic to the viewer's personal feed. It returns the normalized tag.
+func (api TopicAPI) FollowTopic(ctx context.Context, tag string) (string, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tag, err = normalizeTopicTag(tag)
+	if err != nil {
+		return "", err
+	}
+
+	err = api.queries.FollowTopic(ctx, db.FollowTopicParams{
+		ID:     persist.GenerateID(),
+		UserID: userID,
+		Tag:    tag,
+	})
+
+	return tag, err
+}
+
+func (api TopicAPI) UnfollowTopic(ctx context.Context, tag string) (string, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tag, err = normalizeTopicTag(tag)
+	if err != nil {
+		return "", err
+	}
+
+	err = api.queries.UnfollowTopic(ctx, db.UnfollowTopicParams{UserID: userID, Tag: tag})
+	return tag, err
+}
+
+func normalizeTopicTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if !topicTagPattern.MatchString(tag) {
+		return "", validate.ErrInvalidInput{Parameters: []string{"tag"}, Reasons: []string{fmt.Sprintf("must be 1 to %d letters, numbers or underscores", maxHashtagLength)}}
+	}
+	return tag, nil
+}
+
+// extractHashtags returns the distinct lowercased hashtags in a caption in the order they first appear. Tags made up
+// only of numbers (e.g. "#1") and tags that are too long aren't treated as hashtags.
+func extractHashtags(caption string) []string {
+	seen := make(map[string]bool)
+	tags := make([]string, 0)
+
+	for _, match := range hashtagPattern.FindAllStringSubmatch(caption, -1) {
+		tag := strings.ToLower(match[1])
+		if seen[tag] || len([]rune(tag)) > maxHashtagLength || strings.IndexFunc(tag, unicode.IsLetter) < 0 {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+		if len(tags) == maxHashtagsPerPost {
+			break
+		}
+	}
+
+	return tags
+}
+
+// insertPostHashtags replaces the hashtags stored for a post with the ones in its caption. Tags the post already
+// had keep their original creation time, so that editing a post doesn't bring its tags back into trending.
+func insertPostHashtags(ctx context.Context, q *db.Queries, postID persist.DBID, caption *string) error {
+	tags := make([]string, 0)
+	if caption != nil {
+		tags = extractHashtags(*caption)
+	}
+
+	err := q.DeleteStalePostHashtags(ctx, db.DeleteStalePostHashtagsParams{PostID: postID, Tags: tags})
+	if err != nil || len(tags) == 0 {
+		return err
+	}
+
+	return q.InsertPostHashtags(ctx, db.InsertPostHashtagsParams{PostID: postID, Tags: tags})
+}
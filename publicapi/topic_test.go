@@ -0,0 +1,47 @@
+package publicapi
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	manyTags := make([]string, 0, maxHashtagsPerPost+5)
+	expectedManyTags := make([]string, 0, maxHashtagsPerPost)
+	for i := 0; i < maxHashtagsPerPost+5; i++ {
+		manyTags = append(manyTags, fmt.Sprintf("#tag%d", i))
+		if i < maxHashtagsPerPost {
+			expectedManyTags = append(expectedManyTags, fmt.Sprintf("tag%d", i))
+		}
+	}
+
+	tests := []struct {
+		name     string
+		caption  string
+		expected []string
+	}{
+		{name: "no tags", caption: "just a caption", expected: []string{}},
+		{name: "tag at start of caption", caption: "#art is cool", expected: []string{"art"}},
+		{name: "tags after whitespace and punctuation", caption: "love this (#art), #Music!", expected: []string{"art", "music"}},
+		{name: "tags are lowercased and deduplicated", caption: "#Art #ART #art", expected: []string{"art"}},
+		{name: "unicode tags", caption: "#芸術 #café", expected: []string{"芸術", "café"}},
+		{name: "underscores and digits", caption: "#gen_art_2023", expected: []string{"gen_art_2023"}},
+		{name: "url fragments are ignored", caption: "see https://gallery.so/#frag and gallery.so/page#section", expected: []string{}},
+		{name: "html entities are ignored", caption: "it&#39;s &#x27;great&#x27;", expected: []string{}},
+		{name: "hash inside a word is ignored", caption: "c#sharp abc#def", expected: []string{}},
+		{name: "numeric-only tags are ignored", caption: "#1 #2023 #42_", expected: []string{}},
+		{name: "numeric-only tags don't hide other tags", caption: "#1 #first", expected: []string{"first"}},
+		{name: "tags over the max length are ignored", caption: "#" + strings.Repeat("a", maxHashtagLength+1) + " #ok", expected: []string{"ok"}},
+		{name: "tags at the max length are kept", caption: "#" + strings.Repeat("a", maxHashtagLength), expected: []string{strings.Repeat("a", maxHashtagLength)}},
+		{name: "tags are capped per post", caption: strings.Join(manyTags, " "), expected: expectedManyTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, extractHashtags(tt.caption))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package publicapi
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 
+	admin "github.com/mikeydub/go-gallery/adminapi"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/event"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
@@ -45,6 +47,7 @@ var ErrProfileImageTooManySources = errors.New("too many profile image sources p
 var ErrProfileImageUnknownSource = errors.New("unknown profile image source to use")
 var ErrProfileImageNotTokenOwner = errors.New("user is not an owner of the token")
 var ErrProfileImageNotWalletOwner = errors.New("user is not the owner of the wallet")
+var ErrMuteNotFound = errors.New("mute not found")
 
 type UserAPI struct {
 	repos              *postgres.Repositories
@@ -54,6 +57,7 @@ type UserAPI struct {
 	ethClient          *ethclient.Client
 	multichainProvider *multichain.Provider
 	taskClient         *task.Client
+	suspensionCache    *redis.Cache
 }
 
 func (api UserAPI) GetLoggedInUserId(ctx context.Context) persist.DBID {
@@ -82,6 +86,18 @@ func (api UserAPI) GetUserById(ctx context.Context, userID persist.DBID) (*db.Us
 	return &user, nil
 }
 
+// GetActiveSuspension returns the suspension or ban currently in effect for a user, or nil if they aren't suspended.
+func (api UserAPI) GetActiveSuspension(ctx context.Context, userID persist.DBID) (*db.ModerationAction, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID": validate.WithTag(userID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return admin.GetActiveSuspension(ctx, api.queries, api.suspensionCache, userID)
+}
+
 func (api UserAPI) GetUserByVerifiedEmailAddress(ctx context.Context, emailAddress persist.Email) (*db.User, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
@@ -1942,6 +1958,126 @@ func (api UserAPI) UnblockUser(ctx context.Context, userID persist.DBID) error {
 	return api.queries.UnblockUser(ctx, db.UnblockUserParams{UserID: viewerID, BlockedUserID: userID})
 }
 
+// MuteUser hides a user's posts, comments and notifications from the viewer without blocking them.
+// If durationDays is set, the mute expires after that many days.
+func (api UserAPI) MuteUser(ctx context.Context, userID persist.DBID, durationDays *int) (*db.Mute, error) {
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID":       validate.WithTag(userID, fmt.Sprintf("required,ne=%s", viewerID)),
+		"durationDays": validate.WithTag(durationDays, "omitempty,gte=1"),
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := api.loaders.GetUserByIdBatch.Load(userID); err != nil {
+		return nil, err
+	}
+
+	return api.mute(ctx, db.InsertMuteParams{
+		UserID:      viewerID,
+		MutedUserID: util.ToNullString(userID.String(), true),
+	}, durationDays)
+}
+
+// MuteCommunity hides posts featuring a community's tokens, and notifications about the community, from the viewer.
+func (api UserAPI) MuteCommunity(ctx context.Context, communityID persist.DBID, durationDays *int) (*db.Mute, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"communityID":  validate.WithTag(communityID, "required"),
+		"durationDays": validate.WithTag(durationDays, "omitempty,gte=1"),
+	}); err != nil {
+		return nil, err
+	}
+
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := api.loaders.GetCommunityByIDBatch.Load(communityID); err != nil {
+		return nil, err
+	}
+
+	return api.mute(ctx, db.InsertMuteParams{
+		UserID:           viewerID,
+		MutedCommunityID: util.ToNullString(communityID.String(), true),
+	}, durationDays)
+}
+
+// MuteKeyword hides posts, comments and comment notifications containing the keyword from the viewer. Keywords are
+// matched case-insensitively anywhere in the text.
+func (api UserAPI) MuteKeyword(ctx context.Context, keyword string, durationDays *int) (*db.Mute, error) {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"keyword":      validate.WithTag(keyword, "required,max=100"),
+		"durationDays": validate.WithTag(durationDays, "omitempty,gte=1"),
+	}); err != nil {
+		return nil, err
+	}
+
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.mute(ctx, db.InsertMuteParams{
+		UserID:  viewerID,
+		Keyword: util.ToNullString(keyword, true),
+	}, durationDays)
+}
+
+func (api UserAPI) mute(ctx context.Context, params db.InsertMuteParams, durationDays *int) (*db.Mute, error) {
+	params.ID = persist.GenerateID()
+	if durationDays != nil {
+		params.ExpiresAt = sql.NullTime{Time: time.Now().AddDate(0, 0, *durationDays), Valid: true}
+	}
+
+	mute, err := api.queries.InsertMute(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mute, nil
+}
+
+func (api UserAPI) Unmute(ctx context.Context, muteID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"muteID": validate.WithTag(muteID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.queries.DeleteMuteByIDAndUserID(ctx, db.DeleteMuteByIDAndUserIDParams{ID: muteID, UserID: viewerID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrMuteNotFound
+	}
+
+	return err
+}
+
+// GetViewerMutes returns the viewer's mutes that haven't expired, most recent first.
+func (api UserAPI) GetViewerMutes(ctx context.Context) ([]db.Mute, error) {
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetActiveMutesByUserID(ctx, viewerID)
+}
+
 func (api UserAPI) SetPersona(ctx context.Context, persona persist.Persona) error {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
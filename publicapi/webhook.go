@@ -0,0 +1,249 @@
+package publicapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/webhooks"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+var ErrNotCommunityCreator = errors.New("only a creator of the community can add webhooks to it")
+var ErrTooManyWebhooks = fmt.Errorf("users can have at most %d webhooks", webhooks.MaxWebhooksPerUser)
+
+type WebhookAPI struct {
+	queries   *db.Queries
+	loaders   *dataloader.Loaders
+	validator *validator.Validate
+}
+
+func (api WebhookAPI) GetViewerWebhooks(ctx context.Context) ([]db.Webhook, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return api.queries.GetWebhooksByOwnerID(ctx, userID)
+}
+
+// GetWebhookByID returns a webhook owned by the viewer. Other users' webhooks are treated as not found.
+func (api WebhookAPI) GetWebhookByID(ctx context.Context, webhookID persist.DBID) (db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": validate.WithTag(webhookID, "required"),
+	}); err != nil {
+		return db.Webhook{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	hook, err := api.queries.GetWebhookByID(ctx, webhookID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && hook.OwnerID != userID) {
+		return db.Webhook{}, ErrWebhookNotFound
+	}
+
+	return hook, err
+}
+
+// CreateWebhook registers an endpoint for the viewer's events, or for a community's events if communityID is given.
+// The returned webhook's secret is used to verify payloads, and is only returned when the webhook is created.
+func (api WebhookAPI) CreateWebhook(ctx context.Context, url string, eventTypes []string, communityID *persist.DBID) (db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"url":        validate.WithTag(url, "required,max=2048"),
+		"eventTypes": validate.WithTag(eventTypes, "required,min=1,unique"),
+	}); err != nil {
+		return db.Webhook{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	if err := validateWebhookURL(url); err != nil {
+		return db.Webhook{}, err
+	}
+
+	allowed := webhooks.UserEventTypes
+	if communityID != nil {
+		if err := api.validateCommunityCreator(ctx, userID, *communityID); err != nil {
+			return db.Webhook{}, err
+		}
+		allowed = webhooks.CommunityEventTypes
+	}
+
+	if err := validateWebhookEventTypes(eventTypes, allowed); err != nil {
+		return db.Webhook{}, err
+	}
+
+	count, err := api.queries.CountWebhooksByOwnerID(ctx, userID)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+	if count >= webhooks.MaxWebhooksPerUser {
+		return db.Webhook{}, ErrTooManyWebhooks
+	}
+
+	secret, err := webhooks.GenerateSecret()
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	return api.queries.CreateWebhook(ctx, db.CreateWebhookParams{
+		ID:          persist.GenerateID(),
+		OwnerID:     userID,
+		CommunityID: persist.StrPtrToNullStr((*string)(communityID)),
+		Url:         url,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+	})
+}
+
+func (api WebhookAPI) UpdateWebhook(ctx context.Context, webhookID persist.DBID, url *string, eventTypes []string, enabled *bool) (db.Webhook, error) {
+	hook, err := api.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	params := db.UpdateWebhookParams{ID: hook.ID}
+
+	if url != nil {
+		if err := validateWebhookURL(*url); err != nil {
+			return db.Webhook{}, err
+		}
+		params.Url = sql.NullString{String: *url, Valid: true}
+	}
+
+	if eventTypes != nil {
+		allowed := webhooks.UserEventTypes
+		if hook.CommunityID != "" {
+			allowed = webhooks.CommunityEventTypes
+		}
+		if err := validateWebhookEventTypes(eventTypes, allowed); err != nil {
+			return db.Webhook{}, err
+		}
+		params.EventTypes = eventTypes
+	}
+
+	if enabled != nil {
+		params.Enabled = sql.NullBool{Bool: *enabled, Valid: true}
+	}
+
+	return api.queries.UpdateWebhook(ctx, params)
+}
+
+func (api WebhookAPI) DeleteWebhook(ctx context.Context, webhookID persist.DBID) error {
+	hook, err := api.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+	return api.queries.DeleteWebhook(ctx, hook.ID)
+}
+
+// TestWebhook sends a test payload to a webhook and returns the logged delivery. A failed test delivery isn't
+// an error, since its status and response are what the caller needs to see.
+func (api WebhookAPI) TestWebhook(ctx context.Context, webhookID persist.DBID) (db.WebhookDelivery, error) {
+	hook, err := api.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	delivery, err := webhooks.Test(ctx, api.queries, hook)
+	if err != nil && delivery.ID == "" {
+		return db.WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
+
+func (api WebhookAPI) PaginateDeliveriesByWebhookID(ctx context.Context, webhookID persist.DBID, before, after *string, first, last *int) ([]db.WebhookDelivery, PageInfo, error) {
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	hook, err := api.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params TimeIDPagingParams) ([]db.WebhookDelivery, error) {
+		return api.queries.PaginateWebhookDeliveriesByWebhookID(ctx, db.PaginateWebhookDeliveriesByWebhookIDParams{
+			WebhookID:     hook.ID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountWebhookDeliveriesByWebhookID(ctx, hook.ID)
+		return int(total), err
+	}
+
+	cursorFunc := func(d db.WebhookDelivery) (time.Time, persist.DBID, error) {
+		return d.CreatedAt, d.ID, nil
+	}
+
+	paginator := TimeIDPaginator[db.WebhookDelivery]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.Paginate(before, after, first, last)
+}
+
+func (api WebhookAPI) validateCommunityCreator(ctx context.Context, userID, communityID persist.DBID) error {
+	if _, err := api.loaders.GetCommunityByIDBatch.Load(communityID); err != nil {
+		return err
+	}
+
+	creators, err := api.loaders.GetCreatorsByCommunityID.Load(communityID)
+	if err != nil {
+		return err
+	}
+
+	for _, creator := range creators {
+		if creator.CreatorUserID == userID {
+			return nil
+		}
+	}
+
+	return ErrNotCommunityCreator
+}
+
+func validateWebhookURL(url string) error {
+	if err := webhooks.ValidateURL(url); err != nil {
+		return validate.ErrInvalidInput{Parameters: []string{"url"}, Reasons: []string{err.Error()}}
+	}
+	return nil
+}
+
+func validateWebhookEventTypes(eventTypes []string, allowed []string) error {
+	if len(eventTypes) == 0 {
+		return validate.ErrInvalidInput{Parameters: []string{"eventTypes"}, Reasons: []string{"at least one event type is required"}}
+	}
+	for _, t := range eventTypes {
+		if !util.Contains(allowed, t) {
+			return validate.ErrInvalidInput{Parameters: []string{"eventTypes"}, Reasons: []string{fmt.Sprintf("%s events aren't available for this webhook", t)}}
+		}
+	}
+	return nil
+}
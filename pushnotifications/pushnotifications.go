@@ -2,8 +2,10 @@ package pushnotifications
 
 import (
 	"context"
+	"errors"
 	"github.com/getsentry/sentry-go"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/env"
@@ -15,6 +17,7 @@ import (
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/service/tracing"
+	"github.com/mikeydub/go-gallery/service/webhooks"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -68,6 +71,7 @@ func CoreInitServer(ctx context.Context) *gin.Engine {
 	expoHandler := expo.NewPushNotificationHandler(ctx, queries, apiURL, accessToken)
 	taskGroup.POST("send-push-notification", sendPushNotificationHandler(expoHandler))
 	jobGroup.POST("check-push-tickets", checkPushTicketsHandler(expoHandler))
+	taskGroup.POST("deliver-webhook", deliverWebhookHandler(queries))
 
 	// Moshi handlers
 	moshiDB := newMoshiDB()
@@ -118,6 +122,34 @@ func sendPushNotificationHandler(expoHandler *expo.PushNotificationHandler) gin.
 	}
 }
 
+func deliverWebhookHandler(queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		message := task.WebhookDeliveryMessage{}
+
+		if err := c.ShouldBindJSON(&message); err != nil {
+			// Send StatusOK so the task isn't retried, since a retry won't fix a malformed message
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		delivery, err := webhooks.Deliver(c, queries, message.DeliveryID)
+		if err != nil {
+			logger.For(c).WithError(err).WithField("deliveryID", message.DeliveryID).Warn("failed to deliver webhook")
+
+			// Retry the task until the delivery is out of attempts
+			responseCode := http.StatusInternalServerError
+			if errors.Is(err, pgx.ErrNoRows) || delivery.Status == webhooks.StatusFailed {
+				responseCode = http.StatusOK
+			}
+
+			util.ErrResponse(c, responseCode, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
 func checkPushTicketsHandler(expoHandler *expo.PushNotificationHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		err := expoHandler.CheckPushTickets()
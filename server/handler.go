@@ -10,7 +10,6 @@ import (
 
 	"cloud.google.com/go/pubsub"
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
-	"cloud.google.com/go/storage"
 	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -30,8 +29,10 @@ import (
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/event"
 	"github.com/mikeydub/go-gallery/graphql/apq"
+	"github.com/mikeydub/go-gallery/graphql/cost"
 	"github.com/mikeydub/go-gallery/graphql/generated"
 	graphql "github.com/mikeydub/go-gallery/graphql/resolver"
+	"github.com/mikeydub/go-gallery/graphql/sse"
 	"github.com/mikeydub/go-gallery/middleware"
 	"github.com/mikeydub/go-gallery/publicapi"
 	"github.com/mikeydub/go-gallery/service/auth"
@@ -39,25 +40,61 @@ import (
 	"github.com/mikeydub/go-gallery/service/mediamapper"
 	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/recommend"
 	"github.com/mikeydub/go-gallery/service/recommend/userpref"
 	"github.com/mikeydub/go-gallery/service/redis"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/util"
 )
 
-func HandlersInit(router *gin.Engine, repos *postgres.Repositories, queries *db.Queries, httpClient *http.Client, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, throttler *throttle.Locker, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, secrets *secretmanager.Client, graphqlAPQCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache *redis.Cache, magicClient *magicclient.API, recommender *recommend.Recommender, personalization *userpref.Personalization, neynar *farcaster.NeynarAPI, mintLimiter *limiters.KeyRateLimiter) *gin.Engine {
+func HandlersInit(router *gin.Engine, repos *postgres.Repositories, queries *db.Queries, httpClient *http.Client, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, objectStore store.Client, throttler *throttle.Locker, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, secrets *secretmanager.Client, graphqlAPQCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache, suspensionCache *redis.Cache, magicClient *magicclient.API, recommender *recommend.Recommender, personalization *userpref.Personalization, neynar *farcaster.NeynarAPI, mintLimiter *limiters.KeyRateLimiter) *gin.Engine {
 	router.GET("/alive", util.HealthCheckHandler())
 	apqCache := &apq.APQCache{Cache: graphqlAPQCache}
 	publicapiF := func(ctx context.Context, disableDataloaderCaching bool) *publicapi.PublicAPI {
-		api := publicapi.New(ctx, disableDataloaderCaching, repos, queries, httpClient, ethClient, ipfsClient, arweaveClient, storageClient, taskClient, throttler, secrets, apqCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache, magicClient, neynar, mintLimiter)
+		api := publicapi.New(ctx, disableDataloaderCaching, repos, queries, httpClient, ethClient, ipfsClient, arweaveClient, objectStore, taskClient, throttler, secrets, apqCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache, suspensionCache, magicClient, neynar, mintLimiter)
 		return api
 	}
 	GraphqlHandlersInit(router, queries, taskClient, pub, lock, apqCache, authRefreshCache, recommender, personalization, neynar, publicapiF)
+	TaskHandlersInit(router, repos, queries, taskClient, pub, lock, neynar)
 	return router
 }
 
+// TaskHandlersInit adds the handlers for tasks that the backend submits to itself, such as publishing scheduled posts
+func TaskHandlersInit(router *gin.Engine, repos *postgres.Repositories, queries *db.Queries, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, neynar *farcaster.NeynarAPI) {
+	notificationsHandler := notifications.New(queries, pub, taskClient, lock, false)
+	realtimeHub := realtime.New(pub, false)
+
+	authOpts := middleware.BasicAuthOptionBuilder{}
+	tasksGroup := router.Group("/tasks")
+	// Return 200 on auth failures to prevent task retries
+	tasksGroup.Use(middleware.TaskRequired(), middleware.BasicHeaderAuthRequired(env.GetString("BACKEND_TASK_SECRET"), authOpts.WithFailureStatus(http.StatusOK)))
+	tasksGroup.Use(func(c *gin.Context) {
+		event.AddTo(c, false, notificationsHandler, realtimeHub, queries, taskClient, neynar)
+	})
+	tasksGroup.POST("/publish-scheduled-post", publishScheduledPost(repos, queries))
+}
+
+func publishScheduledPost(repos *postgres.Repositories, queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input task.ScheduledPostMessage
+		if err := c.ShouldBindJSON(&input); err != nil {
+			// Remove from queue if bad message
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		if err := publicapi.PublishScheduledPost(c, repos, queries, input.ScheduledPostID); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
 func GraphqlHandlersInit(router *gin.Engine, queries *db.Queries, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, apqCache *apq.APQCache, authRefreshCache *redis.Cache, recommender *recommend.Recommender, personalization *userpref.Personalization, neynar *farcaster.NeynarAPI, publicapiF func(ctx context.Context, disableDataloaderCaching bool) *publicapi.PublicAPI) {
 	graphqlGroup := router.Group("/glry/graphql")
 	graphqlHandler := GraphQLHandler(queries, taskClient, pub, lock, recommender, personalization, neynar, apqCache, publicapiF)
@@ -73,6 +110,8 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 	config.Directives.BasicAuth = graphql.BasicAuthDirectiveHandler()
 	config.Directives.FrontendBuildAuth = graphql.FrontendBuildAuthDirectiveHandler()
 	config.Directives.Experimental = graphql.ExperimentalDirectiveHandler()
+	// Only the schema is used here, so that the @rateLimit arguments are checked at startup
+	config.Directives.RateLimit = graphql.RateLimitDirectiveHandler(generated.NewExecutableSchema(config).Schema(), redis.NewCache(redis.GraphQLRateLimitersCache))
 
 	schema := generated.NewExecutableSchema(config)
 	h := handler.New(schema)
@@ -81,6 +120,8 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 	// We're not using NewDefaultServer anymore because we need a custom
 	// WebSocket transport so we can modify the CheckOrigin function
 	h.AddTransport(transport.Options{})
+	// SSE has to be added before GET and POST, which would otherwise handle event stream requests
+	h.AddTransport(sse.Transport{})
 	h.AddTransport(transport.GET{})
 	h.AddTransport(transport.POST{})
 	h.AddTransport(transport.MultipartForm{})
@@ -91,9 +132,20 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 	h.Use(extension.AutomaticPersistedQuery{
 		Cache: apqCache,
 	})
+	h.Use(&apq.Allowlist{
+		Cache: apqCache,
+		Mode:  env.GetString("GRAPHQL_APQ_MODE"),
+	})
 
 	// End code stolen from handler.NewDefaultServer
 
+	costCache := redis.NewCache(redis.GraphQLCostCache)
+	h.Use(&cost.Limit{
+		Config:     cost.DefaultConfig(env.GetInt("GRAPHQL_MAX_COST")),
+		UserBudget: limiters.NewKeyRateLimiter(context.Background(), costCache, "user", env.GetInt64("GRAPHQL_USER_COST_BUDGET"), time.Minute),
+		IPBudget:   limiters.NewKeyRateLimiter(context.Background(), costCache, "ip", env.GetInt64("GRAPHQL_IP_COST_BUDGET"), time.Minute),
+	})
+
 	h.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
 			// This is okay to blindly return true since our
@@ -123,6 +175,7 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 	h.AroundFields(graphql.RemapAndReportErrors)
 
 	notificationsHandler := notifications.New(queries, pub, taskClient, lock, true)
+	realtimeHub := realtime.New(pub, true)
 
 	h.AroundFields(graphql.MutationCachingHandler(publicapiF))
 
@@ -151,8 +204,9 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 		disableDataloaderCaching := false
 
 		mediamapper.AddTo(c)
-		event.AddTo(c, disableDataloaderCaching, notificationsHandler, queries, taskClient, neynar)
+		event.AddTo(c, disableDataloaderCaching, notificationsHandler, realtimeHub, queries, taskClient, neynar)
 		notifications.AddTo(c, notificationsHandler)
+		realtime.AddTo(c, realtimeHub)
 		recommend.AddTo(c, recommender)
 		userpref.AddTo(c, personalization)
 
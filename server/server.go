@@ -9,7 +9,6 @@ import (
 
 	"cloud.google.com/go/pubsub"
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
-	"cloud.google.com/go/storage"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/everFinance/goar"
 	sentry "github.com/getsentry/sentry-go"
@@ -39,6 +38,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/rpc/arweave"
 	"github.com/mikeydub/go-gallery/service/rpc/ipfs"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/util"
@@ -59,7 +59,7 @@ func Init() {
 	ctx := context.Background()
 	c := ClientInit(ctx)
 	recommender := recommend.NewRecommender(c.Queries, publicapi.GetOnboardingUserRecommendationsBootstrap(c.Queries))
-	personalize := userpref.NewPersonalization(ctx, c.Queries, c.StorageClient)
+	personalize := userpref.NewPersonalization(ctx, c.Queries, c.ObjectStore)
 	router := CoreInit(ctx, c, recommender, personalize)
 	http.Handle("/", router)
 }
@@ -71,7 +71,7 @@ type Clients struct {
 	EthClient       *ethclient.Client
 	IPFSClient      *shell.Shell
 	ArweaveClient   *goar.Client
-	StorageClient   *storage.Client
+	ObjectStore     store.Client
 	TaskClient      *task.Client
 	SecretClient    *secretmanager.Client
 	PubSubClient    *pubsub.Client
@@ -93,7 +93,7 @@ func ClientInit(ctx context.Context) *Clients {
 		EthClient:       rpc.NewEthClient(),
 		IPFSClient:      ipfs.NewShell(),
 		ArweaveClient:   arweave.NewClient(),
-		StorageClient:   rpc.NewStorageClient(ctx),
+		ObjectStore:     rpc.NewObjectStore(ctx),
 		TaskClient:      task.NewClient(ctx),
 		SecretClient:    newSecretsClient(),
 		PubSubClient:    gcp.NewClient(ctx),
@@ -113,12 +113,13 @@ func CoreInit(ctx context.Context, c *Clients, recommender *recommend.Recommende
 	authRefreshCache := redis.NewCache(redis.AuthTokenForceRefreshCache)
 	tokenManageCache := redis.NewCache(redis.TokenManageCache)
 	oneTimeLoginCache := redis.NewCache(redis.OneTimeLoginCache)
+	suspensionCache := redis.NewCache(redis.SuspensionCache)
 	neynar := farcaster.NewNeynarAPI(c.HTTPClient, socialCache, c.Queries)
 	mintLimiter := limiters.NewKeyRateLimiter(ctx, redis.NewCache(redis.MintCache), "inAppMinting", 1, time.Minute*10)
 	recommender.Loop(ctx, time.NewTicker(time.Hour))
 	personalize.Loop(ctx, time.NewTicker(time.Minute*15))
 	return CoreInitHandlerF(ctx, func(r *gin.Engine) {
-		HandlersInit(r, c.Repos, c.Queries, c.HTTPClient, c.EthClient, c.IPFSClient, c.ArweaveClient, c.StorageClient, newThrottler(), c.TaskClient, c.PubSubClient, lock, c.SecretClient, graphqlAPQCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache, c.MagicLinkClient, recommender, personalize, neynar, mintLimiter)
+		HandlersInit(r, c.Repos, c.Queries, c.HTTPClient, c.EthClient, c.IPFSClient, c.ArweaveClient, c.ObjectStore, newThrottler(), c.TaskClient, c.PubSubClient, lock, c.SecretClient, graphqlAPQCache, feedCache, socialCache, authRefreshCache, tokenManageCache, oneTimeLoginCache, suspensionCache, c.MagicLinkClient, recommender, personalize, neynar, mintLimiter)
 	})
 }
 
@@ -186,6 +187,14 @@ func SetDefaults() {
 	viper.SetDefault("IPFS_PROJECT_SECRET", "")
 	viper.SetDefault("GCLOUD_TOKEN_CONTENT_BUCKET", "dev-token-content")
 	viper.SetDefault("GCLOUD_USER_PREF_BUCKET", "dev-user-pref")
+	viper.SetDefault("STORAGE_BACKEND", "gcs")
+	viper.SetDefault("S3_ENDPOINT", "http://localhost:9000")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("S3_ACCESS_KEY_ID", "")
+	viper.SetDefault("S3_SECRET_ACCESS_KEY", "")
+	viper.SetDefault("S3_PUBLIC_URL", "")
+	viper.SetDefault("LOCAL_STORAGE_DIR", "")
+	viper.SetDefault("LOCAL_STORAGE_URL", "")
 	viper.SetDefault("REDIS_URL", "localhost:6379")
 	viper.SetDefault("PREMIUM_CONTRACT_ADDRESS", "0xe01569ca9b39e55bc7c0dfa09f05fa15cb4c7698=[0,1,2,3,4,5,6,7,8]")
 	viper.SetDefault("RPC_URL", "https://eth-goerli.g.alchemy.com/v2/_2u--i79yarLYdOT4Bgydqa0dBceVRLD")
@@ -201,10 +210,15 @@ func SetDefaults() {
 	viper.SetDefault("SENTRY_DSN", "")
 	viper.SetDefault("GCLOUD_FEED_QUEUE", "projects/gallery-local/locations/here/queues/feed-event")
 	viper.SetDefault("GCLOUD_FEED_BUFFER_SECS", 20)
+	viper.SetDefault("GRAPHQL_APQ_MODE", "open")
+	viper.SetDefault("GRAPHQL_MAX_COST", 20000)
+	viper.SetDefault("GRAPHQL_USER_COST_BUDGET", 200000)
+	viper.SetDefault("GRAPHQL_IP_COST_BUDGET", 100000)
 	viper.SetDefault("FEED_SECRET", "feed-secret")
 	viper.SetDefault("TOKEN_PROCESSING_URL", "http://localhost:6500")
 	viper.SetDefault("TEZOS_API_URL", "https://api.tzkt.io")
 	viper.SetDefault("POAP_API_KEY", "")
+	viper.SetDefault("SOLANA_RPC_URL", "")
 	viper.SetDefault("POAP_AUTH_TOKEN", "")
 	viper.SetDefault("GAE_VERSION", "")
 	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
@@ -212,12 +226,16 @@ func SetDefaults() {
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
 	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_REALTIME", "dev-realtime")
 	viper.SetDefault("PUBSUB_SUB_NEW_NOTIFICATIONS", "dev-new-notifications-sub")
 	viper.SetDefault("PUBSUB_SUB_UPDATED_NOTIFICATIONS", "dev-updated-notifications-sub")
 	viper.SetDefault("EMAILS_HOST", "http://localhost:5500")
 	viper.SetDefault("BASIC_AUTH_TOKEN_RETOOL", "TEST_TOKEN_RETOOL")
 	viper.SetDefault("BASIC_AUTH_TOKEN_MONITORING", "TEST_TOKEN_MONITORING")
 	viper.SetDefault("BACKEND_SECRET", "BACKEND_SECRET")
+	viper.SetDefault("BACKEND_URL", "http://localhost:4000")
+	viper.SetDefault("BACKEND_TASK_SECRET", "backend-task-secret")
+	viper.SetDefault("GCLOUD_SCHEDULED_POSTS_QUEUE", "projects/gallery-local/locations/here/queues/scheduled-posts")
 	viper.SetDefault("MERCH_CONTRACT_ADDRESS", "0x01f55be815fbd10b1770b008b8960931a30e7f65")
 	viper.SetDefault("ETH_PRIVATE_KEY", "")
 	viper.SetDefault("FEED_URL", "")
@@ -235,6 +253,8 @@ func SetDefaults() {
 	viper.SetDefault("INFURA_API_KEY", "")
 	viper.SetDefault("INFURA_API_SECRET", "")
 	viper.SetDefault("PUSH_NOTIFICATIONS_SECRET", "push-notifications-secret")
+	viper.SetDefault("PUSH_NOTIFICATIONS_URL", "http://localhost:8000")
+	viper.SetDefault("GCLOUD_WEBHOOKS_QUEUE", "projects/gallery-local/locations/here/queues/webhooks")
 	viper.SetDefault("ZORA_API_KEY", "")
 	viper.SetDefault("GOLDSKY_API_KEY", "")
 	viper.SetDefault("RESERVOIR_API_KEY", "")
@@ -251,6 +271,10 @@ func SetDefaults() {
 	viper.SetDefault("HIGHLIGHT_APP_SECRET", "")
 	viper.SetDefault("MINT_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/mint-processing")
 	viper.SetDefault("SIMPLEHASH_API_KEY", "")
+	viper.SetDefault("MULTICHAIN_PROVIDER_QUORUM", 1)
+	viper.SetDefault("MULTICHAIN_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("MULTICHAIN_FAILURE_COOLDOWN", "1m")
+	viper.SetDefault("MULTICHAIN_FULL_SYNC_INTERVAL", "168h")
 
 	viper.AutomaticEnv()
 
@@ -274,6 +298,7 @@ func SetDefaults() {
 		util.VarNotSetTo("BASIC_AUTH_TOKEN_RETOOL", "TEST_TOKEN_RETOOL")
 		util.VarNotSetTo("BASIC_AUTH_TOKEN_MONITORING", "TEST_TOKEN_MONITORING")
 		util.VarNotSetTo("BACKEND_SECRET", "BACKEND_SECRET")
+		util.VarNotSetTo("BACKEND_TASK_SECRET", "backend-task-secret")
 		util.VarNotSetTo("PUSH_NOTIFICATIONS_SECRET", "push-notifications-secret")
 		util.VarNotSetTo("REFRESH_JWT_SECRET", "Refresh-Test-Secret")
 		util.VarNotSetTo("AUTH_JWT_SECRET", "Test-Secret")
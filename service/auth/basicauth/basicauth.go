@@ -14,6 +14,8 @@ type AuthTokenType string
 const (
 	AuthTokenTypeRetool     AuthTokenType = "Retool"
 	AuthTokenTypeMonitoring AuthTokenType = "Monitoring"
+	// AuthTokenTypeFrontendBuild is used by the frontend build pipeline to upload persisted query manifests
+	AuthTokenTypeFrontendBuild AuthTokenType = "FrontendBuild"
 )
 
 // AuthorizeHeader checks if the request has a Basic Auth header matching the specified
@@ -44,8 +46,9 @@ func AuthorizeHeader(ctx context.Context, username *string, password string) boo
 // known token types. If the request has a valid token, it returns true. Otherwise, it returns false.
 func AuthorizeHeaderForAllowedTypes(ctx context.Context, allowedTypes []AuthTokenType) bool {
 	authTokens := map[AuthTokenType]string{
-		AuthTokenTypeRetool:     env.GetString("BASIC_AUTH_TOKEN_RETOOL"),
-		AuthTokenTypeMonitoring: env.GetString("BASIC_AUTH_TOKEN_MONITORING"),
+		AuthTokenTypeRetool:        env.GetString("BASIC_AUTH_TOKEN_RETOOL"),
+		AuthTokenTypeMonitoring:    env.GetString("BASIC_AUTH_TOKEN_MONITORING"),
+		AuthTokenTypeFrontendBuild: env.GetString("FRONTEND_APQ_UPLOAD_AUTH_TOKEN"),
 	}
 
 	for _, authType := range allowedTypes {
@@ -55,6 +58,11 @@ func AuthorizeHeaderForAllowedTypes(ctx context.Context, allowedTypes []AuthToke
 			continue
 		}
 
+		// An unconfigured token would otherwise match an empty password
+		if authToken == "" {
+			continue
+		}
+
 		if AuthorizeHeader(ctx, nil, authToken) {
 			return true
 		}
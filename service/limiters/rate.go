@@ -54,10 +54,16 @@ func NewKeyRateLimiter(ctx context.Context, cache *redis.Cache, name string, amo
 
 // ForKey will check if the given key has exceeded the rate limit for this named limiter
 func (i *KeyRateLimiter) ForKey(ctx context.Context, key string) (bool, time.Duration, error) {
+	return i.TakeForKey(ctx, key, 1)
+}
+
+// TakeForKey is like ForKey, but counts as `amount` operations instead of one. It's useful for limits
+// on a budget where some operations cost more than others.
+func (i *KeyRateLimiter) TakeForKey(ctx context.Context, key string, amount int64) (bool, time.Duration, error) {
 	key = i.cache.Prefix() + ":" + i.name + ":" + key
 	backend := limiters.NewTokenBucketRedis(i.cache.Client(), key, i.timeToRefill, false)
 	bucket := limiters.NewTokenBucket(i.capacity, i.refillRate, i.lock, backend, i.clock, i.logger)
-	w, err := bucket.Limit(ctx)
+	w, err := bucket.Take(ctx, amount)
 	if err == limiters.ErrLimitExhausted {
 		return false, w, nil
 	} else if err == redislock.ErrNotObtained {
@@ -0,0 +1,570 @@
+// Package gltf reads the geometry of glTF and GLB models so that they can be measured and rendered without a GPU.
+package gltf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	glbMagic     = 0x46546C67 // "glTF"
+	glbChunkJSON = 0x4E4F534A // "JSON"
+	glbChunkBIN  = 0x004E4942 // "BIN\x00"
+
+	modeTriangles     = 4
+	modeTriangleStrip = 5
+	modeTriangleFan   = 6
+
+	// maxTriangles is the most triangles that are kept for rendering. Larger models are still measured in full.
+	maxTriangles = 2_000_000
+)
+
+var (
+	ErrInvalidModel = errors.New("invalid glTF model")
+	ErrNoGeometry   = errors.New("model has no renderable geometry")
+)
+
+// LoadURIFunc returns the contents of a buffer that a model references by URI. uri is exactly as it appears in
+// the model, and may be relative to the model's location.
+type LoadURIFunc func(uri string) ([]byte, error)
+
+// Model is the geometry of a glTF model in world space
+type Model struct {
+	triangles   []triangle
+	min, max    vec3
+	bounded     bool
+	polyCount   int
+	vertexCount int
+}
+
+// BoundingBox returns the corners of the smallest axis-aligned box that contains the model
+func (m *Model) BoundingBox() (lo [3]float64, hi [3]float64) {
+	return m.min, m.max
+}
+
+// PolyCount returns the number of triangles in the model
+func (m *Model) PolyCount() int {
+	return m.polyCount
+}
+
+// VertexCount returns the number of vertices in the model
+func (m *Model) VertexCount() int {
+	return m.vertexCount
+}
+
+type triangle struct {
+	v     [3]vec3
+	color vec3
+}
+
+type document struct {
+	Scene  *int `json:"scene"`
+	Scenes []struct {
+		Nodes []int `json:"nodes"`
+	} `json:"scenes"`
+	Nodes       []node       `json:"nodes"`
+	Meshes      []mesh       `json:"meshes"`
+	Accessors   []accessor   `json:"accessors"`
+	BufferViews []bufferView `json:"bufferViews"`
+	Buffers     []struct {
+		URI        string `json:"uri"`
+		ByteLength int    `json:"byteLength"`
+	} `json:"buffers"`
+	Materials []struct {
+		PbrMetallicRoughness *struct {
+			BaseColorFactor []float64 `json:"baseColorFactor"`
+		} `json:"pbrMetallicRoughness"`
+	} `json:"materials"`
+}
+
+type node struct {
+	Children    []int     `json:"children"`
+	Mesh        *int      `json:"mesh"`
+	Matrix      []float64 `json:"matrix"`
+	Translation []float64 `json:"translation"`
+	Rotation    []float64 `json:"rotation"`
+	Scale       []float64 `json:"scale"`
+}
+
+type mesh struct {
+	Primitives []primitive `json:"primitives"`
+}
+
+type primitive struct {
+	Attributes map[string]int             `json:"attributes"`
+	Indices    *int                       `json:"indices"`
+	Mode       *int                       `json:"mode"`
+	Material   *int                       `json:"material"`
+	Extensions map[string]json.RawMessage `json:"extensions"`
+}
+
+type accessor struct {
+	BufferView    *int   `json:"bufferView"`
+	ByteOffset    int    `json:"byteOffset"`
+	ComponentType int    `json:"componentType"`
+	Normalized    bool   `json:"normalized"`
+	Count         int    `json:"count"`
+	Type          string `json:"type"`
+}
+
+type bufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	ByteStride int `json:"byteStride"`
+}
+
+// IsGLB returns true if data is a binary glTF model
+func IsGLB(data []byte) bool {
+	return len(data) >= 12 && binary.LittleEndian.Uint32(data) == glbMagic
+}
+
+// Parse reads a glTF or GLB model. Buffers that aren't embedded in the model are read with loadURI, which may be nil
+// if the model is self-contained.
+func Parse(data []byte, loadURI LoadURIFunc) (*Model, error) {
+	jsonChunk, binChunk := data, []byte(nil)
+	if IsGLB(data) {
+		var err error
+		jsonChunk, binChunk, err = splitGLB(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var doc document
+	if err := json.Unmarshal(jsonChunk, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidModel, err)
+	}
+
+	buffers := make([][]byte, len(doc.Buffers))
+	for i, b := range doc.Buffers {
+		switch {
+		case b.URI == "" && i == 0 && binChunk != nil:
+			buffers[i] = binChunk
+		case strings.HasPrefix(b.URI, "data:"):
+			comma := strings.IndexByte(b.URI, ',')
+			if comma == -1 {
+				return nil, fmt.Errorf("%w: malformed data uri for buffer %d", ErrInvalidModel, i)
+			}
+			byt, err := base64.StdEncoding.DecodeString(b.URI[comma+1:])
+			if err != nil {
+				return nil, fmt.Errorf("%w: failed to decode buffer %d: %s", ErrInvalidModel, i, err)
+			}
+			buffers[i] = byt
+		case b.URI != "" && loadURI != nil:
+			byt, err := loadURI(b.URI)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load buffer %d: %w", i, err)
+			}
+			buffers[i] = byt
+		default:
+			return nil, fmt.Errorf("%w: buffer %d is not available", ErrInvalidModel, i)
+		}
+	}
+
+	r := reader{doc: &doc, buffers: buffers, model: &Model{}}
+
+	var roots []int
+	switch {
+	case doc.Scene != nil && *doc.Scene < len(doc.Scenes):
+		roots = doc.Scenes[*doc.Scene].Nodes
+	case len(doc.Scenes) > 0:
+		roots = doc.Scenes[0].Nodes
+	default:
+		roots = r.rootNodes()
+	}
+
+	for _, n := range roots {
+		if err := r.visit(n, identity(), 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.model.polyCount == 0 {
+		return nil, ErrNoGeometry
+	}
+
+	return r.model, nil
+}
+
+func splitGLB(data []byte) ([]byte, []byte, error) {
+	if binary.LittleEndian.Uint32(data[4:]) != 2 {
+		return nil, nil, fmt.Errorf("%w: unsupported GLB version %d", ErrInvalidModel, binary.LittleEndian.Uint32(data[4:]))
+	}
+
+	var jsonChunk, binChunk []byte
+	for offset := 12; offset+8 <= len(data); {
+		length := int(binary.LittleEndian.Uint32(data[offset:]))
+		chunkType := binary.LittleEndian.Uint32(data[offset+4:])
+		start, end := offset+8, offset+8+length
+		if length < 0 || end > len(data) {
+			return nil, nil, fmt.Errorf("%w: truncated GLB chunk", ErrInvalidModel)
+		}
+		switch chunkType {
+		case glbChunkJSON:
+			jsonChunk = data[start:end]
+		case glbChunkBIN:
+			binChunk = data[start:end]
+		}
+		offset = end
+	}
+
+	if jsonChunk == nil {
+		return nil, nil, fmt.Errorf("%w: GLB has no JSON chunk", ErrInvalidModel)
+	}
+
+	return bytes.TrimRight(jsonChunk, " \x00"), binChunk, nil
+}
+
+type reader struct {
+	doc     *document
+	buffers [][]byte
+	model   *Model
+}
+
+// rootNodes returns the nodes that aren't the child of any other node, for models that don't define a scene
+func (r reader) rootNodes() []int {
+	isChild := make(map[int]bool)
+	for _, n := range r.doc.Nodes {
+		for _, c := range n.Children {
+			isChild[c] = true
+		}
+	}
+	roots := make([]int, 0)
+	for i := range r.doc.Nodes {
+		if !isChild[i] {
+			roots = append(roots, i)
+		}
+	}
+	return roots
+}
+
+func (r reader) visit(nodeIdx int, parent mat4, depth int) error {
+	// Guard against cycles in malformed models
+	if nodeIdx < 0 || nodeIdx >= len(r.doc.Nodes) || depth > 64 {
+		return fmt.Errorf("%w: invalid node %d", ErrInvalidModel, nodeIdx)
+	}
+
+	n := r.doc.Nodes[nodeIdx]
+	world := parent.mul(n.transform())
+
+	if n.Mesh != nil {
+		if *n.Mesh < 0 || *n.Mesh >= len(r.doc.Meshes) {
+			return fmt.Errorf("%w: invalid mesh %d", ErrInvalidModel, *n.Mesh)
+		}
+		for _, p := range r.doc.Meshes[*n.Mesh].Primitives {
+			if err := r.addPrimitive(p, world); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, c := range n.Children {
+		if err := r.visit(c, world, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r reader) addPrimitive(p primitive, world mat4) error {
+	mode := modeTriangles
+	if p.Mode != nil {
+		mode = *p.Mode
+	}
+
+	// Points and lines have no surface to render, and compressed meshes can't be decoded
+	if mode != modeTriangles && mode != modeTriangleStrip && mode != modeTriangleFan {
+		return nil
+	}
+	if _, ok := p.Extensions["KHR_draco_mesh_compression"]; ok {
+		return nil
+	}
+	if _, ok := p.Extensions["EXT_meshopt_compression"]; ok {
+		return nil
+	}
+
+	posIdx, ok := p.Attributes["POSITION"]
+	if !ok {
+		return nil
+	}
+
+	positions, err := r.readVec3s(posIdx)
+	if err != nil {
+		return err
+	}
+
+	for i, pos := range positions {
+		positions[i] = world.apply(pos)
+		r.model.include(positions[i])
+	}
+	r.model.vertexCount += len(positions)
+
+	var indices []int
+	if p.Indices != nil {
+		indices, err = r.readIndices(*p.Indices)
+		if err != nil {
+			return err
+		}
+	} else {
+		indices = make([]int, len(positions))
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+
+	color := r.baseColor(p.Material)
+
+	addTriangle := func(a, b, c int) error {
+		if a >= len(positions) || b >= len(positions) || c >= len(positions) {
+			return fmt.Errorf("%w: index out of range", ErrInvalidModel)
+		}
+		r.model.polyCount++
+		if len(r.model.triangles) < maxTriangles {
+			r.model.triangles = append(r.model.triangles, triangle{v: [3]vec3{positions[a], positions[b], positions[c]}, color: color})
+		}
+		return nil
+	}
+
+	switch mode {
+	case modeTriangles:
+		for i := 0; i+2 < len(indices); i += 3 {
+			if err := addTriangle(indices[i], indices[i+1], indices[i+2]); err != nil {
+				return err
+			}
+		}
+	case modeTriangleStrip:
+		for i := 0; i+2 < len(indices); i++ {
+			a, b := indices[i], indices[i+1]
+			if i%2 == 1 {
+				a, b = b, a
+			}
+			if err := addTriangle(a, b, indices[i+2]); err != nil {
+				return err
+			}
+		}
+	case modeTriangleFan:
+		for i := 1; i+1 < len(indices); i++ {
+			if err := addTriangle(indices[0], indices[i], indices[i+1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r reader) baseColor(material *int) vec3 {
+	color := vec3{0.8, 0.8, 0.8}
+	if material == nil || *material < 0 || *material >= len(r.doc.Materials) {
+		return color
+	}
+	pbr := r.doc.Materials[*material].PbrMetallicRoughness
+	if pbr == nil || len(pbr.BaseColorFactor) < 3 {
+		return color
+	}
+	return vec3{pbr.BaseColorFactor[0], pbr.BaseColorFactor[1], pbr.BaseColorFactor[2]}
+}
+
+// accessorData returns the bytes of an accessor, the stride between its elements, and the size of each component
+func (r reader) accessorData(idx int, components int) ([]byte, int, int, accessor, error) {
+	if idx < 0 || idx >= len(r.doc.Accessors) {
+		return nil, 0, 0, accessor{}, fmt.Errorf("%w: invalid accessor %d", ErrInvalidModel, idx)
+	}
+
+	a := r.doc.Accessors[idx]
+	if a.BufferView == nil {
+		// Accessors without a buffer view are all zeros
+		return make([]byte, 0), 0, 0, a, nil
+	}
+	if *a.BufferView < 0 || *a.BufferView >= len(r.doc.BufferViews) {
+		return nil, 0, 0, a, fmt.Errorf("%w: invalid buffer view %d", ErrInvalidModel, *a.BufferView)
+	}
+
+	v := r.doc.BufferViews[*a.BufferView]
+	if v.Buffer < 0 || v.Buffer >= len(r.buffers) {
+		return nil, 0, 0, a, fmt.Errorf("%w: invalid buffer %d", ErrInvalidModel, v.Buffer)
+	}
+
+	size := componentSize(a.ComponentType)
+	if size == 0 {
+		return nil, 0, 0, a, fmt.Errorf("%w: invalid component type %d", ErrInvalidModel, a.ComponentType)
+	}
+
+	if a.Count < 0 || a.ByteOffset < 0 || v.ByteOffset < 0 || v.ByteLength < 0 || v.ByteStride < 0 {
+		return nil, 0, 0, a, fmt.Errorf("%w: accessor %d has a negative count, offset, length or stride", ErrInvalidModel, idx)
+	}
+
+	stride := v.ByteStride
+	if stride == 0 {
+		stride = size * components
+	}
+
+	buf := r.buffers[v.Buffer]
+	limit := len(buf)
+	if viewEnd := v.ByteOffset + v.ByteLength; viewEnd >= 0 && viewEnd < limit {
+		limit = viewEnd
+	}
+
+	// Every value is checked against limit before it's added to or multiplied, so that a crafted model can't
+	// overflow the bounds below
+	if a.ByteOffset > limit || v.ByteOffset > limit-a.ByteOffset {
+		return nil, 0, 0, a, fmt.Errorf("%w: accessor %d is out of bounds", ErrInvalidModel, idx)
+	}
+	start := v.ByteOffset + a.ByteOffset
+	end := start
+	if a.Count > 0 {
+		if a.Count-1 > (limit-start)/stride {
+			return nil, 0, 0, a, fmt.Errorf("%w: accessor %d is out of bounds", ErrInvalidModel, idx)
+		}
+		end = start + stride*(a.Count-1) + size*components
+	}
+	if end < start || end > limit {
+		return nil, 0, 0, a, fmt.Errorf("%w: accessor %d is out of bounds", ErrInvalidModel, idx)
+	}
+
+	return buf[start:end], stride, size, a, nil
+}
+
+func (r reader) readVec3s(idx int) ([]vec3, error) {
+	data, stride, size, a, err := r.accessorData(idx, 3)
+	if err != nil {
+		return nil, err
+	}
+	if a.Type != "VEC3" {
+		return nil, fmt.Errorf("%w: positions must be VEC3, got %s", ErrInvalidModel, a.Type)
+	}
+
+	out := make([]vec3, a.Count)
+	if len(data) == 0 {
+		return out, nil
+	}
+
+	for i := range out {
+		for c := 0; c < 3; c++ {
+			out[i][c] = readComponent(data[i*stride+c*size:], a.ComponentType, a.Normalized)
+		}
+	}
+
+	return out, nil
+}
+
+func (r reader) readIndices(idx int) ([]int, error) {
+	data, stride, size, a, err := r.accessorData(idx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if a.Type != "SCALAR" {
+		return nil, fmt.Errorf("%w: indices must be SCALAR, got %s", ErrInvalidModel, a.Type)
+	}
+
+	out := make([]int, a.Count)
+	if len(data) == 0 {
+		return out, nil
+	}
+
+	for i := range out {
+		b := data[i*stride:]
+		switch size {
+		case 1:
+			out[i] = int(b[0])
+		case 2:
+			out[i] = int(binary.LittleEndian.Uint16(b))
+		case 4:
+			out[i] = int(binary.LittleEndian.Uint32(b))
+		}
+	}
+
+	return out, nil
+}
+
+func componentSize(componentType int) int {
+	switch componentType {
+	case 5120, 5121:
+		return 1
+	case 5122, 5123:
+		return 2
+	case 5125, 5126:
+		return 4
+	default:
+		return 0
+	}
+}
+
+func readComponent(b []byte, componentType int, normalized bool) float64 {
+	switch componentType {
+	case 5126:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	case 5120:
+		v := float64(int8(b[0]))
+		if normalized {
+			return math.Max(v/127, -1)
+		}
+		return v
+	case 5121:
+		v := float64(b[0])
+		if normalized {
+			return v / 255
+		}
+		return v
+	case 5122:
+		v := float64(int16(binary.LittleEndian.Uint16(b)))
+		if normalized {
+			return math.Max(v/32767, -1)
+		}
+		return v
+	case 5123:
+		v := float64(binary.LittleEndian.Uint16(b))
+		if normalized {
+			return v / 65535
+		}
+		return v
+	case 5125:
+		return float64(binary.LittleEndian.Uint32(b))
+	default:
+		return 0
+	}
+}
+
+func (m *Model) include(p vec3) {
+	if !m.bounded {
+		m.min, m.max, m.bounded = p, p, true
+		return
+	}
+	for i := 0; i < 3; i++ {
+		m.min[i] = math.Min(m.min[i], p[i])
+		m.max[i] = math.Max(m.max[i], p[i])
+	}
+}
+
+func (n node) transform() mat4 {
+	if len(n.Matrix) == 16 {
+		var m mat4
+		copy(m[:], n.Matrix)
+		return m
+	}
+
+	t := vec3{}
+	if len(n.Translation) == 3 {
+		t = vec3{n.Translation[0], n.Translation[1], n.Translation[2]}
+	}
+
+	q := [4]float64{0, 0, 0, 1}
+	if len(n.Rotation) == 4 {
+		copy(q[:], n.Rotation)
+	}
+
+	s := vec3{1, 1, 1}
+	if len(n.Scale) == 3 {
+		s = vec3{n.Scale[0], n.Scale[1], n.Scale[2]}
+	}
+
+	return fromTRS(t, q, s)
+}
@@ -0,0 +1,139 @@
+package gltf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// quad returns a glTF model of a 2x2 square made of two triangles, translated by one unit along x
+func quad() []byte {
+	buf := new(bytes.Buffer)
+	for _, v := range []float32{-1, -1, 0, 1, -1, 0, 1, 1, 0, -1, 1, 0} {
+		binary.Write(buf, binary.LittleEndian, math.Float32bits(v))
+	}
+	for _, i := range []uint16{0, 1, 2, 0, 2, 3} {
+		binary.Write(buf, binary.LittleEndian, i)
+	}
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+	return []byte(fmt.Sprintf(`{
+		"asset": {"version": "2.0"},
+		"scenes": [{"nodes": [0]}],
+		"nodes": [{"mesh": 0, "translation": [1, 0, 0]}],
+		"meshes": [{"primitives": [{"attributes": {"POSITION": 0}, "indices": 1}]}],
+		"accessors": [
+			{"bufferView": 0, "componentType": 5126, "count": 4, "type": "VEC3"},
+			{"bufferView": 1, "componentType": 5123, "count": 6, "type": "SCALAR"}
+		],
+		"bufferViews": [
+			{"buffer": 0, "byteOffset": 0, "byteLength": 48},
+			{"buffer": 0, "byteOffset": 48, "byteLength": 12}
+		],
+		"buffers": [{"byteLength": 60, "uri": "data:application/octet-stream;base64,%s"}]
+	}`, data))
+}
+
+func TestParse(t *testing.T) {
+	m, err := Parse(quad(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, m.PolyCount())
+	assert.Equal(t, 4, m.VertexCount())
+
+	lo, hi := m.BoundingBox()
+	assert.Equal(t, [3]float64{0, -1, 0}, lo)
+	assert.Equal(t, [3]float64{2, 1, 0}, hi)
+
+	_, err = Parse([]byte(`{"asset": {"version": "2.0"}}`), nil)
+	assert.ErrorIs(t, err, ErrNoGeometry)
+}
+
+func TestParseMalformedAccessors(t *testing.T) {
+	// model has a single triangle whose positions are read through the given accessor and buffer view
+	model := func(accessor, bufferView string) []byte {
+		data := base64.StdEncoding.EncodeToString(make([]byte, 36))
+		return []byte(fmt.Sprintf(`{
+			"asset": {"version": "2.0"},
+			"scenes": [{"nodes": [0]}],
+			"nodes": [{"mesh": 0}],
+			"meshes": [{"primitives": [{"attributes": {"POSITION": 0}}]}],
+			"accessors": [%s],
+			"bufferViews": [%s],
+			"buffers": [{"byteLength": 36, "uri": "data:application/octet-stream;base64,%s"}]
+		}`, accessor, bufferView, data))
+	}
+
+	tests := []struct {
+		name       string
+		accessor   string
+		bufferView string
+	}{
+		{
+			name:       "negative count",
+			accessor:   `{"bufferView": 0, "componentType": 5126, "count": -3, "type": "VEC3"}`,
+			bufferView: `{"buffer": 0, "byteLength": 36}`,
+		},
+		{
+			name:       "negative stride",
+			accessor:   `{"bufferView": 0, "componentType": 5126, "count": 3, "type": "VEC3"}`,
+			bufferView: `{"buffer": 0, "byteLength": 36, "byteStride": -12}`,
+		},
+		{
+			name:       "negative accessor offset",
+			accessor:   `{"bufferView": 0, "byteOffset": -12, "componentType": 5126, "count": 3, "type": "VEC3"}`,
+			bufferView: `{"buffer": 0, "byteLength": 36}`,
+		},
+		{
+			name:       "negative buffer view offset",
+			accessor:   `{"bufferView": 0, "componentType": 5126, "count": 3, "type": "VEC3"}`,
+			bufferView: `{"buffer": 0, "byteOffset": -12, "byteLength": 36}`,
+		},
+		{
+			name:       "offset overflow",
+			accessor:   fmt.Sprintf(`{"bufferView": 0, "byteOffset": %d, "componentType": 5126, "count": 3, "type": "VEC3"}`, math.MaxInt),
+			bufferView: `{"buffer": 0, "byteOffset": 12, "byteLength": 36}`,
+		},
+		{
+			name:       "count overflow",
+			accessor:   fmt.Sprintf(`{"bufferView": 0, "componentType": 5126, "count": %d, "type": "VEC3"}`, math.MaxInt/4),
+			bufferView: `{"buffer": 0, "byteLength": 36, "byteStride": 16}`,
+		},
+		{
+			name:       "past the end of the buffer view",
+			accessor:   `{"bufferView": 0, "byteOffset": 12, "componentType": 5126, "count": 3, "type": "VEC3"}`,
+			bufferView: `{"buffer": 0, "byteLength": 36}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(model(tt.accessor, tt.bufferView), nil)
+			assert.ErrorIs(t, err, ErrInvalidModel)
+		})
+	}
+
+	// The same model with a well-formed accessor parses
+	m, err := Parse(model(`{"bufferView": 0, "componentType": 5126, "count": 3, "type": "VEC3"}`, `{"buffer": 0, "byteLength": 36}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.PolyCount())
+}
+
+func TestRender(t *testing.T) {
+	m, err := Parse(quad(), nil)
+	assert.NoError(t, err)
+
+	background := color.RGBA{255, 255, 255, 255}
+	img := m.Render(RenderOptions{Width: 64, Height: 64, Background: background, Supersample: 2})
+	assert.Equal(t, 64, img.Rect.Dx())
+	assert.NotEqual(t, background, img.RGBAAt(32, 32))
+	assert.Equal(t, background, img.RGBAAt(0, 0))
+
+	// Viewed edge-on, the square covers almost nothing
+	img = m.Render(RenderOptions{Width: 64, Height: 64, Background: background, Yaw: math.Pi / 2})
+	assert.Equal(t, background, img.RGBAAt(20, 32))
+}
@@ -0,0 +1,61 @@
+package gltf
+
+import "math"
+
+type vec3 [3]float64
+
+func (a vec3) sub(b vec3) vec3 { return vec3{a[0] - b[0], a[1] - b[1], a[2] - b[2]} }
+func (a vec3) dot(b vec3) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+func (a vec3) cross(b vec3) vec3 {
+	return vec3{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+func (a vec3) length() float64 { return math.Sqrt(a.dot(a)) }
+func (a vec3) normalize() vec3 {
+	l := a.length()
+	if l == 0 {
+		return a
+	}
+	return vec3{a[0] / l, a[1] / l, a[2] / l}
+}
+
+// mat4 is a 4x4 matrix in column-major order, which is how glTF stores matrices
+type mat4 [16]float64
+
+func identity() mat4 {
+	return mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}
+}
+
+func (m mat4) mul(o mat4) mat4 {
+	var r mat4
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			var sum float64
+			for k := 0; k < 4; k++ {
+				sum += m[k*4+row] * o[col*4+k]
+			}
+			r[col*4+row] = sum
+		}
+	}
+	return r
+}
+
+func (m mat4) apply(p vec3) vec3 {
+	return vec3{
+		m[0]*p[0] + m[4]*p[1] + m[8]*p[2] + m[12],
+		m[1]*p[0] + m[5]*p[1] + m[9]*p[2] + m[13],
+		m[2]*p[0] + m[6]*p[1] + m[10]*p[2] + m[14],
+	}
+}
+
+// fromTRS returns the matrix of a translation, rotation quaternion (x, y, z, w) and scale, applied in scale, rotation, translation order
+func fromTRS(t vec3, q [4]float64, s vec3) mat4 {
+	x, y, z, w := q[0], q[1], q[2], q[3]
+	return mat4{
+		(1 - 2*(y*y+z*z)) * s[0], 2 * (x*y + z*w) * s[0], 2 * (x*z - y*w) * s[0], 0,
+		2 * (x*y - z*w) * s[1], (1 - 2*(x*x+z*z)) * s[1], 2 * (y*z + x*w) * s[1], 0,
+		2 * (x*z + y*w) * s[2], 2 * (y*z - x*w) * s[2], (1 - 2*(x*x+y*y)) * s[2], 0,
+		t[0], t[1], t[2], 1,
+	}
+}
@@ -0,0 +1,175 @@
+package gltf
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+const fieldOfView = 40 * math.Pi / 180
+
+// lightDir points from the model towards the light, in camera space
+var lightDir = vec3{-0.4, 0.6, 0.7}.normalize()
+
+// RenderOptions configures how a model is rendered
+type RenderOptions struct {
+	Width  int
+	Height int
+	// Yaw rotates the model around its vertical axis, in radians
+	Yaw float64
+	// Pitch tilts the model towards the camera, in radians
+	Pitch      float64
+	Background color.RGBA
+	// Supersample renders at a multiple of the output size and scales down to smooth edges
+	Supersample int
+}
+
+// Render draws the model with flat shading and a single light, framed so the whole model is in view
+func (m *Model) Render(opts RenderOptions) *image.RGBA {
+	scale := opts.Supersample
+	if scale < 1 {
+		scale = 1
+	}
+
+	w, h := opts.Width*scale, opts.Height*scale
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = opts.Background.R, opts.Background.G, opts.Background.B, opts.Background.A
+	}
+
+	// Frame the bounding sphere of the model
+	center := vec3{(m.min[0] + m.max[0]) / 2, (m.min[1] + m.max[1]) / 2, (m.min[2] + m.max[2]) / 2}
+	radius := m.max.sub(center).length()
+	if radius == 0 {
+		radius = 1
+	}
+	distance := radius / math.Sin(fieldOfView/2)
+	focal := float64(minInt(w, h)) / 2 / math.Tan(fieldOfView/2)
+
+	sinYaw, cosYaw := math.Sincos(opts.Yaw)
+	sinPitch, cosPitch := math.Sincos(opts.Pitch)
+	toCamera := func(p vec3) vec3 {
+		p = p.sub(center)
+		x := p[0]*cosYaw + p[2]*sinYaw
+		z := -p[0]*sinYaw + p[2]*cosYaw
+		y := p[1]*cosPitch - z*sinPitch
+		z = p[1]*sinPitch + z*cosPitch
+		return vec3{x, y, z - distance}
+	}
+
+	// depth holds 1/z of the closest surface at each pixel, so larger values are closer
+	depth := make([]float64, w*h)
+
+	for _, t := range m.triangles {
+		var cam [3]vec3
+		var screen [3][2]float64
+		for i, v := range t.v {
+			cam[i] = toCamera(v)
+			screen[i] = [2]float64{
+				float64(w)/2 + focal*cam[i][0]/-cam[i][2],
+				float64(h)/2 - focal*cam[i][1]/-cam[i][2],
+			}
+		}
+
+		// Lighting is two-sided because models often have inconsistent winding
+		normal := cam[1].sub(cam[0]).cross(cam[2].sub(cam[0])).normalize()
+		intensity := 0.35 + 0.65*math.Abs(normal.dot(lightDir))
+		shade := color.RGBA{
+			R: toByte(t.color[0] * intensity),
+			G: toByte(t.color[1] * intensity),
+			B: toByte(t.color[2] * intensity),
+			A: 255,
+		}
+
+		rasterize(img, depth, screen, [3]float64{1 / -cam[0][2], 1 / -cam[1][2], 1 / -cam[2][2]}, shade)
+	}
+
+	if scale == 1 {
+		return img
+	}
+
+	return downsample(img, scale)
+}
+
+func rasterize(img *image.RGBA, depth []float64, s [3][2]float64, invZ [3]float64, c color.RGBA) {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+
+	area := edge(s[0], s[1], s[2])
+	if area == 0 {
+		return
+	}
+
+	minX := maxInt(0, int(math.Floor(math.Min(s[0][0], math.Min(s[1][0], s[2][0])))))
+	maxX := minInt(w-1, int(math.Ceil(math.Max(s[0][0], math.Max(s[1][0], s[2][0])))))
+	minY := maxInt(0, int(math.Floor(math.Min(s[0][1], math.Min(s[1][1], s[2][1])))))
+	maxY := minInt(h-1, int(math.Ceil(math.Max(s[0][1], math.Max(s[1][1], s[2][1])))))
+
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			p := [2]float64{float64(x) + 0.5, float64(y) + 0.5}
+			w0 := edge(s[1], s[2], p) / area
+			w1 := edge(s[2], s[0], p) / area
+			w2 := edge(s[0], s[1], p) / area
+			if w0 < 0 || w1 < 0 || w2 < 0 {
+				continue
+			}
+
+			z := w0*invZ[0] + w1*invZ[1] + w2*invZ[2]
+			i := y*w + x
+			if z <= depth[i] {
+				continue
+			}
+			depth[i] = z
+
+			o := img.PixOffset(x, y)
+			img.Pix[o], img.Pix[o+1], img.Pix[o+2], img.Pix[o+3] = c.R, c.G, c.B, c.A
+		}
+	}
+}
+
+func edge(a, b, p [2]float64) float64 {
+	return (b[0]-a[0])*(p[1]-a[1]) - (b[1]-a[1])*(p[0]-a[0])
+}
+
+// downsample shrinks img by factor, averaging each block of pixels
+func downsample(img *image.RGBA, factor int) *image.RGBA {
+	w, h := img.Rect.Dx()/factor, img.Rect.Dy()/factor
+	out := image.NewRGBA(image.Rect(0, 0, w, h))
+	n := factor * factor
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var sum [4]int
+			for dy := 0; dy < factor; dy++ {
+				for dx := 0; dx < factor; dx++ {
+					o := img.PixOffset(x*factor+dx, y*factor+dy)
+					for c := 0; c < 4; c++ {
+						sum[c] += int(img.Pix[o+c])
+					}
+				}
+			}
+			o := out.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				out.Pix[o+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return out
+}
+
+func toByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
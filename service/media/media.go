@@ -2,6 +2,7 @@ package media
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/xml"
 	"errors"
 	"net/http"
@@ -32,6 +33,7 @@ var postfixesToMediaTypes = map[string]mediaWithContentType{
 	"webm": {persist.MediaTypeVideo, "video/webm"},
 	"glb":  {persist.MediaTypeAnimation, "model/gltf-binary"},
 	"gltf": {persist.MediaTypeAnimation, "model/gltf+json"},
+	"usdz": {persist.MediaTypeAnimation, "model/vnd.usdz+zip"},
 	"svg":  {persist.MediaTypeImage, "image/svg+xml"},
 	"pdf":  {persist.MediaTypePDF, "application/pdf"},
 	"html": {persist.MediaTypeHTML, "text/html"},
@@ -195,9 +197,40 @@ func SniffMediaType(buf []byte) (persist.MediaType, string) {
 			return persist.MediaTypeAnimation, "model/gltf+json"
 		}
 	}
+	if contentType == "application/zip" && isUSDZ(buf) {
+		return persist.MediaTypeAnimation, "model/vnd.usdz+zip"
+	}
 	return MediaFromContentType(contentType), contentType
 }
 
+// isUSDZ returns true if buf is the start of a USDZ archive. USDZ files are uncompressed zip archives whose first file is a USD file.
+func isUSDZ(buf []byte) bool {
+	// The name of the first file follows the 30 byte local file header
+	if len(buf) < 30 {
+		return false
+	}
+	nameLen := int(binary.LittleEndian.Uint16(buf[26:28]))
+	if len(buf) < 30+nameLen {
+		return false
+	}
+	name := strings.ToLower(string(buf[30 : 30+nameLen]))
+	return strings.HasSuffix(name, ".usdc") || strings.HasSuffix(name, ".usda") || strings.HasSuffix(name, ".usd")
+}
+
+// ModelFormatFromContentType returns the format of a 3D model from its content type, and false if the content type isn't a 3D model
+func ModelFormatFromContentType(contentType string) (persist.ModelFormat, bool) {
+	switch strings.TrimSpace(strings.Split(contentType, ";")[0]) {
+	case "model/gltf-binary", "model/gltf+binary":
+		return persist.ModelFormatGLB, true
+	case "model/gltf+json":
+		return persist.ModelFormatGLTF, true
+	case "model/vnd.usdz+zip", "model/usd":
+		return persist.ModelFormatUSDZ, true
+	default:
+		return "", false
+	}
+}
+
 // MediaFromContentType will attempt to convert a content type to a media type
 func MediaFromContentType(contentType string) persist.MediaType {
 	contentType = strings.TrimSpace(contentType)
@@ -228,6 +261,8 @@ func MediaFromContentType(contentType string) persist.MediaType {
 		return persist.MediaTypeVideo
 	case "audio":
 		return persist.MediaTypeAudio
+	case "model":
+		return persist.MediaTypeAnimation
 	case "text":
 		switch subType {
 		case "html":
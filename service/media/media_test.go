@@ -0,0 +1,29 @@
+package media
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+func TestSniffMediaTypeUSDZ(t *testing.T) {
+	archive := func(name string) []byte {
+		buf := new(bytes.Buffer)
+		w := zip.NewWriter(buf)
+		f, _ := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		f.Write([]byte("PXR-USDC"))
+		w.Close()
+		return buf.Bytes()
+	}
+
+	mediaType, contentType := SniffMediaType(archive("model.usdc"))
+	assert.Equal(t, persist.MediaTypeAnimation, mediaType)
+	assert.Equal(t, "model/vnd.usdz+zip", contentType)
+
+	mediaType, _ = SniffMediaType(archive("readme.txt"))
+	assert.NotEqual(t, persist.MediaTypeAnimation, mediaType)
+}
@@ -179,21 +179,7 @@ type Provider struct {
 
 // NewProvider creates a new ethereum Provider
 func NewProvider(httpClient *http.Client, chain persist.Chain) *Provider {
-	// currently using v2 endpoints, alchemy recently added v3
-	var apiURL string
-	switch chain {
-	case persist.ChainETH:
-		apiURL = env.GetString("ALCHEMY_API_URL")
-	case persist.ChainOptimism:
-		apiURL = env.GetString("ALCHEMY_OPTIMISM_API_URL")
-	case persist.ChainPolygon:
-		apiURL = env.GetString("ALCHEMY_POLYGON_API_URL")
-	case persist.ChainArbitrum:
-		apiURL = env.GetString("ALCHEMY_ARBITRUM_API_URL")
-	case persist.ChainBase:
-		apiURL = env.GetString("ALCHEMY_BASE_API_URL")
-	}
-
+	apiURL := apiURLForChain(chain)
 	if apiURL == "" {
 		panic(fmt.Sprintf("no alchemy api url set for chain %s", chain))
 	}
@@ -205,6 +191,29 @@ func NewProvider(httpClient *http.Client, chain persist.Chain) *Provider {
 	}
 }
 
+// IsConfigured returns true if an api url is set for the chain
+func IsConfigured(chain persist.Chain) bool {
+	return apiURLForChain(chain) != ""
+}
+
+func apiURLForChain(chain persist.Chain) string {
+	// currently using v2 endpoints, alchemy recently added v3
+	switch chain {
+	case persist.ChainETH:
+		return env.GetString("ALCHEMY_API_URL")
+	case persist.ChainOptimism:
+		return env.GetString("ALCHEMY_OPTIMISM_API_URL")
+	case persist.ChainPolygon:
+		return env.GetString("ALCHEMY_POLYGON_API_URL")
+	case persist.ChainArbitrum:
+		return env.GetString("ALCHEMY_ARBITRUM_API_URL")
+	case persist.ChainBase:
+		return env.GetString("ALCHEMY_BASE_API_URL")
+	default:
+		return ""
+	}
+}
+
 // GetTokensByWalletAddress retrieves tokens for a wallet address on the Ethereum Blockchain
 func (d *Provider) GetTokensByWalletAddress(ctx context.Context, addr persist.Address) ([]common.ChainAgnosticToken, []common.ChainAgnosticContract, error) {
 	u := mustGetNftsEndpoint(d.alchemyAPIURL)
@@ -0,0 +1,119 @@
+package multichain
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/multichain/common"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// checkpointOverlap is how far before a checkpoint an incremental sync starts from
+const checkpointOverlap = 10 * time.Minute
+
+type walletChain struct {
+	WalletID persist.DBID
+	Chain    persist.Chain
+}
+
+type syncedWallet struct {
+	Checkpoint common.SyncCheckpoint
+	FullSync   bool
+}
+
+// syncedWallets tracks the wallets that were synced successfully
+type syncedWallets struct {
+	mu      sync.Mutex
+	wallets map[walletChain]syncedWallet
+}
+
+func (s *syncedWallets) add(key walletChain, checkpoint common.SyncCheckpoint, fullSync bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.wallets == nil {
+		s.wallets = make(map[walletChain]syncedWallet)
+	}
+	s.wallets[key] = syncedWallet{Checkpoint: checkpoint, FullSync: fullSync}
+}
+
+// checkpointIsStale returns true if a wallet hasn't had a full sync within MULTICHAIN_FULL_SYNC_INTERVAL. Incremental syncs can
+// drift from what the wallet actually holds (e.g. if a provider missed a transfer), so wallets are periodically fully synced.
+func checkpointIsStale(checkpoint db.WalletSyncCheckpoint) bool {
+	if !checkpoint.FullSyncedAt.Valid {
+		return true
+	}
+	return time.Since(checkpoint.FullSyncedAt.Time) > env.GetDuration("MULTICHAIN_FULL_SYNC_INTERVAL")
+}
+
+// walletWithAddress returns the user's wallet that matches the address on the given chain
+func walletWithAddress(wallets []persist.Wallet, chain persist.Chain, address persist.Address) (persist.Wallet, bool) {
+	for _, wallet := range wallets {
+		if wallet.Chain != chain && !util.Contains(chain.L1ChainGroup(), wallet.Chain) {
+			continue
+		}
+		if chain.NormalizeAddress(wallet.Address) == chain.NormalizeAddress(address) {
+			return wallet, true
+		}
+	}
+	return persist.Wallet{}, false
+}
+
+func (p *Provider) walletSyncCheckpoints(ctx context.Context, userID persist.DBID, chains []persist.Chain) (map[walletChain]db.WalletSyncCheckpoint, error) {
+	checkpoints, err := p.Queries.GetWalletSyncCheckpointsByUserID(ctx, db.GetWalletSyncCheckpointsByUserIDParams{
+		OwnerUserID: userID,
+		Chains:      util.MapWithoutError(chains, func(c persist.Chain) int32 { return int32(c) }),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[walletChain]db.WalletSyncCheckpoint, len(checkpoints))
+	for _, c := range checkpoints {
+		result[walletChain{WalletID: c.WalletID, Chain: c.Chain}] = c
+	}
+	return result, nil
+}
+
+// saveWalletSyncCheckpoints saves a checkpoint for each wallet that was synced so that the next sync can resume from syncStart
+func (p *Provider) saveWalletSyncCheckpoints(ctx context.Context, userID persist.DBID, syncStart time.Time, synced *syncedWallets) error {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	for key, w := range synced.wallets {
+		err := p.Queries.UpsertWalletSyncCheckpoint(ctx, db.UpsertWalletSyncCheckpointParams{
+			ID:             persist.GenerateID(),
+			WalletID:       key.WalletID,
+			OwnerUserID:    userID,
+			Chain:          key.Chain,
+			BlockNumber:    sql.NullInt64{Int64: int64(w.Checkpoint.BlockNumber), Valid: w.Checkpoint.BlockNumber > 0},
+			ProviderCursor: util.ToNullString(w.Checkpoint.Cursor, true),
+			SyncedAt:       syncStart,
+			IsFullSync:     w.FullSync,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeWalletFromTokens removes a wallet from the owners of the user's tokens. Tokens that are no longer owned by any
+// of the user's wallets are no longer considered held by the user.
+func (p *Provider) removeWalletFromTokens(ctx context.Context, user persist.User, chain persist.Chain, walletID persist.DBID, tokens []common.ChainAgnosticIdentifiers) error {
+	removed, err := p.Queries.RemoveWalletFromTokensByIdentifiers(ctx, db.RemoveWalletFromTokensByIdentifiersParams{
+		WalletID:          walletID.String(),
+		OwnerUserID:       user.ID,
+		Chain:             chain,
+		ContractAddresses: util.MapWithoutError(tokens, func(t common.ChainAgnosticIdentifiers) string { return chain.NormalizeAddress(t.ContractAddress) }),
+		TokenIds:          util.MapWithoutError(tokens, func(t common.ChainAgnosticIdentifiers) string { return t.TokenID.String() }),
+	})
+	if err != nil {
+		return err
+	}
+	logger.For(ctx).Infof("removed wallet=%s from %d token(s) on chain=%s", walletID, removed, chain)
+	return nil
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mikeydub/go-gallery/service/persist"
 )
@@ -47,6 +48,12 @@ type TokensIncrementalContractFetcher interface {
 	GetTokensIncrementallyByContractAddress(ctx context.Context, address persist.Address, maxLimit int) (<-chan ChainAgnosticTokensAndContracts, <-chan error)
 }
 
+// TokensChangedSinceFetcher supports fetching only the tokens of a wallet that changed since a previous sync
+type TokensChangedSinceFetcher interface {
+	// NOTE: implementations MUST close the rec channel
+	GetTokensChangedSinceByWalletAddress(ctx context.Context, address persist.Address, since SyncCheckpoint) (<-chan ChainAgnosticTokenChanges, <-chan error)
+}
+
 type ContractFetcher interface {
 	GetContractByAddress(ctx context.Context, contract persist.Address) (ChainAgnosticContract, error)
 }
@@ -82,6 +89,8 @@ type ChainAgnosticToken struct {
 	ExternalURL     string                        `json:"external_url"`
 	BlockNumber     persist.BlockNumber           `json:"block_number"`
 	IsSpam          *bool                         `json:"is_spam"`
+	// AcquiredAt is when the owner first acquired the token, if the provider reports it
+	AcquiredAt time.Time `json:"acquired_at"`
 }
 
 // ChainAgnosticContract is a contract that is agnostic to the chain it is on
@@ -97,6 +106,25 @@ type ChainAgnosticTokensAndContracts struct {
 	Contracts []ChainAgnosticContract `json:"contracts"`
 }
 
+// SyncCheckpoint is where a previous sync of a wallet left off
+type SyncCheckpoint struct {
+	BlockNumber persist.BlockNumber `json:"block_number"`
+	// Cursor is an opaque, provider specific position in the wallet's history
+	Cursor   string    `json:"cursor"`
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// ChainAgnosticTokenChanges is a page of changes to a wallet's tokens
+type ChainAgnosticTokenChanges struct {
+	// Tokens and Contracts are tokens that the wallet currently holds that changed since the checkpoint
+	Tokens    []ChainAgnosticToken    `json:"tokens"`
+	Contracts []ChainAgnosticContract `json:"contracts"`
+	// Removed are tokens that the wallet no longer holds
+	Removed []ChainAgnosticIdentifiers `json:"removed"`
+	// Checkpoint is where the next sync should resume from once this page is processed
+	Checkpoint SyncCheckpoint `json:"checkpoint"`
+}
+
 // ChainAgnosticTokenDescriptors are the fields that describe a token but cannot be used to uniquely identify it
 type ChainAgnosticTokenDescriptors struct {
 	Name        string `json:"name"`
@@ -16,6 +16,7 @@ type ChainProvider struct {
 	Zora     *ZoraProvider
 	Base     *BaseProvider
 	Polygon  *PolygonProvider
+	Solana   *SolanaProvider
 }
 
 type EthereumProvider struct {
@@ -27,6 +28,7 @@ type EthereumProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 	common.Verifier
@@ -41,6 +43,7 @@ type TezosProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 	common.Verifier
@@ -55,6 +58,7 @@ type OptimismProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 }
@@ -68,6 +72,7 @@ type ArbitrumProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 }
@@ -88,6 +93,7 @@ type ZoraProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 }
@@ -101,6 +107,7 @@ type BaseProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 }
@@ -114,6 +121,15 @@ type PolygonProvider struct {
 	common.TokenMetadataFetcher
 	common.TokensByContractWalletFetcher
 	common.TokensByTokenIdentifiersFetcher
+	common.TokensChangedSinceFetcher
 	common.TokensIncrementalContractFetcher
 	common.TokensIncrementalOwnerFetcher
 }
+
+type SolanaProvider struct {
+	common.TokenDescriptorsFetcher
+	common.TokenIdentifierOwnerFetcher
+	common.TokenMetadataFetcher
+	common.TokensIncrementalOwnerFetcher
+	common.Verifier
+}
@@ -11,11 +11,14 @@ import (
 	"github.com/google/wire"
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/eth"
+	"github.com/mikeydub/go-gallery/service/multichain/alchemy"
 	"github.com/mikeydub/go-gallery/service/multichain/common"
 	"github.com/mikeydub/go-gallery/service/multichain/custom"
 	"github.com/mikeydub/go-gallery/service/multichain/poap"
 	"github.com/mikeydub/go-gallery/service/multichain/simplehash"
+	"github.com/mikeydub/go-gallery/service/multichain/solana"
 	"github.com/mikeydub/go-gallery/service/multichain/tezos"
 	"github.com/mikeydub/go-gallery/service/multichain/wrapper"
 	"github.com/mikeydub/go-gallery/service/persist"
@@ -44,6 +47,7 @@ func NewMultichainProvider(context.Context, *postgres.Repositories, *db.Queries,
 		baseInjector,
 		polygonInjector,
 		arbitrumInjector,
+		solanaInjector,
 	))
 }
 
@@ -66,9 +70,20 @@ func newProviderLookup(p *ChainProvider) ProviderLookup {
 		persist.ChainZora:     p.Zora,
 		persist.ChainBase:     p.Base,
 		persist.ChainPolygon:  p.Polygon,
+		persist.ChainSolana:   p.Solana,
 	}
 }
 
+// evmFailoverInjector fails over to Alchemy when SimpleHash is unavailable. Alchemy is only used if it's configured for the chain.
+func evmFailoverInjector(chain persist.Chain, httpClient *http.Client, simplehashProvider *simplehash.Provider) *wrapper.FailoverWrapper {
+	sources := []wrapper.Source{{Name: "simplehash", Fetcher: simplehashProvider}}
+	if alchemy.IsConfigured(chain) {
+		sources = append(sources, wrapper.Source{Name: "alchemy", Fetcher: alchemy.NewProvider(httpClient, chain)})
+	}
+	health := wrapper.NewHealth(env.GetInt("MULTICHAIN_FAILURE_THRESHOLD"), env.GetDuration("MULTICHAIN_FAILURE_COOLDOWN"))
+	return wrapper.NewFailoverWrapper(chain, health, env.GetInt("MULTICHAIN_PROVIDER_QUORUM"), sources...)
+}
+
 func customMetadataHandlersInjector(ethCleint *ethclient.Client) *custom.CustomMetadataHandlers {
 	panic(wire.Build(
 		custom.NewCustomMetadataHandlers,
@@ -84,6 +99,7 @@ func ethInjector(context.Context, *http.Client, *ethclient.Client) *EthereumProv
 		ethSyncPipelineInjector,
 		ethVerifierInjector,
 		simplehash.NewProvider,
+		evmFailoverInjector,
 	))
 }
 
@@ -95,19 +111,20 @@ func ethProviderInjector(
 	ctx context.Context,
 	syncPipeline *wrapper.SyncPipelineWrapper,
 	verifier *eth.Verifier,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 ) *EthereumProvider {
 	panic(wire.Build(
 		wire.Struct(new(EthereumProvider), "*"),
-		wire.Bind(new(common.ContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.ContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(failoverWrapper)),
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.Verifier), util.ToPointer(verifier)),
 	))
@@ -117,17 +134,18 @@ func ethSyncPipelineInjector(
 	ctx context.Context,
 	httpClient *http.Client,
 	chain persist.Chain,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *wrapper.SyncPipelineWrapper {
 	panic(wire.Build(
 		wire.Struct(new(wrapper.SyncPipelineWrapper), "*"),
-		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(failoverWrapper)),
 		customMetadataHandlersInjector,
 	))
 }
@@ -155,6 +173,7 @@ func tezosProviderInjector(tezosProvider *tezos.Provider, simplehashProvider *si
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
 	))
 }
@@ -163,6 +182,7 @@ func optimismInjector(context.Context, *http.Client, *ethclient.Client) *Optimis
 	panic(wire.Build(
 		wire.Value(persist.ChainOptimism),
 		simplehash.NewProvider,
+		evmFailoverInjector,
 		optimismProviderInjector,
 		optimismSyncPipelineInjector,
 	))
@@ -170,19 +190,20 @@ func optimismInjector(context.Context, *http.Client, *ethclient.Client) *Optimis
 
 func optimismProviderInjector(
 	syncPipeline *wrapper.SyncPipelineWrapper,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 ) *OptimismProvider {
 	panic(wire.Build(
 		wire.Struct(new(OptimismProvider), "*"),
-		wire.Bind(new(common.ContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.ContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(failoverWrapper)),
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 	))
 }
@@ -191,17 +212,18 @@ func optimismSyncPipelineInjector(
 	ctx context.Context,
 	httpClient *http.Client,
 	chain persist.Chain,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *wrapper.SyncPipelineWrapper {
 	panic(wire.Build(
 		wire.Struct(new(wrapper.SyncPipelineWrapper), "*"),
-		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(failoverWrapper)),
 		customMetadataHandlersInjector,
 	))
 }
@@ -210,6 +232,7 @@ func arbitrumInjector(context.Context, *http.Client, *ethclient.Client) *Arbitru
 	panic(wire.Build(
 		wire.Value(persist.ChainArbitrum),
 		simplehash.NewProvider,
+		evmFailoverInjector,
 		arbitrumProviderInjector,
 		arbitrumSyncPipelineInjector,
 	))
@@ -217,19 +240,20 @@ func arbitrumInjector(context.Context, *http.Client, *ethclient.Client) *Arbitru
 
 func arbitrumProviderInjector(
 	syncPipeline *wrapper.SyncPipelineWrapper,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 ) *ArbitrumProvider {
 	panic(wire.Build(
 		wire.Struct(new(ArbitrumProvider), "*"),
-		wire.Bind(new(common.ContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.ContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(failoverWrapper)),
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 	))
 }
@@ -238,17 +262,18 @@ func arbitrumSyncPipelineInjector(
 	ctx context.Context,
 	httpClient *http.Client,
 	chain persist.Chain,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *wrapper.SyncPipelineWrapper {
 	panic(wire.Build(
 		wire.Struct(new(wrapper.SyncPipelineWrapper), "*"),
-		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(failoverWrapper)),
 		customMetadataHandlersInjector,
 	))
 }
@@ -294,6 +319,7 @@ func zoraProviderInjector(
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 	))
 }
@@ -310,6 +336,7 @@ func zoraSyncPipelineInjector(
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
@@ -321,6 +348,7 @@ func baseInjector(context.Context, *http.Client, *ethclient.Client) *BaseProvide
 	panic(wire.Build(
 		wire.Value(persist.ChainBase),
 		simplehash.NewProvider,
+		evmFailoverInjector,
 		baseProvidersInjector,
 		baseSyncPipelineInjector,
 	))
@@ -328,20 +356,21 @@ func baseInjector(context.Context, *http.Client, *ethclient.Client) *BaseProvide
 
 func baseProvidersInjector(
 	syncPipeline *wrapper.SyncPipelineWrapper,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *BaseProvider {
 	panic(wire.Build(
 		wire.Struct(new(BaseProvider), "*"),
-		wire.Bind(new(common.ContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.ContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(failoverWrapper)),
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 	))
 }
@@ -350,17 +379,18 @@ func baseSyncPipelineInjector(
 	ctx context.Context,
 	httpClient *http.Client,
 	chain persist.Chain,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *wrapper.SyncPipelineWrapper {
 	panic(wire.Build(
 		wire.Struct(new(wrapper.SyncPipelineWrapper), "*"),
-		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(failoverWrapper)),
 		customMetadataHandlersInjector,
 	))
 }
@@ -369,6 +399,7 @@ func polygonInjector(context.Context, *http.Client, *ethclient.Client) *PolygonP
 	panic(wire.Build(
 		wire.Value(persist.ChainPolygon),
 		simplehash.NewProvider,
+		evmFailoverInjector,
 		polygonProvidersInjector,
 		polygonSyncPipelineInjector,
 	))
@@ -376,21 +407,22 @@ func polygonInjector(context.Context, *http.Client, *ethclient.Client) *PolygonP
 
 func polygonProvidersInjector(
 	syncPipeline *wrapper.SyncPipelineWrapper,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *PolygonProvider {
 	panic(wire.Build(
 		wire.Struct(new(PolygonProvider), "*"),
-		wire.Bind(new(common.ContractFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.ContractFetcher), util.ToPointer(failoverWrapper)),
 		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(syncPipeline)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(syncPipeline)),
 		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(syncPipeline)),
-		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.ContractsCreatorFetcher), util.ToPointer(failoverWrapper)),
 	))
 }
 
@@ -398,17 +430,18 @@ func polygonSyncPipelineInjector(
 	ctx context.Context,
 	httpClient *http.Client,
 	chain persist.Chain,
-	simplehashProvider *simplehash.Provider,
+	failoverWrapper *wrapper.FailoverWrapper,
 	ethClient *ethclient.Client,
 ) *wrapper.SyncPipelineWrapper {
 	panic(wire.Build(
 		wire.Struct(new(wrapper.SyncPipelineWrapper), "*"),
-		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(simplehashProvider)),
-		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(simplehashProvider)),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensIncrementalContractFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensChangedSinceFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokenMetadataBatcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByContractWalletFetcher), util.ToPointer(failoverWrapper)),
+		wire.Bind(new(common.TokensByTokenIdentifiersFetcher), util.ToPointer(failoverWrapper)),
 		customMetadataHandlersInjector,
 	))
 }
@@ -419,3 +452,21 @@ func tokenProcessingSubmitterInjector(context.Context, *task.Client, *redis.Cach
 		wire.Struct(new(tokenmanage.Registry), "*"),
 	))
 }
+
+func solanaInjector(*http.Client) *SolanaProvider {
+	panic(wire.Build(
+		solanaProviderInjector,
+		solana.NewProvider,
+	))
+}
+
+func solanaProviderInjector(solanaProvider *solana.Provider) *SolanaProvider {
+	panic(wire.Build(
+		wire.Struct(new(SolanaProvider), "*"),
+		wire.Bind(new(common.TokenIdentifierOwnerFetcher), util.ToPointer(solanaProvider)),
+		wire.Bind(new(common.TokensIncrementalOwnerFetcher), util.ToPointer(solanaProvider)),
+		wire.Bind(new(common.TokenMetadataFetcher), util.ToPointer(solanaProvider)),
+		wire.Bind(new(common.TokenDescriptorsFetcher), util.ToPointer(solanaProvider)),
+		wire.Bind(new(common.Verifier), util.ToPointer(solanaProvider)),
+	))
+}
@@ -68,9 +68,13 @@ type chainTokensAndContracts struct {
 	Chain     persist.Chain
 	Tokens    []common.ChainAgnosticToken
 	Contracts []common.ChainAgnosticContract
+	// Removed are tokens that are no longer held by the wallet identified by WalletID
+	Removed  []common.ChainAgnosticIdentifiers
+	WalletID persist.DBID
 }
 
-// SyncTokensByUserID updates the media for all tokens for a user
+// SyncTokensByUserID updates the media for all tokens for a user. Wallets that have a recent checkpoint are synced incrementally
+// if the chain's provider can fetch the tokens that changed since the checkpoint, otherwise every token of the wallet is fetched.
 func (p *Provider) SyncTokensByUserID(ctx context.Context, userID persist.DBID, chains []persist.Chain) error {
 	ctx = logger.NewContextWithFields(ctx, logrus.Fields{"user_id": userID, "chains": chains})
 
@@ -84,6 +88,13 @@ func (p *Provider) SyncTokensByUserID(ctx context.Context, userID persist.DBID,
 		return nil
 	}
 
+	checkpoints, err := p.walletSyncCheckpoints(ctx, user.ID, chains)
+	if err != nil {
+		return err
+	}
+
+	syncStart := time.Now()
+	synced := &syncedWallets{}
 	recCh := make(chan chainTokensAndContracts, len(chains)*len(chainsToAddresses)*8)
 	errCh := make(chan error)
 	wg := &conc.WaitGroup{}
@@ -93,30 +104,34 @@ func (p *Provider) SyncTokensByUserID(ctx context.Context, userID persist.DBID,
 		if !ok {
 			continue
 		}
+		changesFetcher, canSyncChanges := p.Chains[c].(common.TokensChangedSinceFetcher)
 		for _, addr := range a {
 			addr := addr
 			chain := c
+			wallet, hasWallet := walletWithAddress(user.Wallets, chain, addr)
 			wg.Go(func() {
-				logger.For(ctx).Infof("syncing chain=%s; user=%s; wallet=%s", chain, user.Username.String(), addr)
-				pageCh, pageErrCh := fetcher.GetTokensIncrementallyByWalletAddress(ctx, addr)
-				for {
-					select {
-					case page, ok := <-pageCh:
-						if !ok {
-							return
-						}
-						recCh <- chainTokensAndContracts{
-							Chain:     chain,
-							Tokens:    page.Tokens,
-							Contracts: page.Contracts,
-						}
-					case err, ok := <-pageErrCh:
-						if !ok {
-							return
-						}
+				key := walletChain{WalletID: wallet.ID, Chain: chain}
+				if checkpoint, ok := checkpoints[key]; hasWallet && canSyncChanges && ok && !checkpointIsStale(checkpoint) {
+					logger.For(ctx).Infof("syncing changes chain=%s; user=%s; wallet=%s; since=%s", chain, user.Username.String(), addr, checkpoint.SyncedAt)
+					received, next, err := streamWalletTokenChanges(ctx, chain, wallet.ID, addr, changesFetcher, checkpoint, recCh)
+					if err == nil {
+						synced.add(key, next, false)
+						return
+					}
+					// Changes that were already sent can't be retracted, so only fall back to a full sync if nothing was sent
+					if received {
 						errCh <- ErrProviderFailed{Err: err}
 						return
 					}
+					logger.For(ctx).Warnf("failed to sync changes for wallet=%s, falling back to a full sync: %s", addr, err)
+				}
+				logger.For(ctx).Infof("syncing chain=%s; user=%s; wallet=%s", chain, user.Username.String(), addr)
+				if err := streamWalletTokens(ctx, chain, addr, fetcher, recCh); err != nil {
+					errCh <- ErrProviderFailed{Err: err}
+					return
+				}
+				if hasWallet {
+					synced.add(key, common.SyncCheckpoint{}, true)
 				}
 			})
 		}
@@ -129,7 +144,73 @@ func (p *Provider) SyncTokensByUserID(ctx context.Context, userID persist.DBID,
 	}()
 
 	_, _, err = p.addHolderTokensForUser(ctx, user, chains, recCh, errCh)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return p.saveWalletSyncCheckpoints(ctx, user.ID, syncStart, synced)
+}
+
+// streamWalletTokens sends every token held by a wallet to recCh
+func streamWalletTokens(ctx context.Context, chain persist.Chain, address persist.Address, fetcher common.TokensIncrementalOwnerFetcher, recCh chan<- chainTokensAndContracts) error {
+	pageCh, pageErrCh := fetcher.GetTokensIncrementallyByWalletAddress(ctx, address)
+	for {
+		select {
+		case page, ok := <-pageCh:
+			if !ok {
+				return nil
+			}
+			recCh <- chainTokensAndContracts{
+				Chain:     chain,
+				Tokens:    page.Tokens,
+				Contracts: page.Contracts,
+			}
+		case err, ok := <-pageErrCh:
+			if !ok {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// streamWalletTokenChanges sends the tokens of a wallet that changed since the checkpoint to recCh. It returns the checkpoint
+// to resume from on the next sync, and whether any changes were sent before an error occurred.
+func streamWalletTokenChanges(ctx context.Context, chain persist.Chain, walletID persist.DBID, address persist.Address, fetcher common.TokensChangedSinceFetcher, checkpoint db.WalletSyncCheckpoint, recCh chan<- chainTokensAndContracts) (bool, common.SyncCheckpoint, error) {
+	since := common.SyncCheckpoint{
+		BlockNumber: persist.BlockNumber(checkpoint.BlockNumber.Int64),
+		Cursor:      checkpoint.ProviderCursor.String,
+		// Providers can take a while to index a transfer, so look back a bit further than the last sync
+		SyncedAt: checkpoint.SyncedAt.Add(-checkpointOverlap),
+	}
+	next := since
+	var received bool
+
+	pageCh, pageErrCh := fetcher.GetTokensChangedSinceByWalletAddress(ctx, address, since)
+	for {
+		select {
+		case page, ok := <-pageCh:
+			if !ok {
+				return received, next, nil
+			}
+			received = true
+			next = page.Checkpoint
+			recCh <- chainTokensAndContracts{
+				Chain:     chain,
+				Tokens:    page.Tokens,
+				Contracts: page.Contracts,
+				Removed:   page.Removed,
+				WalletID:  walletID,
+			}
+		case err, ok := <-pageErrCh:
+			if !ok {
+				// Keep reading pages until the page channel is closed
+				pageErrCh = nil
+				continue
+			}
+			return received, next, err
+		}
+	}
 }
 
 // SyncCreatedTokensForNewContracts syncs tokens for contracts that the user created but does not currently have any tokens for.
@@ -314,6 +395,33 @@ outer:
 	return newTokens, err
 }
 
+// RemoveTokensFromUserUnchecked removes the owner wallet of each token from the user's tokens without checking with a provider
+// that the wallet no longer holds the token.
+func (p *Provider) RemoveTokensFromUserUnchecked(ctx context.Context, userID persist.DBID, tIDs []persist.TokenUniqueIdentifiers) error {
+	user, err := p.Repos.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	walletTokens := make(map[walletChain][]common.ChainAgnosticIdentifiers)
+	for _, t := range tIDs {
+		wallet, ok := walletWithAddress(user.Wallets, t.Chain, t.OwnerAddress)
+		if !ok {
+			return fmt.Errorf("token(chain=%s, contract=%s; tokenID=%s) requested owner address=%s, but address is not owned by user", t.Chain, t.ContractAddress, t.TokenID, t.OwnerAddress)
+		}
+		key := walletChain{WalletID: wallet.ID, Chain: t.Chain}
+		walletTokens[key] = append(walletTokens[key], common.ChainAgnosticIdentifiers{ContractAddress: t.ContractAddress, TokenID: t.TokenID})
+	}
+
+	for key, tokens := range walletTokens {
+		if err := p.removeWalletFromTokens(ctx, user, key.Chain, key.WalletID, tokens); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SyncTokensByUserIDAndTokenIdentifiers updates the media for specific tokens for a user
 func (p *Provider) SyncTokensByUserIDAndTokenIdentifiers(ctx context.Context, userID persist.DBID, tokenIdentifiers []persist.TokenUniqueIdentifiers) ([]op.TokenFullDetails, error) {
 	user, err := p.Repos.UserRepository.GetByID(ctx, userID)
@@ -661,6 +769,16 @@ func (p *Provider) receiveProviderData(ctx context.Context, user persist.User, r
 				return newTokens, currentContracts, nil
 			}
 
+			if len(page.Removed) > 0 {
+				err := p.removeWalletFromTokens(ctx, user, page.Chain, page.WalletID, page.Removed)
+				if err != nil {
+					return nil, nil, err
+				}
+				if len(page.Tokens) == 0 {
+					continue
+				}
+			}
+
 			contracts, err := p.processContracts(ctx, page.Chain, page.Contracts, false)
 			if err != nil {
 				return nil, nil, err
@@ -1240,6 +1358,10 @@ func chainTokensToUpsertableTokens(chain persist.Chain, tokens []common.ChainAgn
 		}
 
 		finalSeenToken := seenTokens[ti]
+		// A token held across several wallets was acquired when the first of them acquired it
+		if !token.AcquiredAt.IsZero() && (!finalSeenToken.Token.AcquiredAt.Valid || token.AcquiredAt.Before(finalSeenToken.Token.AcquiredAt.Time)) {
+			finalSeenToken.Token.AcquiredAt = sql.NullTime{Time: token.AcquiredAt, Valid: true}
+		}
 		finalSeenToken.Token.OwnedByWallets = util.MapWithoutError(seenWallets[ti], func(w persist.Wallet) persist.DBID { return w.ID })
 		finalSeenToken.Token.Quantity = seenQuantities[ti]
 		seenTokens[ti] = finalSeenToken
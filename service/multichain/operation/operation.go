@@ -204,6 +204,7 @@ func InsertTokens(ctx context.Context, q *db.Queries, tokens []UpsertToken, opt
 		p.TokenIsCreatorToken = append(p.TokenIsCreatorToken, t.IsCreatorToken)
 		p.TokenDefinitionID = append(p.TokenDefinitionID, t.TokenDefinitionID.String())
 		p.TokenContractID = append(p.TokenContractID, t.ContractID.String())
+		p.TokenAcquiredAt = append(p.TokenAcquiredAt, t.AcquiredAt.Time)
 	}
 
 	added, err := q.UpsertTokens(ctx, p)
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/platform"
@@ -590,6 +591,9 @@ func assetToAgnosticToken(t TokenWithOwnership, ownerAddress persist.Address) co
 		ownerAddress = t.Token.Owner
 	}
 
+	// The time is left as zero if it's missing or invalid
+	acquiredAt, _ := time.Parse(time.RFC3339, t.Ownership.AcquiredAt)
+
 	return common.ChainAgnosticToken{
 		ContractAddress: t.Token.Contract,
 		Descriptors:     descriptors,
@@ -600,6 +604,7 @@ func assetToAgnosticToken(t TokenWithOwnership, ownerAddress persist.Address) co
 		TokenMetadata:   t.Token.Metadata,
 		FallbackMedia:   persist.FallbackMedia{ImageURL: persist.NullString(t.Token.Image)},
 		IsSpam:          util.ToPointer(t.Token.IsSpam),
+		AcquiredAt:      acquiredAt,
 	}
 }
 
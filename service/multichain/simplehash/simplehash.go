@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/sourcegraph/conc/pool"
 
@@ -25,6 +26,7 @@ var (
 	getContractsByWalletEndpoint   = checkURL(fmt.Sprintf(getContractsByWalletEndpointTemplate, baseURL))
 	getContractsByOwnerEndpoint    = checkURL(fmt.Sprintf(getContractsByOwnerEndpointTemplate, baseURL))
 	getContractsByDeployerEndpoint = checkURL(fmt.Sprintf(getContractsByDeployerEndpointTemplate, baseURL))
+	getTransfersByWalletEndpoint   = checkURL(fmt.Sprintf(getTransfersByWalletEndpointTemplate, baseURL))
 )
 
 var retryPolicy = retry.Retry{MinWait: 1, MaxWait: 24, MaxRetries: 8}
@@ -50,6 +52,7 @@ const (
 	getContractsByDeployerEndpointTemplate  = "%s/api/v0/contracts_by_deployer"
 	getCollectorsByContractEndpointTemplate = "%s/api/v0/nfts/top_collectors/%s/%s"
 	getOwnersByContractEndpointTemplate     = "%s/api/v0/nfts/owners/%s/%s"
+	getTransfersByWalletEndpointTemplate    = "%s/api/v0/nfts/transfers/wallets"
 	spamScoreThreshold                      = 90
 	tokenBatchLimit                         = 50
 	fetchByTokenCountLimit                  = 1000
@@ -163,6 +166,20 @@ func setSpamFilter(u url.URL, threshold int) url.URL {
 	return u
 }
 
+func setFromTimestamp(u url.URL, t time.Time) url.URL {
+	query := u.Query()
+	query.Set("from_timestamp", strconv.FormatInt(t.Unix(), 10))
+	u.RawQuery = query.Encode()
+	return u
+}
+
+func setOrderBy(u url.URL, orderBy string) url.URL {
+	query := u.Query()
+	query.Set("order_by", orderBy)
+	u.RawQuery = query.Encode()
+	return u
+}
+
 func setNftIDs(u url.URL, chain persist.Chain, ids []common.ChainAgnosticIdentifiers) url.URL {
 	query := u.Query()
 	nftIDs := make([]string, len(ids))
@@ -306,6 +323,21 @@ type getContractsByDeployerResponse struct {
 	Contracts  []simplehashContractDetailed `json:"contracts"`
 }
 
+type simplehashTransfer struct {
+	NftID           string `json:"nft_id"`
+	ContractAddress string `json:"contract_address"`
+	TokenID         string `json:"token_id"`
+	FromAddress     string `json:"from_address"`
+	ToAddress       string `json:"to_address"`
+	BlockNumber     uint64 `json:"block_number"`
+}
+
+type getTransfersByWalletResponse struct {
+	NextCursor string               `json:"next_cursor"`
+	Next       string               `json:"next"`
+	Transfers  []simplehashTransfer `json:"transfers"`
+}
+
 type simplehashTokenOwner struct {
 	OwnerAddress string `json:"owner_address"`
 	Quantity     int    `json:"quantity"`
@@ -317,6 +349,17 @@ type getOwnersByContractResponse struct {
 	Owners     []simplehashTokenOwner `json:"owners"`
 }
 
+// parseAcquiredDate parses an acquisition date, which is returned in UTC without a timezone. The zero time is returned
+// if the date is missing or can't be parsed.
+func parseAcquiredDate(date string) time.Time {
+	for _, layout := range []string{"2006-01-02T15:04:05", time.RFC3339} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 func translateToChainAgnosticToken(t simplehashNFT, ownerAddress persist.Address, isSpam *bool) common.ChainAgnosticToken {
 	var tokenType persist.TokenType
 
@@ -331,16 +374,19 @@ func translateToChainAgnosticToken(t simplehashNFT, ownerAddress persist.Address
 	}
 
 	var quantity persist.HexString
+	var acquiredAt time.Time
 
 	// We've queried for a token from a specific wallet
 	if len(t.QueriedWalletBalances) > 0 {
 		quantity = persist.MustHexString(t.QueriedWalletBalances[0].QuantityString)
+		acquiredAt = parseAcquiredDate(t.QueriedWalletBalances[0].FirstAcquiredDate)
 	}
 
 	// We got a token for a non-specific wallet, just use the first owner
 	if ownerAddress == "" && len(t.Owners) > 0 {
 		ownerAddress = persist.Address(t.Owners[0].OwnerAddress)
 		quantity = persist.MustHexString(t.Owners[0].QuantityString)
+		acquiredAt = parseAcquiredDate(t.Owners[0].FirstAcquiredDate)
 	}
 
 	return common.ChainAgnosticToken{
@@ -363,6 +409,7 @@ func translateToChainAgnosticToken(t simplehashNFT, ownerAddress persist.Address
 		ExternalURL:     t.ExternalURL,
 		Quantity:        quantity,
 		IsSpam:          isSpam,
+		AcquiredAt:      acquiredAt,
 		FallbackMedia: persist.FallbackMedia{
 			ImageURL: persist.NullString(t.ImageURL),
 			Dimensions: persist.Dimensions{
@@ -599,6 +646,129 @@ func (p *Provider) GetTokensIncrementallyByWalletAddress(ctx context.Context, ad
 	return outCh, errCh
 }
 
+// GetTokensChangedSinceByWalletAddress returns the tokens of a wallet that were transferred since the checkpoint. Because a token
+// may have been transferred in and out of the wallet multiple times since the checkpoint, the wallet's current balance of each
+// transferred token is fetched to determine if the token was received or removed.
+func (p *Provider) GetTokensChangedSinceByWalletAddress(ctx context.Context, address persist.Address, since common.SyncCheckpoint) (<-chan common.ChainAgnosticTokenChanges, <-chan error) {
+	outCh := make(chan common.ChainAgnosticTokenChanges)
+	errCh := make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(errCh)
+
+		changed, checkpoint, err := p.getTransferredTokens(ctx, address, since)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		logger.For(ctx).Infof("simplehash found %d transferred token(s) for address=%s since %s", len(changed), address, since.SyncedAt)
+
+		contractIDs := make([]string, 0)
+		seenContracts := make(map[string]bool)
+		for _, t := range changed {
+			contractID := fmtContractID(p.chain, t.ContractAddress)
+			if !seenContracts[contractID] {
+				contractIDs = append(contractIDs, contractID)
+				seenContracts[contractID] = true
+			}
+		}
+
+		held := make(map[persist.TokenIdentifiers]bool)
+
+		for _, batch := range util.ChunkBy(contractIDs, contractBatchLimit) {
+			u := setChain(getNftsByWalletEndpoint, p.chain)
+			u = setWallet(u, address)
+			u = setQueriedWalletBalances(u)
+			u = setLimit(u, tokenBatchLimit)
+			u = setSpamFilter(u, spamScoreThreshold)
+			u = setContractIDs(u, batch)
+
+			next := u.String()
+
+			for next != "" {
+				var body getNftsByWalletResponse
+
+				err := readResponseBodyInto(ctx, p.httpClient, next, &body)
+				if err != nil {
+					errCh <- err
+					return
+				}
+
+				page := common.ChainAgnosticTokenChanges{Checkpoint: checkpoint}
+
+				for _, nft := range body.NFTs {
+					contract := translateToChainAgnosticContract(nft.ContractAddress, nft.Contract, nft.Collection)
+					token := translateToChainAgnosticToken(nft, address, contract.IsSpam)
+					tID := persist.NewTokenIdentifiers(persist.Address(p.chain.NormalizeAddress(token.ContractAddress)), token.TokenID, p.chain)
+					if _, ok := changed[tID]; !ok {
+						continue
+					}
+					held[tID] = true
+					page.Contracts = append(page.Contracts, contract)
+					page.Tokens = append(page.Tokens, token)
+				}
+
+				if len(page.Tokens) > 0 {
+					outCh <- page
+				}
+
+				next = body.Next
+			}
+		}
+
+		removed := common.ChainAgnosticTokenChanges{Checkpoint: checkpoint}
+		for tID, t := range changed {
+			if !held[tID] {
+				removed.Removed = append(removed.Removed, t)
+			}
+		}
+
+		// Always send the last page so that the caller receives the latest checkpoint
+		outCh <- removed
+	}()
+
+	return outCh, errCh
+}
+
+// getTransferredTokens returns the tokens that were transferred to or from a wallet since the checkpoint
+func (p *Provider) getTransferredTokens(ctx context.Context, address persist.Address, since common.SyncCheckpoint) (map[persist.TokenIdentifiers]common.ChainAgnosticIdentifiers, common.SyncCheckpoint, error) {
+	u := setChain(getTransfersByWalletEndpoint, p.chain)
+	u = setWallet(u, address)
+	u = setFromTimestamp(u, since.SyncedAt)
+	u = setOrderBy(u, "timestamp_asc")
+	u = setLimit(u, tokenBatchLimit)
+
+	next := u.String()
+	changed := make(map[persist.TokenIdentifiers]common.ChainAgnosticIdentifiers)
+	checkpoint := common.SyncCheckpoint{BlockNumber: since.BlockNumber}
+
+	for next != "" {
+		var body getTransfersByWalletResponse
+
+		err := readResponseBodyInto(ctx, p.httpClient, next, &body)
+		if err != nil {
+			return nil, common.SyncCheckpoint{}, err
+		}
+
+		for _, t := range body.Transfers {
+			ti := common.ChainAgnosticIdentifiers{
+				ContractAddress: persist.Address(p.chain.NormalizeAddress(persist.Address(t.ContractAddress))),
+				TokenID:         persist.MustTokenID(t.TokenID),
+			}
+			changed[persist.NewTokenIdentifiers(ti.ContractAddress, ti.TokenID, p.chain)] = ti
+			if b := persist.BlockNumber(t.BlockNumber); b > checkpoint.BlockNumber {
+				checkpoint.BlockNumber = b
+			}
+		}
+
+		next = body.Next
+	}
+
+	return changed, checkpoint, nil
+}
+
 func (p *Provider) binRequestsByOwner(ctx context.Context, address persist.Address) (<-chan []string, <-chan error) {
 	outCh := make(chan []string, requestPoolSize)
 	errCh := make(chan error)
@@ -0,0 +1,441 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/multichain/common"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	// pageSize is the max number of assets the DAS API returns per page
+	pageSize = 1000
+
+	interfaceV1NFT           = "V1_NFT"
+	interfaceProgrammableNFT = "ProgrammableNFT"
+	interfaceLegacyNFT       = "Legacy_NFT"
+	interfaceMplCoreAsset    = "MplCoreAsset"
+
+	groupKeyCollection = "collection"
+)
+
+var ErrNotConfigured = errors.New("solana rpc url is not configured")
+
+// TokenIDFromMint converts a mint address to the token ID the mint is stored as
+func TokenIDFromMint(mint persist.Address) (persist.HexTokenID, error) {
+	b, err := base58.Decode(mint.String())
+	if err != nil {
+		return "", err
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return "", fmt.Errorf("mint %s is not a valid public key", mint)
+	}
+	return persist.HexTokenID(hex.EncodeToString(b)), nil
+}
+
+// MintFromTokenID converts a token ID back to the mint address it was derived from
+func MintFromTokenID(tokenID persist.HexTokenID) (persist.Address, error) {
+	n := tokenID.BigInt()
+	if n.Sign() < 0 || n.BitLen() > ed25519.PublicKeySize*8 {
+		return "", fmt.Errorf("token ID %s is not a valid mint", tokenID)
+	}
+	b := make([]byte, ed25519.PublicKeySize)
+	n.FillBytes(b)
+	return persist.Address(base58.Encode(b)), nil
+}
+
+// Provider retrieves Solana NFTs, including compressed NFTs, from an RPC node that supports the Metaplex DAS API
+type Provider struct {
+	rpcURL     string
+	httpClient *http.Client
+}
+
+// NewProvider creates a new Solana Provider
+func NewProvider(httpClient *http.Client) *Provider {
+	return &Provider{
+		rpcURL:     env.GetString("SOLANA_RPC_URL"),
+		httpClient: httpClient,
+	}
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      string `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e rpcError) Error() string {
+	return fmt.Sprintf("solana rpc error %d: %s", e.Code, e.Message)
+}
+
+type dasAsset struct {
+	Interface string `json:"interface"`
+	ID        string `json:"id"`
+	Content   struct {
+		JSONURI  string `json:"json_uri"`
+		Metadata struct {
+			Name        string           `json:"name"`
+			Symbol      string           `json:"symbol"`
+			Description string           `json:"description"`
+			Attributes  []map[string]any `json:"attributes"`
+		} `json:"metadata"`
+		Links struct {
+			Image        string `json:"image"`
+			AnimationURL string `json:"animation_url"`
+			ExternalURL  string `json:"external_url"`
+		} `json:"links"`
+		Files []struct {
+			URI  string `json:"uri"`
+			Mime string `json:"mime"`
+		} `json:"files"`
+	} `json:"content"`
+	Grouping []struct {
+		GroupKey   string `json:"group_key"`
+		GroupValue string `json:"group_value"`
+	} `json:"grouping"`
+	Compression struct {
+		Compressed bool `json:"compressed"`
+	} `json:"compression"`
+	Ownership struct {
+		Owner string `json:"owner"`
+	} `json:"ownership"`
+	Authorities []struct {
+		Address string `json:"address"`
+	} `json:"authorities"`
+	Burnt bool `json:"burnt"`
+}
+
+type dasAssetPage struct {
+	Total int        `json:"total"`
+	Limit int        `json:"limit"`
+	Page  int        `json:"page"`
+	Items []dasAsset `json:"items"`
+}
+
+func (p *Provider) call(ctx context.Context, method string, params any, result any) error {
+	if p.rpcURL == "" {
+		return ErrNotConfigured
+	}
+
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: "gallery", Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return util.GetErrFromResp(resp)
+	}
+
+	var rpcResp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return err
+	}
+	if rpcResp.Error != nil {
+		return *rpcResp.Error
+	}
+	return json.Unmarshal(rpcResp.Result, result)
+}
+
+func (p *Provider) getAsset(ctx context.Context, mint persist.Address) (dasAsset, error) {
+	var asset dasAsset
+	err := p.call(ctx, "getAsset", map[string]any{"id": mint.String()}, &asset)
+	return asset, err
+}
+
+func (p *Provider) getAssetBatch(ctx context.Context, mints []string) ([]dasAsset, error) {
+	var assets []dasAsset
+	err := p.call(ctx, "getAssetBatch", map[string]any{"ids": mints}, &assets)
+	return assets, err
+}
+
+// GetTokensIncrementallyByWalletAddress retrieves the NFTs owned by a wallet one page at a time
+func (p *Provider) GetTokensIncrementallyByWalletAddress(ctx context.Context, address persist.Address) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	recCh := make(chan common.ChainAgnosticTokensAndContracts)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(recCh)
+		for page := 1; ; page++ {
+			var result dasAssetPage
+			err := p.call(ctx, "getAssetsByOwner", map[string]any{
+				"ownerAddress": address.String(),
+				"page":         page,
+				"limit":        pageSize,
+			}, &result)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			tokens, contracts, err := p.assetsToTokens(ctx, result.Items)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			select {
+			case recCh <- common.ChainAgnosticTokensAndContracts{Tokens: tokens, Contracts: contracts}:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+
+			if len(result.Items) < pageSize {
+				return
+			}
+		}
+	}()
+	return recCh, errCh
+}
+
+// GetTokenByTokenIdentifiersAndOwner retrieves a token and checks that it is owned by the given address
+func (p *Provider) GetTokenByTokenIdentifiersAndOwner(ctx context.Context, ti common.ChainAgnosticIdentifiers, owner persist.Address) (common.ChainAgnosticToken, common.ChainAgnosticContract, error) {
+	mint, err := MintFromTokenID(ti.TokenID)
+	if err != nil {
+		return common.ChainAgnosticToken{}, common.ChainAgnosticContract{}, err
+	}
+	asset, err := p.getAsset(ctx, mint)
+	if err != nil {
+		return common.ChainAgnosticToken{}, common.ChainAgnosticContract{}, err
+	}
+	if asset.Ownership.Owner != owner.String() {
+		return common.ChainAgnosticToken{}, common.ChainAgnosticContract{}, fmt.Errorf("token %s is not owned by %s", ti, owner)
+	}
+	tokens, contracts, err := p.assetsToTokens(ctx, []dasAsset{asset})
+	if err != nil {
+		return common.ChainAgnosticToken{}, common.ChainAgnosticContract{}, err
+	}
+	if len(tokens) == 0 {
+		return common.ChainAgnosticToken{}, common.ChainAgnosticContract{}, fmt.Errorf("%s is not an nft", ti)
+	}
+	return tokens[0], contracts[0], nil
+}
+
+// GetTokenMetadataByTokenIdentifiers retrieves the Metaplex metadata of a token
+func (p *Provider) GetTokenMetadataByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) (persist.TokenMetadata, error) {
+	mint, err := MintFromTokenID(ti.TokenID)
+	if err != nil {
+		return persist.TokenMetadata{}, err
+	}
+	asset, err := p.getAsset(ctx, mint)
+	if err != nil {
+		return persist.TokenMetadata{}, err
+	}
+	return assetToMetadata(asset), nil
+}
+
+// GetTokenDescriptorsByTokenIdentifiers retrieves the name and description of a token and its collection
+func (p *Provider) GetTokenDescriptorsByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) (common.ChainAgnosticTokenDescriptors, common.ChainAgnosticContractDescriptors, error) {
+	mint, err := MintFromTokenID(ti.TokenID)
+	if err != nil {
+		return common.ChainAgnosticTokenDescriptors{}, common.ChainAgnosticContractDescriptors{}, err
+	}
+	asset, err := p.getAsset(ctx, mint)
+	if err != nil {
+		return common.ChainAgnosticTokenDescriptors{}, common.ChainAgnosticContractDescriptors{}, err
+	}
+	_, contracts, err := p.assetsToTokens(ctx, []dasAsset{asset})
+	if err != nil {
+		return common.ChainAgnosticTokenDescriptors{}, common.ChainAgnosticContractDescriptors{}, err
+	}
+	if len(contracts) == 0 {
+		return common.ChainAgnosticTokenDescriptors{}, common.ChainAgnosticContractDescriptors{}, fmt.Errorf("%s is not an nft", ti)
+	}
+	return assetToDescriptors(asset), contracts[0].Descriptors, nil
+}
+
+// VerifySignature verifies an ed25519 signature of the message made by the wallet's key
+func (p *Provider) VerifySignature(ctx context.Context, pubKey persist.PubKey, walletType persist.WalletType, message string, signature string) (bool, error) {
+	key, err := base58.Decode(pubKey.String())
+	if err != nil {
+		return false, err
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return false, fmt.Errorf("invalid public key length: %d", len(key))
+	}
+	sig, err := decodeSignature(signature)
+	if err != nil {
+		return false, err
+	}
+	return ed25519.Verify(ed25519.PublicKey(key), []byte(message), sig), nil
+}
+
+// decodeSignature decodes a signature in any of the encodings wallets commonly return
+func decodeSignature(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	if b, err := base58.Decode(s); err == nil && len(b) == ed25519.SignatureSize {
+		return b, nil
+	}
+	if b, err := base64.StdEncoding.DecodeString(s); err == nil && len(b) == ed25519.SignatureSize {
+		return b, nil
+	}
+	if b, err := hex.DecodeString(s); err == nil && len(b) == ed25519.SignatureSize {
+		return b, nil
+	}
+	return nil, fmt.Errorf("signature is not a valid ed25519 signature")
+}
+
+func isNFT(asset dasAsset) bool {
+	if asset.Burnt {
+		return false
+	}
+	switch asset.Interface {
+	case interfaceV1NFT, interfaceProgrammableNFT, interfaceLegacyNFT, interfaceMplCoreAsset:
+		return true
+	default:
+		return false
+	}
+}
+
+// collectionOf returns the collection that the asset belongs to. Assets without a collection are their own contract.
+func collectionOf(asset dasAsset) string {
+	for _, g := range asset.Grouping {
+		if g.GroupKey == groupKeyCollection && g.GroupValue != "" {
+			return g.GroupValue
+		}
+	}
+	return asset.ID
+}
+
+// assetsToTokens converts DAS assets to tokens, skipping any fungible assets
+func (p *Provider) assetsToTokens(ctx context.Context, assets []dasAsset) ([]common.ChainAgnosticToken, []common.ChainAgnosticContract, error) {
+	tokens := make([]common.ChainAgnosticToken, 0, len(assets))
+	collections := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, asset := range assets {
+		if !isNFT(asset) {
+			continue
+		}
+		tokenID, err := TokenIDFromMint(persist.Address(asset.ID))
+		if err != nil {
+			return nil, nil, err
+		}
+		collection := collectionOf(asset)
+		tokens = append(tokens, common.ChainAgnosticToken{
+			Descriptors:     assetToDescriptors(asset),
+			TokenType:       persist.TokenTypeERC721,
+			TokenURI:        persist.TokenURI(asset.Content.JSONURI),
+			TokenID:         tokenID,
+			Quantity:        "1",
+			OwnerAddress:    persist.Address(asset.Ownership.Owner),
+			TokenMetadata:   assetToMetadata(asset),
+			ContractAddress: persist.Address(collection),
+			ExternalURL:     asset.Content.Links.ExternalURL,
+			FallbackMedia: persist.FallbackMedia{
+				ImageURL: persist.NullString(asset.Content.Links.Image),
+			},
+		})
+		if !seen[collection] {
+			seen[collection] = true
+			collections = append(collections, collection)
+		}
+	}
+
+	contracts, err := p.collectionsToContracts(ctx, collections)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, contracts, nil
+}
+
+// collectionsToContracts looks up the collection NFT of each collection to describe the contract
+func (p *Provider) collectionsToContracts(ctx context.Context, collections []string) ([]common.ChainAgnosticContract, error) {
+	if len(collections) == 0 {
+		return []common.ChainAgnosticContract{}, nil
+	}
+
+	byID := make(map[string]dasAsset)
+	for _, chunk := range util.ChunkBy(collections, pageSize) {
+		assets, err := p.getAssetBatch(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range assets {
+			byID[a.ID] = a
+		}
+	}
+
+	contracts := make([]common.ChainAgnosticContract, len(collections))
+	for i, c := range collections {
+		contracts[i] = common.ChainAgnosticContract{Address: persist.Address(c)}
+		a, ok := byID[c]
+		if !ok {
+			continue
+		}
+		contracts[i].Descriptors = common.ChainAgnosticContractDescriptors{
+			Symbol:          a.Content.Metadata.Symbol,
+			Name:            a.Content.Metadata.Name,
+			Description:     a.Content.Metadata.Description,
+			ProfileImageURL: a.Content.Links.Image,
+		}
+		if len(a.Authorities) > 0 {
+			contracts[i].Descriptors.OwnerAddress = persist.Address(a.Authorities[0].Address)
+		}
+	}
+
+	return contracts, nil
+}
+
+func assetToDescriptors(asset dasAsset) common.ChainAgnosticTokenDescriptors {
+	return common.ChainAgnosticTokenDescriptors{
+		Name:        asset.Content.Metadata.Name,
+		Description: asset.Content.Metadata.Description,
+	}
+}
+
+func assetToMetadata(asset dasAsset) persist.TokenMetadata {
+	metadata := persist.TokenMetadata{
+		"name":        asset.Content.Metadata.Name,
+		"symbol":      asset.Content.Metadata.Symbol,
+		"description": asset.Content.Metadata.Description,
+		"compressed":  asset.Compression.Compressed,
+	}
+	if asset.Content.Links.Image != "" {
+		metadata["image_url"] = asset.Content.Links.Image
+	}
+	if asset.Content.Links.AnimationURL != "" {
+		metadata["animation_url"] = asset.Content.Links.AnimationURL
+	}
+	if asset.Content.Links.ExternalURL != "" {
+		metadata["external_url"] = asset.Content.Links.ExternalURL
+	}
+	if len(asset.Content.Metadata.Attributes) > 0 {
+		metadata["attributes"] = asset.Content.Metadata.Attributes
+	}
+	return metadata
+}
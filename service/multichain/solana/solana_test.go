@@ -0,0 +1,52 @@
+package solana
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+func TestMintTokenIDRoundTrip(t *testing.T) {
+	// A mint whose first byte is zero must survive the leading zeros being trimmed from the token ID
+	for _, mint := range []persist.Address{"So11111111111111111111111111111111111111112", "11111111111111111111111111111112"} {
+		tokenID, err := TokenIDFromMint(mint)
+		assert.NoError(t, err)
+		roundTripped, err := MintFromTokenID(tokenID)
+		assert.NoError(t, err)
+		assert.Equal(t, mint, roundTripped)
+	}
+
+	_, err := TokenIDFromMint("not-a-mint")
+	assert.Error(t, err)
+
+	// Token IDs from other chains can be longer than a public key
+	_, err = MintFromTokenID(persist.HexTokenID(strings.Repeat("ff", 33)))
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	message := "Gallery nonce: 123"
+	sig := ed25519.Sign(priv, []byte(message))
+	pubKey := persist.PubKey(base58.Encode(pub))
+	p := NewProvider(nil)
+
+	for _, encoded := range []string{base58.Encode(sig), base64.StdEncoding.EncodeToString(sig)} {
+		valid, err := p.VerifySignature(context.Background(), pubKey, persist.WalletTypeEOA, message, encoded)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	valid, err := p.VerifySignature(context.Background(), pubKey, persist.WalletTypeEOA, "another message", base58.Encode(sig))
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
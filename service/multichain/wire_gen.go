@@ -10,10 +10,13 @@ import (
 	"context"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/eth"
+	"github.com/mikeydub/go-gallery/service/multichain/alchemy"
 	"github.com/mikeydub/go-gallery/service/multichain/custom"
 	"github.com/mikeydub/go-gallery/service/multichain/poap"
 	"github.com/mikeydub/go-gallery/service/multichain/simplehash"
+	"github.com/mikeydub/go-gallery/service/multichain/solana"
 	"github.com/mikeydub/go-gallery/service/multichain/tezos"
 	"github.com/mikeydub/go-gallery/service/multichain/wrapper"
 	"github.com/mikeydub/go-gallery/service/persist"
@@ -40,6 +43,7 @@ func NewMultichainProvider(contextContext context.Context, repositories *postgre
 	zoraProvider := zoraInjector(contextContext, httpClient, client)
 	baseProvider := baseInjector(contextContext, httpClient, client)
 	polygonProvider := polygonInjector(contextContext, httpClient, client)
+	solanaProvider := solanaInjector(httpClient)
 	chainProvider := &ChainProvider{
 		Ethereum: ethereumProvider,
 		Tezos:    tezosProvider,
@@ -49,6 +53,7 @@ func NewMultichainProvider(contextContext context.Context, repositories *postgre
 		Zora:     zoraProvider,
 		Base:     baseProvider,
 		Polygon:  polygonProvider,
+		Solana:   solanaProvider,
 	}
 	tokenProcessingSubmitter := tokenProcessingSubmitterInjector(contextContext, taskClient, cache)
 	provider := multichainProviderInjector(contextContext, repositories, queries, chainProvider, tokenProcessingSubmitter)
@@ -80,9 +85,10 @@ func customMetadataHandlersInjector(ethCleint *ethclient.Client) *custom.CustomM
 func ethInjector(contextContext context.Context, client *http.Client, ethclientClient *ethclient.Client) *EthereumProvider {
 	chain := _wireChainValue
 	provider := simplehash.NewProvider(chain, client)
-	syncPipelineWrapper := ethSyncPipelineInjector(contextContext, client, chain, provider, ethclientClient)
+	failoverWrapper := evmFailoverInjector(chain, client, provider)
+	syncPipelineWrapper := ethSyncPipelineInjector(contextContext, client, chain, failoverWrapper, ethclientClient)
 	verifier := ethVerifierInjector(ethclientClient)
-	ethereumProvider := ethProviderInjector(contextContext, syncPipelineWrapper, verifier, provider)
+	ethereumProvider := ethProviderInjector(contextContext, syncPipelineWrapper, verifier, failoverWrapper)
 	return ethereumProvider
 }
 
@@ -97,16 +103,17 @@ func ethVerifierInjector(ethClient *ethclient.Client) *eth.Verifier {
 	return verifier
 }
 
-func ethProviderInjector(ctx context.Context, syncPipeline *wrapper.SyncPipelineWrapper, verifier *eth.Verifier, simplehashProvider *simplehash.Provider) *EthereumProvider {
+func ethProviderInjector(ctx context.Context, syncPipeline *wrapper.SyncPipelineWrapper, verifier *eth.Verifier, failoverWrapper *wrapper.FailoverWrapper) *EthereumProvider {
 	ethereumProvider := &EthereumProvider{
-		ContractFetcher:                  simplehashProvider,
-		ContractsCreatorFetcher:          simplehashProvider,
-		TokenDescriptorsFetcher:          simplehashProvider,
+		ContractFetcher:                  failoverWrapper,
+		ContractsCreatorFetcher:          failoverWrapper,
+		TokenDescriptorsFetcher:          failoverWrapper,
 		TokenIdentifierOwnerFetcher:      syncPipeline,
 		TokenMetadataBatcher:             syncPipeline,
 		TokenMetadataFetcher:             syncPipeline,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 		Verifier:                         verifier,
@@ -114,16 +121,17 @@ func ethProviderInjector(ctx context.Context, syncPipeline *wrapper.SyncPipeline
 	return ethereumProvider
 }
 
-func ethSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
+func ethSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
 	customMetadataHandlers := customMetadataHandlersInjector(ethClient)
 	syncPipelineWrapper := &wrapper.SyncPipelineWrapper{
 		Chain:                            chain,
-		TokenIdentifierOwnerFetcher:      simplehashProvider,
-		TokensIncrementalOwnerFetcher:    simplehashProvider,
-		TokensIncrementalContractFetcher: simplehashProvider,
-		TokenMetadataBatcher:             simplehashProvider,
-		TokensByTokenIdentifiersFetcher:  simplehashProvider,
-		TokensByContractWalletFetcher:    simplehashProvider,
+		TokenIdentifierOwnerFetcher:      failoverWrapper,
+		TokensIncrementalOwnerFetcher:    failoverWrapper,
+		TokensIncrementalContractFetcher: failoverWrapper,
+		TokensChangedSinceFetcher:        failoverWrapper,
+		TokenMetadataBatcher:             failoverWrapper,
+		TokensByTokenIdentifiersFetcher:  failoverWrapper,
+		TokensByContractWalletFetcher:    failoverWrapper,
 		CustomMetadataWrapper:            customMetadataHandlers,
 	}
 	return syncPipelineWrapper
@@ -151,6 +159,7 @@ func tezosProviderInjector(tezosProvider *tezos.Provider, simplehashProvider *si
 		TokenMetadataFetcher:             simplehashProvider,
 		TokensByContractWalletFetcher:    simplehashProvider,
 		TokensByTokenIdentifiersFetcher:  simplehashProvider,
+		TokensChangedSinceFetcher:        simplehashProvider,
 		TokensIncrementalContractFetcher: simplehashProvider,
 		TokensIncrementalOwnerFetcher:    simplehashProvider,
 		Verifier:                         tezosProvider,
@@ -161,8 +170,9 @@ func tezosProviderInjector(tezosProvider *tezos.Provider, simplehashProvider *si
 func optimismInjector(contextContext context.Context, client *http.Client, ethclientClient *ethclient.Client) *OptimismProvider {
 	chain := _wireChainValue2
 	provider := simplehash.NewProvider(chain, client)
-	syncPipelineWrapper := optimismSyncPipelineInjector(contextContext, client, chain, provider, ethclientClient)
-	optimismProvider := optimismProviderInjector(syncPipelineWrapper, provider)
+	failoverWrapper := evmFailoverInjector(chain, client, provider)
+	syncPipelineWrapper := optimismSyncPipelineInjector(contextContext, client, chain, failoverWrapper, ethclientClient)
+	optimismProvider := optimismProviderInjector(syncPipelineWrapper, failoverWrapper)
 	return optimismProvider
 }
 
@@ -170,32 +180,34 @@ var (
 	_wireChainValue2 = persist.ChainOptimism
 )
 
-func optimismProviderInjector(syncPipeline *wrapper.SyncPipelineWrapper, simplehashProvider *simplehash.Provider) *OptimismProvider {
+func optimismProviderInjector(syncPipeline *wrapper.SyncPipelineWrapper, failoverWrapper *wrapper.FailoverWrapper) *OptimismProvider {
 	optimismProvider := &OptimismProvider{
-		ContractFetcher:                  simplehashProvider,
-		ContractsCreatorFetcher:          simplehashProvider,
-		TokenDescriptorsFetcher:          simplehashProvider,
+		ContractFetcher:                  failoverWrapper,
+		ContractsCreatorFetcher:          failoverWrapper,
+		TokenDescriptorsFetcher:          failoverWrapper,
 		TokenIdentifierOwnerFetcher:      syncPipeline,
 		TokenMetadataBatcher:             syncPipeline,
 		TokenMetadataFetcher:             syncPipeline,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 	}
 	return optimismProvider
 }
 
-func optimismSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
+func optimismSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
 	customMetadataHandlers := customMetadataHandlersInjector(ethClient)
 	syncPipelineWrapper := &wrapper.SyncPipelineWrapper{
 		Chain:                            chain,
-		TokenIdentifierOwnerFetcher:      simplehashProvider,
-		TokensIncrementalOwnerFetcher:    simplehashProvider,
-		TokensIncrementalContractFetcher: simplehashProvider,
-		TokenMetadataBatcher:             simplehashProvider,
-		TokensByTokenIdentifiersFetcher:  simplehashProvider,
-		TokensByContractWalletFetcher:    simplehashProvider,
+		TokenIdentifierOwnerFetcher:      failoverWrapper,
+		TokensIncrementalOwnerFetcher:    failoverWrapper,
+		TokensIncrementalContractFetcher: failoverWrapper,
+		TokensChangedSinceFetcher:        failoverWrapper,
+		TokenMetadataBatcher:             failoverWrapper,
+		TokensByTokenIdentifiersFetcher:  failoverWrapper,
+		TokensByContractWalletFetcher:    failoverWrapper,
 		CustomMetadataWrapper:            customMetadataHandlers,
 	}
 	return syncPipelineWrapper
@@ -204,8 +216,9 @@ func optimismSyncPipelineInjector(ctx context.Context, httpClient *http.Client,
 func arbitrumInjector(contextContext context.Context, client *http.Client, ethclientClient *ethclient.Client) *ArbitrumProvider {
 	chain := _wireChainValue3
 	provider := simplehash.NewProvider(chain, client)
-	syncPipelineWrapper := arbitrumSyncPipelineInjector(contextContext, client, chain, provider, ethclientClient)
-	arbitrumProvider := arbitrumProviderInjector(syncPipelineWrapper, provider)
+	failoverWrapper := evmFailoverInjector(chain, client, provider)
+	syncPipelineWrapper := arbitrumSyncPipelineInjector(contextContext, client, chain, failoverWrapper, ethclientClient)
+	arbitrumProvider := arbitrumProviderInjector(syncPipelineWrapper, failoverWrapper)
 	return arbitrumProvider
 }
 
@@ -213,32 +226,34 @@ var (
 	_wireChainValue3 = persist.ChainArbitrum
 )
 
-func arbitrumProviderInjector(syncPipeline *wrapper.SyncPipelineWrapper, simplehashProvider *simplehash.Provider) *ArbitrumProvider {
+func arbitrumProviderInjector(syncPipeline *wrapper.SyncPipelineWrapper, failoverWrapper *wrapper.FailoverWrapper) *ArbitrumProvider {
 	arbitrumProvider := &ArbitrumProvider{
-		ContractFetcher:                  simplehashProvider,
-		ContractsCreatorFetcher:          simplehashProvider,
-		TokenDescriptorsFetcher:          simplehashProvider,
+		ContractFetcher:                  failoverWrapper,
+		ContractsCreatorFetcher:          failoverWrapper,
+		TokenDescriptorsFetcher:          failoverWrapper,
 		TokenIdentifierOwnerFetcher:      syncPipeline,
 		TokenMetadataBatcher:             syncPipeline,
 		TokenMetadataFetcher:             syncPipeline,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 	}
 	return arbitrumProvider
 }
 
-func arbitrumSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
+func arbitrumSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
 	customMetadataHandlers := customMetadataHandlersInjector(ethClient)
 	syncPipelineWrapper := &wrapper.SyncPipelineWrapper{
 		Chain:                            chain,
-		TokenIdentifierOwnerFetcher:      simplehashProvider,
-		TokensIncrementalOwnerFetcher:    simplehashProvider,
-		TokensIncrementalContractFetcher: simplehashProvider,
-		TokenMetadataBatcher:             simplehashProvider,
-		TokensByTokenIdentifiersFetcher:  simplehashProvider,
-		TokensByContractWalletFetcher:    simplehashProvider,
+		TokenIdentifierOwnerFetcher:      failoverWrapper,
+		TokensIncrementalOwnerFetcher:    failoverWrapper,
+		TokensIncrementalContractFetcher: failoverWrapper,
+		TokensChangedSinceFetcher:        failoverWrapper,
+		TokenMetadataBatcher:             failoverWrapper,
+		TokensByTokenIdentifiersFetcher:  failoverWrapper,
+		TokensByContractWalletFetcher:    failoverWrapper,
 		CustomMetadataWrapper:            customMetadataHandlers,
 	}
 	return syncPipelineWrapper
@@ -282,6 +297,7 @@ func zoraProviderInjector(syncPipeline *wrapper.SyncPipelineWrapper, simplehashP
 		TokenMetadataFetcher:             syncPipeline,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 	}
@@ -295,6 +311,7 @@ func zoraSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chai
 		TokenIdentifierOwnerFetcher:      simplehashProvider,
 		TokensIncrementalOwnerFetcher:    simplehashProvider,
 		TokensIncrementalContractFetcher: simplehashProvider,
+		TokensChangedSinceFetcher:        simplehashProvider,
 		TokenMetadataBatcher:             simplehashProvider,
 		TokensByTokenIdentifiersFetcher:  simplehashProvider,
 		TokensByContractWalletFetcher:    simplehashProvider,
@@ -306,8 +323,9 @@ func zoraSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chai
 func baseInjector(contextContext context.Context, client *http.Client, ethclientClient *ethclient.Client) *BaseProvider {
 	chain := _wireChainValue5
 	provider := simplehash.NewProvider(chain, client)
-	syncPipelineWrapper := baseSyncPipelineInjector(contextContext, client, chain, provider, ethclientClient)
-	baseProvider := baseProvidersInjector(syncPipelineWrapper, provider, ethclientClient)
+	failoverWrapper := evmFailoverInjector(chain, client, provider)
+	syncPipelineWrapper := baseSyncPipelineInjector(contextContext, client, chain, failoverWrapper, ethclientClient)
+	baseProvider := baseProvidersInjector(syncPipelineWrapper, failoverWrapper, ethclientClient)
 	return baseProvider
 }
 
@@ -315,32 +333,34 @@ var (
 	_wireChainValue5 = persist.ChainBase
 )
 
-func baseProvidersInjector(syncPipeline *wrapper.SyncPipelineWrapper, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *BaseProvider {
+func baseProvidersInjector(syncPipeline *wrapper.SyncPipelineWrapper, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *BaseProvider {
 	baseProvider := &BaseProvider{
-		ContractFetcher:                  simplehashProvider,
-		ContractsCreatorFetcher:          simplehashProvider,
-		TokenDescriptorsFetcher:          simplehashProvider,
+		ContractFetcher:                  failoverWrapper,
+		ContractsCreatorFetcher:          failoverWrapper,
+		TokenDescriptorsFetcher:          failoverWrapper,
 		TokenIdentifierOwnerFetcher:      syncPipeline,
 		TokenMetadataBatcher:             syncPipeline,
 		TokenMetadataFetcher:             syncPipeline,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 	}
 	return baseProvider
 }
 
-func baseSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
+func baseSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
 	customMetadataHandlers := customMetadataHandlersInjector(ethClient)
 	syncPipelineWrapper := &wrapper.SyncPipelineWrapper{
 		Chain:                            chain,
-		TokenIdentifierOwnerFetcher:      simplehashProvider,
-		TokensIncrementalOwnerFetcher:    simplehashProvider,
-		TokensIncrementalContractFetcher: simplehashProvider,
-		TokenMetadataBatcher:             simplehashProvider,
-		TokensByTokenIdentifiersFetcher:  simplehashProvider,
-		TokensByContractWalletFetcher:    simplehashProvider,
+		TokenIdentifierOwnerFetcher:      failoverWrapper,
+		TokensIncrementalOwnerFetcher:    failoverWrapper,
+		TokensIncrementalContractFetcher: failoverWrapper,
+		TokensChangedSinceFetcher:        failoverWrapper,
+		TokenMetadataBatcher:             failoverWrapper,
+		TokensByTokenIdentifiersFetcher:  failoverWrapper,
+		TokensByContractWalletFetcher:    failoverWrapper,
 		CustomMetadataWrapper:            customMetadataHandlers,
 	}
 	return syncPipelineWrapper
@@ -349,8 +369,9 @@ func baseSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chai
 func polygonInjector(contextContext context.Context, client *http.Client, ethclientClient *ethclient.Client) *PolygonProvider {
 	chain := _wireChainValue6
 	provider := simplehash.NewProvider(chain, client)
-	syncPipelineWrapper := polygonSyncPipelineInjector(contextContext, client, chain, provider, ethclientClient)
-	polygonProvider := polygonProvidersInjector(syncPipelineWrapper, provider, ethclientClient)
+	failoverWrapper := evmFailoverInjector(chain, client, provider)
+	syncPipelineWrapper := polygonSyncPipelineInjector(contextContext, client, chain, failoverWrapper, ethclientClient)
+	polygonProvider := polygonProvidersInjector(syncPipelineWrapper, failoverWrapper, ethclientClient)
 	return polygonProvider
 }
 
@@ -358,32 +379,34 @@ var (
 	_wireChainValue6 = persist.ChainPolygon
 )
 
-func polygonProvidersInjector(syncPipeline *wrapper.SyncPipelineWrapper, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *PolygonProvider {
+func polygonProvidersInjector(syncPipeline *wrapper.SyncPipelineWrapper, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *PolygonProvider {
 	polygonProvider := &PolygonProvider{
-		ContractFetcher:                  simplehashProvider,
-		ContractsCreatorFetcher:          simplehashProvider,
-		TokenDescriptorsFetcher:          simplehashProvider,
+		ContractFetcher:                  failoverWrapper,
+		ContractsCreatorFetcher:          failoverWrapper,
+		TokenDescriptorsFetcher:          failoverWrapper,
 		TokenIdentifierOwnerFetcher:      syncPipeline,
 		TokenMetadataBatcher:             syncPipeline,
-		TokenMetadataFetcher:             simplehashProvider,
+		TokenMetadataFetcher:             failoverWrapper,
 		TokensByContractWalletFetcher:    syncPipeline,
 		TokensByTokenIdentifiersFetcher:  syncPipeline,
+		TokensChangedSinceFetcher:        syncPipeline,
 		TokensIncrementalContractFetcher: syncPipeline,
 		TokensIncrementalOwnerFetcher:    syncPipeline,
 	}
 	return polygonProvider
 }
 
-func polygonSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, simplehashProvider *simplehash.Provider, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
+func polygonSyncPipelineInjector(ctx context.Context, httpClient *http.Client, chain persist.Chain, failoverWrapper *wrapper.FailoverWrapper, ethClient *ethclient.Client) *wrapper.SyncPipelineWrapper {
 	customMetadataHandlers := customMetadataHandlersInjector(ethClient)
 	syncPipelineWrapper := &wrapper.SyncPipelineWrapper{
 		Chain:                            chain,
-		TokenIdentifierOwnerFetcher:      simplehashProvider,
-		TokensIncrementalOwnerFetcher:    simplehashProvider,
-		TokensIncrementalContractFetcher: simplehashProvider,
-		TokenMetadataBatcher:             simplehashProvider,
-		TokensByTokenIdentifiersFetcher:  simplehashProvider,
-		TokensByContractWalletFetcher:    simplehashProvider,
+		TokenIdentifierOwnerFetcher:      failoverWrapper,
+		TokensIncrementalOwnerFetcher:    failoverWrapper,
+		TokensIncrementalContractFetcher: failoverWrapper,
+		TokensChangedSinceFetcher:        failoverWrapper,
+		TokenMetadataBatcher:             failoverWrapper,
+		TokensByTokenIdentifiersFetcher:  failoverWrapper,
+		TokensByContractWalletFetcher:    failoverWrapper,
 		CustomMetadataWrapper:            customMetadataHandlers,
 	}
 	return syncPipelineWrapper
@@ -400,9 +423,36 @@ func tokenProcessingSubmitterInjector(contextContext context.Context, client *ta
 	return tokenProcessingSubmitter
 }
 
+func solanaInjector(client *http.Client) *SolanaProvider {
+	provider := solana.NewProvider(client)
+	solanaProvider := solanaProviderInjector(provider)
+	return solanaProvider
+}
+
+func solanaProviderInjector(solanaProvider *solana.Provider) *SolanaProvider {
+	multichainSolanaProvider := &SolanaProvider{
+		TokenDescriptorsFetcher:       solanaProvider,
+		TokenIdentifierOwnerFetcher:   solanaProvider,
+		TokenMetadataFetcher:          solanaProvider,
+		TokensIncrementalOwnerFetcher: solanaProvider,
+		Verifier:                      solanaProvider,
+	}
+	return multichainSolanaProvider
+}
+
 // inject.go:
 
 // New chains must be added here
 func newProviderLookup(p *ChainProvider) ProviderLookup {
-	return ProviderLookup{persist.ChainETH: p.Ethereum, persist.ChainTezos: p.Tezos, persist.ChainOptimism: p.Optimism, persist.ChainArbitrum: p.Arbitrum, persist.ChainPOAP: p.Poap, persist.ChainZora: p.Zora, persist.ChainBase: p.Base, persist.ChainPolygon: p.Polygon}
+	return ProviderLookup{persist.ChainETH: p.Ethereum, persist.ChainTezos: p.Tezos, persist.ChainOptimism: p.Optimism, persist.ChainArbitrum: p.Arbitrum, persist.ChainPOAP: p.Poap, persist.ChainZora: p.Zora, persist.ChainBase: p.Base, persist.ChainPolygon: p.Polygon, persist.ChainSolana: p.Solana}
+}
+
+// evmFailoverInjector fails over to Alchemy when SimpleHash is unavailable. Alchemy is only used if it's configured for the chain.
+func evmFailoverInjector(chain persist.Chain, httpClient *http.Client, simplehashProvider *simplehash.Provider) *wrapper.FailoverWrapper {
+	sources := []wrapper.Source{{Name: "simplehash", Fetcher: simplehashProvider}}
+	if alchemy.IsConfigured(chain) {
+		sources = append(sources, wrapper.Source{Name: "alchemy", Fetcher: alchemy.NewProvider(httpClient, chain)})
+	}
+	health := wrapper.NewHealth(env.GetInt("MULTICHAIN_FAILURE_THRESHOLD"), env.GetDuration("MULTICHAIN_FAILURE_COOLDOWN"))
+	return wrapper.NewFailoverWrapper(chain, health, env.GetInt("MULTICHAIN_PROVIDER_QUORUM"), sources...)
 }
@@ -0,0 +1,557 @@
+package wrapper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/multichain/common"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// mergedPageSize is the max number of tokens sent per page when merging results from multiple sources
+const mergedPageSize = 500
+
+// Source is a named provider that may implement any of the fetcher interfaces in the common package
+type Source struct {
+	Name    string
+	Fetcher any
+}
+
+// ErrNoAvailableSource is returned when no source can handle a request, either because no source
+// implements the requested capability or because the circuit of every source that does is open.
+type ErrNoAvailableSource struct {
+	Chain      persist.Chain
+	Capability string
+}
+
+func (e ErrNoAvailableSource) Error() string {
+	return fmt.Sprintf("no available source for %s on chain=%s", e.Capability, e.Chain)
+}
+
+// ErrAllSourcesFailed is returned when every source that was tried failed
+type ErrAllSourcesFailed struct {
+	Chain      persist.Chain
+	Capability string
+	Errs       util.MultiErr
+}
+
+func (e ErrAllSourcesFailed) Error() string {
+	return fmt.Sprintf("all sources failed for %s on chain=%s: %s", e.Capability, e.Chain, e.Errs)
+}
+
+// FailoverWrapper sends requests to an ordered list of sources. Sources are tried in order, skipping over
+// sources whose circuit is open, until one of them succeeds. If Quorum is greater than one, requests that
+// can be merged are sent to the first Quorum healthy sources at once and their results are merged, with
+// data from earlier sources taking precedence. Quorum requests succeed as long as at least one source succeeds.
+type FailoverWrapper struct {
+	Chain   persist.Chain
+	Sources []Source
+	Health  *Health
+	Quorum  int
+}
+
+func NewFailoverWrapper(chain persist.Chain, health *Health, quorum int, sources ...Source) *FailoverWrapper {
+	return &FailoverWrapper{
+		Chain:   chain,
+		Sources: sources,
+		Health:  health,
+		Quorum:  quorum,
+	}
+}
+
+type namedFetcher[F any] struct {
+	Name    string
+	Fetcher F
+}
+
+func (w *FailoverWrapper) quorumSize(available int) int {
+	if w.Quorum < available {
+		return w.Quorum
+	}
+	return available
+}
+
+func (w *FailoverWrapper) sourceKey(name string) string {
+	return fmt.Sprintf("%s:%s", name, w.Chain)
+}
+
+// capableSources returns the sources that implement F
+func capableSources[F any](w *FailoverWrapper) []namedFetcher[F] {
+	sources := make([]namedFetcher[F], 0, len(w.Sources))
+	for _, s := range w.Sources {
+		if f, ok := s.Fetcher.(F); ok {
+			sources = append(sources, namedFetcher[F]{Name: s.Name, Fetcher: f})
+		}
+	}
+	return sources
+}
+
+// acquire reports whether the source's circuit allows a request. It's checked right before each request is sent,
+// so that the trial request of a half-open circuit is only used up by a source that is actually called.
+func (w *FailoverWrapper) acquire(name string) bool {
+	return w.Health.Allow(w.sourceKey(name))
+}
+
+// acquireQuorum acquires up to Quorum sources in order. The sources after the last one that was checked are
+// returned as the rest, to be acquired later if needed.
+func acquireQuorum[S any](w *FailoverWrapper, sources []S, name func(S) string) (quorum []S, rest []S) {
+	quorum = make([]S, 0, w.quorumSize(len(sources)))
+	for i, s := range sources {
+		if len(quorum) == w.Quorum {
+			return quorum, sources[i:]
+		}
+		if w.acquire(name(s)) {
+			quorum = append(quorum, s)
+		}
+	}
+	return quorum, nil
+}
+
+func (w *FailoverWrapper) recordResult(ctx context.Context, capability string, name string, err error) {
+	if err == nil {
+		w.Health.RecordSuccess(w.sourceKey(name))
+		return
+	}
+	// Don't penalize a source for a request that was cancelled by the caller, but give back its trial request
+	// if it was one
+	if ctx.Err() != nil {
+		w.Health.Release(w.sourceKey(name))
+		return
+	}
+	logger.For(ctx).Warnf("source=%s failed %s on chain=%s: %s", name, capability, w.Chain, err)
+	w.Health.RecordFailure(w.sourceKey(name), err)
+}
+
+// callFirst calls each healthy source in order and returns the first successful result
+func callFirst[F any, T any](ctx context.Context, w *FailoverWrapper, capability string, f func(F) (T, error)) (T, error) {
+	var zero T
+	sources := capableSources[F](w)
+	errs := make([]error, 0, len(sources))
+	for _, s := range sources {
+		if !w.acquire(s.Name) {
+			continue
+		}
+		r, err := f(s.Fetcher)
+		w.recordResult(ctx, capability, s.Name, err)
+		if err == nil {
+			return r, nil
+		}
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", s.Name, err))
+	}
+	if len(errs) == 0 {
+		return zero, ErrNoAvailableSource{Chain: w.Chain, Capability: capability}
+	}
+	return zero, ErrAllSourcesFailed{Chain: w.Chain, Capability: capability, Errs: errs}
+}
+
+// callQuorum calls the first Quorum healthy sources concurrently and merges their results in source order.
+// If none of them succeed, the remaining sources are tried in order.
+func callQuorum[F any, T any](ctx context.Context, w *FailoverWrapper, capability string, f func(F) (T, error), merge func(T, T) T) (T, error) {
+	if w.Quorum <= 1 {
+		return callFirst(ctx, w, capability, f)
+	}
+
+	var zero T
+	quorum, rest := acquireQuorum(w, capableSources[F](w), func(s namedFetcher[F]) string { return s.Name })
+	if len(quorum) == 0 {
+		return zero, ErrNoAvailableSource{Chain: w.Chain, Capability: capability}
+	}
+
+	results := make([]T, len(quorum))
+	errs := make([]error, len(quorum))
+
+	var wg sync.WaitGroup
+	for i, s := range quorum {
+		i, s := i, s
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = f(s.Fetcher)
+			w.recordResult(ctx, capability, s.Name, errs[i])
+		}()
+	}
+	wg.Wait()
+
+	var merged T
+	var succeeded bool
+	failed := make([]error, 0, len(quorum)+len(rest))
+
+	for i, s := range quorum {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Errorf("%s: %w", s.Name, errs[i]))
+			continue
+		}
+		if !succeeded {
+			merged = results[i]
+			succeeded = true
+			continue
+		}
+		merged = merge(merged, results[i])
+	}
+
+	if succeeded {
+		return merged, nil
+	}
+
+	if ctx.Err() != nil {
+		return zero, ctx.Err()
+	}
+
+	for _, s := range rest {
+		if !w.acquire(s.Name) {
+			continue
+		}
+		r, err := f(s.Fetcher)
+		w.recordResult(ctx, capability, s.Name, err)
+		if err == nil {
+			return r, nil
+		}
+		failed = append(failed, fmt.Errorf("%s: %w", s.Name, err))
+	}
+
+	return zero, ErrAllSourcesFailed{Chain: w.Chain, Capability: capability, Errs: failed}
+}
+
+type tokenAndContract struct {
+	Token    common.ChainAgnosticToken
+	Contract common.ChainAgnosticContract
+}
+
+type tokensAndContract struct {
+	Tokens   []common.ChainAgnosticToken
+	Contract common.ChainAgnosticContract
+}
+
+type tokenAndContractDescriptors struct {
+	Token    common.ChainAgnosticTokenDescriptors
+	Contract common.ChainAgnosticContractDescriptors
+}
+
+func (w *FailoverWrapper) GetTokenByTokenIdentifiersAndOwner(ctx context.Context, ti common.ChainAgnosticIdentifiers, address persist.Address) (common.ChainAgnosticToken, common.ChainAgnosticContract, error) {
+	r, err := callQuorum(ctx, w, "GetTokenByTokenIdentifiersAndOwner", func(f common.TokenIdentifierOwnerFetcher) (tokenAndContract, error) {
+		t, c, err := f.GetTokenByTokenIdentifiersAndOwner(ctx, ti, address)
+		return tokenAndContract{Token: t, Contract: c}, err
+	}, func(a, b tokenAndContract) tokenAndContract {
+		return tokenAndContract{Token: mergeChainAgnosticTokens(a.Token, b.Token), Contract: mergeChainAgnosticContracts(a.Contract, b.Contract)}
+	})
+	return r.Token, r.Contract, err
+}
+
+func (w *FailoverWrapper) GetTokensByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) ([]common.ChainAgnosticToken, common.ChainAgnosticContract, error) {
+	r, err := callFirst(ctx, w, "GetTokensByTokenIdentifiers", func(f common.TokensByTokenIdentifiersFetcher) (tokensAndContract, error) {
+		t, c, err := f.GetTokensByTokenIdentifiers(ctx, ti)
+		return tokensAndContract{Tokens: t, Contract: c}, err
+	})
+	return r.Tokens, r.Contract, err
+}
+
+func (w *FailoverWrapper) GetTokensByContractWallet(ctx context.Context, contract persist.ChainAddress, wallet persist.Address) ([]common.ChainAgnosticToken, common.ChainAgnosticContract, error) {
+	r, err := callFirst(ctx, w, "GetTokensByContractWallet", func(f common.TokensByContractWalletFetcher) (tokensAndContract, error) {
+		t, c, err := f.GetTokensByContractWallet(ctx, contract, wallet)
+		return tokensAndContract{Tokens: t, Contract: c}, err
+	})
+	return r.Tokens, r.Contract, err
+}
+
+func (w *FailoverWrapper) GetContractByAddress(ctx context.Context, address persist.Address) (common.ChainAgnosticContract, error) {
+	return callQuorum(ctx, w, "GetContractByAddress", func(f common.ContractFetcher) (common.ChainAgnosticContract, error) {
+		return f.GetContractByAddress(ctx, address)
+	}, mergeChainAgnosticContracts)
+}
+
+func (w *FailoverWrapper) GetContractsByCreatorAddress(ctx context.Context, address persist.Address) ([]common.ChainAgnosticContract, error) {
+	return callFirst(ctx, w, "GetContractsByCreatorAddress", func(f common.ContractsCreatorFetcher) ([]common.ChainAgnosticContract, error) {
+		return f.GetContractsByCreatorAddress(ctx, address)
+	})
+}
+
+func (w *FailoverWrapper) GetTokenDescriptorsByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) (common.ChainAgnosticTokenDescriptors, common.ChainAgnosticContractDescriptors, error) {
+	r, err := callQuorum(ctx, w, "GetTokenDescriptorsByTokenIdentifiers", func(f common.TokenDescriptorsFetcher) (tokenAndContractDescriptors, error) {
+		t, c, err := f.GetTokenDescriptorsByTokenIdentifiers(ctx, ti)
+		return tokenAndContractDescriptors{Token: t, Contract: c}, err
+	}, func(a, b tokenAndContractDescriptors) tokenAndContractDescriptors {
+		return tokenAndContractDescriptors{Token: mergeTokenDescriptors(a.Token, b.Token), Contract: mergeContractDescriptors(a.Contract, b.Contract)}
+	})
+	return r.Token, r.Contract, err
+}
+
+func (w *FailoverWrapper) GetTokenMetadataByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) (persist.TokenMetadata, error) {
+	return callQuorum(ctx, w, "GetTokenMetadataByTokenIdentifiers", func(f common.TokenMetadataFetcher) (persist.TokenMetadata, error) {
+		return f.GetTokenMetadataByTokenIdentifiers(ctx, ti)
+	}, mergeMetadata)
+}
+
+func (w *FailoverWrapper) GetTokenMetadataByTokenIdentifiersBatch(ctx context.Context, tIDs []common.ChainAgnosticIdentifiers) ([]persist.TokenMetadata, error) {
+	return callQuorum(ctx, w, "GetTokenMetadataByTokenIdentifiersBatch", func(f common.TokenMetadataBatcher) ([]persist.TokenMetadata, error) {
+		return f.GetTokenMetadataByTokenIdentifiersBatch(ctx, tIDs)
+	}, func(a, b []persist.TokenMetadata) []persist.TokenMetadata {
+		for i := range a {
+			if i < len(b) {
+				a[i] = mergeMetadata(a[i], b[i])
+			}
+		}
+		return a
+	})
+}
+
+func (w *FailoverWrapper) GetTokensIncrementallyByWalletAddress(ctx context.Context, address persist.Address) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	capability := "GetTokensIncrementallyByWalletAddress"
+	sources := capableSources[common.TokensIncrementalOwnerFetcher](w)
+	streams := util.MapWithoutError(sources, func(s namedFetcher[common.TokensIncrementalOwnerFetcher]) namedStream {
+		return namedStream{Name: s.Name, Open: func() (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+			return s.Fetcher.GetTokensIncrementallyByWalletAddress(ctx, address)
+		}}
+	})
+	if w.Quorum > 1 {
+		return w.mergeStreams(ctx, capability, streams)
+	}
+	return w.failoverStreams(ctx, capability, streams)
+}
+
+func (w *FailoverWrapper) GetTokensIncrementallyByContractAddress(ctx context.Context, address persist.Address, maxLimit int) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	capability := "GetTokensIncrementallyByContractAddress"
+	sources := capableSources[common.TokensIncrementalContractFetcher](w)
+	streams := util.MapWithoutError(sources, func(s namedFetcher[common.TokensIncrementalContractFetcher]) namedStream {
+		return namedStream{Name: s.Name, Open: func() (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+			return s.Fetcher.GetTokensIncrementallyByContractAddress(ctx, address, maxLimit)
+		}}
+	})
+	return w.failoverStreams(ctx, capability, streams)
+}
+
+// GetTokensChangedSinceByWalletAddress reads changes from the first healthy source that supports fetching changes, moving onto
+// the next source if a source fails. Changes are never merged across sources because each source reports its own checkpoint.
+func (w *FailoverWrapper) GetTokensChangedSinceByWalletAddress(ctx context.Context, address persist.Address, since common.SyncCheckpoint) (<-chan common.ChainAgnosticTokenChanges, <-chan error) {
+	capability := "GetTokensChangedSinceByWalletAddress"
+	sources := capableSources[common.TokensChangedSinceFetcher](w)
+
+	outCh := make(chan common.ChainAgnosticTokenChanges)
+	outErrCh := make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(outErrCh)
+
+		errs := make(util.MultiErr, 0, len(sources))
+
+		for _, s := range sources {
+			if !w.acquire(s.Name) {
+				continue
+			}
+			err := readChangesStream(ctx, s.Fetcher, address, since, outCh)
+			w.recordResult(ctx, capability, s.Name, err)
+			if err == nil {
+				return
+			}
+			if ctx.Err() != nil {
+				outErrCh <- ctx.Err()
+				return
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", s.Name, err))
+		}
+
+		if len(errs) == 0 {
+			outErrCh <- ErrNoAvailableSource{Chain: w.Chain, Capability: capability}
+			return
+		}
+
+		outErrCh <- ErrAllSourcesFailed{Chain: w.Chain, Capability: capability, Errs: errs}
+	}()
+
+	return outCh, outErrCh
+}
+
+// readChangesStream forwards pages of changes from a fetcher until the stream completes or fails
+func readChangesStream(ctx context.Context, f common.TokensChangedSinceFetcher, address persist.Address, since common.SyncCheckpoint, outCh chan<- common.ChainAgnosticTokenChanges) error {
+	recCh, errCh := f.GetTokensChangedSinceByWalletAddress(ctx, address, since)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case page, ok := <-recCh:
+			if !ok {
+				return nil
+			}
+			outCh <- page
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			if err != nil {
+				go func() {
+					for range recCh {
+					}
+				}()
+				return err
+			}
+		}
+	}
+}
+
+type namedStream struct {
+	Name string
+	Open func() (<-chan common.ChainAgnosticTokensAndContracts, <-chan error)
+}
+
+// readStream forwards pages from a stream to f until the stream completes or fails
+func readStream(ctx context.Context, recCh <-chan common.ChainAgnosticTokensAndContracts, errCh <-chan error, f func(common.ChainAgnosticTokensAndContracts)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case page, ok := <-recCh:
+			if !ok {
+				return nil
+			}
+			f(page)
+		case err, ok := <-errCh:
+			if !ok {
+				// Some providers close their error channel before their page channel
+				errCh = nil
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// drainStream discards whatever is left on an abandoned stream so that the provider's goroutines can exit
+func drainStream(recCh <-chan common.ChainAgnosticTokensAndContracts, errCh <-chan error) {
+	go func() {
+		for range recCh {
+		}
+	}()
+	go func() {
+		for range errCh {
+		}
+	}()
+}
+
+// failoverStreams reads from each stream in order, moving onto the next stream if a stream fails. Pages
+// that were already sent before a stream failed are not retracted; downstream upserts are idempotent so
+// tokens that are sent again by the next stream are handled the same way.
+func (w *FailoverWrapper) failoverStreams(ctx context.Context, capability string, streams []namedStream) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	outCh := make(chan common.ChainAgnosticTokensAndContracts)
+	outErrCh := make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(outErrCh)
+
+		if err := w.readStreamsInOrder(ctx, capability, streams, nil, outCh); err != nil {
+			outErrCh <- err
+		}
+	}()
+
+	return outCh, outErrCh
+}
+
+// readStreamsInOrder forwards pages from the first stream that completes successfully. prevErrs are errors
+// from streams that were already tried and are included in the returned error if every stream fails.
+func (w *FailoverWrapper) readStreamsInOrder(ctx context.Context, capability string, streams []namedStream, prevErrs []error, outCh chan<- common.ChainAgnosticTokensAndContracts) error {
+	errs := append(util.MultiErr{}, prevErrs...)
+
+	for _, s := range streams {
+		if !w.acquire(s.Name) {
+			continue
+		}
+		recCh, errCh := s.Open()
+		err := readStream(ctx, recCh, errCh, func(page common.ChainAgnosticTokensAndContracts) { outCh <- page })
+		w.recordResult(ctx, capability, s.Name, err)
+		if err == nil {
+			return nil
+		}
+		drainStream(recCh, errCh)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", s.Name, err))
+	}
+
+	if len(errs) == 0 {
+		return ErrNoAvailableSource{Chain: w.Chain, Capability: capability}
+	}
+
+	return ErrAllSourcesFailed{Chain: w.Chain, Capability: capability, Errs: errs}
+}
+
+// mergeStreams reads the first Quorum streams concurrently and sends the merged result once every stream has
+// completed. Streams that fail are excluded from the merge. If every stream in the quorum fails, the remaining
+// streams are tried in order.
+func (w *FailoverWrapper) mergeStreams(ctx context.Context, capability string, streams []namedStream) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	if len(streams) <= 1 {
+		return w.failoverStreams(ctx, capability, streams)
+	}
+
+	outCh := make(chan common.ChainAgnosticTokensAndContracts)
+	outErrCh := make(chan error)
+
+	go func() {
+		defer close(outCh)
+		defer close(outErrCh)
+
+		quorum, rest := acquireQuorum(w, streams, func(s namedStream) string { return s.Name })
+		if len(quorum) == 0 {
+			outErrCh <- ErrNoAvailableSource{Chain: w.Chain, Capability: capability}
+			return
+		}
+
+		pages := make([][]common.ChainAgnosticTokensAndContracts, len(quorum))
+		errs := make([]error, len(quorum))
+
+		var wg sync.WaitGroup
+		for i, s := range quorum {
+			i, s := i, s
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				recCh, errCh := s.Open()
+				errs[i] = readStream(ctx, recCh, errCh, func(page common.ChainAgnosticTokensAndContracts) {
+					pages[i] = append(pages[i], page)
+				})
+				w.recordResult(ctx, capability, s.Name, errs[i])
+				if errs[i] != nil {
+					drainStream(recCh, errCh)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if ctx.Err() != nil {
+			outErrCh <- ctx.Err()
+			return
+		}
+
+		succeeded := make([][]common.ChainAgnosticTokensAndContracts, 0, len(quorum))
+		failed := make([]error, 0, len(quorum))
+		for i, s := range quorum {
+			if errs[i] != nil {
+				failed = append(failed, fmt.Errorf("%s: %w", s.Name, errs[i]))
+				continue
+			}
+			succeeded = append(succeeded, pages[i])
+		}
+
+		if len(succeeded) == 0 {
+			if err := w.readStreamsInOrder(ctx, capability, rest, failed, outCh); err != nil {
+				outErrCh <- err
+			}
+			return
+		}
+
+		for _, page := range mergePages(w.Chain, succeeded, mergedPageSize) {
+			outCh <- page
+		}
+	}()
+
+	return outCh, outErrCh
+}
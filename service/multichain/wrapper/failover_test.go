@@ -0,0 +1,198 @@
+package wrapper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/multichain/common"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+type stubOwnerFetcher struct {
+	pages []common.ChainAgnosticTokensAndContracts
+	err   error
+	calls int
+}
+
+func (s *stubOwnerFetcher) GetTokensIncrementallyByWalletAddress(ctx context.Context, address persist.Address) (<-chan common.ChainAgnosticTokensAndContracts, <-chan error) {
+	s.calls++
+	recCh := make(chan common.ChainAgnosticTokensAndContracts)
+	errCh := make(chan error)
+	go func() {
+		defer close(recCh)
+		defer close(errCh)
+		for _, page := range s.pages {
+			recCh <- page
+		}
+		if s.err != nil {
+			errCh <- s.err
+		}
+	}()
+	return recCh, errCh
+}
+
+func (s *stubOwnerFetcher) GetContractByAddress(ctx context.Context, address persist.Address) (common.ChainAgnosticContract, error) {
+	s.calls++
+	return common.ChainAgnosticContract{Address: address, Descriptors: common.ChainAgnosticContractDescriptors{Name: "stub"}}, s.err
+}
+
+func readAll(recCh <-chan common.ChainAgnosticTokensAndContracts, errCh <-chan error) ([]common.ChainAgnosticToken, error) {
+	var tokens []common.ChainAgnosticToken
+	err := readStream(context.Background(), recCh, errCh, func(page common.ChainAgnosticTokensAndContracts) {
+		tokens = append(tokens, page.Tokens...)
+	})
+	return tokens, err
+}
+
+func page(contract string, tokenIDs ...string) common.ChainAgnosticTokensAndContracts {
+	p := common.ChainAgnosticTokensAndContracts{Contracts: []common.ChainAgnosticContract{{Address: persist.Address(contract)}}}
+	for _, id := range tokenIDs {
+		p.Tokens = append(p.Tokens, common.ChainAgnosticToken{ContractAddress: persist.Address(contract), TokenID: persist.HexTokenID(id), OwnerAddress: "0xowner"})
+	}
+	return p
+}
+
+func TestHealth(t *testing.T) {
+	now := time.Now()
+	h := NewHealth(2, time.Minute)
+	h.now = func() time.Time { return now }
+
+	t.Run("opens after threshold is reached", func(t *testing.T) {
+		h.RecordFailure("a", errors.New("boom"))
+		assert.True(t, h.Allow("a"))
+		h.RecordFailure("a", errors.New("boom"))
+		assert.False(t, h.Allow("a"))
+		assert.Equal(t, "open", h.Status("a").State)
+	})
+
+	t.Run("lets a single trial request through after cooldown", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		assert.True(t, h.Allow("a"))
+		assert.False(t, h.Allow("a"))
+	})
+
+	t.Run("failed trial re-opens circuit", func(t *testing.T) {
+		h.RecordFailure("a", errors.New("boom"))
+		assert.False(t, h.Allow("a"))
+	})
+
+	t.Run("successful trial closes circuit", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		assert.True(t, h.Allow("a"))
+		h.RecordSuccess("a")
+		assert.True(t, h.Allow("a"))
+		assert.Equal(t, 0, h.Status("a").ConsecutiveFailures)
+	})
+
+	t.Run("released trial lets the next request through", func(t *testing.T) {
+		h.RecordFailure("b", errors.New("boom"))
+		h.RecordFailure("b", errors.New("boom"))
+		now = now.Add(2 * time.Minute)
+		assert.True(t, h.Allow("b"))
+		h.Release("b")
+		assert.True(t, h.Allow("b"))
+		assert.False(t, h.Allow("b"))
+	})
+}
+
+func TestFailoverWrapper(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fails over to next source when a stream fails", func(t *testing.T) {
+		primary := &stubOwnerFetcher{pages: []common.ChainAgnosticTokensAndContracts{page("0xa", "1")}, err: errors.New("boom")}
+		secondary := &stubOwnerFetcher{pages: []common.ChainAgnosticTokensAndContracts{page("0xa", "1", "2")}}
+		w := NewFailoverWrapper(persist.ChainETH, NewHealth(5, time.Minute), 1, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+
+		tokens, err := readAll(w.GetTokensIncrementallyByWalletAddress(ctx, "0xowner"))
+
+		assert.NoError(t, err)
+		assert.Len(t, tokens, 3)
+		assert.Equal(t, 1, w.Health.Status(w.sourceKey("primary")).ConsecutiveFailures)
+	})
+
+	t.Run("returns error when every source fails", func(t *testing.T) {
+		primary := &stubOwnerFetcher{err: errors.New("boom")}
+		secondary := &stubOwnerFetcher{err: errors.New("bang")}
+		w := NewFailoverWrapper(persist.ChainETH, NewHealth(5, time.Minute), 1, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+
+		_, err := readAll(w.GetTokensIncrementallyByWalletAddress(ctx, "0xowner"))
+
+		_, ok := err.(ErrAllSourcesFailed)
+		assert.True(t, ok)
+	})
+
+	t.Run("skips sources with an open circuit", func(t *testing.T) {
+		primary := &stubOwnerFetcher{err: errors.New("boom")}
+		secondary := &stubOwnerFetcher{}
+		w := NewFailoverWrapper(persist.ChainETH, NewHealth(1, time.Minute), 1, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+
+		_, err := w.GetContractByAddress(ctx, "0xa")
+		assert.NoError(t, err)
+		_, err = w.GetContractByAddress(ctx, "0xa")
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, primary.calls)
+		assert.Equal(t, 2, secondary.calls)
+	})
+
+	t.Run("merges results from quorum sources", func(t *testing.T) {
+		primary := &stubOwnerFetcher{pages: []common.ChainAgnosticTokensAndContracts{page("0xa", "1", "2")}}
+		secondary := &stubOwnerFetcher{pages: []common.ChainAgnosticTokensAndContracts{page("0xa", "2", "3"), page("0xb", "1")}}
+		w := NewFailoverWrapper(persist.ChainETH, NewHealth(5, time.Minute), 2, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+
+		tokens, err := readAll(w.GetTokensIncrementallyByWalletAddress(ctx, "0xowner"))
+
+		assert.NoError(t, err)
+		assert.Len(t, tokens, 4)
+	})
+
+	t.Run("merge succeeds if one quorum source fails", func(t *testing.T) {
+		primary := &stubOwnerFetcher{err: errors.New("boom")}
+		secondary := &stubOwnerFetcher{pages: []common.ChainAgnosticTokensAndContracts{page("0xa", "1")}}
+		w := NewFailoverWrapper(persist.ChainETH, NewHealth(5, time.Minute), 2, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+
+		tokens, err := readAll(w.GetTokensIncrementallyByWalletAddress(ctx, "0xowner"))
+
+		assert.NoError(t, err)
+		assert.Len(t, tokens, 1)
+	})
+
+	t.Run("only uses the trial request of a source that is called", func(t *testing.T) {
+		now := time.Now()
+		health := NewHealth(1, time.Minute)
+		health.now = func() time.Time { return now }
+		primary := &stubOwnerFetcher{}
+		secondary := &stubOwnerFetcher{}
+		w := NewFailoverWrapper(persist.ChainETH, health, 1, Source{Name: "primary", Fetcher: primary}, Source{Name: "secondary", Fetcher: secondary})
+		health.RecordFailure(w.sourceKey("secondary"), errors.New("boom"))
+		now = now.Add(2 * time.Minute)
+
+		_, err := w.GetContractByAddress(ctx, "0xa")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, secondary.calls)
+		assert.True(t, health.Allow(w.sourceKey("secondary")))
+	})
+
+	t.Run("releases the trial request of a cancelled call", func(t *testing.T) {
+		now := time.Now()
+		health := NewHealth(1, time.Minute)
+		health.now = func() time.Time { return now }
+		primary := &stubOwnerFetcher{err: context.Canceled}
+		w := NewFailoverWrapper(persist.ChainETH, health, 1, Source{Name: "primary", Fetcher: primary})
+		health.RecordFailure(w.sourceKey("primary"), errors.New("boom"))
+		now = now.Add(2 * time.Minute)
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := w.GetContractByAddress(cancelled, "0xa")
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, primary.calls)
+		assert.True(t, health.Allow(w.sourceKey("primary")))
+	})
+}
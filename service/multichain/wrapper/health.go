@@ -0,0 +1,159 @@
+package wrapper
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultCooldown         = time.Minute
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// SourceStatus is a snapshot of the health of a single source
+type SourceStatus struct {
+	Name                string
+	State               string
+	ConsecutiveFailures int
+	LastError           error
+	LastFailure         time.Time
+	LastSuccess         time.Time
+}
+
+type sourceHealth struct {
+	state               circuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	lastErr             error
+	lastFailure         time.Time
+	lastSuccess         time.Time
+}
+
+// Health tracks the health of sources and acts as a circuit breaker for each of them.
+// After FailureThreshold consecutive failures a source's circuit is opened and the source is skipped
+// until Cooldown has elapsed. Once the cooldown is over, a single trial request is let through: if it
+// succeeds the circuit is closed again, otherwise it is re-opened for another cooldown.
+type Health struct {
+	FailureThreshold int
+	Cooldown         time.Duration
+	mu               sync.Mutex
+	sources          map[string]*sourceHealth
+	now              func() time.Time
+}
+
+func NewHealth(failureThreshold int, cooldown time.Duration) *Health {
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+	return &Health{
+		FailureThreshold: failureThreshold,
+		Cooldown:         cooldown,
+		sources:          make(map[string]*sourceHealth),
+		now:              time.Now,
+	}
+}
+
+// Allow reports whether a request should be sent to the source
+func (h *Health) Allow(name string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := h.get(name)
+	switch s.state {
+	case circuitOpen:
+		if h.now().Sub(s.openedAt) < h.Cooldown {
+			return false
+		}
+		// Let a single trial request through
+		s.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// A trial request is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// RecordSuccess closes the source's circuit
+func (h *Health) RecordSuccess(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := h.get(name)
+	s.state = circuitClosed
+	s.consecutiveFailures = 0
+	s.lastSuccess = h.now()
+}
+
+// RecordFailure counts a failure against the source, opening its circuit if the threshold is reached
+// or if the failed request was a trial request.
+func (h *Health) RecordFailure(name string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := h.get(name)
+	s.consecutiveFailures++
+	s.lastErr = err
+	s.lastFailure = h.now()
+	if s.state == circuitHalfOpen || s.consecutiveFailures >= h.FailureThreshold {
+		s.state = circuitOpen
+		s.openedAt = s.lastFailure
+	}
+}
+
+// Release gives back a trial request that was let through but didn't complete, such as when the caller cancelled it,
+// so that the next request can be the trial instead
+func (h *Health) Release(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := h.get(name)
+	if s.state == circuitHalfOpen {
+		s.state = circuitOpen
+	}
+}
+
+// Status returns a snapshot of the source's health
+func (h *Health) Status(name string) SourceStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := h.get(name)
+	return SourceStatus{
+		Name:                name,
+		State:               s.state.String(),
+		ConsecutiveFailures: s.consecutiveFailures,
+		LastError:           s.lastErr,
+		LastFailure:         s.lastFailure,
+		LastSuccess:         s.lastSuccess,
+	}
+}
+
+func (h *Health) get(name string) *sourceHealth {
+	s, ok := h.sources[name]
+	if !ok {
+		s = &sourceHealth{state: circuitClosed}
+		h.sources[name] = s
+	}
+	return s
+}
@@ -0,0 +1,139 @@
+package wrapper
+
+import (
+	"github.com/mikeydub/go-gallery/service/multichain/common"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// mergeChainAgnosticTokens fills in empty fields of a with fields from b
+func mergeChainAgnosticTokens(a, b common.ChainAgnosticToken) common.ChainAgnosticToken {
+	a.Descriptors = mergeTokenDescriptors(a.Descriptors, b.Descriptors)
+	a.TokenMetadata = mergeMetadata(a.TokenMetadata, b.TokenMetadata)
+	if a.TokenType == "" {
+		a.TokenType = b.TokenType
+	}
+	if a.TokenURI == "" {
+		a.TokenURI = b.TokenURI
+	}
+	if a.Quantity == "" {
+		a.Quantity = b.Quantity
+	}
+	if !a.FallbackMedia.IsServable() {
+		a.FallbackMedia = b.FallbackMedia
+	}
+	if a.BlockNumber == 0 {
+		a.BlockNumber = b.BlockNumber
+	}
+	a.ExternalURL = util.FirstNonEmptyString(a.ExternalURL, b.ExternalURL)
+	a.IsSpam = mergeIsSpam(a.IsSpam, b.IsSpam)
+	return a
+}
+
+// mergeChainAgnosticContracts fills in empty fields of a with fields from b
+func mergeChainAgnosticContracts(a, b common.ChainAgnosticContract) common.ChainAgnosticContract {
+	a.Descriptors = mergeContractDescriptors(a.Descriptors, b.Descriptors)
+	if a.Address == "" {
+		a.Address = b.Address
+	}
+	if a.LatestBlock < b.LatestBlock {
+		a.LatestBlock = b.LatestBlock
+	}
+	a.IsSpam = mergeIsSpam(a.IsSpam, b.IsSpam)
+	return a
+}
+
+func mergeTokenDescriptors(a, b common.ChainAgnosticTokenDescriptors) common.ChainAgnosticTokenDescriptors {
+	a.Name = util.FirstNonEmptyString(a.Name, b.Name)
+	a.Description = util.FirstNonEmptyString(a.Description, b.Description)
+	return a
+}
+
+func mergeContractDescriptors(a, b common.ChainAgnosticContractDescriptors) common.ChainAgnosticContractDescriptors {
+	a.Symbol = util.FirstNonEmptyString(a.Symbol, b.Symbol)
+	a.Name = util.FirstNonEmptyString(a.Name, b.Name)
+	a.Description = util.FirstNonEmptyString(a.Description, b.Description)
+	a.ProfileImageURL = util.FirstNonEmptyString(a.ProfileImageURL, b.ProfileImageURL)
+	a.OwnerAddress = persist.Address(util.FirstNonEmptyString(a.OwnerAddress.String(), b.OwnerAddress.String()))
+	return a
+}
+
+func mergeMetadata(a, b persist.TokenMetadata) persist.TokenMetadata {
+	if len(a) > 0 {
+		return a
+	}
+	return b
+}
+
+// mergeIsSpam marks a token as spam if any source considers it spam, consistent with how contracts
+// from multiple providers are merged when they are persisted.
+func mergeIsSpam(a, b *bool) *bool {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return util.ToPointer(*a || *b)
+}
+
+type mergeTokenKey struct {
+	ContractAddress string
+	TokenID         persist.HexTokenID
+	OwnerAddress    string
+}
+
+// mergePages merges the pages read from multiple sources into pages of at most pageSize tokens. Sources
+// are in order of precedence. Every page includes the contracts of the tokens in that page.
+func mergePages(chain persist.Chain, sources [][]common.ChainAgnosticTokensAndContracts, pageSize int) []common.ChainAgnosticTokensAndContracts {
+	tokens := make(map[mergeTokenKey]common.ChainAgnosticToken)
+	tokenOrder := make([]mergeTokenKey, 0)
+	contracts := make(map[string]common.ChainAgnosticContract)
+
+	for _, pages := range sources {
+		for _, page := range pages {
+			for _, c := range page.Contracts {
+				address := chain.NormalizeAddress(c.Address)
+				if existing, ok := contracts[address]; ok {
+					contracts[address] = mergeChainAgnosticContracts(existing, c)
+					continue
+				}
+				contracts[address] = c
+			}
+			for _, t := range page.Tokens {
+				k := mergeTokenKey{
+					ContractAddress: chain.NormalizeAddress(t.ContractAddress),
+					TokenID:         t.TokenID,
+					OwnerAddress:    chain.NormalizeAddress(t.OwnerAddress),
+				}
+				if existing, ok := tokens[k]; ok {
+					tokens[k] = mergeChainAgnosticTokens(existing, t)
+					continue
+				}
+				tokens[k] = t
+				tokenOrder = append(tokenOrder, k)
+			}
+		}
+	}
+
+	if len(tokenOrder) == 0 {
+		return nil
+	}
+
+	merged := make([]common.ChainAgnosticTokensAndContracts, 0, len(tokenOrder)/pageSize+1)
+
+	for _, keys := range util.ChunkBy(tokenOrder, pageSize) {
+		var page common.ChainAgnosticTokensAndContracts
+		seenContracts := make(map[string]bool)
+		for _, k := range keys {
+			page.Tokens = append(page.Tokens, tokens[k])
+			if c, ok := contracts[k.ContractAddress]; ok && !seenContracts[k.ContractAddress] {
+				page.Contracts = append(page.Contracts, c)
+				seenContracts[k.ContractAddress] = true
+			}
+		}
+		merged = append(merged, page)
+	}
+
+	return merged
+}
@@ -19,6 +19,7 @@ type SyncPipelineWrapper struct {
 	TokenIdentifierOwnerFetcher      common.TokenIdentifierOwnerFetcher
 	TokensIncrementalOwnerFetcher    common.TokensIncrementalOwnerFetcher
 	TokensIncrementalContractFetcher common.TokensIncrementalContractFetcher
+	TokensChangedSinceFetcher        common.TokensChangedSinceFetcher
 	TokenMetadataBatcher             common.TokenMetadataBatcher
 	TokensByTokenIdentifiersFetcher  common.TokensByTokenIdentifiersFetcher
 	TokensByContractWalletFetcher    common.TokensByContractWalletFetcher
@@ -43,6 +44,27 @@ func (w SyncPipelineWrapper) GetTokensIncrementallyByContractAddress(ctx context
 	return recCh, errCh
 }
 
+func (w SyncPipelineWrapper) GetTokensChangedSinceByWalletAddress(ctx context.Context, address persist.Address, since common.SyncCheckpoint) (<-chan common.ChainAgnosticTokenChanges, <-chan error) {
+	recCh, errCh := w.TokensChangedSinceFetcher.GetTokensChangedSinceByWalletAddress(ctx, address, since)
+	outCh := make(chan common.ChainAgnosticTokenChanges)
+	go func() {
+		defer close(outCh)
+		for page := range recCh {
+			page.Tokens = w.CustomMetadataWrapper.LoadAll(ctx, w.Chain, page.Tokens)
+			select {
+			case outCh <- page:
+			case <-ctx.Done():
+				go func() {
+					for range recCh {
+					}
+				}()
+				return
+			}
+		}
+	}()
+	return outCh, errCh
+}
+
 func (w SyncPipelineWrapper) GetTokensByTokenIdentifiers(ctx context.Context, ti common.ChainAgnosticIdentifiers) ([]common.ChainAgnosticToken, common.ChainAgnosticContract, error) {
 	t, c, err := w.TokensByTokenIdentifiersFetcher.GetTokensByTokenIdentifiers(ctx, ti)
 	t = w.CustomMetadataWrapper.LoadAll(ctx, w.Chain, t)
@@ -213,6 +213,8 @@ func New(queries *db.Queries, pub *pubsub.Client, taskClient *task.Client, lock
 	// single notification actions
 	notifDispatcher.AddHandler(persist.ActionCommentedOnFeedEvent, singleHandler)
 	notifDispatcher.AddHandler(persist.ActionCommentedOnPost, singleHandler)
+	notifDispatcher.AddHandler(persist.ActionRepostedPost, singleHandler)
+	notifDispatcher.AddHandler(persist.ActionQuotedPost, singleHandler)
 	notifDispatcher.AddHandler(persist.ActionReplyToComment, singleHandler)
 	notifDispatcher.AddHandler(persist.ActionMentionUser, singleHandler)
 	notifDispatcher.AddHandler(persist.ActionMentionCommunity, singleHandler)
@@ -748,6 +750,15 @@ func createPushMessage(ctx context.Context, notif db.Notification, queries *db.Q
 			return task.PushNotificationMessage{}, err
 		}
 		return message, nil
+	case notif.Action == persist.ActionRepostedPost || notif.Action == persist.ActionQuotedPost:
+		repost, err := queries.GetRepostByID(ctx, notif.Data.RepostID)
+		if err != nil {
+			return task.PushNotificationMessage{}, err
+		}
+		if err := limiter.tryFeedEntity(ctx, repost.ActorID, notif.OwnerID, notif.PostID); err != nil {
+			return task.PushNotificationMessage{}, err
+		}
+		return message, nil
 	case notif.Action == persist.ActionTopActivityBadgeReceived:
 		if err := limiter.tryUsers(ctx, notif.OwnerID); err != nil {
 			return task.PushNotificationMessage{}, err
@@ -879,6 +890,26 @@ func NotificationToUserFacingData(ctx context.Context, queries *coredb.Queries,
 			Action:      action,
 			PreviewText: util.TruncateWithEllipsis(comment.Comment, 40),
 		}, nil
+	case persist.ActionRepostedPost, persist.ActionQuotedPost:
+		repost, err := queries.GetRepostByID(ctx, n.Data.RepostID)
+		if err != nil {
+			return UserFacingNotificationData{}, fmt.Errorf("failed to get repost %s: %w", n.Data.RepostID, err)
+		}
+		userActor, err := queries.GetUserById(ctx, repost.ActorID)
+		if err != nil {
+			return UserFacingNotificationData{}, fmt.Errorf("failed to get user for repost actor %s: %w", repost.ActorID, err)
+		}
+		if n.Action == persist.ActionQuotedPost {
+			return UserFacingNotificationData{
+				Actor:       userActor.Username.String,
+				Action:      "quoted your post",
+				PreviewText: util.TruncateWithEllipsis(repost.Caption.String, 40),
+			}, nil
+		}
+		return UserFacingNotificationData{
+			Actor:  userActor.Username.String,
+			Action: "reposted your post",
+		}, nil
 	case persist.ActionViewedGallery:
 		if len(n.Data.AuthedViewerIDs)+len(n.Data.UnauthedViewerIDs) > 1 {
 			return UserFacingNotificationData{
@@ -1109,6 +1140,8 @@ func actionSupportsPushNotifications(action persist.Action) bool {
 		return true
 	case persist.ActionAdmiredPost:
 		return true
+	case persist.ActionRepostedPost, persist.ActionQuotedPost:
+		return true
 	case persist.ActionAdmiredToken:
 		return true
 	case persist.ActionAdmiredComment:
@@ -1178,6 +1211,10 @@ func sendPushNotifications(ctx context.Context, notif db.Notification, queries *
 
 func insertAndPublishNotif(ctx context.Context, notif db.Notification, queries *db.Queries, ps *pubsub.Client, taskClient *task.Client, limiter *pushLimiter) error {
 	newNotif, err := addNotification(ctx, notif, queries)
+	if errors.Is(err, errNotificationMuted) {
+		logger.For(ctx).Infof("not sending notification to %s: muted by recipient", notif.OwnerID)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create notification: %w", err)
 	}
@@ -1240,6 +1277,16 @@ func sendNotification(ctx context.Context, newNotif db.Notification, queries *db
 }
 
 func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecentNotif db.Notification, queries *db.Queries, ps *pubsub.Client, taskClient *task.Client, limiter *pushLimiter) error {
+	// Grouped notifications skip addNotification, so they need to be checked against the recipient's mutes here
+	err := checkNotificationMuted(ctx, notif, queries)
+	if errors.Is(err, errNotificationMuted) {
+		logger.For(ctx).Infof("not updating notification for %s: muted by recipient", notif.OwnerID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check notification mutes: %w", err)
+	}
+
 	var amount = notif.Amount
 	resultData := mostRecentNotif.Data.Concat(notif.Data)
 	switch notif.Action {
@@ -1254,7 +1301,7 @@ func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecen
 	default:
 		amount = mostRecentNotif.Amount + notif.Amount
 	}
-	err := queries.UpdateNotification(ctx, db.UpdateNotificationParams{
+	err = queries.UpdateNotification(ctx, db.UpdateNotificationParams{
 		ID: mostRecentNotif.ID,
 		// this concat will put the notif.Data values at the beginning of the array, sorted from most recently added to oldest added
 		Data:   resultData,
@@ -1294,7 +1341,30 @@ func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecen
 	return nil
 }
 
+var errNotificationMuted = errors.New("notification muted by recipient")
+
+// checkNotificationMuted returns errNotificationMuted if the recipient has muted whoever or whatever caused notif
+func checkNotificationMuted(ctx context.Context, notif db.Notification, queries *db.Queries) error {
+	muted, err := queries.IsNotificationMuted(ctx, db.IsNotificationMutedParams{
+		OwnerID:     notif.OwnerID,
+		EventIds:    util.MapWithoutError(notif.EventIds, func(id persist.DBID) string { return id.String() }),
+		CommentID:   util.ToNullString(notif.CommentID.String(), true),
+		CommunityID: util.ToNullString(notif.CommunityID.String(), true),
+	})
+	if err != nil {
+		return err
+	}
+	if muted {
+		return errNotificationMuted
+	}
+	return nil
+}
+
 func addNotification(ctx context.Context, notif db.Notification, queries *db.Queries) (db.Notification, error) {
+	if err := checkNotificationMuted(ctx, notif, queries); err != nil {
+		return db.Notification{}, err
+	}
+
 	id := persist.GenerateID()
 	switch notif.Action {
 	case persist.ActionAdmiredFeedEvent:
@@ -1435,7 +1505,7 @@ func addNotification(ctx context.Context, notif db.Notification, queries *db.Que
 			Data:     notif.Data,
 			EventIds: notif.EventIds,
 		})
-	case persist.ActionUserPostedFirstPost:
+	case persist.ActionUserPostedFirstPost, persist.ActionRepostedPost, persist.ActionQuotedPost:
 		return queries.CreatePostNotification(ctx, db.CreatePostNotificationParams{
 			ID:       id,
 			OwnerID:  notif.OwnerID,
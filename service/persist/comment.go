@@ -2,6 +2,8 @@ package persist
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
@@ -16,6 +18,31 @@ type Comment struct {
 	Deleted     bool      `json:"deleted"`
 }
 
+// CommentRestriction controls who can comment on a post
+type CommentRestriction string
+
+const (
+	CommentRestrictionEveryone  CommentRestriction = "EVERYONE"
+	CommentRestrictionFollowers CommentRestriction = "FOLLOWERS"
+	CommentRestrictionMutuals   CommentRestriction = "MUTUALS"
+	CommentRestrictionNobody    CommentRestriction = "NOBODY"
+)
+
+func (c *CommentRestriction) UnmarshalGQL(v any) error {
+	val, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("CommentRestriction must be a string")
+	}
+	switch CommentRestriction(val) {
+	case CommentRestrictionEveryone, CommentRestrictionFollowers, CommentRestrictionMutuals, CommentRestrictionNobody:
+		*c = CommentRestriction(val)
+		return nil
+	}
+	return fmt.Errorf("unknown CommentRestriction: %s", val)
+}
+
+func (c CommentRestriction) MarshalGQL(w io.Writer) { w.Write([]byte(strconv.Quote(string(c)))) }
+
 type MentionType string
 
 const (
@@ -34,6 +34,8 @@ const (
 	ActionAdmiredComment                  Action = "AdmiredComment"
 	ActionCommentedOnFeedEvent            Action = "CommentedOnFeedEvent"
 	ActionCommentedOnPost                 Action = "CommentedOnPost"
+	ActionRepostedPost                    Action = "RepostedPost"
+	ActionQuotedPost                      Action = "QuotedPost"
 	ActionReplyToComment                  Action = "RepliedToComment"
 	ActionMentionUser                     Action = "MentionUser"
 	ActionMentionCommunity                Action = "MentionCommunity"
@@ -124,6 +126,30 @@ func (e ErrPostNotFoundByID) Error() string {
 	return fmt.Sprintf("post not found by id=%s", e.ID)
 }
 
+var errRepostNotFound ErrRepostNotFound
+
+type ErrRepostNotFound struct{}
+
+func (e ErrRepostNotFound) Unwrap() error { return notFoundError }
+func (e ErrRepostNotFound) Error() string { return "repost not found" }
+
+type ErrRepostNotFoundByID struct{ ID DBID }
+
+func (e ErrRepostNotFoundByID) Unwrap() error { return errRepostNotFound }
+func (e ErrRepostNotFoundByID) Error() string {
+	return fmt.Sprintf("repost not found by id=%s", e.ID)
+}
+
+type ErrRepostNotFoundByActorIDPostID struct {
+	ActorID DBID
+	PostID  DBID
+}
+
+func (e ErrRepostNotFoundByActorIDPostID) Unwrap() error { return errRepostNotFound }
+func (e ErrRepostNotFoundByActorIDPostID) Error() string {
+	return fmt.Sprintf("repost not found by actorID=%s; postID=%s", e.ActorID, e.PostID)
+}
+
 type ErrUnknownAction struct {
 	Action Action
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"io"
+	"strconv"
 )
 
 type FeedEntityType int
@@ -11,6 +12,8 @@ type FeedEntityType int
 const (
 	FeedEventTypeTag FeedEntityType = iota
 	PostTypeTag
+	RepostTypeTag
+	QuotePostTypeTag
 )
 
 const (
@@ -37,11 +40,20 @@ func (r *ReportReason) UnmarshalGQL(v any) error {
 	return nil
 }
 
-func (r ReportReason) MarshalGQL(w io.Writer) { w.Write([]byte(string(r))) }
+func (r ReportReason) MarshalGQL(w io.Writer) { w.Write([]byte(strconv.Quote(string(r)))) }
 
 func (r ReportReason) Value() (driver.Value, error) { return string(r), nil }
 
 func (r *ReportReason) Scan(v any) error {
-	*r = ReportReason(v.(byte))
+	switch v := v.(type) {
+	case nil:
+		*r = ""
+	case string:
+		*r = ReportReason(v)
+	case []byte:
+		*r = ReportReason(v)
+	default:
+		return fmt.Errorf("unsupported type for ReportReason: %T", v)
+	}
 	return nil
 }
@@ -0,0 +1,172 @@
+package persist
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+)
+
+// GalleryVersionCollection is a collection as it was when a gallery version was saved
+type GalleryVersionCollection struct {
+	ID             DBID                             `json:"id"`
+	Name           string                           `json:"name"`
+	CollectorsNote string                           `json:"collectors_note"`
+	Hidden         bool                             `json:"hidden"`
+	Tokens         DBIDList                         `json:"tokens"`
+	Layout         TokenLayout                      `json:"layout"`
+	TokenSettings  map[DBID]CollectionTokenSettings `json:"token_settings"`
+}
+
+// GalleryVersionCollections are a gallery's collections in display order
+type GalleryVersionCollections []GalleryVersionCollection
+
+// GalleryVersionDiff describes what changed between a gallery version and the version before it
+type GalleryVersionDiff struct {
+	NameChanged          bool
+	DescriptionChanged   bool
+	CollectionsReordered bool
+	AddedCollections     []DBID
+	RemovedCollections   []DBID
+	ChangedCollections   []GalleryVersionCollectionDiff
+}
+
+// GalleryVersionCollectionDiff describes what changed in a collection that is in both versions
+type GalleryVersionCollectionDiff struct {
+	CollectionID          DBID
+	NameChanged           bool
+	CollectorsNoteChanged bool
+	HiddenChanged         bool
+	LayoutChanged         bool
+	TokenSettingsChanged  bool
+	TokensReordered       bool
+	AddedTokens           []DBID
+	RemovedTokens         []DBID
+}
+
+// Diff returns the changes to the collections since prev. Name and description changes are left to the caller,
+// since they aren't part of the collections.
+func (c GalleryVersionCollections) Diff(prev GalleryVersionCollections) GalleryVersionDiff {
+	diff := GalleryVersionDiff{
+		AddedCollections:   []DBID{},
+		RemovedCollections: []DBID{},
+		ChangedCollections: []GalleryVersionCollectionDiff{},
+	}
+
+	prevByID := make(map[DBID]GalleryVersionCollection, len(prev))
+	for _, collection := range prev {
+		prevByID[collection.ID] = collection
+	}
+
+	curByID := make(map[DBID]GalleryVersionCollection, len(c))
+	for _, collection := range c {
+		curByID[collection.ID] = collection
+	}
+
+	for _, collection := range c {
+		before, ok := prevByID[collection.ID]
+		if !ok {
+			diff.AddedCollections = append(diff.AddedCollections, collection.ID)
+			continue
+		}
+		if changes, changed := collection.diff(before); changed {
+			diff.ChangedCollections = append(diff.ChangedCollections, changes)
+		}
+	}
+
+	for _, collection := range prev {
+		if _, ok := curByID[collection.ID]; !ok {
+			diff.RemovedCollections = append(diff.RemovedCollections, collection.ID)
+		}
+	}
+
+	diff.CollectionsReordered = reordered(collectionIDs(prev), collectionIDs(c))
+
+	return diff
+}
+
+func (c GalleryVersionCollection) diff(prev GalleryVersionCollection) (GalleryVersionCollectionDiff, bool) {
+	diff := GalleryVersionCollectionDiff{
+		CollectionID:          c.ID,
+		NameChanged:           c.Name != prev.Name,
+		CollectorsNoteChanged: c.CollectorsNote != prev.CollectorsNote,
+		HiddenChanged:         c.Hidden != prev.Hidden,
+		LayoutChanged:         !reflect.DeepEqual(c.Layout, prev.Layout),
+		TokenSettingsChanged:  !reflect.DeepEqual(c.TokenSettings, prev.TokenSettings),
+		TokensReordered:       reordered(prev.Tokens, c.Tokens),
+		AddedTokens:           missingFrom(prev.Tokens, c.Tokens),
+		RemovedTokens:         missingFrom(c.Tokens, prev.Tokens),
+	}
+
+	changed := diff.NameChanged || diff.CollectorsNoteChanged || diff.HiddenChanged || diff.LayoutChanged ||
+		diff.TokenSettingsChanged || diff.TokensReordered || len(diff.AddedTokens) > 0 || len(diff.RemovedTokens) > 0
+
+	return diff, changed
+}
+
+// Value implements the driver.Valuer interface for the GalleryVersionCollections type
+func (c GalleryVersionCollections) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan implements the Scanner interface for the GalleryVersionCollections type
+func (c *GalleryVersionCollections) Scan(value interface{}) error {
+	if value == nil {
+		*c = GalleryVersionCollections{}
+		return nil
+	}
+	return json.Unmarshal(value.([]byte), c)
+}
+
+func collectionIDs(collections GalleryVersionCollections) []DBID {
+	ids := make([]DBID, len(collections))
+	for i, collection := range collections {
+		ids[i] = collection.ID
+	}
+	return ids
+}
+
+// missingFrom returns the IDs in ids that aren't in from
+func missingFrom(from []DBID, ids []DBID) []DBID {
+	seen := make(map[DBID]bool, len(from))
+	for _, id := range from {
+		seen[id] = true
+	}
+
+	missing := []DBID{}
+	for _, id := range ids {
+		if !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// reordered reports whether the IDs found in both prev and cur appear in a different order
+func reordered(prev []DBID, cur []DBID) bool {
+	inCur := make(map[DBID]bool, len(cur))
+	for _, id := range cur {
+		inCur[id] = true
+	}
+
+	inPrev := make(map[DBID]bool, len(prev))
+	kept := make([]DBID, 0, len(prev))
+	for _, id := range prev {
+		inPrev[id] = true
+		if inCur[id] {
+			kept = append(kept, id)
+		}
+	}
+
+	i := 0
+	for _, id := range cur {
+		if !inPrev[id] {
+			continue
+		}
+		if i >= len(kept) || kept[i] != id {
+			return true
+		}
+		i++
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package persist
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ReportSubjectType is the kind of content that a report or moderation action applies to
+type ReportSubjectType string
+
+const (
+	ReportSubjectTypePost    ReportSubjectType = "POST"
+	ReportSubjectTypeComment ReportSubjectType = "COMMENT"
+	ReportSubjectTypeUser    ReportSubjectType = "USER"
+	ReportSubjectTypeGallery ReportSubjectType = "GALLERY"
+)
+
+func (t *ReportSubjectType) UnmarshalGQL(v any) error {
+	val, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ReportSubjectType must be a string")
+	}
+	switch ReportSubjectType(val) {
+	case ReportSubjectTypePost, ReportSubjectTypeComment, ReportSubjectTypeUser, ReportSubjectTypeGallery:
+		*t = ReportSubjectType(val)
+		return nil
+	}
+	return fmt.Errorf("unknown ReportSubjectType: %s", val)
+}
+
+func (t ReportSubjectType) MarshalGQL(w io.Writer) { w.Write([]byte(strconv.Quote(string(t)))) }
+
+// ModerationActionType is an action taken by a moderator, or automatically, in response to reports
+type ModerationActionType string
+
+const (
+	ModerationActionHide    ModerationActionType = "HIDE"
+	ModerationActionWarn    ModerationActionType = "WARN"
+	ModerationActionSuspend ModerationActionType = "SUSPEND"
+	ModerationActionBan     ModerationActionType = "BAN"
+	ModerationActionDismiss ModerationActionType = "DISMISS"
+)
+
+func (a *ModerationActionType) UnmarshalGQL(v any) error {
+	val, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ModerationActionType must be a string")
+	}
+	switch ModerationActionType(val) {
+	case ModerationActionHide, ModerationActionWarn, ModerationActionSuspend, ModerationActionBan, ModerationActionDismiss:
+		*a = ModerationActionType(val)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationActionType: %s", val)
+}
+
+func (a ModerationActionType) MarshalGQL(w io.Writer) { w.Write([]byte(strconv.Quote(string(a)))) }
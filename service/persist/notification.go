@@ -88,6 +88,7 @@ type NotificationData struct {
 	NewTokenID                     DBID                            `json:"new_token_id,omitempty"`
 	NewTokenQuantity               HexString                       `json:"new_token_quantity,omitempty"`
 	OriginalCommentID              DBID                            `json:"original_comment_id,omitempty"`
+	RepostID                       DBID                            `json:"repost_id,omitempty"`
 	ActivityBadgeThreshold         int                             `json:"activity_badge_threshold,omitempty"`
 	NewTopActiveUser               bool                            `json:"new_top_active_user,omitempty"`
 	AnnouncementDetails            *AnnouncementDetails            `json:"announcement_details,omitempty"`
@@ -103,6 +104,7 @@ func (n NotificationData) Validate() NotificationData {
 	result.NewTokenID = n.NewTokenID
 	result.NewTokenQuantity = n.NewTokenQuantity
 	result.OriginalCommentID = n.OriginalCommentID
+	result.RepostID = n.RepostID
 	result.FollowedBack = n.FollowedBack
 	result.Refollowed = n.Refollowed
 	result.ActivityBadgeThreshold = n.ActivityBadgeThreshold
@@ -123,6 +125,7 @@ func (n NotificationData) Concat(other NotificationData) NotificationData {
 	result.NewTokenQuantity = other.NewTokenQuantity.Add(n.NewTokenQuantity)
 	result.NewTokenID = DBID(util.FirstNonEmptyString(other.NewTokenID.String(), n.NewTokenID.String()))
 	result.OriginalCommentID = DBID(util.FirstNonEmptyString(other.OriginalCommentID.String(), n.OriginalCommentID.String()))
+	result.RepostID = DBID(util.FirstNonEmptyString(other.RepostID.String(), n.RepostID.String()))
 	result.ActivityBadgeThreshold, _ = util.FindFirst([]int{other.ActivityBadgeThreshold, n.ActivityBadgeThreshold}, func(i int) bool {
 		return i > 0
 	})
@@ -0,0 +1,31 @@
+package persist
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// SpamReason is a signal that contributed to a spam score
+type SpamReason struct {
+	Reason string `json:"reason"`
+	// Weight is how much the signal added to the score. Signals that a contract isn't spam have a negative weight.
+	Weight int    `json:"weight"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type SpamReasons []SpamReason
+
+func (s SpamReasons) Value() (driver.Value, error) {
+	if s == nil {
+		s = SpamReasons{}
+	}
+	return json.Marshal(s)
+}
+
+func (s *SpamReasons) Scan(value interface{}) error {
+	if value == nil {
+		*s = SpamReasons{}
+		return nil
+	}
+	return json.Unmarshal(value.([]byte), s)
+}
@@ -104,11 +104,13 @@ const (
 	ChainZora
 	// ChainBase represents the base chain
 	ChainBase
+	// ChainSolana represents the Solana blockchain
+	ChainSolana
 	ChainBaseSepolia = Chain(84532)
 
 	// MaxChainValue is the highest valid chain value, and should always be updated to
 	// point to the most recently added chain type.
-	MaxChainValue = ChainBase
+	MaxChainValue = ChainSolana
 )
 
 func MustTokenID(s string) HexTokenID {
@@ -140,15 +142,17 @@ var L1Chains = map[Chain]L1Chain{
 	ChainBase:        L1Chain(ChainETH),
 	ChainETH:         L1Chain(ChainETH),
 	ChainTezos:       L1Chain(ChainTezos),
+	ChainSolana:      L1Chain(ChainSolana),
 	ChainBaseSepolia: L1Chain(ChainETH),
 }
 
 var L1ChainGroups = map[L1Chain][]Chain{
-	L1Chain(ChainETH):   EvmChains,
-	L1Chain(ChainTezos): {ChainTezos},
+	L1Chain(ChainETH):    EvmChains,
+	L1Chain(ChainTezos):  {ChainTezos},
+	L1Chain(ChainSolana): {ChainSolana},
 }
 
-var AllChains = []Chain{ChainETH, ChainArbitrum, ChainPolygon, ChainOptimism, ChainTezos, ChainPOAP, ChainZora, ChainBase}
+var AllChains = []Chain{ChainETH, ChainArbitrum, ChainPolygon, ChainOptimism, ChainTezos, ChainPOAP, ChainZora, ChainBase, ChainSolana}
 var EvmChains = util.MapKeys(evmChains)
 var evmChains map[Chain]bool = map[Chain]bool{
 	ChainETH:      true,
@@ -469,6 +473,8 @@ func (c Chain) String() string {
 		return "zora"
 	case ChainBase:
 		return "base"
+	case ChainSolana:
+		return "solana"
 	default:
 		return strconv.Itoa(int(c))
 	}
@@ -479,6 +485,9 @@ func (c Chain) NormalizeAddress(addr Address) string {
 	if evmChains[c] {
 		return strings.ToLower(addr.String())
 	}
+	if c == ChainSolana {
+		return addr.NormalizeBase58().String()
+	}
 	return addr.String()
 }
 
@@ -538,6 +547,8 @@ func (c *Chain) UnmarshalJSON(data []byte) error {
 			*c = ChainZora
 		case "base":
 			*c = ChainBase
+		case "solana":
+			*c = ChainSolana
 		}
 		return nil
 	}
@@ -569,6 +580,8 @@ func (c *Chain) UnmarshalGQL(v interface{}) error {
 		*c = ChainZora
 	case "base":
 		*c = ChainBase
+	case "solana":
+		*c = ChainSolana
 	}
 	return nil
 }
@@ -592,6 +605,8 @@ func (c Chain) MarshalGQL(w io.Writer) {
 		w.Write([]byte(`"Zora"`))
 	case ChainBase:
 		w.Write([]byte(`"Base"`))
+	case ChainSolana:
+		w.Write([]byte(`"Solana"`))
 	}
 }
 
@@ -15,6 +15,40 @@ type Media struct {
 	MediaURL        NullString `json:"media_url,omitempty"`
 	MediaType       MediaType  `json:"media_type"`
 	Dimensions      Dimensions `json:"dimensions"`
+	Model           *ModelInfo `json:"model,omitempty"`
+	Audio           *AudioInfo `json:"audio,omitempty"`
+}
+
+// AudioInfo describes an audio file
+type AudioInfo struct {
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+	// Bitrate is in bits per second
+	Bitrate     int        `json:"bitrate,omitempty"`
+	WaveformURL NullString `json:"waveform_url,omitempty"`
+}
+
+// ModelFormat is the file format of a 3D model
+type ModelFormat string
+
+const (
+	ModelFormatGLB  ModelFormat = "glb"
+	ModelFormatGLTF ModelFormat = "gltf"
+	// ModelFormatUSDZ is the format that iOS uses for AR viewing
+	ModelFormatUSDZ ModelFormat = "usdz"
+)
+
+// ModelInfo describes the geometry of a 3D model
+type ModelInfo struct {
+	Format      ModelFormat       `json:"format"`
+	BoundingBox *ModelBoundingBox `json:"bounding_box,omitempty"`
+	PolyCount   int               `json:"poly_count,omitempty"`
+	VertexCount int               `json:"vertex_count,omitempty"`
+}
+
+// ModelBoundingBox is the smallest axis-aligned box that contains a model
+type ModelBoundingBox struct {
+	Min [3]float64 `json:"min"`
+	Max [3]float64 `json:"max"`
 }
 
 // IsServable returns true if the token's Media has enough information to serve it's assets.
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -42,6 +43,33 @@ func (p *ProcessingCause) Scan(value interface{}) error {
 	return nil
 }
 
+// TokenProcessingAttempt is a failed attempt at processing a token
+type TokenProcessingAttempt struct {
+	Attempt         int             `json:"attempt"`
+	ProcessingCause ProcessingCause `json:"processing_cause"`
+	ErrorClass      string          `json:"error_class"`
+	ErrorMessage    string          `json:"error_message"`
+	ProcessingJobID DBID            `json:"processing_job_id"`
+	FailedAt        time.Time       `json:"failed_at"`
+}
+
+type TokenProcessingAttempts []TokenProcessingAttempt
+
+func (t TokenProcessingAttempts) Value() (driver.Value, error) {
+	if t == nil {
+		t = TokenProcessingAttempts{}
+	}
+	return json.Marshal(t)
+}
+
+func (t *TokenProcessingAttempts) Scan(value interface{}) error {
+	if value == nil {
+		*t = TokenProcessingAttempts{}
+		return nil
+	}
+	return json.Unmarshal(value.([]byte), t)
+}
+
 type TokenProperties struct {
 	HasPrimaryMedia bool `json:"has_primary_media"`
 	HasThumbnail    bool `json:"has_thumbnail"`
@@ -102,6 +130,7 @@ type PipelineMetadata struct {
 	MetadataRetrieval                              PipelineStepStatus `json:"metadata_retrieval,omitempty"`
 	TokenInfoRetrieval                             PipelineStepStatus `json:"token_info_retrieval,omitempty"`
 	MediaURLsRetrieval                             PipelineStepStatus `json:"media_urls_retrieval,omitempty"`
+	ExistingMediaLookup                            PipelineStepStatus `json:"existing_media_lookup,omitempty"`
 	AnimationContentHeaderValueRetrieval           PipelineStepStatus `json:"animation_content_header_value_retrieval,omitempty"`
 	AnimationReaderRetrieval                       PipelineStepStatus `json:"animation_reader_retrieval,omitempty"`
 	AnimationDetermineMediaTypeWithReader          PipelineStepStatus `json:"animation_determine_media_type_with_reader,omitempty"`
@@ -150,6 +179,21 @@ type PipelineMetadata struct {
 	MediaResultComparison                          PipelineStepStatus `json:"media_result_comparison,omitempty"`
 }
 
+// FailedSteps returns the names of the steps that errored, sorted by name
+func (p PipelineMetadata) FailedSteps() []string {
+	byt, _ := json.Marshal(p)
+	var steps map[string]PipelineStepStatus
+	json.Unmarshal(byt, &steps)
+	failed := make([]string, 0)
+	for step, status := range steps {
+		if status == PipelineStepStatusError {
+			failed = append(failed, step)
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
+
 func (p PipelineMetadata) Value() (driver.Value, error) {
 	return json.Marshal(p)
 }
@@ -11,6 +11,7 @@ import (
 
 	"blockwatch.cc/tzgo/tezos"
 	"github.com/lib/pq"
+	"github.com/mr-tron/base58"
 )
 
 // Wallet represents an address on any chain
@@ -88,6 +89,8 @@ func (c *ChainAddress) updateCasing() {
 	// TODO: Add an IsCaseSensitive to the Chain type?
 	case L1Chain(ChainETH):
 		c.address = Address(strings.ToLower(c.address.String()))
+	case L1Chain(ChainSolana):
+		c.address = c.address.NormalizeBase58()
 	}
 }
 
@@ -189,6 +192,8 @@ func (c *L1ChainAddress) updateCasing() {
 	// TODO: Add an IsCaseSensitive to the Chain type?
 	case L1Chain(ChainETH):
 		c.address = Address(strings.ToLower(c.address.String()))
+	case L1Chain(ChainSolana):
+		c.address = c.address.NormalizeBase58()
 	}
 }
 
@@ -253,6 +258,8 @@ func (c *ChainPubKey) updateCasing() {
 	// TODO: Add an IsCaseSensitive to the Chain type?
 	case ChainETH:
 		c.pubKey = PubKey(strings.ToLower(c.pubKey.String()))
+	case ChainSolana:
+		c.pubKey = PubKey(Address(c.pubKey).NormalizeBase58())
 	}
 }
 
@@ -377,6 +384,23 @@ func (n Address) String() string {
 	return string(n)
 }
 
+// NormalizeBase58 normalizes a base58 encoded address, such as a Solana address. Base58 addresses are case-sensitive, so
+// unlike hex addresses they can't be lowercased. The address is returned without surrounding whitespace if it isn't valid base58.
+func (n Address) NormalizeBase58() Address {
+	trimmed := strings.TrimSpace(n.String())
+	decoded, err := base58.Decode(trimmed)
+	if err != nil || len(decoded) == 0 {
+		return Address(trimmed)
+	}
+	return Address(base58.Encode(decoded))
+}
+
+// IsValidBase58PublicKey returns true if the address is a base58 encoded 32 byte public key, which is the format of Solana addresses
+func (n Address) IsValidBase58PublicKey() bool {
+	decoded, err := base58.Decode(strings.TrimSpace(n.String()))
+	return err == nil && len(decoded) == 32
+}
+
 // Value implements the database/sql driver Valuer interface for the NullString type
 func (n Address) Value() (driver.Value, error) {
 	if n.String() == "" {
@@ -0,0 +1,222 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/gin-gonic/gin"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const hubContextKey = "realtime.hub"
+
+// subscriberBufferSize is how many messages a subscriber can fall behind before messages are dropped for it
+const subscriberBufferSize = 16
+
+type Kind string
+
+const (
+	KindCommentAdded   Kind = "comment_added"
+	KindAdmireAdded    Kind = "admire_added"
+	KindPostCreated    Kind = "post_created"
+	KindTokenProcessed Kind = "token_processed"
+)
+
+// Message is published to every server instance, which then delivers it to any local subscribers of its channel
+type Message struct {
+	Kind              Kind         `json:"kind"`
+	Channel           string       `json:"channel"`
+	ActorID           persist.DBID `json:"actor_id,omitempty"`
+	PostID            persist.DBID `json:"post_id,omitempty"`
+	FeedEventID       persist.DBID `json:"feed_event_id,omitempty"`
+	CommentID         persist.DBID `json:"comment_id,omitempty"`
+	AdmireID          persist.DBID `json:"admire_id,omitempty"`
+	TokenDefinitionID persist.DBID `json:"token_definition_id,omitempty"`
+}
+
+// PostChannel receives comments and admires on a post
+func PostChannel(postID persist.DBID) string {
+	return "post:" + postID.String()
+}
+
+// FeedEventChannel receives comments and admires on a feed event
+func FeedEventChannel(feedEventID persist.DBID) string {
+	return "feedEvent:" + feedEventID.String()
+}
+
+// UserPostsChannel receives posts created by a user
+func UserPostsChannel(userID persist.DBID) string {
+	return "userPosts:" + userID.String()
+}
+
+// TokenDefinitionChannel receives updates when a token definition has finished processing
+func TokenDefinitionChannel(tokenDefinitionID persist.DBID) string {
+	return "tokenDefinition:" + tokenDefinitionID.String()
+}
+
+// Hub publishes messages to a pubsub topic that every server instance is subscribed to, so that a
+// subscriber receives a message regardless of which instance it was published from
+type Hub struct {
+	pubSub *pubsub.Client
+	mu     sync.RWMutex
+	subs   map[string]map[*subscriber]bool
+}
+
+type subscriber struct {
+	ch chan Message
+}
+
+// New returns a hub that publishes to the realtime topic. If listen is true, the hub also receives
+// messages from the topic and delivers them to its subscribers.
+func New(pub *pubsub.Client, listen bool) *Hub {
+	h := &Hub{pubSub: pub, subs: make(map[string]map[*subscriber]bool)}
+	if pub != nil && listen {
+		go h.receiveFromPubSub()
+	} else if listen {
+		logger.For(nil).Warn("pubsub not configured, realtime messages will not be received")
+	}
+	return h
+}
+
+func AddTo(ctx *gin.Context, h *Hub) {
+	ctx.Set(hubContextKey, h)
+}
+
+func For(ctx context.Context) *Hub {
+	gc := util.MustGetGinContext(ctx)
+	return gc.Value(hubContextKey).(*Hub)
+}
+
+// Publish sends a message to every server instance. Publishing is a no-op if pubsub isn't configured.
+func (h *Hub) Publish(ctx context.Context, msg Message) error {
+	if h == nil || h.pubSub == nil {
+		return nil
+	}
+
+	marshalled, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	result := h.pubSub.Topic(env.GetString("PUBSUB_TOPIC_REALTIME")).Publish(ctx, &pubsub.Message{Data: marshalled})
+	if _, err := result.Get(ctx); err != nil {
+		return fmt.Errorf("failed to publish realtime message: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe returns a channel that receives messages published to any of the given channels. The returned
+// channel is closed once ctx is done.
+func (h *Hub) Subscribe(ctx context.Context, channels ...string) <-chan Message {
+	s := &subscriber{ch: make(chan Message, subscriberBufferSize)}
+
+	h.mu.Lock()
+	for _, c := range channels {
+		if h.subs[c] == nil {
+			h.subs[c] = make(map[*subscriber]bool)
+		}
+		h.subs[c][s] = true
+	}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		for _, c := range channels {
+			delete(h.subs[c], s)
+			if len(h.subs[c]) == 0 {
+				delete(h.subs, c)
+			}
+		}
+		close(s.ch)
+	}()
+
+	return s.ch
+}
+
+// deliver sends a message to the local subscribers of its channel. Subscribers that have fallen
+// too far behind miss the message rather than blocking delivery to everyone else.
+func (h *Hub) deliver(ctx context.Context, msg Message) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for s := range h.subs[msg.Channel] {
+		select {
+		case s.ch <- msg:
+		default:
+			logger.For(ctx).Warnf("realtime subscriber for channel=%s is full, dropping message", msg.Channel)
+		}
+	}
+}
+
+func (h *Hub) receiveFromPubSub() {
+	ctx := context.Background()
+	topic := env.GetString("PUBSUB_TOPIC_REALTIME")
+
+	sub, err := h.subscribe(ctx, topic, fmt.Sprintf("realtime-%s", persist.GenerateID()))
+	if err != nil {
+		logger.For(nil).Errorf("error creating realtime subscription: %s", err)
+		panic(err)
+	}
+
+	logger.For(nil).Info("subscribed to realtime pubsub topic")
+
+	err = sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		defer msg.Ack()
+		var m Message
+		if err := json.Unmarshal(msg.Data, &m); err != nil {
+			logger.For(ctx).Warnf("failed to unmarshal realtime message: %s", err)
+			return
+		}
+		h.deliver(ctx, m)
+	})
+	if err != nil {
+		logger.For(nil).Errorf("error receiving realtime messages from pubsub: %s", err)
+		panic(err)
+	}
+}
+
+// subscribe returns a subscription to the given topic, creating the topic if it doesn't exist yet
+func (h *Hub) subscribe(ctx context.Context, topic, name string) (*pubsub.Subscription, error) {
+	sub, err := createSubscription(ctx, h.pubSub, topic, name)
+	if err == nil {
+		return sub, nil
+	}
+
+	if errTopicMissing(err) {
+		if _, err := h.pubSub.CreateTopic(ctx, topic); err != nil {
+			return nil, err
+		}
+	}
+
+	return createSubscription(ctx, h.pubSub, topic, name)
+}
+
+func createSubscription(ctx context.Context, client *pubsub.Client, topic, name string) (*pubsub.Subscription, error) {
+	// Each instance has its own subscription so that every instance receives every message. Subscriptions
+	// left behind by instances that have shut down are cleaned up by the expiration policy.
+	return client.CreateSubscription(ctx, name, pubsub.SubscriptionConfig{
+		Topic:            client.Topic(topic),
+		ExpirationPolicy: time.Hour * 24,
+	})
+}
+
+func errTopicMissing(err error) bool {
+	var aErr *apierror.APIError
+	if ok := errors.As(err, &aErr); ok && aErr.GRPCStatus().Code() == codes.NotFound {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package realtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliver(t *testing.T) {
+	h := New(nil, false)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	postSub := h.Subscribe(ctx, PostChannel("post"))
+	userSub := h.Subscribe(ctx, UserPostsChannel("a"), UserPostsChannel("b"))
+
+	h.deliver(ctx, Message{Kind: KindCommentAdded, Channel: PostChannel("post"), CommentID: "comment"})
+	h.deliver(ctx, Message{Kind: KindPostCreated, Channel: UserPostsChannel("b"), PostID: "post"})
+	h.deliver(ctx, Message{Kind: KindPostCreated, Channel: UserPostsChannel("c"), PostID: "other"})
+
+	assert.Equal(t, "comment", (<-postSub).CommentID.String())
+	assert.Equal(t, "post", (<-userSub).PostID.String())
+	assert.Len(t, userSub, 0)
+
+	cancel()
+	_, open := <-postSub
+	assert.False(t, open)
+	_, open = <-userSub
+	assert.False(t, open)
+}
+
+func TestDeliverDropsWhenSubscriberIsFull(t *testing.T) {
+	h := New(nil, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := h.Subscribe(ctx, TokenDefinitionChannel("token"))
+	for i := 0; i < subscriberBufferSize+5; i++ {
+		h.deliver(ctx, Message{Kind: KindTokenProcessed, Channel: TokenDefinitionChannel("token")})
+	}
+
+	assert.Len(t, sub, subscriberBufferSize)
+}
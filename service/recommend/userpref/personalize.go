@@ -11,7 +11,6 @@ import (
 	"sync"
 	"time"
 
-	"cloud.google.com/go/storage"
 	"github.com/gin-gonic/gin"
 	"github.com/james-bowman/sparse"
 
@@ -182,7 +181,7 @@ func (k *Personalization) Loop(ctx context.Context, ticker *time.Ticker) {
 	}()
 }
 
-func NewPersonalization(ctx context.Context, q *db.Queries, c *storage.Client) *Personalization {
+func NewPersonalization(ctx context.Context, q *db.Queries, c store.Client) *Personalization {
 	b := store.NewBucketStorer(c, env.GetString("GCLOUD_USER_PREF_BUCKET"))
 	k := &Personalization{q: q, b: b}
 	k.update(ctx)
@@ -48,6 +48,7 @@ var (
 	PushNotificationRateLimitersCache = CacheConfig{database: rateLimiters, keyPrefix: "push", displayName: "pushNotificationLimiters"}
 	OneTimeLoginCache                 = CacheConfig{database: misc, keyPrefix: "otl", displayName: "oneTimeLogin"}
 	AuthTokenForceRefreshCache        = CacheConfig{database: misc, keyPrefix: "authRefresh", displayName: "authTokenForceRefresh"}
+	SuspensionCache                   = CacheConfig{database: misc, keyPrefix: "suspension", displayName: "suspension"}
 	CommunitiesCache                  = CacheConfig{database: communities, keyPrefix: "", displayName: "communities"}
 	IndexerServerThrottleCache        = CacheConfig{database: indexerServerThrottle, keyPrefix: "", displayName: "indexerServerThrottle"}
 	RefreshNFTsThrottleCache          = CacheConfig{database: refreshNFTsThrottle, keyPrefix: "", displayName: "refreshNFTsThrottle"}
@@ -55,6 +56,8 @@ var (
 	TokenProcessingMetadataCache      = CacheConfig{database: tokenProcessing, keyPrefix: "metadata", displayName: "tokenProcessingMetadata"}
 	EmailThrottleCache                = CacheConfig{database: emailThrottle, keyPrefix: "", displayName: "emailThrottle"}
 	GraphQLAPQCache                   = CacheConfig{database: graphQLAPQ, keyPrefix: "", displayName: "graphQLAPQ"}
+	GraphQLCostCache                  = CacheConfig{database: rateLimiters, keyPrefix: "gqlcost", displayName: "graphQLCost"}
+	GraphQLRateLimitersCache          = CacheConfig{database: rateLimiters, keyPrefix: "gqlratelimit", displayName: "graphQLRateLimiters"}
 	FeedCache                         = CacheConfig{database: feed, keyPrefix: "", displayName: "feed"}
 	SocialCache                       = CacheConfig{database: social, keyPrefix: "", displayName: "social"}
 	SearchCache                       = CacheConfig{keyPrefix: "search", displayName: "search"}
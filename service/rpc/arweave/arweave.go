@@ -15,6 +15,38 @@ func IsArweaveURL(u string) bool {
 	return strings.HasPrefix(u, "ar://") || strings.HasPrefix(u, "arweave://")
 }
 
+// IsArweaveGatewayURL returns true if the URL is a transaction served from the default gateway
+func IsArweaveGatewayURL(u string) bool {
+	return strings.HasPrefix(u, ArweaveHost+"/")
+}
+
+// TransactionPathFrom returns the transaction ID of an Arweave URL, along with the path within the transaction if there is one
+func TransactionPathFrom(u string) (string, bool) {
+	if IsArweaveGatewayURL(u) {
+		u = strings.TrimPrefix(u, ArweaveHost+"/")
+	} else if IsArweaveURL(u) {
+		u = uriFrom(u)
+	} else {
+		return "", false
+	}
+
+	u, _, _ = strings.Cut(u, "#")
+	u, query, _ := strings.Cut(u, "?")
+	u = strings.TrimSuffix(u, "/")
+
+	// Transaction IDs are the base64url encoding of a 32 byte hash
+	id, _, _ := strings.Cut(u, "/")
+	if len(id) != 43 {
+		return "", false
+	}
+
+	if query != "" {
+		u += "?" + query
+	}
+
+	return u, true
+}
+
 func BestGatewayNodeFrom(u string) string {
 	if !IsArweaveURL(u) {
 		return u
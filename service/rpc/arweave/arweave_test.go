@@ -0,0 +1,37 @@
+package arweave
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionPathFrom(t *testing.T) {
+	const txID = "bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U"
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+		ok       bool
+	}{
+		{"ar", "ar://" + txID, txID, true},
+		{"arweave", "arweave://" + txID, txID, true},
+		{"gateway", ArweaveHost + "/" + txID, txID, true},
+		{"path", "ar://" + txID + "/1.png", txID + "/1.png", true},
+		{"trailing slash", ArweaveHost + "/" + txID + "/", txID, true},
+		{"query", "ar://" + txID + "?seed=1", txID + "?seed=1", true},
+		{"query after path", ArweaveHost + "/" + txID + "/index.html?seed=1", txID + "/index.html?seed=1", true},
+		{"fragment", "ar://" + txID + "#top", txID, true},
+		{"short ID", "ar://abc", "", false},
+		{"other gateway", "https://example.com/" + txID, "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := TransactionPathFrom(tc.url)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-ipfs-api"
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/tracing"
@@ -224,6 +225,60 @@ func IsIpfsGatewayURL(ipfsURL string) bool {
 	return strings.HasPrefix(u.Path, "/ipfs")
 }
 
+// IsIpfsSubdomainGatewayURL returns true if the URL is served from a subdomain gateway, e.g. https://<cid>.ipfs.dweb.link
+func IsIpfsSubdomainGatewayURL(ipfsURL string) bool {
+	u, err := url.Parse(ipfsURL)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	_, gateway, ok := strings.Cut(u.Host, ".")
+	return ok && strings.HasPrefix(gateway, "ipfs.")
+}
+
+// ContentPathFrom returns the content path of an IPFS URL with its CID in a canonical form, so that the same content
+// is identified the same way regardless of the gateway or CID version used to reference it. The query is kept because
+// some tokens (e.g. fxhash) render different outputs from the same CID depending on it.
+func ContentPathFrom(ipfsURL string) (string, bool) {
+	ipfsURL = strings.TrimSpace(ipfsURL)
+
+	var uri string
+	switch {
+	case IsIpfsURL(ipfsURL):
+		// Some tokens use ipfs://ipfs/<cid> instead of ipfs://<cid>
+		uri = strings.TrimPrefix(uriFrom(ipfsURL), "ipfs/")
+	case IsIpfsSubdomainGatewayURL(ipfsURL):
+		u, _ := url.Parse(ipfsURL)
+		root, _, _ := strings.Cut(u.Host, ".")
+		uri = root + u.EscapedPath()
+		if u.RawQuery != "" {
+			uri += "?" + u.RawQuery
+		}
+	default:
+		return "", false
+	}
+
+	uri, _, _ = strings.Cut(uri, "#")
+	uri, query, _ := strings.Cut(uri, "?")
+	root, rest, _ := strings.Cut(uri, "/")
+
+	c, err := cid.Decode(root)
+	if err != nil {
+		return "", false
+	}
+
+	// CIDv0 and CIDv1 of the same content share a multihash
+	path := cid.NewCidV1(c.Type(), c.Hash()).String()
+
+	if rest = strings.TrimSuffix(rest, "/"); rest != "" {
+		path += "/" + rest
+	}
+	if query != "" {
+		path += "?" + query
+	}
+
+	return path, true
+}
+
 // authTransport decorates each request with a basic auth header.
 type authTransport struct {
 	http.RoundTripper
@@ -0,0 +1,45 @@
+package ipfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentPathFrom(t *testing.T) {
+	const (
+		cidV0 = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+		cidV1 = "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"
+	)
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+		ok       bool
+	}{
+		{"CIDv0", "ipfs://" + cidV0, cidV1, true},
+		{"CIDv1", "ipfs://" + cidV1, cidV1, true},
+		{"ipfs prefix in uri", "ipfs://ipfs/" + cidV0 + "/1.png", cidV1 + "/1.png", true},
+		{"path", "ipfs://" + cidV0 + "/images/1.png", cidV1 + "/images/1.png", true},
+		{"trailing slash", "ipfs://" + cidV0 + "/", cidV1, true},
+		{"path gateway", "https://ipfs.io/ipfs/" + cidV0 + "/1.png", cidV1 + "/1.png", true},
+		{"subdomain gateway", "https://" + cidV1 + ".ipfs.dweb.link/1.png", cidV1 + "/1.png", true},
+		{"subdomain gateway root", "https://" + cidV1 + ".ipfs.dweb.link", cidV1, true},
+		{"query", "ipfs://" + cidV0 + "?fxhash=oo123", cidV1 + "?fxhash=oo123", true},
+		{"query after path", "https://ipfs.io/ipfs/" + cidV0 + "/index.html?seed=1", cidV1 + "/index.html?seed=1", true},
+		{"query on subdomain gateway", "https://" + cidV1 + ".ipfs.dweb.link/?seed=1", cidV1 + "?seed=1", true},
+		{"fragment", "ipfs://" + cidV0 + "/1.png#top", cidV1 + "/1.png", true},
+		{"invalid CID", "ipfs://notacid/1.png", "", false},
+		{"not ipfs", "https://example.com/1.png", "", false},
+		{"http gateway", "http://ipfs.io/ipfs/" + cidV0, "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := ContentPathFrom(tc.url)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
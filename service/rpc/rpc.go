@@ -43,6 +43,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/rpc/arweave"
 	"github.com/mikeydub/go-gallery/service/rpc/ipfs"
 	"github.com/mikeydub/go-gallery/service/rpc/onchfs"
+	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/tracing"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/mikeydub/go-gallery/util/retry"
@@ -136,6 +137,40 @@ func NewStorageClient(ctx context.Context) *storage.Client {
 	return storageClient
 }
 
+// NewObjectStore returns a client for the object store configured by STORAGE_BACKEND, which is one of "gcs", "s3" or "local".
+// The s3 backend works with any S3-compatible store, such as a MinIO container in a local stack.
+func NewObjectStore(ctx context.Context) store.Client {
+	switch backend := env.GetString("STORAGE_BACKEND"); backend {
+	case "", "gcs":
+		return store.NewGCSClient(NewStorageClient(ctx))
+	case "s3":
+		c, err := store.NewS3Client(
+			env.GetString("S3_ENDPOINT"),
+			env.GetString("S3_REGION"),
+			env.GetString("S3_ACCESS_KEY_ID"),
+			env.GetString("S3_SECRET_ACCESS_KEY"),
+			env.GetString("S3_PUBLIC_URL"),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return c
+	case "local":
+		dir := env.GetString("LOCAL_STORAGE_DIR")
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "gallery-storage")
+		}
+		c, err := store.NewLocalClient(dir, env.GetString("LOCAL_STORAGE_URL"))
+		if err != nil {
+			panic(err)
+		}
+		logger.For(ctx).Infof("storing objects locally in %s", dir)
+		return c
+	default:
+		panic(fmt.Sprintf("unknown storage backend: %s", backend))
+	}
+}
+
 // newHTTPClientForRPC returns an http.Client configured with default settings intended for RPC calls.
 func newHTTPClientForRPC(continueTrace bool, spanOptions ...sentry.SpanOption) *http.Client {
 	// get x509 cert pool
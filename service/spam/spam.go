@@ -0,0 +1,241 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	// Threshold is the score at or above which a contract or token is considered spam
+	Threshold = 50
+	MaxScore  = 100
+)
+
+const (
+	ReasonProviderMarked = "provider_marked"
+	ReasonUserVotes      = "user_votes"
+	ReasonAirdrop        = "airdrop"
+	ReasonDisplayed      = "displayed"
+	ReasonNewContract    = "new_contract"
+	ReasonPhishingURL    = "phishing_url"
+	ReasonClaimText      = "claim_text"
+)
+
+const (
+	newContractAge = 7 * 24 * time.Hour
+	// minAirdropHolders is the fewest holders a contract needs before it can look like an airdrop
+	minAirdropHolders = 25
+	// minVoters is the fewest users that must mark a contract's tokens as spam before their votes count
+	minVoters = 2
+)
+
+var (
+	urlPattern      = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|io|xyz|net|org|site|online|app|top|club|live|link|gift|fun|vip|pro|claims?)\b`)
+	claimPattern    = regexp.MustCompile(`(?i)\b(claim|claimable|reward|rewards|voucher|airdrop|redeem|eligible|giveaway|bonus|free mint|visit)\b`)
+	currencyPattern = regexp.MustCompile(`(?i)\$\s?\d[\d,.]*|\b\d[\d,.]*\s?(usdt|usdc|eth|weth|matic)\b`)
+)
+
+// IsSpam returns true if score is high enough to be considered spam
+func IsSpam(score int) bool {
+	return score >= Threshold
+}
+
+// Score is a spam score and the reasons that contributed to it
+type Score struct {
+	Score   int
+	Reasons persist.SpamReasons
+}
+
+// Combine adds scores together, e.g. a token's score with its contract's score
+func Combine(scores ...Score) Score {
+	combined := Score{Reasons: persist.SpamReasons{}}
+	for _, s := range scores {
+		combined.Score += s.Score
+		combined.Reasons = append(combined.Reasons, s.Reasons...)
+	}
+	if combined.Score > MaxScore {
+		combined.Score = MaxScore
+	}
+	return combined
+}
+
+// ContractSignals are what's known about a contract that's used to score it
+type ContractSignals struct {
+	IsProviderMarkedSpam bool
+	// CreatedAt is when the contract was first seen
+	CreatedAt   time.Time
+	Name        string
+	Description string
+	// Holders is the number of users that hold a token from the contract
+	Holders int
+	// SpamVoters is the number of users that marked a token from the contract as spam
+	SpamVoters int
+	// EarlyHolders is the number of holders that acquired a token within two days of the first holder, as happens with
+	// an airdrop. Tokens from providers that don't report when they were acquired use when they were first synced instead.
+	EarlyHolders int
+	// DisplayingHolders is the number of holders that display a token from the contract in a gallery
+	DisplayingHolders int
+}
+
+// ScoreContract scores how likely a contract is to be spam
+func ScoreContract(s ContractSignals, now time.Time) (int, persist.SpamReasons) {
+	reasons := persist.SpamReasons{}
+
+	if s.IsProviderMarkedSpam {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonProviderMarked, Weight: 60})
+	}
+
+	if s.SpamVoters >= minVoters {
+		voters := float64(s.SpamVoters)
+		ratio := voters / math.Max(float64(s.Holders), voters)
+		reasons = append(reasons, persist.SpamReason{
+			Reason: ReasonUserVotes,
+			Weight: int(math.Max(10, math.Round(50*ratio))),
+			Detail: fmt.Sprintf("%d of %d holders", s.SpamVoters, int(math.Max(float64(s.Holders), voters))),
+		})
+	}
+
+	if s.Holders > 0 {
+		holders := float64(s.Holders)
+		displayRatio := float64(s.DisplayingHolders) / holders
+
+		// Spam is usually sent to many wallets at once and then ignored by the people that receive it
+		if s.Holders >= minAirdropHolders && float64(s.EarlyHolders)/holders >= 0.8 && displayRatio < 0.02 {
+			reasons = append(reasons, persist.SpamReason{
+				Reason: ReasonAirdrop,
+				Weight: 30,
+				Detail: fmt.Sprintf("%d holders, %d displaying", s.Holders, s.DisplayingHolders),
+			})
+		}
+
+		// People don't choose to show off spam
+		if s.Holders >= 5 && displayRatio >= 0.1 {
+			reasons = append(reasons, persist.SpamReason{
+				Reason: ReasonDisplayed,
+				Weight: -30,
+				Detail: fmt.Sprintf("%d of %d holders", s.DisplayingHolders, s.Holders),
+			})
+		}
+	}
+
+	if !s.CreatedAt.IsZero() && now.Sub(s.CreatedAt) < newContractAge {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonNewContract, Weight: 10})
+	}
+
+	_, textReasons := ScoreMetadata(s.Name, s.Description)
+	reasons = append(reasons, textReasons...)
+
+	return total(reasons), reasons
+}
+
+// ScoreMetadata scores how likely a token is to be spam from its name and description
+func ScoreMetadata(name, description string) (int, persist.SpamReasons) {
+	reasons := persist.SpamReasons{}
+
+	// Names are shown everywhere a token is, so spam puts links and offers in them to get noticed
+	if m := urlPattern.FindString(name); m != "" {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonPhishingURL, Weight: 40, Detail: m})
+	}
+	if m := claimPattern.FindString(name); m != "" {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonClaimText, Weight: 30, Detail: m})
+	}
+	if m := currencyPattern.FindString(name); m != "" {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonClaimText, Weight: 20, Detail: m})
+	}
+
+	// Links in descriptions are common, so they're only suspicious when paired with an offer
+	if m := claimPattern.FindString(description); m != "" {
+		reasons = append(reasons, persist.SpamReason{Reason: ReasonClaimText, Weight: 15, Detail: m})
+		if u := urlPattern.FindString(description); u != "" {
+			reasons = append(reasons, persist.SpamReason{Reason: ReasonPhishingURL, Weight: 20, Detail: u})
+		}
+	}
+
+	return total(reasons), reasons
+}
+
+func total(reasons persist.SpamReasons) int {
+	var score int
+	for _, r := range reasons {
+		score += r.Weight
+	}
+	if score < 0 {
+		return 0
+	}
+	if score > MaxScore {
+		return MaxScore
+	}
+	return score
+}
+
+// ScoreContracts scores and saves the scores of contracts
+func ScoreContracts(ctx context.Context, queries *db.Queries, contractIDs []persist.DBID) error {
+	if len(contractIDs) == 0 {
+		return nil
+	}
+
+	rows, err := queries.GetContractSpamSignals(ctx, util.MapWithoutError(contractIDs, func(id persist.DBID) string { return id.String() }))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, r := range rows {
+		score, reasons := ScoreContract(ContractSignals{
+			IsProviderMarkedSpam: r.IsProviderMarkedSpam,
+			CreatedAt:            r.CreatedAt,
+			Name:                 r.Name.String,
+			Description:          r.Description.String,
+			Holders:              int(r.Holders),
+			SpamVoters:           int(r.SpamVoters),
+			EarlyHolders:         int(r.EarlyHolders),
+			DisplayingHolders:    int(r.DisplayingHolders),
+		}, now)
+
+		err := queries.UpsertContractSpamScore(ctx, db.UpsertContractSpamScoreParams{
+			ContractID: r.ContractID,
+			Score:      int32(score),
+			Reasons:    reasons,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RescoreContracts scores up to limit contracts that haven't been scored since scoredBefore, oldest first
+func RescoreContracts(ctx context.Context, queries *db.Queries, scoredBefore time.Time, limit int) (int, error) {
+	contractIDs, err := queries.GetContractIDsToScoreForSpam(ctx, db.GetContractIDsToScoreForSpamParams{
+		ScoredBefore: scoredBefore,
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logger.For(ctx).Infof("scoring %d contracts for spam", len(contractIDs))
+
+	return len(contractIDs), ScoreContracts(ctx, queries, contractIDs)
+}
+
+// ScoreTokenDefinition scores and saves the score of a token from its metadata
+func ScoreTokenDefinition(ctx context.Context, queries *db.Queries, tokenDefinitionID persist.DBID, name, description string) error {
+	score, reasons := ScoreMetadata(strings.TrimSpace(name), strings.TrimSpace(description))
+	return queries.UpsertTokenDefinitionSpamScore(ctx, db.UpsertTokenDefinitionSpamScoreParams{
+		TokenDefinitionID: tokenDefinitionID,
+		Score:             int32(score),
+		Reasons:           reasons,
+	})
+}
@@ -0,0 +1,71 @@
+package spam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreMetadata(t *testing.T) {
+	t.Run("scores a phishing name as spam", func(t *testing.T) {
+		score, reasons := ScoreMetadata("Claim your $5000 reward at rewards-eth.xyz", "")
+		assert.True(t, IsSpam(score))
+		assert.NotEmpty(t, reasons)
+	})
+
+	t.Run("does not score an ordinary token", func(t *testing.T) {
+		score, reasons := ScoreMetadata("Chromie Squiggle #4521", "Simple and easily identifiable. See https://artblocks.io for more.")
+		assert.Equal(t, 0, score)
+		assert.Empty(t, reasons)
+	})
+
+	t.Run("only flags description links paired with an offer", func(t *testing.T) {
+		score, _ := ScoreMetadata("Token", "Visit www.free-tokens.site to claim")
+		assert.Equal(t, 35, score)
+	})
+}
+
+func TestScoreContract(t *testing.T) {
+	now := time.Now()
+
+	t.Run("scores an airdropped contract nobody displays as spam", func(t *testing.T) {
+		score, _ := ScoreContract(ContractSignals{
+			CreatedAt:         now.Add(-24 * time.Hour),
+			Name:              "USDC Rewards",
+			Holders:           1000,
+			EarlyHolders:      990,
+			DisplayingHolders: 1,
+			SpamVoters:        12,
+		}, now)
+		assert.True(t, IsSpam(score))
+	})
+
+	t.Run("does not score a displayed collection as spam", func(t *testing.T) {
+		score, _ := ScoreContract(ContractSignals{
+			CreatedAt:         now.Add(-365 * 24 * time.Hour),
+			Name:              "Fidenza",
+			Holders:           500,
+			EarlyHolders:      20,
+			DisplayingHolders: 200,
+		}, now)
+		assert.Equal(t, 0, score)
+	})
+
+	t.Run("clamps the score", func(t *testing.T) {
+		score, _ := ScoreContract(ContractSignals{
+			IsProviderMarkedSpam: true,
+			CreatedAt:            now,
+			Name:                 "Claim $1000 at www.spam.xyz",
+			Holders:              10,
+			SpamVoters:           10,
+		}, now)
+		assert.Equal(t, MaxScore, score)
+	})
+}
+
+func TestCombine(t *testing.T) {
+	combined := Combine(Score{Score: 80}, Score{Score: 40})
+	assert.Equal(t, MaxScore, combined.Score)
+	assert.NotNil(t, combined.Reasons)
+}
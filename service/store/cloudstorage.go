@@ -7,29 +7,23 @@ import (
 	"io"
 	"net/http"
 
-	"cloud.google.com/go/storage"
 	"google.golang.org/api/googleapi"
 
 	"github.com/mikeydub/go-gallery/util/retry"
 )
 
-var ObjAttrsOptions objectAttrsOptions
-
+// BucketStorer reads and writes objects in a bucket
 type BucketStorer struct {
-	b *storage.BucketHandle
+	b Bucket
 }
 
-func NewBucketStorer(c *storage.Client, bucketName string) BucketStorer {
-	return BucketStorer{c.Bucket(string(bucketName))}
+func NewBucketStorer(c Client, bucketName string) BucketStorer {
+	return BucketStorer{c.Bucket(bucketName)}
 }
 
 // Exists checks if an object exists in the bucket
 func (s BucketStorer) Exists(ctx context.Context, objName string) (bool, error) {
-	_, err := s.Metadata(ctx, objName)
-	if err != nil && err != storage.ErrObjectNotExist {
-		return false, err
-	}
-	return err != storage.ErrObjectNotExist, nil
+	return Exists(ctx, s.b, objName)
 }
 
 // ExistsRetry checks if an object exists, but retries on Unauthorized errors.
@@ -43,35 +37,30 @@ func (s BucketStorer) ExistsRetry(ctx context.Context, objName string) (exists b
 	return exists, err
 }
 
-func (s BucketStorer) Metadata(ctx context.Context, objName string) (*storage.ObjectAttrs, error) {
-	o := s.b.Object(objName)
-	return o.Attrs(ctx)
+func (s BucketStorer) Metadata(ctx context.Context, objName string) (ObjectAttrs, error) {
+	return s.b.Attrs(ctx, objName)
 }
 
-func (s BucketStorer) NewReader(ctx context.Context, objName string) (*storage.Reader, error) {
-	o := s.b.Object(objName)
-	return o.NewReader(ctx)
-}
-
-func (s BucketStorer) NewWriter(ctx context.Context, objName string, opts ...func(*storage.ObjectAttrs)) *storage.Writer {
-	o := s.b.Object(objName)
-	w := o.NewWriter(ctx)
-	for _, opt := range opts {
-		opt(&w.ObjectAttrs)
-	}
-	return w
+func (s BucketStorer) NewReader(ctx context.Context, objName string) (io.ReadCloser, error) {
+	return s.b.NewReader(ctx, objName)
 }
 
-func (s BucketStorer) Write(ctx context.Context, objName string, b []byte, opts ...func(*storage.ObjectAttrs)) (int, error) {
-	w := s.NewWriter(ctx, objName)
-	defer w.Close()
-	return w.Write(b)
+func (s BucketStorer) NewWriter(ctx context.Context, objName string, opts ...func(*ObjectAttrs)) io.WriteCloser {
+	return s.b.NewWriter(ctx, objName, opts...)
 }
 
-func (s BucketStorer) WriteGzip(ctx context.Context, objName string, b []byte, opts ...func(*storage.ObjectAttrs)) (int, error) {
+func (s BucketStorer) Write(ctx context.Context, objName string, b []byte, opts ...func(*ObjectAttrs)) (int, error) {
 	w := s.NewWriter(ctx, objName, opts...)
+	n, err := w.Write(b)
+	if err != nil {
+		w.Close()
+		return n, err
+	}
+	return n, w.Close()
+}
 
-	w.ObjectAttrs.ContentEncoding = "gzip"
+func (s BucketStorer) WriteGzip(ctx context.Context, objName string, b []byte, opts ...func(*ObjectAttrs)) (int, error) {
+	w := s.NewWriter(ctx, objName, append(opts, ObjAttrsOptions.WithContentEncoding("gzip"))...)
 
 	gz := gzip.NewWriter(w)
 	buf := bytes.NewReader(b)
@@ -90,29 +79,6 @@ func (s BucketStorer) WriteGzip(ctx context.Context, objName string, b []byte, o
 	return int(n), err
 }
 
-type objectAttrsOptions struct{}
-
-// WithContentType sets the Content-Type header of the object
-func (objectAttrsOptions) WithContentType(typ string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.ContentType = typ
-	}
-}
-
-// WithCustomMetadata sets custom metadata on the object
-func (objectAttrsOptions) WithCustomMetadata(m map[string]string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.Metadata = m
-	}
-}
-
-// WithContentEncoding sets the Content-Encoding header of the object
-func (objectAttrsOptions) WithContentEncoding(enc string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.ContentEncoding = enc
-	}
-}
-
 func isUnauthorizedError(err error) bool {
 	if gcpErr, ok := err.(*googleapi.Error); ok {
 		if gcpErr.Code == http.StatusUnauthorized {
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// GCSClient stores objects in Google Cloud Storage
+type GCSClient struct {
+	c *storage.Client
+}
+
+func NewGCSClient(c *storage.Client) GCSClient {
+	return GCSClient{c}
+}
+
+func (g GCSClient) Bucket(name string) Bucket {
+	return GCSBucket{g.c.Bucket(name), name}
+}
+
+type GCSBucket struct {
+	b    *storage.BucketHandle
+	name string
+}
+
+func (g GCSBucket) Name() string {
+	return g.name
+}
+
+func (g GCSBucket) Attrs(ctx context.Context, objName string) (ObjectAttrs, error) {
+	attrs, err := g.b.Object(objName).Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		return ObjectAttrs{}, ErrObjectNotExist
+	}
+	if err != nil {
+		return ObjectAttrs{}, err
+	}
+	return ObjectAttrs{
+		ContentType:     attrs.ContentType,
+		ContentEncoding: attrs.ContentEncoding,
+		CacheControl:    attrs.CacheControl,
+		Metadata:        attrs.Metadata,
+		Size:            attrs.Size,
+	}, nil
+}
+
+func (g GCSBucket) NewReader(ctx context.Context, objName string) (io.ReadCloser, error) {
+	r, err := g.b.Object(objName).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, ErrObjectNotExist
+	}
+	return r, err
+}
+
+func (g GCSBucket) NewWriter(ctx context.Context, objName string, opts ...func(*ObjectAttrs)) io.WriteCloser {
+	var attrs ObjectAttrs
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+	w := g.b.Object(objName).NewWriter(ctx)
+	w.ContentType = attrs.ContentType
+	w.ContentEncoding = attrs.ContentEncoding
+	w.CacheControl = attrs.CacheControl
+	w.Metadata = attrs.Metadata
+	w.ChunkSize = chunkSize(attrs.Size)
+	w.ChunkRetryDeadline = 5 * time.Minute
+	w.ProgressFunc = func(written int64) {
+		logger.For(ctx).Infof("wrote %s to %s", util.InByteSizeFormat(uint64(written)), objName)
+	}
+	return w
+}
+
+func (g GCSBucket) URL(objName string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", g.name, objName)
+}
+
+// chunkSize returns the size of the chunks to upload an object in, given the expected size of the object
+func chunkSize(size int64) int {
+	if size <= 0 {
+		return 4 * 1024 * 1024
+	}
+	if size < 4*1024*1024 {
+		return int(size)
+	} else if size > 8*1024*1024 && size < 32*1024*1024 {
+		return 8 * 1024 * 1024
+	} else if size > 32*1024*1024 {
+		return 16 * 1024 * 1024
+	}
+	return 4 * 1024 * 1024
+}
@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// attrsDir is the directory that object attributes are kept in, relative to the root of the store
+const attrsDir = ".attrs"
+
+// LocalClient stores objects on the local filesystem, which is useful for running a stack without cloud credentials.
+// Each bucket is a directory under the root of the store.
+type LocalClient struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalClient returns a client that stores objects under dir. Object URLs are built from publicURL if it's set,
+// for example to point at a file server in front of dir, otherwise they are file URLs.
+func NewLocalClient(dir, publicURL string) (LocalClient, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return LocalClient{}, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return LocalClient{}, err
+	}
+	return LocalClient{dir: dir, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
+
+func (c LocalClient) Bucket(name string) Bucket {
+	return LocalBucket{c, name}
+}
+
+type LocalBucket struct {
+	c    LocalClient
+	name string
+}
+
+func (b LocalBucket) Name() string {
+	return b.name
+}
+
+func (b LocalBucket) Attrs(ctx context.Context, objName string) (ObjectAttrs, error) {
+	objPath, attrsPath, err := b.paths(objName)
+	if err != nil {
+		return ObjectAttrs{}, err
+	}
+
+	info, err := os.Stat(objPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ObjectAttrs{}, ErrObjectNotExist
+	}
+	if err != nil {
+		return ObjectAttrs{}, err
+	}
+
+	var attrs ObjectAttrs
+	byt, err := os.ReadFile(attrsPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return ObjectAttrs{}, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(byt, &attrs); err != nil {
+			return ObjectAttrs{}, err
+		}
+	}
+
+	attrs.Size = info.Size()
+	return attrs, nil
+}
+
+func (b LocalBucket) NewReader(ctx context.Context, objName string) (io.ReadCloser, error) {
+	objPath, _, err := b.paths(objName)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(objPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotExist
+	}
+	return f, err
+}
+
+func (b LocalBucket) NewWriter(ctx context.Context, objName string, opts ...func(*ObjectAttrs)) io.WriteCloser {
+	var attrs ObjectAttrs
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	objPath, attrsPath, err := b.paths(objName)
+	if err != nil {
+		return &localWriter{err: err}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(objPath), 0o755); err != nil {
+		return &localWriter{err: err}
+	}
+
+	// Write to a temp file and move it into place on close so that readers never see a partially written object
+	f, err := os.CreateTemp(filepath.Dir(objPath), "."+filepath.Base(objPath)+".*")
+	if err != nil {
+		return &localWriter{err: err}
+	}
+
+	return &localWriter{f: f, objPath: objPath, attrsPath: attrsPath, attrs: attrs}
+}
+
+func (b LocalBucket) URL(objName string) string {
+	if b.c.publicURL != "" {
+		return fmt.Sprintf("%s/%s/%s", b.c.publicURL, b.name, objName)
+	}
+	return "file://" + filepath.ToSlash(filepath.Join(b.c.dir, b.name, objName))
+}
+
+// paths returns the path of the object and the path of its attributes
+func (b LocalBucket) paths(objName string) (string, string, error) {
+	objPath := filepath.Join(b.c.dir, b.name, objName)
+	if !strings.HasPrefix(objPath, filepath.Join(b.c.dir, b.name)+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("invalid object name: %s", objName)
+	}
+	return objPath, filepath.Join(b.c.dir, attrsDir, b.name, objName+".json"), nil
+}
+
+type localWriter struct {
+	f         *os.File
+	objPath   string
+	attrsPath string
+	attrs     ObjectAttrs
+	closed    bool
+	err       error
+}
+
+func (w *localWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.f.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// Close moves the written object into place, or discards it if a write failed
+func (w *localWriter) Close() error {
+	if w.closed || w.f == nil {
+		return w.err
+	}
+	w.closed = true
+
+	if err := w.f.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+	if w.err != nil {
+		os.Remove(w.f.Name())
+		return w.err
+	}
+
+	byt, err := json.Marshal(w.attrs)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(w.attrsPath), 0o755); err != nil {
+		w.err = err
+		return err
+	}
+	if err := os.WriteFile(w.attrsPath, byt, 0o644); err != nil {
+		w.err = err
+		return err
+	}
+
+	w.err = os.Rename(w.f.Name(), w.objPath)
+	return w.err
+}
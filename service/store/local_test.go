@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBucket(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewLocalClient(t.TempDir(), "http://localhost:9000")
+	assert.NoError(t, err)
+	b := NewBucketStorer(c, "bucket")
+
+	exists, err := b.Exists(ctx, "object")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = b.NewReader(ctx, "object")
+	assert.ErrorIs(t, err, ErrObjectNotExist)
+
+	_, err = b.Write(ctx, "object", []byte("hello"), ObjAttrsOptions.WithContentType("text/plain"))
+	assert.NoError(t, err)
+
+	attrs, err := b.Metadata(ctx, "object")
+	assert.NoError(t, err)
+	assert.Equal(t, "text/plain", attrs.ContentType)
+	assert.Equal(t, int64(5), attrs.Size)
+
+	r, err := b.NewReader(ctx, "object")
+	assert.NoError(t, err)
+	defer r.Close()
+	byt, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(byt))
+
+	assert.Equal(t, "http://localhost:9000/bucket/object", c.Bucket("bucket").URL("object"))
+
+	_, err = b.Write(ctx, "../escape", []byte("hello"))
+	assert.Error(t, err)
+}
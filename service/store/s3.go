@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3Client stores objects in S3 or an S3-compatible store such as MinIO
+type S3Client struct {
+	s3        *s3.S3
+	uploader  *s3manager.Uploader
+	publicURL string
+}
+
+// NewS3Client returns a client for the store at endpoint. Buckets are addressed by path rather than by subdomain so that
+// self-hosted stores work without extra DNS setup. Object URLs are built from publicURL, or from the endpoint if publicURL is empty.
+func NewS3Client(endpoint, region, accessKey, secretKey, publicURL string) (S3Client, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String(region),
+		Credentials:      credentials.NewStaticCredentials(accessKey, secretKey, ""),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		return S3Client{}, err
+	}
+	if publicURL == "" {
+		publicURL = endpoint
+	}
+	svc := s3.New(sess)
+	return S3Client{
+		s3:        svc,
+		uploader:  s3manager.NewUploaderWithClient(svc),
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}, nil
+}
+
+func (c S3Client) Bucket(name string) Bucket {
+	return S3Bucket{c, name}
+}
+
+type S3Bucket struct {
+	c    S3Client
+	name string
+}
+
+func (b S3Bucket) Name() string {
+	return b.name
+}
+
+func (b S3Bucket) Attrs(ctx context.Context, objName string) (ObjectAttrs, error) {
+	out, err := b.c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    aws.String(objName),
+	})
+	if err != nil {
+		return ObjectAttrs{}, s3Err(err)
+	}
+	return ObjectAttrs{
+		ContentType:     aws.StringValue(out.ContentType),
+		ContentEncoding: aws.StringValue(out.ContentEncoding),
+		CacheControl:    aws.StringValue(out.CacheControl),
+		Metadata:        aws.StringValueMap(out.Metadata),
+		Size:            aws.Int64Value(out.ContentLength),
+	}, nil
+}
+
+func (b S3Bucket) NewReader(ctx context.Context, objName string) (io.ReadCloser, error) {
+	out, err := b.c.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    aws.String(objName),
+	})
+	if err != nil {
+		return nil, s3Err(err)
+	}
+	return out.Body, nil
+}
+
+func (b S3Bucket) NewWriter(ctx context.Context, objName string, opts ...func(*ObjectAttrs)) io.WriteCloser {
+	var attrs ObjectAttrs
+	for _, opt := range opts {
+		opt(&attrs)
+	}
+
+	input := &s3manager.UploadInput{
+		Bucket:   aws.String(b.name),
+		Key:      aws.String(objName),
+		Metadata: aws.StringMap(attrs.Metadata),
+	}
+	if attrs.ContentType != "" {
+		input.ContentType = aws.String(attrs.ContentType)
+	}
+	if attrs.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(attrs.ContentEncoding)
+	}
+	if attrs.CacheControl != "" {
+		input.CacheControl = aws.String(attrs.CacheControl)
+	}
+
+	// The uploader reads from the body, so writes are piped to an upload running in the background
+	pr, pw := io.Pipe()
+	input.Body = pr
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+
+	go func() {
+		_, err := b.c.uploader.UploadWithContext(ctx, input)
+		// Unblock any pending writes if the upload stopped early
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+
+	return w
+}
+
+func (b S3Bucket) URL(objName string) string {
+	return fmt.Sprintf("%s/%s/%s", b.c.publicURL, b.name, objName)
+}
+
+type s3Writer struct {
+	pw        *io.PipeWriter
+	done      chan error
+	closeOnce sync.Once
+	err       error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close waits for the upload to finish
+func (w *s3Writer) Close() error {
+	w.closeOnce.Do(func() {
+		w.pw.Close()
+		w.err = <-w.done
+	})
+	return w.err
+}
+
+func s3Err(err error) error {
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return ErrObjectNotExist
+	}
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+		return ErrObjectNotExist
+	}
+	return err
+}
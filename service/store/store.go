@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrObjectNotExist is returned when an object doesn't exist in a bucket
+var ErrObjectNotExist = errors.New("store: object doesn't exist")
+
+// Client is a client for an object store
+type Client interface {
+	// Bucket returns a handle to the named bucket. It does not check that the bucket exists.
+	Bucket(name string) Bucket
+}
+
+// Bucket is a bucket of objects in an object store
+type Bucket interface {
+	// Name is the name of the bucket
+	Name() string
+	// Attrs returns the attributes of an object, or ErrObjectNotExist if the object doesn't exist
+	Attrs(ctx context.Context, objName string) (ObjectAttrs, error)
+	// NewReader reads an object, or returns ErrObjectNotExist if the object doesn't exist
+	NewReader(ctx context.Context, objName string) (io.ReadCloser, error)
+	// NewWriter writes to an object, replacing it if it exists. The object isn't guaranteed to be
+	// written until Close returns without an error.
+	NewWriter(ctx context.Context, objName string, opts ...func(*ObjectAttrs)) io.WriteCloser
+	// URL is the public URL of an object
+	URL(objName string) string
+}
+
+// ObjectAttrs are the attributes of an object
+type ObjectAttrs struct {
+	ContentType     string
+	ContentEncoding string
+	CacheControl    string
+	Metadata        map[string]string
+	// Size is the size of the object in bytes. When writing, it is a hint of how large the object will be and is zero if unknown.
+	Size int64
+}
+
+// Exists checks if an object exists in the bucket
+func Exists(ctx context.Context, b Bucket, objName string) (bool, error) {
+	_, err := b.Attrs(ctx, objName)
+	if err != nil && err != ErrObjectNotExist {
+		return false, err
+	}
+	return err != ErrObjectNotExist, nil
+}
+
+var ObjAttrsOptions objectAttrsOptions
+
+type objectAttrsOptions struct{}
+
+// WithContentType sets the Content-Type header of the object
+func (objectAttrsOptions) WithContentType(typ string) func(*ObjectAttrs) {
+	return func(a *ObjectAttrs) {
+		a.ContentType = typ
+	}
+}
+
+// WithCustomMetadata sets custom metadata on the object
+func (objectAttrsOptions) WithCustomMetadata(m map[string]string) func(*ObjectAttrs) {
+	return func(a *ObjectAttrs) {
+		a.Metadata = m
+	}
+}
+
+// WithContentEncoding sets the Content-Encoding header of the object
+func (objectAttrsOptions) WithContentEncoding(enc string) func(*ObjectAttrs) {
+	return func(a *ObjectAttrs) {
+		a.ContentEncoding = enc
+	}
+}
+
+// WithCacheControl sets the Cache-Control header of the object
+func (objectAttrsOptions) WithCacheControl(c string) func(*ObjectAttrs) {
+	return func(a *ObjectAttrs) {
+		a.CacheControl = c
+	}
+}
+
+// WithSize hints at the size of the object being written, which some stores use to size upload chunks
+func (objectAttrsOptions) WithSize(size int64) func(*ObjectAttrs) {
+	return func(a *ObjectAttrs) {
+		a.Size = size
+	}
+}
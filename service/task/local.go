@@ -0,0 +1,334 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	taskspb "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+)
+
+const (
+	// Defaults match the defaults of a Cloud Tasks queue
+	defaultLocalQueueMaxAttempts             = 100
+	defaultLocalQueueMinBackoff              = 100 * time.Millisecond
+	defaultLocalQueueMaxBackoff              = time.Hour
+	defaultLocalQueueDeadline                = 10 * time.Minute
+	defaultLocalQueueMaxDispatchesPerSecond  = 500
+	defaultLocalQueueMaxConcurrentDispatches = 1000
+	defaultLocalQueueConcurrency             = 10
+	defaultLocalQueuePollInterval            = time.Second
+)
+
+// localQueue is a durable task queue backed by Postgres, for environments that run without Cloud Tasks.
+// Tasks are dispatched over HTTP the same way Cloud Tasks dispatches them: a task succeeds when its handler
+// responds with a 2xx status, and is otherwise retried with exponential backoff until it runs out of attempts
+// and is dead-lettered. Any number of processes can dispatch from the same queue, and each queue's rate limits
+// hold across all of them the way they do for a Cloud Tasks queue.
+type localQueue struct {
+	pool                    *pgxpool.Pool
+	queries                 *db.Queries
+	httpClient              *http.Client
+	maxAttempts             int
+	minBackoff              time.Duration
+	maxBackoff              time.Duration
+	maxDispatchesPerSecond  map[string]float64
+	maxConcurrentDispatches map[string]int
+	concurrency             int
+	pollInterval            time.Duration
+}
+
+func newLocalQueue(pool *pgxpool.Pool) *localQueue {
+	q := &localQueue{
+		pool:                    pool,
+		queries:                 db.New(pool),
+		httpClient:              &http.Client{},
+		maxAttempts:             env.GetInt("LOCAL_TASK_QUEUE_MAX_ATTEMPTS"),
+		minBackoff:              env.GetDuration("LOCAL_TASK_QUEUE_MIN_BACKOFF"),
+		maxBackoff:              env.GetDuration("LOCAL_TASK_QUEUE_MAX_BACKOFF"),
+		maxDispatchesPerSecond:  queueLimitsFromEnv("LOCAL_TASK_QUEUE_MAX_DISPATCHES_PER_SECOND", func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }),
+		maxConcurrentDispatches: queueLimitsFromEnv("LOCAL_TASK_QUEUE_MAX_CONCURRENT_DISPATCHES", strconv.Atoi),
+		concurrency:             env.GetInt("LOCAL_TASK_QUEUE_CONCURRENCY"),
+		pollInterval:            defaultLocalQueuePollInterval,
+	}
+	if q.maxAttempts <= 0 {
+		q.maxAttempts = defaultLocalQueueMaxAttempts
+	}
+	if q.minBackoff <= 0 {
+		q.minBackoff = defaultLocalQueueMinBackoff
+	}
+	if q.maxBackoff <= 0 {
+		q.maxBackoff = defaultLocalQueueMaxBackoff
+	}
+	if q.concurrency <= 0 {
+		q.concurrency = defaultLocalQueueConcurrency
+	}
+	return q
+}
+
+func useLocalQueue(ctx context.Context) func(ctx context.Context, queue string, task *taskspb.Task) error {
+	logger.For(ctx).Info("Initializing task client with local queue")
+	q := newLocalQueue(postgres.NewPgxClient())
+	// Dispatching outlives any one request, so it doesn't use a request's context
+	go q.run(context.Background())
+	return q.enqueue
+}
+
+func (q *localQueue) enqueue(ctx context.Context, queue string, task *taskspb.Task) error {
+	scheduledAt := time.Now()
+	if task.ScheduleTime != nil {
+		scheduledAt = task.ScheduleTime.AsTime()
+	}
+
+	deadline := defaultLocalQueueDeadline
+	if task.DispatchDeadline != nil {
+		deadline = task.DispatchDeadline.AsDuration()
+	}
+
+	return q.queries.InsertQueuedTask(ctx, db.InsertQueuedTaskParams{
+		ID:                 persist.GenerateID(),
+		Queue:              queue,
+		Url:                task.GetHttpRequest().GetUrl(),
+		Method:             task.GetHttpRequest().GetHttpMethod().String(),
+		Headers:            task.GetHttpRequest().GetHeaders(),
+		Body:               task.GetHttpRequest().GetBody(),
+		ScheduledAt:        scheduledAt,
+		DispatchDeadlineMs: deadline.Milliseconds(),
+	})
+}
+
+// run claims tasks that are due and dispatches them until the context is cancelled
+func (q *localQueue) run(ctx context.Context) {
+	slots := make(chan struct{}, q.concurrency)
+	for {
+		free := cap(slots) - len(slots)
+
+		var tasks []db.QueuedTask
+		if free > 0 {
+			var err error
+			tasks, err = q.claim(ctx, free)
+			if err != nil {
+				logger.For(ctx).WithError(err).Error("failed to claim queued tasks")
+			}
+		}
+
+		for _, t := range tasks {
+			slots <- struct{}{}
+			go func(t db.QueuedTask) {
+				defer func() { <-slots }()
+				q.dispatch(ctx, t)
+			}(t)
+		}
+
+		// Keep claiming while there's a backlog, otherwise wait for more tasks to come due
+		if len(tasks) > 0 && len(tasks) == free {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(q.pollInterval):
+		}
+	}
+}
+
+// claim claims up to limit due tasks without going over any queue's limits. Claims are serialized across processes
+// so that the limits hold for a queue as a whole rather than for each process.
+func (q *localQueue) claim(ctx context.Context, limit int) ([]db.QueuedTask, error) {
+	tx, err := q.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	queries := q.queries.WithTx(tx)
+	if err := queries.LockQueuedTaskClaims(ctx); err != nil {
+		return nil, err
+	}
+
+	states, err := queries.GetQueuedTaskQueueStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	params := db.ClaimQueuedTasksParams{Limit: int32(limit)}
+	nextDispatchAt := make(map[string]time.Time, len(states))
+
+	for _, s := range states {
+		allowed := q.maxConcurrentDispatchesFor(s.Queue) - int(s.InFlight)
+		byRate, next := dispatchAllowance(q.maxDispatchesPerSecondFor(s.Queue), s.NextDispatchAt.Time, now)
+		if byRate < allowed {
+			allowed = byRate
+		}
+		if allowed <= 0 {
+			continue
+		}
+		params.Queues = append(params.Queues, s.Queue)
+		params.QueueLimits = append(params.QueueLimits, int32(allowed))
+		nextDispatchAt[s.Queue] = next
+	}
+
+	if len(params.Queues) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := queries.ClaimQueuedTasks(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make(map[string]int)
+	for _, t := range tasks {
+		claimed[t.Queue]++
+	}
+
+	for queue, n := range claimed {
+		err := queries.UpsertQueuedTaskQueue(ctx, db.UpsertQueuedTaskQueueParams{
+			Queue:          queue,
+			NextDispatchAt: nextDispatchAt[queue].Add(time.Duration(n) * dispatchInterval(q.maxDispatchesPerSecondFor(queue))),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (q *localQueue) maxDispatchesPerSecondFor(queue string) float64 {
+	if rate, ok := q.maxDispatchesPerSecond[queue]; ok {
+		return rate
+	}
+	return defaultLocalQueueMaxDispatchesPerSecond
+}
+
+func (q *localQueue) maxConcurrentDispatchesFor(queue string) int {
+	if n, ok := q.maxConcurrentDispatches[queue]; ok {
+		return n
+	}
+	return defaultLocalQueueMaxConcurrentDispatches
+}
+
+func (q *localQueue) dispatch(ctx context.Context, t db.QueuedTask) {
+	err := q.send(ctx, t)
+	if err == nil {
+		if err := q.queries.DeleteQueuedTask(ctx, t.ID); err != nil {
+			logger.For(ctx).WithError(err).Errorf("failed to delete dispatched task %s", t.ID)
+		}
+		return
+	}
+
+	if int(t.Attempts) >= q.maxAttempts {
+		if err := q.queries.DeadLetterQueuedTask(ctx, db.DeadLetterQueuedTaskParams{ID: t.ID, LastError: err.Error()}); err != nil {
+			logger.For(ctx).WithError(err).Errorf("failed to dead-letter task %s", t.ID)
+		}
+		err = fmt.Errorf("dead-lettered task %s to %s on queue %s after %d attempts: %w", t.ID, t.Url, t.Queue, t.Attempts, err)
+		logger.For(ctx).Error(err)
+		sentryutil.ReportError(ctx, err)
+		return
+	}
+
+	retryIn := backoff(int(t.Attempts), q.minBackoff, q.maxBackoff)
+	logger.For(ctx).WithError(err).Warnf("task %s to %s failed on attempt %d, retrying in %s", t.ID, t.Url, t.Attempts, retryIn)
+	err = q.queries.RetryQueuedTask(ctx, db.RetryQueuedTaskParams{ID: t.ID, ScheduledAt: time.Now().Add(retryIn), LastError: err.Error()})
+	if err != nil {
+		logger.For(ctx).WithError(err).Errorf("failed to reschedule task %s", t.ID)
+	}
+}
+
+func (q *localQueue) send(ctx context.Context, t db.QueuedTask) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(t.DispatchDeadlineMs)*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, t.Method, t.Url, bytes.NewReader(t.Body))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range t.Headers {
+		req.Header.Add(key, value)
+	}
+
+	// Our task handlers expect these to be set
+	req.Header.Set("X-CloudTasks-TaskName", t.ID.String())
+	req.Header.Set("X-CloudTasks-QueueName", t.Queue)
+	req.Header.Set("X-CloudTasks-TaskRetryCount", strconv.Itoa(int(t.Attempts)-1))
+
+	resp, err := q.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// backoff returns how long to wait before the next attempt, doubling after every attempt up to maxBackoff
+func backoff(attempts int, minBackoff, maxBackoff time.Duration) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+// dispatchAllowance returns how many tasks a queue limited to rate dispatches per second can dispatch at now, and the
+// time that those dispatches are paced from. nextDispatchAt is when the queue's previous dispatches allow it to dispatch
+// again. A queue that has been idle can dispatch up to a second's worth of tasks at once, like a Cloud Tasks queue bursts.
+func dispatchAllowance(rate float64, nextDispatchAt, now time.Time) (int, time.Time) {
+	if nextDispatchAt.Before(now) {
+		nextDispatchAt = now
+	}
+	interval := dispatchInterval(rate)
+	burst := time.Duration(math.Ceil(rate)) * interval
+	return int(now.Add(burst).Sub(nextDispatchAt) / interval), nextDispatchAt
+}
+
+func dispatchInterval(rate float64) time.Duration {
+	return time.Duration(float64(time.Second) / rate)
+}
+
+// queueLimitsFromEnv reads a list of <queue>=<limit> entries from an env var
+func queueLimitsFromEnv[T int | float64](name string, parse func(string) (T, error)) map[string]T {
+	limits := make(map[string]T)
+	for _, entry := range env.GetStringSlice(name) {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			panic(fmt.Errorf("invalid env var: %s entry %q isn't of the form <queue>=<limit>", name, entry))
+		}
+		limit, err := parse(entry[i+1:])
+		if err != nil || limit <= 0 {
+			panic(fmt.Errorf("invalid env var: %s entry %q doesn't have a positive limit", name, entry))
+		}
+		limits[entry[:i]] = limit
+	}
+	return limits
+}
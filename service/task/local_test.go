@@ -0,0 +1,266 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	migrate "github.com/mikeydub/go-gallery/db"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/docker"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(1, time.Second, time.Minute))
+	assert.Equal(t, 2*time.Second, backoff(2, time.Second, time.Minute))
+	assert.Equal(t, 32*time.Second, backoff(6, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, backoff(7, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, backoff(1000, time.Second, time.Minute))
+}
+
+func TestDispatchAllowance(t *testing.T) {
+	now := time.Now()
+
+	n, next := dispatchAllowance(5, time.Time{}, now)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, now, next)
+
+	n, next = dispatchAllowance(5, now.Add(600*time.Millisecond), now)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, now.Add(600*time.Millisecond), next)
+
+	n, _ = dispatchAllowance(0.5, time.Time{}, now)
+	assert.Equal(t, 1, n)
+
+	n, _ = dispatchAllowance(0.5, now.Add(time.Second), now)
+	assert.Equal(t, 0, n)
+}
+
+func TestLocalQueue(t *testing.T) {
+	ctx := context.Background()
+	pool := usePostgres(t)
+
+	t.Run("claims due tasks", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		submitTestTask(t, q, "queue", "http://localhost/due", 0)
+		submitTestTask(t, q, "queue", "http://localhost/later", time.Hour)
+
+		tasks, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, "http://localhost/due", tasks[0].Url)
+		assert.Equal(t, int32(1), tasks[0].Attempts)
+		assert.True(t, tasks[0].LockedUntil.Time.After(time.Now()))
+
+		tasks, err = q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, tasks, "claimed tasks shouldn't be claimed again")
+	})
+
+	t.Run("deletes completed tasks", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		queueNames := make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queueNames <- r.Header.Get("X-CloudTasks-QueueName")
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		submitTestTask(t, q, "queue", server.URL, 0)
+
+		task := claimOne(t, q)
+		q.dispatch(ctx, task)
+
+		assert.Equal(t, "queue", <-queueNames)
+		_, found := getTestTask(t, pool, task.ID)
+		assert.False(t, found)
+	})
+
+	t.Run("retries failed tasks with backoff", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		server := failingServer(t)
+		submitTestTask(t, q, "queue", server.URL, 0)
+
+		task := claimOne(t, q)
+		q.dispatch(ctx, task)
+
+		retried, found := getTestTask(t, pool, task.ID)
+		require.True(t, found)
+		assert.Equal(t, int32(1), retried.Attempts)
+		assert.False(t, retried.LockedUntil.Valid)
+		assert.False(t, retried.DeadLetteredAt.Valid)
+		assert.Contains(t, retried.LastError.String, "500")
+		assert.WithinDuration(t, time.Now().Add(q.minBackoff), retried.ScheduledAt, 5*time.Second)
+
+		tasks, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, tasks, "tasks shouldn't be claimed before their backoff elapses")
+	})
+
+	t.Run("dead-letters tasks that run out of attempts", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		q.maxAttempts = 1
+		server := failingServer(t)
+		submitTestTask(t, q, "queue", server.URL, 0)
+
+		task := claimOne(t, q)
+		q.dispatch(ctx, task)
+
+		deadLettered, found := getTestTask(t, pool, task.ID)
+		require.True(t, found)
+		assert.True(t, deadLettered.DeadLetteredAt.Valid)
+		assert.False(t, deadLettered.LockedUntil.Valid)
+		assert.Contains(t, deadLettered.LastError.String, "500")
+
+		_, err := pool.Exec(ctx, "update queued_tasks set scheduled_at = now() - interval '1 hour' where id = $1", task.ID)
+		require.NoError(t, err)
+		tasks, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, tasks, "dead-lettered tasks shouldn't be claimed")
+	})
+
+	t.Run("limits concurrent dispatches per queue", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		q.maxConcurrentDispatches = map[string]int{"limited": 2}
+		for i := 0; i < 3; i++ {
+			submitTestTask(t, q, "limited", "http://localhost/limited", 0)
+		}
+		submitTestTask(t, q, "other", "http://localhost/other", 0)
+
+		claimed, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"limited": 2, "other": 1}, countByQueue(claimed))
+
+		tasks, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, tasks, "a queue at its limit shouldn't dispatch more tasks")
+
+		require.NoError(t, q.queries.DeleteQueuedTask(ctx, claimedFrom(claimed, "limited")))
+		tasks, err = q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"limited": 1}, countByQueue(tasks))
+	})
+
+	t.Run("limits dispatch rate per queue", func(t *testing.T) {
+		q := newTestQueue(t, pool)
+		q.maxDispatchesPerSecond = map[string]float64{"limited": 1}
+		for i := 0; i < 3; i++ {
+			submitTestTask(t, q, "limited", "http://localhost/limited", 0)
+		}
+
+		tasks, err := q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 1)
+
+		tasks, err = q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, tasks, "a queue shouldn't dispatch faster than its rate")
+
+		// Let a second pass
+		_, err = pool.Exec(ctx, "update queued_task_queues set next_dispatch_at = next_dispatch_at - interval '1 second'")
+		require.NoError(t, err)
+		tasks, err = q.claim(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 1)
+	})
+}
+
+// usePostgres starts a running Postgres Docker container with migrations applied, and returns a pool connected to it.
+// The container is deleted when the test and its subtests complete.
+func usePostgres(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	viper.SetDefault("ENV", "local")
+	viper.SetDefault("POSTGRES_USER", "gallery_backend")
+	viper.SetDefault("POSTGRES_PASSWORD", "")
+	viper.SetDefault("POSTGRES_DB", "postgres")
+	viper.AutomaticEnv()
+
+	r, err := docker.StartPostgres()
+	require.NoError(t, err)
+	hostAndPort := strings.Split(r.GetHostPort("5432/tcp"), ":")
+	t.Setenv("POSTGRES_HOST", hostAndPort[0])
+	t.Setenv("POSTGRES_PORT", hostAndPort[1])
+
+	err = migrate.RunMigrations(postgres.MustCreateClient(postgres.WithUser("postgres")), "./db/migrations/core")
+	require.NoError(t, err)
+
+	pool := postgres.NewPgxClient()
+	t.Cleanup(func() {
+		pool.Close()
+		r.Close()
+	})
+	return pool
+}
+
+// newTestQueue returns a queue over an empty queued_tasks table
+func newTestQueue(t *testing.T, pool *pgxpool.Pool) *localQueue {
+	t.Helper()
+	for _, table := range []string{"queued_tasks", "queued_task_queues"} {
+		_, err := pool.Exec(context.Background(), "delete from "+table)
+		require.NoError(t, err)
+	}
+	q := newLocalQueue(pool)
+	q.minBackoff = time.Minute
+	return q
+}
+
+func submitTestTask(t *testing.T, q *localQueue, queue, url string, delay time.Duration) {
+	t.Helper()
+	c := &Client{sendFunc: q.enqueue}
+	require.NoError(t, c.submitTask(context.Background(), queue, url, WithDelay(delay)))
+}
+
+func claimOne(t *testing.T, q *localQueue) db.QueuedTask {
+	t.Helper()
+	tasks, err := q.claim(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	return tasks[0]
+}
+
+func getTestTask(t *testing.T, pool *pgxpool.Pool, id persist.DBID) (db.QueuedTask, bool) {
+	t.Helper()
+	var task db.QueuedTask
+	err := pool.QueryRow(context.Background(), "select id, attempts, scheduled_at, locked_until, last_error, dead_lettered_at from queued_tasks where id = $1", id).
+		Scan(&task.ID, &task.Attempts, &task.ScheduledAt, &task.LockedUntil, &task.LastError, &task.DeadLetteredAt)
+	if err == pgx.ErrNoRows {
+		return task, false
+	}
+	require.NoError(t, err)
+	return task, true
+}
+
+func failingServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func countByQueue(tasks []db.QueuedTask) map[string]int {
+	counts := make(map[string]int)
+	for _, t := range tasks {
+		counts[t.Queue]++
+	}
+	return counts
+}
+
+func claimedFrom(tasks []db.QueuedTask, queue string) persist.DBID {
+	for _, t := range tasks {
+		if t.Queue == queue {
+			return t.ID
+		}
+	}
+	return ""
+}
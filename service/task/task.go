@@ -112,7 +112,8 @@ func (t *TokenIdentifiersQuantities) UnmarshalJSON(b []byte) error {
 }
 
 type TokenProcessingUserTokensMessage struct {
-	UserID           persist.DBID               `json:"user_id" binding:"required"`
+	UserID persist.DBID `json:"user_id" binding:"required"`
+	// TokenIdentifiers maps each token to the balance its owner address holds. A balance of zero means the token was sent away.
 	TokenIdentifiers TokenIdentifiersQuantities `json:"token_identifiers" binding:"required"`
 }
 
@@ -125,6 +126,14 @@ type ValidateNFTsMessage struct {
 	OwnerAddress persist.EthereumAddress `json:"wallet"`
 }
 
+type ScheduledPostMessage struct {
+	ScheduledPostID persist.DBID `json:"scheduled_post_id" binding:"required"`
+}
+
+type WebhookDeliveryMessage struct {
+	DeliveryID persist.DBID `json:"delivery_id" binding:"required"`
+}
+
 type PushNotificationMessage struct {
 	PushTokenID persist.DBID   `json:"pushTokenID"`
 	Title       string         `json:"title"`
@@ -191,6 +200,15 @@ func (c *Client) CreateTaskForWalletRemoval(ctx context.Context, message TokenPr
 	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span))
 }
 
+func (c *Client) CreateTaskForUserTokens(ctx context.Context, message TokenProcessingUserTokensMessage) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForUserTokens")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"User ID": message.UserID, "Tokens": len(message.TokenIdentifiers)})
+	queue := env.GetString("TOKEN_PROCESSING_QUEUE")
+	url := fmt.Sprintf("%s/owners/process/user-tokens", env.GetString("TOKEN_PROCESSING_URL"))
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span))
+}
+
 func (c *Client) CreateTaskTokenProcessingForOpenseaStreamer(ctx context.Context, message persist.OpenSeaWebhookInput) error {
 	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskTokenProcessingForOpenseaStreamer")
 	defer tracing.FinishSpan(span)
@@ -258,6 +276,27 @@ func (c *Client) CreateTaskForSlackPostFeedBot(ctx context.Context, message Feed
 	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret), WithDelay(2*time.Minute))
 }
 
+// CreateTaskForScheduledPost creates a task to publish a scheduled post after the delay
+func (c *Client) CreateTaskForScheduledPost(ctx context.Context, message ScheduledPostMessage, delay time.Duration) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForScheduledPost")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"ScheduledPostID": message.ScheduledPostID})
+	queue := env.GetString("GCLOUD_SCHEDULED_POSTS_QUEUE")
+	url := fmt.Sprintf("%s/tasks/publish-scheduled-post", env.GetString("BACKEND_URL"))
+	secret := env.GetString("BACKEND_TASK_SECRET")
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret), WithDelay(delay))
+}
+
+func (c *Client) CreateTaskForWebhookDelivery(ctx context.Context, message WebhookDeliveryMessage) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForWebhookDelivery")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"DeliveryID": message.DeliveryID})
+	queue := env.GetString("GCLOUD_WEBHOOKS_QUEUE")
+	url := fmt.Sprintf("%s/tasks/deliver-webhook", env.GetString("PUSH_NOTIFICATIONS_URL"))
+	secret := env.GetString("PUSH_NOTIFICATIONS_SECRET")
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret))
+}
+
 // NewClient returns a new task client with tracing enabled.
 func NewClient(ctx context.Context) *Client {
 	skipQueues := make(map[string]bool)
@@ -265,7 +304,9 @@ func NewClient(ctx context.Context) *Client {
 		skipQueues[q] = true
 	}
 
-	if env.GetBool("CLOUD_TASKS_DIRECT_DISPATCH_ENABLED") {
+	if env.GetBool("CLOUD_TASKS_LOCAL_QUEUE_ENABLED") {
+		return &Client{skipQueues: skipQueues, sendFunc: useLocalQueue(ctx)}
+	} else if env.GetBool("CLOUD_TASKS_DIRECT_DISPATCH_ENABLED") {
 		return &Client{skipQueues: skipQueues, sendFunc: useDirectDispatch(ctx)}
 	} else {
 		return &Client{skipQueues: skipQueues, sendFunc: useCloudTasks(ctx, newGCPClient(ctx))}
@@ -25,6 +25,7 @@ var (
 	pauseFlakingContractFor = time.Hour
 	flakingAmount           = int64(100)
 	flakingSpan             = time.Hour
+	replayBatchSize         = 100
 )
 
 // ErrBadToken is an error indicating that there is an issue with the token itself
@@ -99,6 +100,7 @@ type Manager struct {
 	tickTokenF     TickTokenF
 	metricReporter metric.MetricReporter
 	maxRetries     func(db.TokenDefinition) int
+	queries        *db.Queries
 }
 
 func New(ctx context.Context, taskClient *task.Client, cache *redis.Cache, tickTokenF TickTokenF) *Manager {
@@ -114,9 +116,12 @@ func New(ctx context.Context, taskClient *task.Client, cache *redis.Cache, tickT
 	}
 }
 
-func NewWithRetries(ctx context.Context, taskClient *task.Client, cache *redis.Cache, maxRetries func(db.TokenDefinition) int, tickTokenF TickTokenF) *Manager {
+// NewWithRetries returns a Manager that retries tokens that fail with ErrBadToken. Failed attempts are recorded with queries,
+// and tokens that are still failing once they run out of retries are dead-lettered so that they can be replayed later.
+func NewWithRetries(ctx context.Context, taskClient *task.Client, cache *redis.Cache, queries *db.Queries, maxRetries func(db.TokenDefinition) int, tickTokenF TickTokenF) *Manager {
 	m := New(ctx, taskClient, cache, tickTokenF)
 	m.maxRetries = maxRetries
+	m.queries = queries
 	return m
 }
 
@@ -173,7 +178,8 @@ func (m Manager) StartProcessing(ctx context.Context, td db.TokenDefinition, att
 		<-done
 		m.tickTokenF(td) // mark that the token ran so if an error occured tryRetry delays the next run appropriately
 		m.recordError(ctx, td, err)
-		m.tryRetry(ctx, td, err, attempts)
+		exhausted, _ := m.tryRetry(ctx, td, err, attempts)
+		m.recordFailure(ctx, td, tm, err, attempts, cause, exhausted)
 		m.throttle.Unlock(ctx, "lock:"+td.ID.String())
 		recordMetrics(ctx, m.metricReporter, td.Chain, tm.Media.MediaType, err, time.Since(start), cause)
 		return nil
@@ -211,37 +217,124 @@ func (m Manager) recordError(ctx context.Context, td db.TokenDefinition, origina
 
 	if nowFlaky {
 		err := ErrContractFlaking{Chain: td.Chain, Contract: td.ContractAddress, Err: originalErr, Duration: time.Hour * 3}
-		logger.For(ctx).Warnf(err.Error())
+		logger.For(ctx).Warn(err.Error())
 		sentryutil.ReportError(ctx, err)
 	}
 }
 
-func (m Manager) tryRetry(ctx context.Context, td db.TokenDefinition, err error, attempts int) error {
+// tryRetry reenqueues the token if it failed with an error that can be retried. It returns true if the token failed
+// with a retryable error but ran out of retries.
+func (m Manager) tryRetry(ctx context.Context, td db.TokenDefinition, err error, attempts int) (bool, error) {
 	// Only retry intermittent errors related to the token e.g. missing metadata
 	if !util.ErrorIs[ErrBadToken](err) {
 		m.Registry.finish(ctx, td.ID)
-		return nil
+		return false, nil
 	}
 
 	if m.Paused(ctx, td) {
 		m.Registry.finish(ctx, td.ID)
-		return nil
+		return false, nil
 	}
 
-	if err == nil || m.maxRetries == nil || attempts >= m.maxRetries(td) {
+	if err == nil || m.maxRetries == nil {
 		m.Registry.finish(ctx, td.ID)
-		return nil
+		return false, nil
+	}
+
+	if attempts >= m.maxRetries(td) {
+		m.Registry.finish(ctx, td.ID)
+		return true, nil
 	}
 
 	delay, err := m.tickTokenF(td)
 	if err != nil {
 		logger.For(ctx).Errorf("failed to get retry delay, not retrying: %s", err)
 		m.Registry.finish(ctx, td.ID)
-		return err
+		return false, err
 	}
 
 	m.Registry.SetEnqueue(ctx, td.ID)
-	return m.Submitter.SubmitTokenForRetry(ctx, td.ID, attempts+1, delay)
+	return false, m.Submitter.SubmitTokenForRetry(ctx, td.ID, attempts+1, delay)
+}
+
+// recordFailure records a failed attempt at processing a token that can be retried. If the token ran out of retries, it's
+// dead-lettered so that it can be found and replayed with ReplayFailures.
+func (m Manager) recordFailure(ctx context.Context, td db.TokenDefinition, tm db.TokenMedia, err error, attempts int, cause persist.ProcessingCause, deadLettered bool) {
+	if m.queries == nil || !util.ErrorIs[ErrBadToken](err) {
+		return
+	}
+
+	class := errorClass(err)
+
+	// attempts counts retries, so the first attempt is zero
+	made := attempts + 1
+
+	recordErr := m.queries.UpsertTokenProcessingFailure(ctx, db.UpsertTokenProcessingFailureParams{
+		ID:                persist.GenerateID(),
+		TokenDefinitionID: td.ID,
+		Chain:             td.Chain,
+		ContractAddress:   td.ContractAddress,
+		ProcessingCause:   cause,
+		ErrorClass:        class,
+		ErrorMessage:      err.Error(),
+		Attempts:          int32(made),
+		AttemptHistory: persist.TokenProcessingAttempts{{
+			Attempt:         made,
+			ProcessingCause: cause,
+			ErrorClass:      class,
+			ErrorMessage:    err.Error(),
+			ProcessingJobID: tm.ProcessingJobID,
+			FailedAt:        time.Now(),
+		}},
+		LastProcessingJobID: tm.ProcessingJobID,
+		DeadLettered:        deadLettered,
+	})
+	if recordErr != nil {
+		logger.For(ctx).Errorf("failed to record processing failure: %s", recordErr)
+		sentryutil.ReportError(ctx, recordErr)
+		return
+	}
+
+	if deadLettered {
+		logger.For(ctx).Warnf("dead-lettered token=%s after %d attempts; last error: %s", td.ID, made, err)
+	}
+}
+
+// ReplayFailures resubmits dead-lettered tokens for processing and marks their failures as replayed
+func ReplayFailures(ctx context.Context, queries *db.Queries, submitter Submitter, failures []db.TokenProcessingFailure) error {
+	for _, chunk := range util.ChunkBy(failures, replayBatchSize) {
+		tokenDefinitionIDs := make([]persist.DBID, len(chunk))
+		failureIDs := make([]string, len(chunk))
+		for i, f := range chunk {
+			tokenDefinitionIDs[i] = f.TokenDefinitionID
+			failureIDs[i] = f.ID.String()
+		}
+		if err := submitter.SubmitNewTokens(ctx, tokenDefinitionIDs); err != nil {
+			return err
+		}
+		if err := queries.MarkTokenProcessingFailuresReplayed(ctx, failureIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errorClass returns a name for the kind of error that a run failed with, so that failures can be grouped together.
+// The class of a typed error is its type, and the class of a sentinel error is its message.
+func errorClass(err error) string {
+	for err != nil {
+		if _, ok := err.(ErrBadToken); !ok {
+			switch class := fmt.Sprintf("%T", err); class {
+			case "*fmt.wrapError":
+			case "*errors.errorString":
+				return err.Error()
+			default:
+				return class
+			}
+		}
+		err = errors.Unwrap(err)
+	}
+	return "unknown"
 }
 
 // Registry handles the storing of object state managed by Manager
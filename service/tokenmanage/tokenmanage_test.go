@@ -0,0 +1,23 @@
+package tokenmanage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testError struct{ Err error }
+
+func (e testError) Unwrap() error { return e.Err }
+func (e testError) Error() string { return "test error" }
+
+func TestErrorClass(t *testing.T) {
+	sentinel := errors.New("no media urls")
+
+	assert.Equal(t, "tokenmanage.testError", errorClass(ErrBadToken{Err: testError{Err: sentinel}}))
+	assert.Equal(t, "tokenmanage.testError", errorClass(ErrBadToken{Err: fmt.Errorf("wrapped: %w", testError{})}))
+	assert.Equal(t, "no media urls", errorClass(ErrBadToken{Err: fmt.Errorf("wrapped: %w", sentinel)}))
+	assert.Equal(t, "unknown", errorClass(nil))
+}
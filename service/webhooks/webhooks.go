@@ -0,0 +1,346 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// Event types that endpoints can subscribe to
+const (
+	EventPostCreated          = "post.created"
+	EventUserFollowed         = "user.followed"
+	EventTokensAddedToGallery = "gallery.tokens_added"
+	EventNewTokenHolder       = "community.new_holder"
+	// EventTest is only sent by testing a webhook
+	EventTest = "webhook.test"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+const (
+	SignatureHeader = "X-Gallery-Signature"
+	EventHeader     = "X-Gallery-Event"
+	DeliveryHeader  = "X-Gallery-Delivery"
+	TimestampHeader = "X-Gallery-Timestamp"
+)
+
+const (
+	// MaxAttempts is how many times a delivery is attempted before it is marked as failed
+	MaxAttempts = 8
+	// MaxWebhooksPerUser is the most webhooks a user can register
+	MaxWebhooksPerUser = 10
+
+	deliveryTimeout = 10 * time.Second
+	// maxErrorLength is how much of a failed response's body is kept in the delivery log
+	maxErrorLength = 1024
+)
+
+var errBlockedAddress = errors.New("webhook endpoint resolves to a non-public address")
+
+// UserEventTypes are the events a user's webhooks can subscribe to
+var UserEventTypes = []string{EventPostCreated, EventUserFollowed, EventTokensAddedToGallery}
+
+// CommunityEventTypes are the events a community's webhooks can subscribe to
+var CommunityEventTypes = []string{EventNewTokenHolder}
+
+var client = &http.Client{
+	Timeout: deliveryTimeout,
+	Transport: &http.Transport{
+		DialContext:         (&net.Dialer{Timeout: 5 * time.Second, Control: restrictDial}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+		MaxIdleConnsPerHost: 2,
+	},
+	// Redirects could be used to reach addresses that the endpoint's URL can't
+	CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+}
+
+// Payload is the body sent to an endpoint
+type Payload struct {
+	ID        persist.DBID `json:"id"`
+	WebhookID persist.DBID `json:"webhook_id"`
+	Type      string       `json:"type"`
+	CreatedAt time.Time    `json:"created_at"`
+	Data      any          `json:"data"`
+}
+
+type PostCreatedData struct {
+	PostID persist.DBID `json:"post_id"`
+	UserID persist.DBID `json:"user_id"`
+}
+
+type UserFollowedData struct {
+	UserID     persist.DBID `json:"user_id"`
+	FollowerID persist.DBID `json:"follower_id"`
+}
+
+type TokensAddedToGalleryData struct {
+	UserID       persist.DBID   `json:"user_id"`
+	GalleryID    persist.DBID   `json:"gallery_id"`
+	CollectionID persist.DBID   `json:"collection_id"`
+	TokenIDs     []persist.DBID `json:"token_ids"`
+}
+
+type NewTokenHolderData struct {
+	CommunityID persist.DBID `json:"community_id"`
+	TokenID     persist.DBID `json:"token_id"`
+	HolderID    persist.DBID `json:"holder_id"`
+}
+
+type TestData struct {
+	Message string `json:"message"`
+}
+
+// GenerateSecret returns a new secret for signing payloads
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header for a payload. The signature is an HMAC-SHA256 of the timestamp and body
+// joined by a period, which lets endpoints reject old payloads that are replayed.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// ValidateURL checks that an endpoint can be delivered to
+func ValidateURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && env.GetString("ENV") == "local") {
+		return fmt.Errorf("webhook url must use https")
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("webhook url must have a host")
+	}
+	if u.User != nil {
+		return fmt.Errorf("webhook url can't contain credentials")
+	}
+	return nil
+}
+
+// Dispatch logs a delivery of the event to each webhook and creates a task to send it
+func Dispatch(ctx context.Context, q *db.Queries, taskClient *task.Client, hooks []db.Webhook, eventType string, data any) error {
+	for _, hook := range hooks {
+		delivery, err := createDelivery(ctx, q, hook, eventType, data)
+		if err != nil {
+			return err
+		}
+		err = taskClient.CreateTaskForWebhookDelivery(ctx, task.WebhookDeliveryMessage{DeliveryID: delivery.ID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deliver sends a logged delivery to its endpoint. An error is returned if the attempt failed, in which case the
+// returned delivery's status is pending if it should be retried.
+func Deliver(ctx context.Context, q *db.Queries, deliveryID persist.DBID) (db.WebhookDelivery, error) {
+	delivery, err := q.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return delivery, err
+	}
+
+	if delivery.Status != StatusPending {
+		return delivery, nil
+	}
+
+	hook, err := q.GetWebhookByID(ctx, delivery.WebhookID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !hook.Enabled) {
+		return recordAttempt(ctx, q, delivery, StatusFailed, 0, "webhook was deleted or disabled")
+	}
+	if err != nil {
+		return delivery, err
+	}
+
+	// Creators can be removed from a community after adding a webhook to it
+	if hook.CommunityID != "" {
+		isCreator, err := q.IsCommunityCreator(ctx, db.IsCommunityCreatorParams{CommunityID: hook.CommunityID, UserID: hook.OwnerID})
+		if err != nil {
+			return delivery, err
+		}
+		if !isCreator {
+			return recordAttempt(ctx, q, delivery, StatusFailed, 0, "webhook owner is no longer a creator of the community")
+		}
+	}
+
+	return deliver(ctx, q, hook, delivery, MaxAttempts)
+}
+
+// Test sends a test payload to a webhook. The delivery is only attempted once.
+func Test(ctx context.Context, q *db.Queries, hook db.Webhook) (db.WebhookDelivery, error) {
+	delivery, err := createDelivery(ctx, q, hook, EventTest, TestData{Message: "This is a test delivery from Gallery"})
+	if err != nil {
+		return delivery, err
+	}
+	return deliver(ctx, q, hook, delivery, 1)
+}
+
+func createDelivery(ctx context.Context, q *db.Queries, hook db.Webhook, eventType string, data any) (db.WebhookDelivery, error) {
+	id := persist.GenerateID()
+
+	b, err := json.Marshal(Payload{
+		ID:        id,
+		WebhookID: hook.ID,
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data:      data,
+	})
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return q.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:        id,
+		WebhookID: hook.ID,
+		EventType: eventType,
+		Payload:   pgtype.JSONB{Bytes: b, Status: pgtype.Present},
+	})
+}
+
+func deliver(ctx context.Context, q *db.Queries, hook db.Webhook, delivery db.WebhookDelivery, maxAttempts int32) (db.WebhookDelivery, error) {
+	statusCode, attemptErr := send(ctx, hook, delivery)
+	if attemptErr == nil {
+		return recordAttempt(ctx, q, delivery, StatusSucceeded, statusCode, "")
+	}
+
+	logger.For(ctx).Warnf("failed to deliver webhook delivery=%s to webhook=%s: %s", delivery.ID, hook.ID, attemptErr)
+
+	status := StatusPending
+	if delivery.Attempts+1 >= maxAttempts {
+		status = StatusFailed
+	}
+
+	delivery, err := recordAttempt(ctx, q, delivery, status, statusCode, attemptErr.Error())
+	if err != nil {
+		return delivery, err
+	}
+
+	return delivery, attemptErr
+}
+
+func send(ctx context.Context, hook db.Webhook, delivery db.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	body := delivery.Payload.Bytes
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Gallery-Webhooks/1.0")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	now := time.Now()
+	req.Header.Set(TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, now, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	return resp.StatusCode, fmt.Errorf("endpoint responded with status %d: %s", resp.StatusCode, b)
+}
+
+func recordAttempt(ctx context.Context, q *db.Queries, delivery db.WebhookDelivery, status string, statusCode int, errMsg string) (db.WebhookDelivery, error) {
+	if len(errMsg) > maxErrorLength {
+		errMsg = errMsg[:maxErrorLength]
+	}
+	return q.UpdateWebhookDeliveryAttempt(ctx, db.UpdateWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         status,
+		ResponseStatus: sql.NullInt32{Int32: int32(statusCode), Valid: statusCode != 0},
+		Error:          sql.NullString{String: errMsg, Valid: errMsg != ""},
+	})
+}
+
+// restrictDial stops endpoints from being used to reach internal services. It runs after a host is resolved,
+// so a public hostname that resolves to a private address is also rejected.
+func restrictDial(network, address string, _ syscall.RawConn) error {
+	if env.GetString("ENV") == "local" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return errBlockedAddress
+	}
+	return nil
+}
+
+// nonPublicNets are ranges that can reach internal services but aren't covered by the net.IP helpers
+var nonPublicNets = util.MapWithoutError([]string{
+	"0.0.0.0/8",     // "this network"
+	"100.64.0.0/10", // carrier-grade NAT, also used by some cloud internal networks
+	"64:ff9b::/96",  // NAT64, which can embed any IPv4 address
+}, func(cidr string) *net.IPNet {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return n
+})
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
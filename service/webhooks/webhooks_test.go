@@ -0,0 +1,34 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"delivery"}`)
+	ts := time.Unix(1700000000, 0)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	expected := "t=1700000000,v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, expected, Sign("secret", ts, body))
+	assert.NotEqual(t, expected, Sign("other", ts, body))
+	assert.NotEqual(t, expected, Sign("secret", ts.Add(time.Second), body))
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "10.0.0.1", "192.168.1.1", "169.254.169.254", "::1", "fd00::1", "0.0.0.0", "0.1.2.3", "100.64.0.1", "100.127.255.254", "64:ff9b::a9fe:a9fe"} {
+		assert.False(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "100.128.0.1", "2606:4700:4700::1111"} {
+		assert.True(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+}
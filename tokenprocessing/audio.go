@@ -0,0 +1,140 @@
+package tokenprocessing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/store"
+)
+
+const (
+	waveformWidth  = 1200
+	waveformHeight = 240
+	waveformColor  = "#000000"
+)
+
+type audioProbe struct {
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		Duration  string `json:"duration"`
+		BitRate   string `json:"bit_rate"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+		BitRate  string `json:"bit_rate"`
+	} `json:"format"`
+}
+
+// audioInfoAndCache reads the duration and bitrate of an audio file and caches an image of its waveform
+func audioInfoAndCache(ctx context.Context, tids persist.TokenIdentifiers, audioURL string, bucket store.Bucket) (*persist.AudioInfo, []cachedMediaObject) {
+	info, err := getAudioInfo(ctx, audioURL)
+	if err != nil {
+		logger.For(ctx).Warnf("could not read audio info for %s: %s", tids, err)
+		info = &persist.AudioInfo{}
+	}
+
+	waveformObj, err := waveformAndCache(ctx, tids, audioURL, bucket)
+	if err != nil {
+		logger.For(ctx).Errorf("could not create waveform for %s: %s", tids, err)
+		return info, nil
+	}
+
+	return info, []cachedMediaObject{waveformObj}
+}
+
+func getAudioInfo(ctx context.Context, url string) (*persist.AudioInfo, error) {
+	c := exec.CommandContext(ctx, "ffprobe", "-hide_banner", "-loglevel", "error", "-show_format", "-show_streams", "-select_streams", "a:0", url, "-print_format", "json")
+	outBuf, err := c.Output()
+	if err != nil {
+		return nil, errFromExitErr(err)
+	}
+
+	var p audioProbe
+	if err := json.Unmarshal(outBuf, &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ffprobe output: %w", err)
+	}
+
+	// Prefer what the container reports, and fall back to the audio stream for formats that don't report it
+	durationStr, bitrateStr := p.Format.Duration, p.Format.BitRate
+	for _, s := range p.Streams {
+		if s.CodecType != "audio" {
+			continue
+		}
+		if durationStr == "" || durationStr == "N/A" {
+			durationStr = s.Duration
+		}
+		if bitrateStr == "" || bitrateStr == "N/A" {
+			bitrateStr = s.BitRate
+		}
+		break
+	}
+
+	info := &persist.AudioInfo{}
+
+	if duration, err := strconv.ParseFloat(durationStr, 64); err == nil {
+		info.DurationSeconds = duration
+	}
+
+	if bitrate, err := strconv.Atoi(bitrateStr); err == nil {
+		info.Bitrate = bitrate
+	}
+
+	logger.For(ctx).Debugf("got audio info %+v for %s", info, url)
+	return info, nil
+}
+
+func waveformAndCache(ctx context.Context, tids persist.TokenIdentifiers, audioURL string, bucket store.Bucket) (cachedMediaObject, error) {
+	obj := cachedMediaObject{
+		ObjectType:      objectTypeWaveform,
+		MediaType:       persist.MediaTypeImage,
+		TokenID:         tids.TokenID,
+		ContractAddress: tids.ContractAddress,
+		Chain:           tids.Chain,
+		ContentType:     "image/png",
+	}
+
+	logger.For(ctx).Infof("caching waveform for '%s'", obj.fileName())
+
+	timeBeforeCopy := time.Now()
+
+	sw := newObjectWriter(ctx, bucket, obj.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType("image/png"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"waveformURL": truncateString(audioURL, 100)}),
+	)
+
+	if err := audioWaveformToWriter(ctx, audioURL, sw); err != nil {
+		return cachedMediaObject{}, errStoreObjectFailed{err: err, bucket: bucket, object: obj}
+	}
+
+	if err := sw.Close(); err != nil {
+		return cachedMediaObject{}, err
+	}
+
+	logger.For(ctx).Infof("storage copy took %s", time.Since(timeBeforeCopy))
+
+	purgeIfExists(ctx, bucket, obj.fileName())
+
+	return obj, nil
+}
+
+func audioWaveformToWriter(ctx context.Context, url string, writer io.Writer) error {
+	filter := fmt.Sprintf("aformat=channel_layouts=mono,showwavespic=s=%dx%d:colors=%s", waveformWidth, waveformHeight, waveformColor)
+	c := exec.CommandContext(ctx, "ffmpeg", "-hide_banner", "-loglevel", "error", "-i", url, "-filter_complex", filter, "-frames:v", "1", "-f", "image2", "-c:v", "png", "pipe:1")
+	errBuf := new(bytes.Buffer)
+	c.Stderr = errBuf
+	c.Stdout = writer
+	err := c.Run()
+	if _, ok := isExitErr(err); ok {
+		return errors.New(errBuf.String())
+	}
+	return err
+}
@@ -30,7 +30,7 @@ func handlersInitServer(ctx context.Context, router *gin.Engine, tp *tokenProces
 	mintRetry := limiters.NewKeyRateLimiter(ctx, tokenManageCache, "tickMint", 1, 10*time.Second)
 
 	refreshManager := tokenmanage.New(ctx, taskClient, tokenManageCache, tickTokenSyncF(ctx, fastRetry, slowRetry, mintRetry))
-	syncManager := tokenmanage.NewWithRetries(ctx, taskClient, tokenManageCache, maxRetriesForTokenSync, tickTokenSyncF(ctx, fastRetry, slowRetry, mintRetry))
+	syncManager := tokenmanage.NewWithRetries(ctx, taskClient, tokenManageCache, mc.Queries, maxRetriesForTokenSync, tickTokenSyncF(ctx, fastRetry, slowRetry, mintRetry))
 	highlightProvider := highlight.NewProvider(http.DefaultClient)
 	mintManager := tokenmanage.New(ctx, taskClient, tokenManageCache, tickTokenF(ctx, mintRetry))
 
@@ -52,9 +52,11 @@ func handlersInitServer(ctx context.Context, router *gin.Engine, tp *tokenProces
 	// Return 200 on auth failures to prevent task/job retries
 	ownersGroup.POST("/process/opensea", middleware.BasicHeaderAuthRequired(env.GetString("OPENSEA_WEBHOOK_SECRET"), authOpts.WithFailureStatus(http.StatusOK)), processOwnersForOpenseaTokens(mc, mc.Queries))
 	ownersGroup.POST("/process/wallet-removal", processWalletRemoval(mc.Queries))
+	ownersGroup.POST("/process/user-tokens", processOwnersForUserTokens(mc, mc.Queries))
 
 	contractsGroup := router.Group("/contracts")
 	contractsGroup.POST("/detect-spam", detectSpamContracts(mc.Queries))
+	contractsGroup.POST("/score-spam", scoreSpamContracts(mc.Queries))
 
 	return router
 }
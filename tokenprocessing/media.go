@@ -19,7 +19,6 @@ import (
 	"time"
 
 	"cloud.google.com/go/compute/metadata"
-	"cloud.google.com/go/storage"
 	"github.com/everFinance/goar"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/sirupsen/logrus"
@@ -30,6 +29,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/mediamapper"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/rpc"
+	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/tokenmanage"
 	"github.com/mikeydub/go-gallery/util"
 )
@@ -54,7 +54,7 @@ type errInvalidMedia struct {
 }
 
 type errStoreObjectFailed struct {
-	bucket string
+	bucket store.Bucket
 	object cachedMediaObject
 	err    error
 }
@@ -80,7 +80,7 @@ func (e errInvalidMedia) Unwrap() error {
 }
 
 func (e errStoreObjectFailed) Error() string {
-	return fmt.Sprintf("failed to write object to key: %s/%s: %s", e.bucket, e.object.fileName(), e.err)
+	return fmt.Sprintf("failed to write object to key: %s/%s: %s", e.bucket.Name(), e.object.fileName(), e.err)
 }
 
 func (e errStoreObjectFailed) Unwrap() error {
@@ -98,7 +98,7 @@ type cachePipelineMetadata struct {
 	LiveRenderGCP                *persist.PipelineStepStatus
 }
 
-func createRawMedia(pCtx context.Context, tids persist.TokenIdentifiers, mediaType persist.MediaType, tokenBucket, animURL, imgURL string, objects []cachedMediaObject) persist.Media {
+func createRawMedia(pCtx context.Context, tids persist.TokenIdentifiers, mediaType persist.MediaType, tokenBucket store.Bucket, animURL, imgURL string, objects []cachedMediaObject) persist.Media {
 	switch mediaType {
 	case persist.MediaTypeHTML:
 		return getHTMLMedia(pCtx, tids, tokenBucket, animURL, imgURL, objects)
@@ -149,7 +149,7 @@ func createMediaFromResults(ctx context.Context, job *tokenProcessingJob, animRe
 	return job.createMediaFromCachedObjects(ctx, objects)
 }
 
-func createMediaFromCachedObjects(ctx context.Context, tokenBucket string, objects map[objectType]cachedMediaObject) persist.Media {
+func createMediaFromCachedObjects(ctx context.Context, tokenBucket store.Bucket, objects map[objectType]cachedMediaObject) persist.Media {
 	var primaryObject cachedMediaObject
 
 	if obj, ok := objects[objectTypeAnimation]; ok {
@@ -165,6 +165,7 @@ func createMediaFromCachedObjects(ctx context.Context, tokenBucket string, objec
 	var thumbnailObject *cachedMediaObject
 	var liveRenderObject = util.MapFindOrNil(objects, objectTypeLiveRender)
 	var profileImageObject = util.MapFindOrNil(objects, objectTypeProfileImage)
+	var waveformObject = util.MapFindOrNil(objects, objectTypeWaveform)
 
 	if primaryObject.ObjectType == objectTypeAnimation || primaryObject.ObjectType == objectTypeSVG {
 		// animations should have a thumbnail that could be an image or svg or thumbnail
@@ -188,6 +189,8 @@ func createMediaFromCachedObjects(ctx context.Context, tokenBucket string, objec
 	result := persist.Media{
 		MediaURL:  persist.NullString(primaryObject.storageURL(tokenBucket)),
 		MediaType: primaryObject.MediaType,
+		Model:     primaryObject.Model,
+		Audio:     primaryObject.Audio,
 	}
 
 	if thumbnailObject != nil {
@@ -202,6 +205,10 @@ func createMediaFromCachedObjects(ctx context.Context, tokenBucket string, objec
 		result.ProfileImageURL = persist.NullString(profileImageObject.storageURL(tokenBucket))
 	}
 
+	if waveformObject != nil && result.Audio != nil {
+		result.Audio.WaveformURL = persist.NullString(waveformObject.storageURL(tokenBucket))
+	}
+
 	var err error
 	switch result.MediaType {
 	case persist.MediaTypeSVG:
@@ -313,7 +320,7 @@ func getSvgDimensions(ctx context.Context, url string) (persist.Dimensions, erro
 	}, nil
 }
 
-func getHTMLMedia(pCtx context.Context, tids persist.TokenIdentifiers, tokenBucket, vURL, imgURL string, cachedObjects []cachedMediaObject) persist.Media {
+func getHTMLMedia(pCtx context.Context, tids persist.TokenIdentifiers, tokenBucket store.Bucket, vURL, imgURL string, cachedObjects []cachedMediaObject) persist.Media {
 	res := persist.Media{
 		MediaType: persist.MediaTypeHTML,
 	}
@@ -441,22 +448,25 @@ func findNameAndDescription(metadata persist.TokenMetadata) (string, string) {
 	return name, description
 }
 
-func objectExists(ctx context.Context, client *storage.Client, bucket, fileName string) (bool, error) {
-	objHandle := client.Bucket(bucket).Object(fileName)
-	_, err := objHandle.Attrs(ctx)
-	if err != nil && err != storage.ErrObjectNotExist {
-		return false, fmt.Errorf("could not get object attrs for %s: %s", objHandle.ObjectName(), err)
+func objectExists(ctx context.Context, bucket store.Bucket, fileName string) (bool, error) {
+	exists, err := store.Exists(ctx, bucket, fileName)
+	if err != nil {
+		return false, fmt.Errorf("could not get object attrs for %s: %s", fileName, err)
 	}
-	return err != storage.ErrObjectNotExist, nil
+	return exists, nil
 }
 
-func purgeIfExists(ctx context.Context, bucket string, fileName string, client *storage.Client) error {
-	exists, err := objectExists(ctx, client, bucket, fileName)
+// purgeIfExists purges the object from the image CDN, which only serves objects stored in GCS
+func purgeIfExists(ctx context.Context, bucket store.Bucket, fileName string) error {
+	if _, ok := bucket.(store.GCSBucket); !ok {
+		return nil
+	}
+	exists, err := objectExists(ctx, bucket, fileName)
 	if err != nil {
 		return err
 	}
 	if exists {
-		if err := mediamapper.PurgeImage(ctx, fmt.Sprintf("https://storage.googleapis.com/%s/%s", bucket, fileName)); err != nil {
+		if err := mediamapper.PurgeImage(ctx, bucket.URL(fileName)); err != nil {
 			logger.For(ctx).Warnf("could not purge file %s: %s", fileName, err)
 		}
 	}
@@ -464,10 +474,10 @@ func purgeIfExists(ctx context.Context, bucket string, fileName string, client *
 	return nil
 }
 
-func persistToStorage(ctx context.Context, client *storage.Client, reader io.Reader, bucket string, object cachedMediaObject, metadata map[string]string) error {
-	writer := newObjectWriter(ctx, client, bucket, object.fileName(), object.ContentLength,
-		objAttrsOpts.WithContentType(object.ContentType),
-		objAttrsOpts.WithCustomMetadata(metadata),
+func persistToStorage(ctx context.Context, reader io.Reader, bucket store.Bucket, object cachedMediaObject, metadata map[string]string) error {
+	writer := newObjectWriter(ctx, bucket, object.fileName(), object.ContentLength,
+		store.ObjAttrsOptions.WithContentType(object.ContentType),
+		store.ObjAttrsOptions.WithCustomMetadata(metadata),
 	)
 	if written, err := io.Copy(writer, util.NewLoggingReader(ctx, reader, reader.(io.WriterTo))); err != nil {
 		if object.ContentLength != nil {
@@ -490,6 +500,7 @@ const (
 	objectTypeLiveRender
 	objectTypeSVG
 	objectTypeProfileImage
+	objectTypeWaveform
 )
 
 func (o objectType) String() string {
@@ -506,6 +517,8 @@ func (o objectType) String() string {
 		return "svg"
 	case objectTypeProfileImage:
 		return "pfp"
+	case objectTypeWaveform:
+		return "waveform"
 	case objectTypeUnknown:
 		return "unknown"
 	default:
@@ -518,6 +531,7 @@ var nonOverridableObjectTypes = map[objectType]bool{
 	objectTypeThumbnail:    true,
 	objectTypeLiveRender:   true,
 	objectTypeProfileImage: true,
+	objectTypeWaveform:     true,
 }
 
 // mediaTypeToObjectTypeLookup are default mappings from media type to object type
@@ -550,6 +564,10 @@ type cachedMediaObject struct {
 	ContentType     string
 	ContentLength   *int64
 	ObjectType      objectType
+	// Model is set for 3D models
+	Model *persist.ModelInfo
+	// Audio is set for audio files
+	Audio *persist.AudioInfo
 }
 
 func (m cachedMediaObject) fileName() string {
@@ -559,11 +577,11 @@ func (m cachedMediaObject) fileName() string {
 	return fmt.Sprintf("%d-%s-%s-%s", m.Chain, m.TokenID, m.ContractAddress, m.ObjectType)
 }
 
-func (m cachedMediaObject) storageURL(tokenBucket string) string {
-	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", tokenBucket, m.fileName())
+func (m cachedMediaObject) storageURL(tokenBucket store.Bucket) string {
+	return tokenBucket.URL(m.fileName())
 }
 
-func cacheRawMedia(ctx context.Context, reader *util.FileHeaderReader, tids persist.TokenIdentifiers, mediaType persist.MediaType, contentLength *int64, contentType string, oType objectType, bucket, ogURL string, client *storage.Client, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+func cacheRawMedia(ctx context.Context, reader *util.FileHeaderReader, tids persist.TokenIdentifiers, mediaType persist.MediaType, contentLength *int64, contentType string, oType objectType, bucket store.Bucket, ogURL string, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
 	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.StoreGCP, "StoreGCP")
 	defer traceCallback()
 
@@ -577,7 +595,7 @@ func cacheRawMedia(ctx context.Context, reader *util.FileHeaderReader, tids pers
 		ObjectType:      mediaTypeToObjectType(mediaType, oType),
 	}
 
-	err := persistToStorage(ctx, client, reader, bucket, object, map[string]string{
+	err := persistToStorage(ctx, reader, bucket, object, map[string]string{
 		"originalURL": truncateString(ogURL, 100),
 		"mediaType":   mediaType.String(),
 	})
@@ -586,11 +604,11 @@ func cacheRawMedia(ctx context.Context, reader *util.FileHeaderReader, tids pers
 		return cachedMediaObject{}, err
 	}
 
-	purgeIfExists(ctx, bucket, object.fileName(), client)
+	purgeIfExists(ctx, bucket, object.fileName())
 	return object, err
 }
 
-func cacheRawAnimationMedia(ctx context.Context, reader *util.FileHeaderReader, tids persist.TokenIdentifiers, mediaType persist.MediaType, oType objectType, bucket, ogURL string, client *storage.Client, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+func cacheRawAnimationMedia(ctx context.Context, reader *util.FileHeaderReader, tids persist.TokenIdentifiers, mediaType persist.MediaType, contentType string, oType objectType, bucket store.Bucket, ogURL string, capture io.Writer, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
 	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.AnimationGzip, "AnimationGzip")
 	defer traceCallback()
 
@@ -599,16 +617,22 @@ func cacheRawAnimationMedia(ctx context.Context, reader *util.FileHeaderReader,
 		TokenID:         tids.TokenID,
 		ContractAddress: tids.ContractAddress,
 		Chain:           tids.Chain,
+		ContentType:     contentType,
 		ObjectType:      mediaTypeToObjectType(mediaType, oType),
 	}
 
-	sw := newObjectWriter(ctx, client, bucket, object.fileName(), nil,
-		objAttrsOpts.WithContentEncoding("gzip"),
-		objAttrsOpts.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": mediaType.String()}),
+	sw := newObjectWriter(ctx, bucket, object.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType(contentType),
+		store.ObjAttrsOptions.WithContentEncoding("gzip"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": mediaType.String()}),
 	)
 	writer := gzip.NewWriter(sw)
 
-	written, err := io.Copy(writer, util.NewLoggingReader(ctx, reader, reader))
+	if capture == nil {
+		capture = io.Discard
+	}
+
+	written, err := io.Copy(io.MultiWriter(writer, capture), util.NewLoggingReader(ctx, reader, reader))
 	if err != nil {
 		if object.ContentLength != nil {
 			logger.For(ctx).Errorf("wrote %d out of %d bytes before error: %s", written, *object.ContentLength, err)
@@ -629,7 +653,7 @@ func cacheRawAnimationMedia(ctx context.Context, reader *util.FileHeaderReader,
 		return cachedMediaObject{}, err
 	}
 
-	purgeIfExists(ctx, bucket, object.fileName(), client)
+	purgeIfExists(ctx, bucket, object.fileName())
 	return object, nil
 }
 
@@ -638,7 +662,7 @@ type rasterizeResponse struct {
 	GIF *string `json:"gif"`
 }
 
-func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenIdentifiers, bucket, ogURL string, httpClient *http.Client, client *storage.Client, subMeta *cachePipelineMetadata) ([]cachedMediaObject, error) {
+func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenIdentifiers, bucket store.Bucket, ogURL string, httpClient *http.Client, subMeta *cachePipelineMetadata) ([]cachedMediaObject, error) {
 	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.SVGRasterize, "SVGRasterize")
 	defer traceCallback()
 
@@ -699,15 +723,15 @@ func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenId
 		ObjectType:      mediaTypeToObjectType(persist.MediaTypeImage, objectTypeThumbnail),
 	}
 
-	sw := newObjectWriter(ctx, client, bucket, pngObject.fileName(), pngObject.ContentLength,
-		objAttrsOpts.WithContentType(pngObject.ContentType),
-		objAttrsOpts.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": persist.MediaTypeImage.String()}),
+	sw := newObjectWriter(ctx, bucket, pngObject.fileName(), pngObject.ContentLength,
+		store.ObjAttrsOptions.WithContentType(pngObject.ContentType),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": persist.MediaTypeImage.String()}),
 	)
 
 	_, err = sw.Write(data)
 	if err != nil {
 		persist.FailStep(subMeta.SVGRasterize)
-		return nil, fmt.Errorf("could not write to bucket %s for %s: %s", bucket, pngObject.fileName(), err)
+		return nil, fmt.Errorf("could not write to bucket %s for %s: %s", bucket.Name(), pngObject.fileName(), err)
 	}
 
 	if err := sw.Close(); err != nil {
@@ -715,7 +739,7 @@ func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenId
 		return nil, err
 	}
 
-	purgeIfExists(ctx, bucket, pngObject.fileName(), client)
+	purgeIfExists(ctx, bucket, pngObject.fileName())
 
 	objects = append(objects, pngObject)
 
@@ -738,15 +762,15 @@ func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenId
 			ObjectType:      mediaTypeToObjectType(persist.MediaTypeGIF, objectTypeLiveRender),
 		}
 
-		sw := newObjectWriter(ctx, client, bucket, gifObject.fileName(), gifObject.ContentLength,
-			objAttrsOpts.WithContentType(gifObject.ContentType),
-			objAttrsOpts.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": persist.MediaTypeGIF.String()}),
+		sw := newObjectWriter(ctx, bucket, gifObject.fileName(), gifObject.ContentLength,
+			store.ObjAttrsOptions.WithContentType(gifObject.ContentType),
+			store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"originalURL": truncateString(ogURL, 100), "mediaType": persist.MediaTypeGIF.String()}),
 		)
 
 		_, err = sw.Write(data)
 		if err != nil {
 			persist.FailStep(subMeta.SVGRasterize)
-			return nil, fmt.Errorf("could not write to bucket %s for %s: %s", bucket, gifObject.fileName(), err)
+			return nil, fmt.Errorf("could not write to bucket %s for %s: %s", bucket.Name(), gifObject.fileName(), err)
 		}
 
 		if err := sw.Close(); err != nil {
@@ -754,7 +778,7 @@ func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenId
 			return nil, err
 		}
 
-		purgeIfExists(ctx, bucket, gifObject.fileName(), client)
+		purgeIfExists(ctx, bucket, gifObject.fileName())
 
 		objects = append(objects, gifObject)
 
@@ -763,7 +787,7 @@ func cacheRasterizedSVG(ctx context.Context, svgURL string, tids persist.TokenId
 	return objects, nil
 }
 
-func thumbnailAndCache(ctx context.Context, tids persist.TokenIdentifiers, videoURL, bucket string, client *storage.Client, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+func thumbnailAndCache(ctx context.Context, tids persist.TokenIdentifiers, videoURL string, bucket store.Bucket, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
 	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.ThumbnailGCP, "ThumbnailGCP")
 	defer traceCallback()
 
@@ -780,9 +804,9 @@ func thumbnailAndCache(ctx context.Context, tids persist.TokenIdentifiers, video
 
 	timeBeforeCopy := time.Now()
 
-	sw := newObjectWriter(ctx, client, bucket, obj.fileName(), nil,
-		objAttrsOpts.WithContentType("image/jpeg"),
-		objAttrsOpts.WithCustomMetadata(map[string]string{"thumbnailedURL": videoURL}),
+	sw := newObjectWriter(ctx, bucket, obj.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType("image/jpeg"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"thumbnailedURL": videoURL}),
 	)
 
 	logger.For(ctx).Infof("thumbnailing %s", videoURL)
@@ -798,12 +822,12 @@ func thumbnailAndCache(ctx context.Context, tids persist.TokenIdentifiers, video
 
 	logger.For(ctx).Infof("storage copy took %s", time.Since(timeBeforeCopy))
 
-	purgeIfExists(ctx, bucket, obj.fileName(), client)
+	purgeIfExists(ctx, bucket, obj.fileName())
 
 	return obj, nil
 }
 
-func createLiveRenderAndCache(ctx context.Context, tids persist.TokenIdentifiers, videoURL, bucket string, client *storage.Client, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+func createLiveRenderAndCache(ctx context.Context, tids persist.TokenIdentifiers, videoURL string, bucket store.Bucket, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
 
 	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.LiveRenderGCP, "LiveRenderGCP")
 	defer traceCallback()
@@ -821,9 +845,9 @@ func createLiveRenderAndCache(ctx context.Context, tids persist.TokenIdentifiers
 
 	timeBeforeCopy := time.Now()
 
-	sw := newObjectWriter(ctx, client, bucket, obj.fileName(), nil,
-		objAttrsOpts.WithContentType("video/mp4"),
-		objAttrsOpts.WithCustomMetadata(map[string]string{"liveRenderedURL": videoURL}),
+	sw := newObjectWriter(ctx, bucket, obj.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType("video/mp4"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"liveRenderedURL": videoURL}),
 	)
 
 	logger.For(ctx).Infof("creating live render for %s", videoURL)
@@ -839,7 +863,7 @@ func createLiveRenderAndCache(ctx context.Context, tids persist.TokenIdentifiers
 
 	logger.For(ctx).Infof("storage copy took %s", time.Since(timeBeforeCopy))
 
-	purgeIfExists(ctx, bucket, obj.fileName(), client)
+	purgeIfExists(ctx, bucket, obj.fileName())
 
 	return obj, nil
 }
@@ -864,7 +888,7 @@ func readerFromURL(ctx context.Context, mediaURL string, mediaType persist.Media
 	return reader, mediaType, nil
 }
 
-func cacheObjectsFromURL(pCtx context.Context, tids persist.TokenIdentifiers, mediaURL string, oType objectType, httpClient *http.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, bucket string, subMeta *cachePipelineMetadata) ([]cachedMediaObject, error) {
+func cacheObjectsFromURL(pCtx context.Context, tids persist.TokenIdentifiers, mediaURL string, oType objectType, httpClient *http.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, bucket store.Bucket, subMeta *cachePipelineMetadata) ([]cachedMediaObject, error) {
 	asURI := persist.TokenURI(mediaURL)
 	timeBeforePredict := time.Now()
 	mediaType, contentType, contentLength := func() (persist.MediaType, string, *int64) {
@@ -941,17 +965,21 @@ func cacheObjectsFromURL(pCtx context.Context, tids persist.TokenIdentifiers, me
 
 	if mediaType == persist.MediaTypeAnimation {
 		timeBeforeCache := time.Now()
-		obj, err := cacheRawAnimationMedia(pCtx, reader, tids, mediaType, oType, bucket, mediaURL, storageClient, subMeta)
+		capture := newModelCapture(maxRenderableModelSize)
+		obj, err := cacheRawAnimationMedia(pCtx, reader, tids, mediaType, contentType, oType, bucket, mediaURL, capture, subMeta)
 		if err != nil {
 			logger.For(pCtx).Errorf("could not cache animation: %s", err)
 			return nil, err
 		}
 		logger.For(pCtx).Infof("cached animation for %s in %s", tids, time.Since(timeBeforeCache))
-		return []cachedMediaObject{obj}, nil
+
+		modelInfo, previews := renderModelAndCache(pCtx, tids, capture, contentType, mediaURL, bucket, ipfsClient, arweaveClient, subMeta)
+		obj.Model = modelInfo
+		return append([]cachedMediaObject{obj}, previews...), nil
 	}
 
 	timeBeforeCache := time.Now()
-	obj, err := cacheRawMedia(pCtx, reader, tids, mediaType, contentLength, contentType, oType, bucket, mediaURL, storageClient, subMeta)
+	obj, err := cacheRawMedia(pCtx, reader, tids, mediaType, contentLength, contentType, oType, bucket, mediaURL, subMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -960,23 +988,27 @@ func cacheObjectsFromURL(pCtx context.Context, tids persist.TokenIdentifiers, me
 	result := []cachedMediaObject{obj}
 	if mediaType == persist.MediaTypeVideo {
 		videoURL := obj.storageURL(bucket)
-		thumbObj, err := thumbnailAndCache(pCtx, tids, videoURL, bucket, storageClient, subMeta)
+		thumbObj, err := thumbnailAndCache(pCtx, tids, videoURL, bucket, subMeta)
 		if err != nil {
 			logger.For(pCtx).Errorf("could not create thumbnail for %s: %s", tids, err)
 		} else {
 			result = append(result, thumbObj)
 		}
 
-		liveObj, err := createLiveRenderAndCache(pCtx, tids, videoURL, bucket, storageClient, subMeta)
+		liveObj, err := createLiveRenderAndCache(pCtx, tids, videoURL, bucket, subMeta)
 		if err != nil {
 			logger.For(pCtx).Errorf("could not create live render for %s: %s", tids, err)
 		} else {
 			result = append(result, liveObj)
 		}
 
+	} else if mediaType == persist.MediaTypeAudio {
+		audioInfo, objs := audioInfoAndCache(pCtx, tids, obj.storageURL(bucket), bucket)
+		result[0].Audio = audioInfo
+		result = append(result, objs...)
 	} else if mediaType == persist.MediaTypeSVG {
 		timeBeforeCache := time.Now()
-		obj, err := cacheRasterizedSVG(pCtx, obj.storageURL(bucket), tids, bucket, mediaURL, httpClient, storageClient, subMeta)
+		obj, err := cacheRasterizedSVG(pCtx, obj.storageURL(bucket), tids, bucket, mediaURL, httpClient, subMeta)
 		if err != nil {
 			logger.For(pCtx).Errorf("could not cache svg rasterization: %s", err)
 			// still return the original object as svg
@@ -1118,58 +1150,12 @@ func truncateString(s string, i int) string {
 	return s
 }
 
-func newObjectWriter(ctx context.Context, client *storage.Client, bucket, fileName string, contentLength *int64, opts ...func(*storage.ObjectAttrs)) *storage.Writer {
-	writer := client.Bucket(bucket).Object(fileName).NewWriter(ctx)
-	writer.ProgressFunc = func(written int64) {
-		logger.For(ctx).Infof("wrote %s to %s", util.InByteSizeFormat(uint64(written)), fileName)
-	}
-	writer.ObjectAttrs.CacheControl = "no-cache, no-store"
-	writer.ChunkSize = contentLengthToChunkSize(contentLength)
-	writer.ChunkRetryDeadline = 5 * time.Minute
-	for _, opt := range opts {
-		opt(&writer.ObjectAttrs)
-	}
-	return writer
-}
-
-type objectAttrsOptions struct{}
-
-var objAttrsOpts objectAttrsOptions
-
-// WithContentType sets the Content-Type header of the object
-func (objectAttrsOptions) WithContentType(typ string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.ContentType = typ
-	}
-}
-
-// WithCustomMetadata sets custom metadata on the object
-func (objectAttrsOptions) WithCustomMetadata(m map[string]string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.Metadata = m
-	}
-}
-
-// WithContentEncoding sets the Content-Encoding header of the object
-func (objectAttrsOptions) WithContentEncoding(enc string) func(*storage.ObjectAttrs) {
-	return func(a *storage.ObjectAttrs) {
-		a.ContentEncoding = enc
-	}
-}
-
-func contentLengthToChunkSize(contentLength *int64) int {
-	if contentLength == nil {
-		return 4 * 1024 * 1024
-	}
-	cl := *contentLength
-	if cl < 4*1024*1024 {
-		return int(cl)
-	} else if cl > 8*1024*1024 && cl < 32*1024*1024 {
-		return 8 * 1024 * 1024
-	} else if cl > 32*1024*1024 {
-		return 16 * 1024 * 1024
+func newObjectWriter(ctx context.Context, bucket store.Bucket, fileName string, contentLength *int64, opts ...func(*store.ObjectAttrs)) io.WriteCloser {
+	opts = append([]func(*store.ObjectAttrs){store.ObjAttrsOptions.WithCacheControl("no-cache, no-store")}, opts...)
+	if contentLength != nil {
+		opts = append(opts, store.ObjAttrsOptions.WithSize(*contentLength))
 	}
-	return 4 * 1024 * 1024
+	return bucket.NewWriter(ctx, fileName, opts...)
 }
 
 func errFromExitErr(err error) error {
@@ -0,0 +1,300 @@
+package tokenprocessing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"net/url"
+	"os/exec"
+	"time"
+
+	"github.com/everFinance/goar"
+	shell "github.com/ipfs/go-ipfs-api"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/media"
+	"github.com/mikeydub/go-gallery/service/media/gltf"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/rpc"
+	"github.com/mikeydub/go-gallery/service/store"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+const (
+	// maxRenderableModelSize is the size of the largest model that is rendered. Larger models are cached without previews.
+	maxRenderableModelSize = 64 * util.MB
+	modelThumbnailSize     = 1024
+	modelLiveRenderSize    = 512
+	modelLiveRenderFrames  = 72
+	modelLiveRenderFPS     = 24
+)
+
+var (
+	modelBackground = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	// Models are shown from slightly above and to the side
+	modelYaw   = math.Pi / 6
+	modelPitch = math.Pi / 12
+)
+
+// modelCapture keeps a copy of a model while it's being cached so that it can be rendered afterwards.
+// It stops keeping the copy once the model is larger than limit, and never fails a write.
+type modelCapture struct {
+	buf      bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func newModelCapture(limit int) *modelCapture {
+	return &modelCapture{limit: limit}
+}
+
+func (m *modelCapture) Write(p []byte) (int, error) {
+	if m.overflow {
+		return len(p), nil
+	}
+	if m.buf.Len()+len(p) > m.limit {
+		m.overflow = true
+		m.buf = bytes.Buffer{}
+		return len(p), nil
+	}
+	return m.buf.Write(p)
+}
+
+// modelFormat returns the format of a model from its content type, falling back to sniffing the model itself
+func modelFormat(contentType string, data []byte) (persist.ModelFormat, bool) {
+	if format, ok := media.ModelFormatFromContentType(contentType); ok {
+		return format, true
+	}
+	if gltf.IsGLB(data) {
+		return persist.ModelFormatGLB, true
+	}
+	if len(data) > 512 {
+		data = data[:512]
+	}
+	if len(data) == 0 {
+		return "", false
+	}
+	_, contentType = media.SniffMediaType(data)
+	return media.ModelFormatFromContentType(contentType)
+}
+
+// renderModelAndCache measures a 3D model and caches a rendered thumbnail and turntable preview of it.
+// Models are rendered on the CPU, so previews are only made for glTF models that are small enough to render.
+func renderModelAndCache(ctx context.Context, tids persist.TokenIdentifiers, capture *modelCapture, contentType string, modelURL string, bucket store.Bucket, ipfsClient *shell.Shell, arweaveClient *goar.Client, subMeta *cachePipelineMetadata) (*persist.ModelInfo, []cachedMediaObject) {
+	if capture.overflow {
+		logger.For(ctx).Infof("model is larger than %s, not rendering", util.InByteSizeFormat(uint64(maxRenderableModelSize)))
+		return nil, nil
+	}
+
+	format, ok := modelFormat(contentType, capture.buf.Bytes())
+	if !ok {
+		return nil, nil
+	}
+
+	info := &persist.ModelInfo{Format: format}
+
+	// USD can't be rendered, but is still marked as a model so that it can be viewed in AR
+	if format == persist.ModelFormatUSDZ {
+		return info, nil
+	}
+
+	timeBeforeParse := time.Now()
+	model, err := gltf.Parse(capture.buf.Bytes(), modelBufferLoader(ctx, modelURL, ipfsClient, arweaveClient))
+	if err != nil {
+		logger.For(ctx).Warnf("could not read model geometry for %s: %s", tids, err)
+		return info, nil
+	}
+
+	lo, hi := model.BoundingBox()
+	info.BoundingBox = &persist.ModelBoundingBox{Min: lo, Max: hi}
+	info.PolyCount = model.PolyCount()
+	info.VertexCount = model.VertexCount()
+	logger.For(ctx).Infof("read model with %d polygons for %s in %s", info.PolyCount, tids, time.Since(timeBeforeParse))
+
+	objects := make([]cachedMediaObject, 0, 2)
+
+	thumbObj, err := modelThumbnailAndCache(ctx, tids, model, modelURL, bucket, subMeta)
+	if err != nil {
+		logger.For(ctx).Errorf("could not create model thumbnail for %s: %s", tids, err)
+	} else {
+		objects = append(objects, thumbObj)
+	}
+
+	liveObj, err := modelLiveRenderAndCache(ctx, tids, model, modelURL, bucket, subMeta)
+	if err != nil {
+		logger.For(ctx).Errorf("could not create model live render for %s: %s", tids, err)
+	} else {
+		objects = append(objects, liveObj)
+	}
+
+	return info, objects
+}
+
+// modelBufferLoader loads buffers that a glTF model references relative to where the model is hosted
+func modelBufferLoader(ctx context.Context, modelURL string, ipfsClient *shell.Shell, arweaveClient *goar.Client) gltf.LoadURIFunc {
+	return func(uri string) ([]byte, error) {
+		base, err := url.Parse(modelURL)
+		if err != nil {
+			return nil, err
+		}
+		ref, err := url.Parse(uri)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved := base.ResolveReference(ref).String()
+		reader, _, err := rpc.GetDataFromURIAsReader(ctx, persist.TokenURI(resolved), persist.MediaTypeUnknown, ipfsClient, arweaveClient, util.MB, time.Minute, true)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		byt, err := io.ReadAll(io.LimitReader(reader, int64(maxRenderableModelSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(byt) > maxRenderableModelSize {
+			return nil, fmt.Errorf("buffer %s is larger than %d bytes", resolved, maxRenderableModelSize)
+		}
+		return byt, nil
+	}
+}
+
+func modelThumbnailAndCache(ctx context.Context, tids persist.TokenIdentifiers, model *gltf.Model, modelURL string, bucket store.Bucket, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.ThumbnailGCP, "ThumbnailGCP")
+	defer traceCallback()
+
+	obj := cachedMediaObject{
+		ObjectType:      mediaTypeToObjectType(persist.MediaTypeImage, objectTypeThumbnail),
+		MediaType:       persist.MediaTypeImage,
+		TokenID:         tids.TokenID,
+		ContractAddress: tids.ContractAddress,
+		Chain:           tids.Chain,
+		ContentType:     "image/png",
+	}
+
+	logger.For(ctx).Infof("caching model thumbnail for '%s'", obj.fileName())
+
+	timeBeforeRender := time.Now()
+
+	img := model.Render(gltf.RenderOptions{
+		Width:       modelThumbnailSize,
+		Height:      modelThumbnailSize,
+		Yaw:         modelYaw,
+		Pitch:       modelPitch,
+		Background:  modelBackground,
+		Supersample: 2,
+	})
+
+	sw := newObjectWriter(ctx, bucket, obj.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType("image/png"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"thumbnailedURL": truncateString(modelURL, 100)}),
+	)
+
+	if err := png.Encode(sw, img); err != nil {
+		persist.FailStep(subMeta.ThumbnailGCP)
+		return cachedMediaObject{}, errStoreObjectFailed{err: err, bucket: bucket, object: obj}
+	}
+
+	if err := sw.Close(); err != nil {
+		persist.FailStep(subMeta.ThumbnailGCP)
+		return cachedMediaObject{}, err
+	}
+
+	logger.For(ctx).Infof("model thumbnail took %s", time.Since(timeBeforeRender))
+
+	purgeIfExists(ctx, bucket, obj.fileName())
+
+	return obj, nil
+}
+
+func modelLiveRenderAndCache(ctx context.Context, tids persist.TokenIdentifiers, model *gltf.Model, modelURL string, bucket store.Bucket, subMeta *cachePipelineMetadata) (cachedMediaObject, error) {
+	traceCallback, ctx := persist.TrackStepStatus(ctx, subMeta.LiveRenderGCP, "LiveRenderGCP")
+	defer traceCallback()
+
+	obj := cachedMediaObject{
+		ObjectType:      mediaTypeToObjectType(persist.MediaTypeVideo, objectTypeLiveRender),
+		MediaType:       persist.MediaTypeVideo,
+		TokenID:         tids.TokenID,
+		ContractAddress: tids.ContractAddress,
+		Chain:           tids.Chain,
+		ContentType:     "video/mp4",
+	}
+
+	logger.For(ctx).Infof("caching model live render for '%s'", obj.fileName())
+
+	timeBeforeRender := time.Now()
+
+	sw := newObjectWriter(ctx, bucket, obj.fileName(), nil,
+		store.ObjAttrsOptions.WithContentType("video/mp4"),
+		store.ObjAttrsOptions.WithCustomMetadata(map[string]string{"liveRenderedURL": truncateString(modelURL, 100)}),
+	)
+
+	if err := createModelTurntableVideo(ctx, model, sw); err != nil {
+		persist.FailStep(subMeta.LiveRenderGCP)
+		return cachedMediaObject{}, errStoreObjectFailed{err: err, bucket: bucket, object: obj}
+	}
+
+	if err := sw.Close(); err != nil {
+		persist.FailStep(subMeta.LiveRenderGCP)
+		return cachedMediaObject{}, err
+	}
+
+	logger.For(ctx).Infof("model live render took %s", time.Since(timeBeforeRender))
+
+	purgeIfExists(ctx, bucket, obj.fileName())
+
+	return obj, nil
+}
+
+// createModelTurntableVideo renders one full turn of the model and encodes the frames as an mp4
+func createModelTurntableVideo(ctx context.Context, model *gltf.Model, writer io.Writer) error {
+	size := fmt.Sprintf("%dx%d", modelLiveRenderSize, modelLiveRenderSize)
+	c := exec.CommandContext(ctx, "ffmpeg", "-hide_banner", "-loglevel", "error", "-f", "rawvideo", "-pix_fmt", "rgba", "-s", size, "-framerate", fmt.Sprint(modelLiveRenderFPS), "-i", "pipe:0", "-pix_fmt", "yuv420p", "-movflags", "frag_keyframe+empty_moov", "-f", "mp4", "pipe:1")
+	errBuf := new(bytes.Buffer)
+	c.Stderr = errBuf
+	c.Stdout = writer
+
+	stdin, err := c.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := c.Start(); err != nil {
+		return err
+	}
+
+	writeErr := func() error {
+		defer stdin.Close()
+		for i := 0; i < modelLiveRenderFrames; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			frame := model.Render(gltf.RenderOptions{
+				Width:      modelLiveRenderSize,
+				Height:     modelLiveRenderSize,
+				Yaw:        modelYaw + 2*math.Pi*float64(i)/modelLiveRenderFrames,
+				Pitch:      modelPitch,
+				Background: modelBackground,
+			})
+			if _, err := stdin.Write(frame.Pix); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	err = c.Wait()
+	if _, ok := isExitErr(err); ok {
+		return errors.New(errBuf.String())
+	}
+	if err != nil {
+		return err
+	}
+	return writeErr
+}
@@ -5,18 +5,20 @@ import (
 	"errors"
 	"net/http"
 
-	"cloud.google.com/go/storage"
 	"github.com/everFinance/goar"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/jackc/pgtype"
 	"github.com/sirupsen/logrus"
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/platform"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/media"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/rpc"
+	"github.com/mikeydub/go-gallery/service/store"
 	"github.com/mikeydub/go-gallery/service/tokenmanage"
 	"github.com/mikeydub/go-gallery/util"
 )
@@ -27,19 +29,19 @@ type tokenProcessor struct {
 	metadataFinder *MetadataFinder
 	ipfsClient     *shell.Shell
 	arweaveClient  *goar.Client
-	stg            *storage.Client
-	tokenBucket    string
+	tokenBucket    store.Bucket
+	realtime       *realtime.Hub
 }
 
-func NewTokenProcessor(queries *db.Queries, httpClient *http.Client, metadataFinder *MetadataFinder, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string) *tokenProcessor {
+func NewTokenProcessor(queries *db.Queries, httpClient *http.Client, metadataFinder *MetadataFinder, ipfsClient *shell.Shell, arweaveClient *goar.Client, tokenBucket store.Bucket, realtimeHub *realtime.Hub) *tokenProcessor {
 	return &tokenProcessor{
 		queries:        queries,
 		metadataFinder: metadataFinder,
 		httpClient:     httpClient,
 		ipfsClient:     ipfsClient,
 		arweaveClient:  arweaveClient,
-		stg:            stg,
 		tokenBucket:    tokenBucket,
+		realtime:       realtimeHub,
 	}
 }
 
@@ -74,6 +76,10 @@ type tokenProcessingJob struct {
 	animKeywords []string
 	// placeHolderImageURL is an image URL that is downloaded from if processing from metadata fails
 	placeHolderImageURL string
+	// sourceKey identifies the content the token's media is made from. It's empty if the media isn't content-addressed.
+	sourceKey string
+	// existingMediaID is the ID of media from another token made from the same content that the job reuses instead of processing again
+	existingMediaID persist.DBID
 }
 
 type PipelineOption func(*tokenProcessingJob)
@@ -221,6 +227,13 @@ func (tpj *tokenProcessingJob) run(ctx context.Context) (persist.Media, persist.
 		return tokenMedia, metadata, wrapWithBadTokenErr(err)
 	}
 
+	tpj.sourceKey = mediaSourceKey(imgURL, pfpURL, animURL)
+	if existing, ok := tpj.existingMedia(ctx, metadata); ok {
+		logger.For(ctx).Infof("reusing media=%s processed from the same source", existing.ID)
+		tpj.existingMediaID = existing.ID
+		return existing.Media, metadata, nil
+	}
+
 	tokenMedia, err = tpj.cacheMediaFromURLs(ctx, imgURL, pfpURL, animURL, metadata,
 		tpj.requireImage && imgURL != "",
 		tpj.requireFxHashSigned,
@@ -256,7 +269,7 @@ func (tpj *tokenProcessingJob) urlsToDownload(ctx context.Context, metadata pers
 func (tpj *tokenProcessingJob) cacheFromURL(ctx context.Context, tids persist.TokenIdentifiers, defaultObjectType objectType, mediaURL string, subMeta *cachePipelineMetadata) chan cacheResult {
 	resultCh := make(chan cacheResult)
 	go func() {
-		cachedObjects, err := cacheObjectsFromURL(ctx, tids, mediaURL, defaultObjectType, tpj.tp.httpClient, tpj.tp.ipfsClient, tpj.tp.arweaveClient, tpj.tp.stg, tpj.tp.tokenBucket, subMeta)
+		cachedObjects, err := cacheObjectsFromURL(ctx, tids, mediaURL, defaultObjectType, tpj.tp.httpClient, tpj.tp.ipfsClient, tpj.tp.arweaveClient, tpj.tp.tokenBucket, subMeta)
 		resultCh <- cacheResult{cachedObjects, err}
 	}()
 	return resultCh
@@ -372,7 +385,8 @@ func (tpj *tokenProcessingJob) cacheMediaFromURLs(ctx context.Context, imgURL, p
 		return createMediaFromResults(ctx, tpj, animResult, imgResult, pfpResult), err
 	}
 
-	// If there is a placeholder URL available, use that instead.
+	// If there is a placeholder URL available, use that instead. The media then no longer comes from the source, so it isn't shared.
+	tpj.sourceKey = ""
 	placeHolderImgResult, placeHolderAnimResult := tpj.cacheMediaFromPlaceholder(ctx)
 	if !imgResult.IsSuccess() && placeHolderImgResult.IsSuccess() {
 		imgResult = placeHolderImgResult
@@ -448,11 +462,38 @@ func (tpj *tokenProcessingJob) saveResult(ctx context.Context, media persist.Med
 
 	name, description := findNameAndDescription(metadata)
 
+	tokenProperties := persist.TokenProperties{
+		HasMetadata:     len(metadata) > 0,
+		HasPrimaryMedia: media.MediaType.IsValid() && media.MediaURL != "",
+		HasThumbnail:    media.ThumbnailURL != "",
+		HasLiveRender:   media.LivePreviewURL != "",
+		HasDimensions:   media.Dimensions.Valid(),
+		HasName:         name != "",
+		HasDescription:  description != "",
+	}
+
+	if tpj.existingMediaID != "" {
+		return tpj.tp.queries.InsertTokenPipelineResultsWithExistingMedia(ctx, db.InsertTokenPipelineResultsWithExistingMediaParams{
+			ProcessingJobID:  tpj.id,
+			TokenProperties:  tokenProperties,
+			PipelineMetadata: *tpj.pipelineMetadata,
+			ProcessingCause:  tpj.cause,
+			ProcessorVersion: env.GetString("VERSION"),
+			NewMetadata:      newMetadata,
+			NewName:          util.ToNullString(name, true),
+			NewDescription:   util.ToNullString(description, true),
+			TokenMediaID:     tpj.existingMediaID,
+			Chain:            tpj.token.Chain,
+			ContractAddress:  tpj.contract.ContractAddress,
+			TokenID:          tpj.token.TokenID,
+		})
+	}
+
 	params := db.InsertTokenPipelineResultsParams{
 		ProcessingJobID:  tpj.id,
 		PipelineMetadata: *tpj.pipelineMetadata,
 		ProcessingCause:  tpj.cause,
-		ProcessorVersion: "",
+		ProcessorVersion: env.GetString("VERSION"),
 		RetiringMediaID:  persist.GenerateID(),
 		Chain:            tpj.token.Chain,
 		ContractAddress:  tpj.contract.ContractAddress,
@@ -464,18 +505,24 @@ func (tpj *tokenProcessingJob) saveResult(ctx context.Context, media persist.Med
 		NewMetadata:      newMetadata,
 		NewName:          util.ToNullString(name, true),
 		NewDescription:   util.ToNullString(description, true),
+		TokenProperties:  tokenProperties,
 	}
 
-	params.TokenProperties = persist.TokenProperties{
-		HasMetadata:     len(metadata) > 0,
-		HasPrimaryMedia: media.MediaType.IsValid() && media.MediaURL != "",
-		HasThumbnail:    media.ThumbnailURL != "",
-		HasLiveRender:   media.LivePreviewURL != "",
-		HasDimensions:   media.Dimensions.Valid(),
-		HasName:         params.NewName.String != "",
-		HasDescription:  params.NewDescription.String != "",
+	r, err := tpj.tp.queries.InsertTokenPipelineResults(ctx, params)
+	if err != nil {
+		return r.TokenMedia, err
 	}
 
-	r, err := tpj.tp.queries.InsertTokenPipelineResults(ctx, params)
-	return r.TokenMedia, err
+	// Let other tokens made from the same content reuse this media
+	if tpj.sourceKey != "" && r.TokenMedia.Active {
+		err := tpj.tp.queries.UpsertTokenMediaSource(ctx, db.UpsertTokenMediaSourceParams{
+			SourceKey:    tpj.sourceKey,
+			TokenMediaID: r.TokenMedia.ID,
+		})
+		if err != nil {
+			logger.For(ctx).Warnf("failed to save media source: %s", err)
+		}
+	}
+
+	return r.TokenMedia, nil
 }
@@ -26,7 +26,9 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain/operation"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/spam"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/service/tokenmanage"
@@ -266,45 +268,127 @@ func processOwnersForOpenseaTokens(mc *multichain.Provider, queries *db.Queries)
 			logger.For(ctx).Infof("added %d new tokens for user=%s", len(newTokens), user.ID)
 		}
 
-		for _, token := range newTokens {
-			logger.For(ctx).Infof("added tokenDBID=%s to user=%s", token.Instance.ID, token.Instance.OwnerUserID)
+		beforeBalances := map[persist.TokenUniqueIdentifiers]persist.HexString{
+			{Chain: tokenToAdd.Chain, ContractAddress: tokenToAdd.ContractAddress, TokenID: tokenToAdd.TokenID}: beforeBalance,
+		}
 
-			dbToken, err := queries.GetUniqueTokenIdentifiersByTokenID(c, token.Instance.ID)
-			if err != nil {
-				logger.For(ctx).Errorf("error getting unique token identifiers from tokenID=%s: %s", token.Instance.ID, err)
-				continue
-			}
+		dispatchTokensReceivedEvents(ctx, queries, user.ID, newTokens, beforeBalances)
 
-			newBalance := big.NewInt(0).Sub(dbToken.Quantity.BigInt(), beforeBalance.BigInt())
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
 
-			if newBalance.Cmp(big.NewInt(0)) <= 0 {
-				logger.For(ctx).Infof("token quantity is 0 or less, skipping")
+// processOwnersForUserTokens updates the tokens a user holds from the balances reported by the indexer
+func processOwnersForUserTokens(mc *multichain.Provider, queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input task.TokenProcessingUserTokensMessage
+		if err := c.ShouldBindJSON(&input); err != nil {
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		ctx := logger.NewContextWithFields(c, logrus.Fields{"userID": input.UserID})
+
+		held := make([]persist.TokenUniqueIdentifiers, 0, len(input.TokenIdentifiers))
+		quantities := make([]persist.HexString, 0, len(input.TokenIdentifiers))
+		beforeBalances := make(map[persist.TokenUniqueIdentifiers]persist.HexString)
+		sent := make([]persist.TokenUniqueIdentifiers, 0)
+
+		for tID, quantity := range input.TokenIdentifiers {
+			if quantity.BigInt().Sign() <= 0 {
+				sent = append(sent, tID)
 				continue
 			}
 
-			// one event per token identifier (grouping ERC-1155s)
-			err = event.Dispatch(ctx, db.Event{
-				ID:             persist.GenerateID(),
-				ActorID:        persist.DBIDToNullStr(user.ID),
-				ResourceTypeID: persist.ResourceTypeToken,
-				SubjectID:      token.Instance.ID,
-				UserID:         user.ID,
-				TokenID:        token.Instance.ID,
-				Action:         persist.ActionNewTokensReceived,
-				Data: persist.EventData{
-					NewTokenID:       token.Instance.ID,
-					NewTokenQuantity: persist.HexString(newBalance.Text(16)),
-				},
+			beforeToken, _ := queries.GetTokenByUserTokenIdentifiers(ctx, db.GetTokenByUserTokenIdentifiersParams{
+				OwnerID:         input.UserID,
+				TokenID:         tID.TokenID,
+				Chain:           tID.Chain,
+				ContractAddress: tID.ContractAddress,
 			})
-			if err != nil {
-				logger.For(ctx).Errorf("error dispatching event: %s", err)
+
+			beforeBalance := persist.HexString("0")
+			if beforeToken.Token.ID != "" {
+				beforeBalance = beforeToken.Token.Quantity
+			}
+
+			held = append(held, tID)
+			quantities = append(quantities, quantity)
+			beforeBalances[persist.TokenUniqueIdentifiers{Chain: tID.Chain, ContractAddress: tID.ContractAddress, TokenID: tID.TokenID}] = beforeBalance
+		}
+
+		logger.For(ctx).Infof("Processing: user=%s - %d token(s) received, %d token(s) sent", input.UserID, len(held), len(sent))
+
+		if len(sent) > 0 {
+			if err := mc.RemoveTokensFromUserUnchecked(ctx, input.UserID, sent); err != nil {
+				logger.For(ctx).Errorf("error removing tokens: %s", err)
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
 			}
 		}
 
+		if len(held) == 0 {
+			c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+			return
+		}
+
+		newTokens, err := mc.AddTokensToUserUnchecked(ctx, input.UserID, held, quantities)
+		if err != nil {
+			logger.For(ctx).Errorf("error syncing tokens: %s", err)
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		dispatchTokensReceivedEvents(ctx, queries, input.UserID, newTokens, beforeBalances)
+
 		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
 
+// dispatchTokensReceivedEvents dispatches an event for each synced token whose quantity is now higher than the
+// user's balance before the sync. beforeBalances is keyed by chain, contract address and token ID.
+func dispatchTokensReceivedEvents(ctx context.Context, queries *db.Queries, userID persist.DBID, newTokens []operation.TokenFullDetails, beforeBalances map[persist.TokenUniqueIdentifiers]persist.HexString) {
+	for _, token := range newTokens {
+		logger.For(ctx).Infof("added tokenDBID=%s to user=%s", token.Instance.ID, token.Instance.OwnerUserID)
+
+		dbToken, err := queries.GetUniqueTokenIdentifiersByTokenID(ctx, token.Instance.ID)
+		if err != nil {
+			logger.For(ctx).Errorf("error getting unique token identifiers from tokenID=%s: %s", token.Instance.ID, err)
+			continue
+		}
+
+		beforeBalance, ok := beforeBalances[persist.TokenUniqueIdentifiers{Chain: dbToken.Chain, ContractAddress: dbToken.ContractAddress, TokenID: dbToken.TokenID}]
+		if !ok {
+			beforeBalance = persist.HexString("0")
+		}
+
+		newBalance := big.NewInt(0).Sub(dbToken.Quantity.BigInt(), beforeBalance.BigInt())
+
+		if newBalance.Cmp(big.NewInt(0)) <= 0 {
+			logger.For(ctx).Infof("token quantity is 0 or less, skipping")
+			continue
+		}
+
+		// one event per token identifier (grouping ERC-1155s)
+		err = event.Dispatch(ctx, db.Event{
+			ID:             persist.GenerateID(),
+			ActorID:        persist.DBIDToNullStr(userID),
+			ResourceTypeID: persist.ResourceTypeToken,
+			SubjectID:      token.Instance.ID,
+			UserID:         userID,
+			TokenID:        token.Instance.ID,
+			Action:         persist.ActionNewTokensReceived,
+			Data: persist.EventData{
+				NewTokenID:       token.Instance.ID,
+				NewTokenQuantity: persist.HexString(newBalance.Text(16)),
+			},
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("error dispatching event: %s", err)
+		}
+	}
+}
+
 // detectSpamContracts refreshes the alchemy_spam_contracts table with marked contracts from Alchemy
 func detectSpamContracts(queries *db.Queries) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -387,6 +471,19 @@ func detectSpamContracts(queries *db.Queries) gin.HandlerFunc {
 	}
 }
 
+func scoreSpamContracts(queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Rescore contracts that haven't been scored in a day since their holders may have changed
+		_, err := spam.RescoreContracts(c, queries, time.Now().Add(-24*time.Hour), 500)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
 func processWalletRemoval(queries *db.Queries) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input task.TokenProcessingWalletRemovalMessage
@@ -417,6 +514,12 @@ func processWalletRemoval(queries *db.Queries) gin.HandlerFunc {
 			return
 		}
 
+		// Sync checkpoints of a removed wallet would be stale if the wallet is ever added back
+		if err := queries.DeleteWalletSyncCheckpointsByWalletIDs(c, util.StringersToStrings(input.WalletIDs)); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
 		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
@@ -832,5 +935,25 @@ func runManagedPipeline(ctx context.Context, tp *tokenProcessor, tm *tokenmanage
 	}
 
 	defer closing(savedMedia, jobErr)
-	return savedMedia, jobErr
+
+	// Don't score or announce a token whose job failed, since its media and metadata may be incomplete
+	if jobErr != nil {
+		return savedMedia, jobErr
+	}
+
+	name, description := findNameAndDescription(metadata)
+	if err := spam.ScoreTokenDefinition(ctx, tp.queries, td.ID, name, description); err != nil {
+		logger.For(ctx).Errorf("error scoring token for spam: %s", err)
+	}
+
+	err = tp.realtime.Publish(ctx, realtime.Message{
+		Kind:              realtime.KindTokenProcessed,
+		Channel:           realtime.TokenDefinitionChannel(td.ID),
+		TokenDefinitionID: td.ID,
+	})
+	if err != nil {
+		logger.For(ctx).Errorf("error publishing token processed message: %s", err)
+	}
+
+	return savedMedia, nil
 }
@@ -0,0 +1,96 @@
+package tokenprocessing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/media"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/rpc/arweave"
+	"github.com/mikeydub/go-gallery/service/rpc/ipfs"
+)
+
+// mediaSourceKey identifies the content that a token's media is made from, so that tokens that share the same content
+// (e.g. every token in an edition) can share the same media. An empty key is returned if any of the URLs aren't
+// content-addressed, because the content behind them could change or differ between tokens.
+func mediaSourceKey(imgURL, pfpURL media.ImageURL, animURL media.AnimationURL) string {
+	if imgURL == "" && pfpURL == "" && animURL == "" {
+		return ""
+	}
+
+	parts := make([]string, 0, 3)
+
+	for _, u := range []struct {
+		name string
+		url  string
+	}{
+		{"image", string(imgURL)},
+		{"pfp", string(pfpURL)},
+		{"animation", string(animURL)},
+	} {
+		if u.url == "" {
+			continue
+		}
+		key, ok := contentKeyFromURL(u.url)
+		if !ok {
+			return ""
+		}
+		parts = append(parts, u.name+"="+key)
+	}
+
+	h := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(h[:])
+}
+
+func contentKeyFromURL(u string) (string, bool) {
+	if path, ok := ipfs.ContentPathFrom(u); ok {
+		return "ipfs/" + path, true
+	}
+	if path, ok := arweave.TransactionPathFrom(u); ok {
+		return "ar/" + path, true
+	}
+	return "", false
+}
+
+// existingMedia returns active media that was already processed from the same source as the job's media
+func (tpj *tokenProcessingJob) existingMedia(ctx context.Context, metadata persist.TokenMetadata) (db.TokenMedia, bool) {
+	if tpj.sourceKey == "" {
+		return db.TokenMedia{}, false
+	}
+
+	// A refresh is a request to process the token again, so don't reuse what might be the reason for the refresh
+	if tpj.cause == persist.ProcessingCauseRefresh {
+		return db.TokenMedia{}, false
+	}
+
+	// Let the pipeline handle tokens that aren't ready yet
+	if tpj.requireFxHashSigned && !tpj.fxHashIsSignedF(metadata) {
+		return db.TokenMedia{}, false
+	}
+
+	traceCallback, ctx := persist.TrackStepStatus(ctx, &tpj.pipelineMetadata.ExistingMediaLookup, "ExistingMediaLookup")
+	defer traceCallback()
+
+	existing, err := tpj.tp.queries.GetActiveMediaBySourceKey(ctx, tpj.sourceKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.TokenMedia{}, false
+	}
+	if err != nil {
+		persist.FailStep(&tpj.pipelineMetadata.ExistingMediaLookup)
+		logger.For(ctx).Warnf("failed to lookup existing media: %s", err)
+		return db.TokenMedia{}, false
+	}
+
+	if !existing.Media.IsServable() {
+		return db.TokenMedia{}, false
+	}
+
+	return existing, true
+}
@@ -0,0 +1,53 @@
+package tokenprocessing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikeydub/go-gallery/service/media"
+)
+
+func TestMediaSourceKey(t *testing.T) {
+	const (
+		cidV0 = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+		cidV1 = "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"
+		txID  = "bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U"
+	)
+
+	key := func(img, pfp, anim string) string {
+		return mediaSourceKey(media.ImageURL(img), media.ImageURL(pfp), media.AnimationURL(anim))
+	}
+
+	t.Run("no urls have no key", func(t *testing.T) {
+		assert.Empty(t, key("", "", ""))
+	})
+
+	t.Run("urls that aren't content-addressed have no key", func(t *testing.T) {
+		assert.Empty(t, key("https://example.com/1.png", "", ""))
+		assert.Empty(t, key("ipfs://"+cidV0+"/1.png", "", "https://example.com/1.html"))
+	})
+
+	t.Run("the same content has the same key", func(t *testing.T) {
+		expected := key("ipfs://"+cidV0+"/1.png", "", "")
+		assert.NotEmpty(t, expected)
+		assert.Equal(t, expected, key("ipfs://"+cidV1+"/1.png", "", ""))
+		assert.Equal(t, expected, key("https://ipfs.io/ipfs/"+cidV0+"/1.png", "", ""))
+		assert.Equal(t, expected, key("https://"+cidV1+".ipfs.dweb.link/1.png", "", ""))
+	})
+
+	t.Run("arweave urls have a key", func(t *testing.T) {
+		assert.NotEmpty(t, key("ar://"+txID, "", ""))
+		assert.Equal(t, key("ar://"+txID, "", ""), key("https://arweave.net/"+txID, "", ""))
+	})
+
+	t.Run("different content has a different key", func(t *testing.T) {
+		assert.NotEqual(t, key("ipfs://"+cidV0+"/1.png", "", ""), key("ipfs://"+cidV0+"/2.png", "", ""))
+		assert.NotEqual(t, key("ipfs://"+cidV0+"?seed=1", "", ""), key("ipfs://"+cidV0+"?seed=2", "", ""))
+	})
+
+	t.Run("the same url in a different role has a different key", func(t *testing.T) {
+		assert.NotEqual(t, key("ipfs://"+cidV0, "", ""), key("", "", "ipfs://"+cidV0))
+		assert.NotEqual(t, key("ipfs://"+cidV0, "", ""), key("", "ipfs://"+cidV0, ""))
+	})
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/mikeydub/go-gallery/service/farcaster"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/multichain"
+	"github.com/mikeydub/go-gallery/service/multichain/solana"
 	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/realtime"
 	"github.com/mikeydub/go-gallery/service/redis"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/throttle"
@@ -54,9 +56,11 @@ func CoreInitServer(ctx context.Context, clients *server.Clients, mc *multichain
 
 	notificationsHandler := notifications.New(clients.Queries, clients.PubSubClient, clients.TaskClient, redis.NewLockClient(redis.NewCache(redis.NotificationLockCache)), false)
 
+	realtimeHub := realtime.New(clients.PubSubClient, false)
+
 	router.Use(func(c *gin.Context) {
 		var farcasterAPI *farcaster.NeynarAPI // nil because tokenprocessing doesn't require farcaster for event processing
-		event.AddTo(c, false, notificationsHandler, clients.Queries, clients.TaskClient, farcasterAPI)
+		event.AddTo(c, false, notificationsHandler, realtimeHub, clients.Queries, clients.TaskClient, farcasterAPI)
 	})
 
 	if env.GetString("ENV") != "production" {
@@ -81,7 +85,7 @@ func CoreInitServer(ctx context.Context, clients *server.Clients, mc *multichain
 		(*t).DisableKeepAlives = true
 	}
 
-	tp := NewTokenProcessor(clients.Queries, http.DefaultClient, &metadataFetcher, clients.IPFSClient, clients.ArweaveClient, clients.StorageClient, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"))
+	tp := NewTokenProcessor(clients.Queries, http.DefaultClient, &metadataFetcher, clients.IPFSClient, clients.ArweaveClient, clients.ObjectStore.Bucket(env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET")), realtimeHub)
 
 	return handlersInitServer(ctx, router, tp, mc, clients.Repos, t, clients.TaskClient, redis.NewCache(redis.TokenManageCache))
 }
@@ -95,6 +99,14 @@ func setDefaults() {
 	viper.SetDefault("ENV", "local")
 	viper.SetDefault("GCLOUD_TOKEN_LOGS_BUCKET", "dev-eth-token-logs")
 	viper.SetDefault("GCLOUD_TOKEN_CONTENT_BUCKET", "dev-token-content")
+	viper.SetDefault("STORAGE_BACKEND", "gcs")
+	viper.SetDefault("S3_ENDPOINT", "http://localhost:9000")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("S3_ACCESS_KEY_ID", "")
+	viper.SetDefault("S3_SECRET_ACCESS_KEY", "")
+	viper.SetDefault("S3_PUBLIC_URL", "")
+	viper.SetDefault("LOCAL_STORAGE_DIR", "")
+	viper.SetDefault("LOCAL_STORAGE_URL", "")
 	viper.SetDefault("POSTGRES_HOST", "0.0.0.0")
 	viper.SetDefault("POSTGRES_PORT", 5432)
 	viper.SetDefault("POSTGRES_USER", "gallery_backend")
@@ -110,6 +122,7 @@ func setDefaults() {
 	viper.SetDefault("ALCHEMY_POLYGON_API_URL", "")
 	viper.SetDefault("ALCHEMY_BASE_SEPOLIA_API_URL", "")
 	viper.SetDefault("POAP_API_KEY", "")
+	viper.SetDefault("SOLANA_RPC_URL", "")
 	viper.SetDefault("POAP_AUTH_TOKEN", "")
 	viper.SetDefault("TOKEN_PROCESSING_URL", "http://localhost:6500")
 	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
@@ -118,6 +131,7 @@ func setDefaults() {
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
 	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_REALTIME", "dev-realtime")
 	viper.SetDefault("PUBSUB_SUB_NEW_NOTIFICATIONS", "dev-new-notifications-sub")
 	viper.SetDefault("PUBSUB_SUB_UPDATED_NOTIFICATIONS", "dev-updated-notifications-sub")
 	viper.SetDefault("RASTERIZER_URL", "http://localhost:3000")
@@ -127,6 +141,13 @@ func setDefaults() {
 	viper.SetDefault("OPENSEA_WEBHOOK_SECRET", "")
 	viper.SetDefault("MINT_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/mint-processing")
 	viper.SetDefault("SIMPLEHASH_API_KEY", "")
+	viper.SetDefault("MULTICHAIN_PROVIDER_QUORUM", 1)
+	viper.SetDefault("MULTICHAIN_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("MULTICHAIN_FAILURE_COOLDOWN", "1m")
+	viper.SetDefault("MULTICHAIN_FULL_SYNC_INTERVAL", "168h")
+	viper.SetDefault("PUSH_NOTIFICATIONS_URL", "http://localhost:8000")
+	viper.SetDefault("PUSH_NOTIFICATIONS_SECRET", "push-notifications-secret")
+	viper.SetDefault("GCLOUD_WEBHOOKS_QUEUE", "projects/gallery-local/locations/here/queues/webhooks")
 
 	viper.AutomaticEnv()
 
@@ -206,6 +227,12 @@ func assetURL(chain persist.Chain, contractAddress persist.Address, tokenID pers
 		return fmt.Sprintf("https://opensea.io/assets/matic/%s/%d", contractAddress.String(), tokenID.ToInt())
 	case persist.ChainTezos:
 		return fmt.Sprintf("https://objkt.com/asset/%s/%d", contractAddress.String(), tokenID.ToInt())
+	case persist.ChainSolana:
+		mint, err := solana.MintFromTokenID(tokenID)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("https://solscan.io/token/%s", mint)
 	default:
 		return ""
 	}
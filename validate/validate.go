@@ -191,6 +191,10 @@ func ChainAddressValidator(sl validator.StructLevel) {
 		sl.ReportError(address, "Address", "Address", "required", "")
 	}
 
+	if chain == persist.ChainSolana && !address.IsValidBase58PublicKey() {
+		sl.ReportError(address, "Address", "Address", "valid_solana_address", "")
+	}
+
 	if chain < 0 || chain > persist.MaxChainValue {
 		sl.ReportError(chain, "Chain", "Chain", "valid_chain_type", "")
 	}